@@ -0,0 +1,95 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package cmd
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/spf13/cobra"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/config"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/blobstore"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/database"
+	log "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+)
+
+// NewBlobMigrateCmd ...
+func NewBlobMigrateCmd() *cobra.Command {
+	var cfgFile string
+	var from, to string
+	var batchSize int
+	var deleteSource bool
+
+	blobMigrateCmd := cobra.Command{
+		Use:   "blob-migrate",
+		Short: "migrate blobs(compliance report results) between blob storage backends.",
+		Run: func(cmd *cobra.Command, args []string) {
+			// 加载配置
+			cfg, err := config.Load(cfgFile)
+			if err != nil {
+				log.Fatalf("failed to load config: %s", err)
+			}
+			if cfg.MysqlConfig == nil {
+				log.Fatalf("mysql config not found, skip blob migrate...")
+			}
+			if batchSize <= 0 {
+				log.Fatalf("batch-size must be greater than 0")
+			}
+
+			database.InitDBClient(cfg.MysqlConfig, slog.Default())
+			repo.SetDefault(database.Client())
+
+			fromStore, err := blobstore.New(from, cfg.BlobStorage)
+			if err != nil {
+				log.Fatalf("failed to init source blob storage: %s", err)
+			}
+			toStore, err := blobstore.New(to, cfg.BlobStorage)
+			if err != nil {
+				log.Fatalf("failed to init target blob storage: %s", err)
+			}
+
+			stats, err := biz.MigrateComplianceReportBlobs(
+				context.Background(), fromStore, toStore, batchSize, deleteSource,
+			)
+			if err != nil {
+				log.Fatalf("failed to migrate blobs: %s", err)
+			}
+			if stats.Failed > 0 {
+				log.Fatalf("blob migrate finished with %d failures: %+v", stats.Failed, *stats)
+			}
+			log.Infof("blob migrate success: %+v", *stats)
+		},
+	}
+
+	// 配置文件路径，如果未指定，会从环境变量读取各项配置
+	blobMigrateCmd.Flags().StringVar(&cfgFile, "conf", "", "config file")
+	blobMigrateCmd.Flags().StringVar(&from, "from", blobstore.BackendDB, "source backend: db/s3")
+	blobMigrateCmd.Flags().StringVar(&to, "to", blobstore.BackendS3, "target backend: db/s3")
+	blobMigrateCmd.Flags().IntVar(&batchSize, "batch-size", 100, "reports per batch")
+	blobMigrateCmd.Flags().BoolVar(&deleteSource, "delete-source", false, "delete source blobs after verification")
+
+	return &blobMigrateCmd
+}
+
+func init() {
+	rootCmd.AddCommand(NewBlobMigrateCmd())
+}
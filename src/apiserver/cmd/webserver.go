@@ -25,19 +25,25 @@ import (
 	"os"
 	"os/signal"
 	"strconv"
+	"syscall"
 	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/config"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/blobstore"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/database"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/sentry"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/shutdown"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/storage"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/trace"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/router"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/goroutinex"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/schema"
 )
 
 // NewWebServerCmd ...
@@ -70,6 +76,44 @@ func NewWebServerCmd() *cobra.Command {
 			// 设置repo db
 			repo.SetDefault(database.Client())
 
+			// 配置了只读副本时启用读写分离
+			err = repo.EnableReadReplicas(database.Client(), database.ReplicaDialectors(cfg.MysqlConfig)...)
+			if err != nil {
+				logging.Fatalf("failed to enable read replicas: %s", err)
+			}
+
+			// 初始化大对象存储
+			if err = blobstore.Init(cfg.BlobStorage); err != nil {
+				logging.Fatalf("failed to init blob storage: %s", err)
+			}
+
+			// 校验规则级别覆盖，存在未知规则时拒绝启动
+			if err = schema.SetRuleSeverityOverrides(cfg.Biz.RuleSeverities); err != nil {
+				logging.Fatalf("failed to load rule severities: %s", err)
+			}
+
+			// 上游节点数告警阈值
+			if cfg.Biz.UpstreamNodesWarnThreshold != 0 {
+				schema.SetUpstreamNodesWarnThreshold(cfg.Biz.UpstreamNodesWarnThreshold)
+			}
+
+			// 每个资源保留的历史版本数
+			if cfg.Biz.ResourceRevisionRetention != 0 {
+				model.SetResourceRevisionRetention(cfg.Biz.ResourceRevisionRetention)
+			}
+
+			// 合规报告保留天数
+			if cfg.Biz.ComplianceReportRetentionDays != 0 {
+				biz.SetComplianceReportRetentionDays(cfg.Biz.ComplianceReportRetentionDays)
+			}
+
+			// 加载外部 schema 目录，目录变化后自动重新加载
+			if cfg.Biz.SchemaDir != "" {
+				if err = schema.WatchSchemaDir(context.Background(), cfg.Biz.SchemaDir); err != nil {
+					logging.Fatalf("failed to load schema dir: %s", err)
+				}
+			}
+
 			// 初始化 sentry
 			if err = sentry.Init(cfg.Sentry); err != nil {
 				logging.Warnf("failed to init sentry: %s", err)
@@ -91,25 +135,53 @@ func NewWebServerCmd() *cobra.Command {
 					logging.Fatalf("Start server failed: %s", err)
 				}
 			}()
-			baseCtx := context.Background()
+			baseCtx, stopBackground := context.WithCancel(context.Background())
 			// 启动同步
 			syncer := biz.NewSyncer(baseCtx)
 			goroutinex.GoroutineWithRecovery(baseCtx, func() {
 				syncer.Run()
 			})
 			biz.SyncAll(baseCtx, syncer.SystemItemChannel)
-			ctx, cancel := context.WithTimeout(
-				baseCtx, time.Duration(cfg.Service.Server.GraceTimeout)*time.Second,
-			)
-			defer cancel()
+			// 启动临时路由过期检查
+			goroutinex.GoroutineWithRecovery(baseCtx, func() {
+				biz.RunRouteExpiryChecker(baseCtx)
+			})
+			// 恢复上次退出时中断的发布任务
+			goroutinex.GoroutineWithRecovery(baseCtx, func() {
+				if err := biz.ResumeInterruptedPublishTasks(baseCtx); err != nil {
+					logging.Errorf("resume interrupted publish tasks failed: %s", err)
+				}
+			})
+
+			// 退出钩子按注册的逆序执行
+			manager := shutdown.Default()
+			manager.OnShutdown("sentry", func(ctx context.Context) error {
+				sentry.Flush(5 * time.Second)
+				return nil
+			})
+			manager.OnShutdown("trace", trace.Shutdown)
+			manager.OnShutdown("etcd", func(ctx context.Context) error {
+				return storage.CloseAll()
+			})
+			manager.OnShutdown("background", func(ctx context.Context) error {
+				stopBackground()
+				return nil
+			})
+			manager.OnShutdown("http", srv.Shutdown)
+			manager.OnShutdown("publish", biz.InterruptRunningPublishTasks)
+
 			// 等待中断信号以优雅地关闭服务器
 			quit := make(chan os.Signal, 1)
-			signal.Notify(quit, os.Interrupt)
+			signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
 			<-quit
 
 			logging.Infof("Shutdown server ...")
-			if err = srv.Shutdown(ctx); err != nil {
-				logging.Fatalf("Shutdown server failed: %s", err)
+			ctx, cancel := context.WithTimeout(
+				context.Background(), time.Duration(cfg.Service.Server.GraceTimeout)*time.Second,
+			)
+			defer cancel()
+			if err = manager.Shutdown(ctx, time.Duration(cfg.Service.Server.DrainTimeout)*time.Second); err != nil {
+				logging.Errorf("Shutdown server failed: %s", err)
 			}
 			logging.Infof("Server exiting")
 		},
@@ -0,0 +1,131 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package testsupport
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+)
+
+// complianceReportTimeout 等待合规报告生成的超时时间
+const complianceReportTimeout = 10 * time.Second
+
+// EtcdKey 资源在 etcd 中的标准 key: {网关前缀}/{资源类型}/{id}
+func EtcdKey(gateway *Gateway, resourceType constant.APISIXResource, id string) string {
+	return gateway.Prefix + "/" + constant.ResourceTypePrefixMap[resourceType] + "/" + id
+}
+
+// EtcdGet 读取 etcd 中 key 的值，key 不存在时返回 false
+func (h *Harness) EtcdGet(t testing.TB, key string) (string, bool) {
+	t.Helper()
+	resp, err := h.EtcdClient.Get(context.Background(), key)
+	require.NoError(t, err)
+	if len(resp.Kvs) == 0 {
+		return "", false
+	}
+	return string(resp.Kvs[0].Value), true
+}
+
+// EtcdPut 绕过 api 直接写 etcd，用于模拟外部修改
+func (h *Harness) EtcdPut(t testing.TB, key string, value string) {
+	t.Helper()
+	_, err := h.EtcdClient.Put(context.Background(), key, value)
+	require.NoError(t, err)
+}
+
+// EtcdDelete 绕过 api 直接删除 etcd 中的 key，用于模拟外部修改
+func (h *Harness) EtcdDelete(t testing.TB, key string) {
+	t.Helper()
+	_, err := h.EtcdClient.Delete(context.Background(), key)
+	require.NoError(t, err)
+}
+
+// ComplianceResults 合规检查结果
+type ComplianceResults []dto.ComplianceResourceResult
+
+// Find 查找资源的检查结果
+func (r ComplianceResults) Find(resourceType constant.APISIXResource, id string) (dto.ComplianceResourceResult, bool) {
+	for _, result := range r {
+		if result.ResourceType == resourceType && result.ResourceID == id {
+			return result, true
+		}
+	}
+	return dto.ComplianceResourceResult{}, false
+}
+
+// Findings 资源指定检查项的问题，无问题时为空
+func (r ComplianceResults) Findings(
+	resourceType constant.APISIXResource,
+	id string,
+	check constant.ComplianceCheck,
+) []dto.ComplianceFinding {
+	result, ok := r.Find(resourceType, id)
+	if !ok {
+		return nil
+	}
+	var findings []dto.ComplianceFinding
+	for _, finding := range result.Findings {
+		if finding.Check == check {
+			findings = append(findings, finding)
+		}
+	}
+	return findings
+}
+
+// Drift 资源的漂移问题，无漂移时为空
+func (r ComplianceResults) Drift(resourceType constant.APISIXResource, id string) []string {
+	var messages []string
+	for _, finding := range r.Findings(resourceType, id, constant.ComplianceCheckDrift) {
+		messages = append(messages, finding.Message)
+	}
+	return messages
+}
+
+// RunCompliance 通过 api 生成合规报告并等待完成
+func (h *Harness) RunCompliance(t testing.TB, gateway *Gateway) ComplianceResults {
+	t.Helper()
+	resp := h.Do(http.MethodPost, gateway.Path("/compliance_reports/"), nil)
+	require.Equal(t, http.StatusOK, resp.Code, resp.String())
+	reportID := resp.Data().Get("id").Int()
+
+	deadline := time.Now().Add(complianceReportTimeout)
+	for time.Now().Before(deadline) {
+		resp = h.Do(http.MethodGet, gateway.Path("/compliance_reports/%d/", reportID), nil)
+		require.Equal(t, http.StatusOK, resp.Code, resp.String())
+		switch constant.ComplianceReportStatus(resp.Data().Get("status").String()) {
+		case constant.ComplianceReportStatusSuccess:
+			var results ComplianceResults
+			require.NoError(t, json.Unmarshal([]byte(resp.Data().Get("result").Raw), &results))
+			return results
+		case constant.ComplianceReportStatusFailed:
+			require.FailNow(t, "compliance report failed", resp.Data().Get("message").String())
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	require.FailNow(t, "compliance report timeout")
+	return nil
+}
@@ -0,0 +1,157 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package testsupport 提供端到端测试脚手架：启动完整的 web 路由、SQLite 数据库与 embedded etcd，
+// 通过真实的 HTTP 请求操作资源，并断言 etcd 中的数据与漂移检查结果
+package testsupport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tidwall/gjson"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/server/v3/embed"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/web"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/config"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/middleware"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/cryptography"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/validation"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/util"
+)
+
+const (
+	// DefaultUser 请求默认使用的用户，创建网关时会成为网关维护者
+	DefaultUser = "admin"
+
+	apiPrefix = "/api/v1/web"
+)
+
+// Harness 端到端测试脚手架
+type Harness struct {
+	// Router 完整的 web 路由，不包含 session、csrf 与登录认证中间件
+	Router *gin.Engine
+	// EtcdClient 直连 embedded etcd 的客户端，key 不带网关前缀
+	EtcdClient *clientv3.Client
+	// EtcdEndpoint embedded etcd 地址
+	EtcdEndpoint string
+	// User 请求使用的用户
+	User string
+
+	etcdServer *embed.Etcd
+	gatewaySeq atomic.Int64
+}
+
+// New 初始化加密组件、内存数据库与 embedded etcd，并注册 web 路由
+func New() (*Harness, error) {
+	if err := cryptography.Init("jxi18GX5w2qgHwfZCFpn07q8FScXJOd3", "k2dbCGetyusW"); err != nil {
+		return nil, err
+	}
+	if config.G == nil {
+		config.G = &config.Config{}
+	}
+	util.InitEmbedDb()
+	client, server, err := util.StartEmbedEtcdClientOnFreePort(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	validation.RegisterValidator()
+	gin.SetMode(gin.TestMode)
+	h := &Harness{
+		EtcdClient:   client,
+		EtcdEndpoint: "http://" + server.Clients[0].Addr().String(),
+		User:         DefaultUser,
+		etcdServer:   server,
+	}
+	router := gin.New()
+	router.Use(middleware.Recovery())
+	router.Use(middleware.RequestID())
+	group := router.Group(apiPrefix)
+	group.Use(func(c *gin.Context) {
+		ginx.SetUserID(c, h.User)
+		c.Next()
+	})
+	web.RegisterWebRoutes(group)
+	h.Router = router
+	return h, nil
+}
+
+// Close 关闭 embedded etcd 并清理数据目录
+func (h *Harness) Close() {
+	_ = h.EtcdClient.Close()
+	h.etcdServer.Close()
+	_ = os.RemoveAll(h.etcdServer.Config().Dir)
+}
+
+// Response HTTP 响应
+type Response struct {
+	Code   int
+	Header http.Header
+	Body   []byte
+}
+
+// JSON 按 gjson 路径读取响应体
+func (r *Response) JSON(path string) gjson.Result {
+	return gjson.GetBytes(r.Body, path)
+}
+
+// Data 读取响应体中的 data 字段
+func (r *Response) Data() gjson.Result {
+	return r.JSON("data")
+}
+
+// String ...
+func (r *Response) String() string {
+	return fmt.Sprintf("%d %s", r.Code, r.Body)
+}
+
+// Do 发送请求，path 为 /api/v1/web 之后的部分；body 为 []byte/string 时原样发送，否则序列化为 json
+func (h *Harness) Do(method string, path string, body any) *Response {
+	var reader *bytes.Reader
+	switch b := body.(type) {
+	case nil:
+		reader = bytes.NewReader(nil)
+	case []byte:
+		reader = bytes.NewReader(b)
+	case string:
+		reader = bytes.NewReader([]byte(b))
+	default:
+		raw, err := json.Marshal(b)
+		if err != nil {
+			panic(err)
+		}
+		reader = bytes.NewReader(raw)
+	}
+	req := httptest.NewRequest(method, apiPrefix+path, reader)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	w := httptest.NewRecorder()
+	h.Router.ServeHTTP(w, req)
+	return &Response{Code: w.Code, Header: w.Header(), Body: w.Body.Bytes()}
+}
@@ -0,0 +1,204 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package testsupport
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+// resourcePathMap 资源类型对应的 web api 路径
+var resourcePathMap = map[constant.APISIXResource]string{
+	constant.Route:          "routes",
+	constant.Service:        "services",
+	constant.Upstream:       "upstreams",
+	constant.PluginConfig:   "plugin_configs",
+	constant.PluginMetadata: "plugin_metadatas",
+	constant.Consumer:       "consumers",
+	constant.ConsumerGroup:  "consumer_groups",
+	constant.GlobalRule:     "global_rules",
+	constant.Proto:          "protos",
+	constant.SSL:            "ssls",
+	constant.StreamRoute:    "stream_routes",
+	constant.Secret:         "secrets",
+}
+
+// Gateway 测试网关
+type Gateway struct {
+	ID     int
+	Name   string
+	Prefix string
+}
+
+// Path 网关下的 api 路径
+func (g *Gateway) Path(format string, args ...any) string {
+	return fmt.Sprintf("/gateways/%d", g.ID) + fmt.Sprintf(format, args...)
+}
+
+// CreateGateway 通过 api 创建网关，每个网关使用独立的 etcd 前缀
+func (h *Harness) CreateGateway(t testing.TB) *Gateway {
+	t.Helper()
+	gateway := h.newGateway()
+	resp := h.Do(http.MethodPost, "/gateways/", h.GatewayBody(gateway))
+	require.Equal(t, http.StatusCreated, resp.Code, resp.String())
+
+	resp = h.Do(http.MethodGet, "/gateways/", nil)
+	require.Equal(t, http.StatusOK, resp.Code, resp.String())
+	for _, item := range resp.Data().Array() {
+		if item.Get("name").String() == gateway.Name {
+			gateway.ID = int(item.Get("id").Int())
+		}
+	}
+	require.NotZero(t, gateway.ID, "gateway %s not found after create", gateway.Name)
+	return gateway
+}
+
+// OnboardGateway 通过接入引导 api 创建网关，网关处于 onboarding 状态
+func (h *Harness) OnboardGateway(t testing.TB) *Gateway {
+	t.Helper()
+	gateway := h.newGateway()
+	resp := h.Do(http.MethodPost, "/gateways/onboarding/", h.GatewayBody(gateway))
+	require.Equal(t, http.StatusOK, resp.Code, resp.String())
+	gateway.ID = int(resp.Data().Get("gateway_id").Int())
+	require.NotZero(t, gateway.ID, resp.String())
+	return gateway
+}
+
+// GatewayBody 网关创建、更新的请求参数
+func (h *Harness) GatewayBody(gateway *Gateway) map[string]any {
+	return map[string]any{
+		"name":             gateway.Name,
+		"mode":             1,
+		"maintainers":      []string{h.User},
+		"apisix_version":   "3.11.0",
+		"apisix_type":      constant.APISIXTypeAPISIX,
+		"etcd_endpoints":   []string{h.EtcdEndpoint},
+		"etcd_schema_type": "http",
+		"etcd_prefix":      gateway.Prefix,
+		"etcd_username":    "test",
+		"etcd_password":    "test",
+	}
+}
+
+// newGateway 生成名称与 etcd 前缀唯一的网关
+func (h *Harness) newGateway() *Gateway {
+	seq := h.gatewaySeq.Add(1)
+	return &Gateway{
+		Name:   fmt.Sprintf("e2e-gateway-%d", seq),
+		Prefix: fmt.Sprintf("/e2e-%d", seq),
+	}
+}
+
+// ResourcePath 资源的 api 路径，id 为空时为列表路径
+func (h *Harness) ResourcePath(gateway *Gateway, resourceType constant.APISIXResource, id string) string {
+	if id == "" {
+		return gateway.Path("/%s/", resourcePathMap[resourceType])
+	}
+	return gateway.Path("/%s/%s/", resourcePathMap[resourceType], id)
+}
+
+// CreateResource 通过 api 创建资源并返回资源 id，body 中必须包含 name
+func (h *Harness) CreateResource(
+	t testing.TB,
+	gateway *Gateway,
+	resourceType constant.APISIXResource,
+	body map[string]any,
+) string {
+	t.Helper()
+	resp := h.Do(http.MethodPost, h.ResourcePath(gateway, resourceType, ""), body)
+	require.Equal(t, http.StatusCreated, resp.Code, resp.String())
+	name, _ := body["name"].(string)
+	id := h.FindResourceID(t, gateway, resourceType, name)
+	require.NotEmpty(t, id, "%s %s not found after create", resourceType, name)
+	return id
+}
+
+// FindResourceID 按名称查找资源 id，不存在时返回空
+func (h *Harness) FindResourceID(
+	t testing.TB,
+	gateway *Gateway,
+	resourceType constant.APISIXResource,
+	name string,
+) string {
+	t.Helper()
+	path := h.ResourcePath(gateway, resourceType, "") + "?limit=100&name=" + url.QueryEscape(name)
+	resp := h.Do(http.MethodGet, path, nil)
+	require.Equal(t, http.StatusOK, resp.Code, resp.String())
+	for _, item := range resp.Data().Get("results").Array() {
+		if item.Get("name").String() == name {
+			return item.Get("id").String()
+		}
+	}
+	return ""
+}
+
+// GetResource 通过 api 获取资源详情，资源不存在时返回 false
+func (h *Harness) GetResource(
+	t testing.TB,
+	gateway *Gateway,
+	resourceType constant.APISIXResource,
+	id string,
+) (gjson.Result, bool) {
+	t.Helper()
+	resp := h.Do(http.MethodGet, h.ResourcePath(gateway, resourceType, id), nil)
+	if resp.Code != http.StatusOK {
+		return gjson.Result{}, false
+	}
+	return resp.Data(), true
+}
+
+// ResourceStatus 资源在编辑区的发布状态
+func (h *Harness) ResourceStatus(
+	t testing.TB,
+	gateway *Gateway,
+	resourceType constant.APISIXResource,
+	id string,
+) constant.ResourceStatus {
+	t.Helper()
+	data, ok := h.GetResource(t, gateway, resourceType, id)
+	require.True(t, ok, "%s %s not found", resourceType, id)
+	return constant.ResourceStatus(data.Get("status").String())
+}
+
+// Publish 发布资源，返回响应由调用方断言
+func (h *Harness) Publish(gateway *Gateway, resourceType constant.APISIXResource, ids ...string) *Response {
+	return h.Do(http.MethodPost, gateway.Path("/publish/"), map[string]any{
+		"resource_type":    resourceType,
+		"resource_id_list": ids,
+	})
+}
+
+// MustPublish 发布资源并断言成功
+func (h *Harness) MustPublish(
+	t testing.TB,
+	gateway *Gateway,
+	resourceType constant.APISIXResource,
+	ids ...string,
+) {
+	t.Helper()
+	resp := h.Publish(gateway, resourceType, ids...)
+	require.Equal(t, http.StatusCreated, resp.Code, resp.String())
+}
@@ -38,11 +38,15 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
 
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/basic/serializer"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/version"
 )
 
@@ -70,6 +74,45 @@ func Healthz(c *gin.Context) {
 	c.JSON(http.StatusOK, serializer.HealthResponse{Healthy: true})
 }
 
+// EtcdHealthz ...
+//
+//	@Summary	检查网关 etcd 的连通性
+//	@Description	分别连接网关 etcd 的每个节点，使用网关配置的账号发起一次读请求并记录往返耗时；
+//	@Description	全部网关的 etcd 多数节点检查通过时返回 200，否则返回 503
+//	@Tags		basic
+//	@Param		token		query		string	true	"healthz api token"
+//	@Param		gateway_id	query		int		false	"网关 ID，不传则检查全部网关"
+//	@Success	200			{object}	serializer.EtcdHealthResponse
+//	@Router		/healthz/etcd [get]
+func EtcdHealthz(c *gin.Context) {
+	var req serializer.EtcdHealthRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	gateways, err := biz.CheckGatewaysEtcdHealth(c.Request.Context(), req.GatewayID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ginx.NotFoundJSONResponse(c, err)
+			return
+		}
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	resp := serializer.EtcdHealthResponse{Healthy: true, Gateways: gateways}
+	for _, gateway := range gateways {
+		if !gateway.Healthy {
+			resp.Healthy = false
+		}
+	}
+	if !resp.Healthy {
+		ginx.BaseErrorJSONResponseWithData(
+			c, ginx.ServiceUnavailable, "网关 etcd 多数节点不可达", http.StatusServiceUnavailable, resp)
+		return
+	}
+	ginx.SuccessJSONResponse(c, resp)
+}
+
 // Version ...
 //
 //	@Summary	服务版本信息
@@ -41,6 +41,7 @@ func Register(router *gin.Engine) {
 	healthzRouter := router.Group("/healthz")
 	healthzRouter.Use(middleware.QueryTokenAuth(config.G.Service.HealthzToken))
 	healthzRouter.GET("", handler.Healthz)
+	healthzRouter.GET("/etcd", handler.EtcdHealthz)
 	// metrics
 	metricRouter := router.Group("/metrics")
 	metricRouter.Use(middleware.QueryTokenAuth(config.G.Service.MetricToken))
@@ -19,11 +19,24 @@
 // Package serializer ...
 package serializer
 
+import "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+
 // HealthResponse ...
 type HealthResponse struct {
 	Healthy bool `json:"healthy"`
 }
 
+// EtcdHealthRequest ...
+type EtcdHealthRequest struct {
+	GatewayID int `json:"gateway_id" form:"gateway_id"` // 只检查指定网关，不传则检查全部网关
+}
+
+// EtcdHealthResponse ...
+type EtcdHealthResponse struct {
+	Healthy  bool                    `json:"healthy"` // 全部网关的 etcd 多数节点均可访问且鉴权通过
+	Gateways []dto.GatewayEtcdHealth `json:"gateways"`
+}
+
 // VersionResponse ...
 type VersionResponse struct {
 	Version   string `json:"version"`
@@ -0,0 +1,214 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/tidwall/gjson"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+)
+
+// CredentialsSection 导出文件中单独存放 consumer 凭证字段及 credential 的段
+const CredentialsSection constant.APISIXResource = "consumer_credentials"
+
+// CheckImportCredentials 检查待导入 consumer 的凭证，返回需要生成凭证及需要明确是否覆盖的 consumer，不修改待导入资源
+func CheckImportCredentials(
+	ctx context.Context,
+	uploadInfo *ResourceUploadInfo,
+) (*dto.ConsumerCredentialImportReport, error) {
+	return resolveImportCredentials(ctx, uploadInfo, false)
+}
+
+// ApplyImportCredentials 将凭证段回填到待导入的 consumer 并加入待导入的 credential：
+// 未携带凭证时保留网关中已有的凭证，仍没有凭证的字段保持占位值，需生成凭证后才能发布；
+// 凭证与网关中已有的不一致时按 CredentialOverwrite 覆盖或保留，未明确指定时返回错误
+func ApplyImportCredentials(
+	ctx context.Context,
+	uploadInfo *ResourceUploadInfo,
+) (*dto.ConsumerCredentialImportReport, error) {
+	report, err := resolveImportCredentials(ctx, uploadInfo, true)
+	if err != nil {
+		return nil, err
+	}
+	if len(report.Conflicts) > 0 {
+		ids := make([]string, 0, len(report.Conflicts))
+		for _, conflict := range report.Conflicts {
+			ids = append(ids, conflict.ConsumerID)
+		}
+		return report, fmt.Errorf("consumer %s 的凭证与网关中已有凭证不一致，请通过 credential_overwrite 指定是否覆盖",
+			strings.Join(ids, ", "))
+	}
+	return report, nil
+}
+
+func resolveImportCredentials(
+	ctx context.Context,
+	uploadInfo *ResourceUploadInfo,
+	apply bool,
+) (*dto.ConsumerCredentialImportReport, error) {
+	consumerCredentials := make(map[string]json.RawMessage)
+	var credentials []ResourceInfo
+	for _, info := range uploadInfo.Credentials {
+		switch info.ResourceType {
+		case constant.Consumer:
+			consumerCredentials[info.ResourceID] = info.Config
+		case constant.Credential:
+			if info.ConsumerID == "" {
+				return nil, fmt.Errorf("credential %s 缺少所属 consumer", info.ResourceID)
+			}
+			credentials = append(credentials, info)
+		default:
+			return nil, fmt.Errorf("凭证段不支持资源类型 %s", info.ResourceType)
+		}
+	}
+	existingConsumers, err := resourceConfigMap(ctx, constant.Consumer)
+	if err != nil {
+		return nil, err
+	}
+	existingCredentials, err := resourceConfigMap(ctx, constant.Credential)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &dto.ConsumerCredentialImportReport{
+		PendingGeneration: []dto.ConsumerCredentialItem{},
+		Conflicts:         []dto.ConsumerCredentialItem{},
+	}
+	consumerNames := make(map[string]string)
+	for id, config := range existingConsumers {
+		consumerNames[id] = gjson.GetBytes(config, model.GetResourceNameKey(constant.Consumer)).String()
+	}
+	conflicts := make(map[string][]string)
+	for _, resources := range []map[constant.APISIXResource][]ResourceInfo{uploadInfo.Add, uploadInfo.Update} {
+		consumers := resources[constant.Consumer]
+		for i := range consumers {
+			consumer := &consumers[i]
+			consumerNames[consumer.ResourceID] = gjson.GetBytes(
+				consumer.Config, model.GetResourceNameKey(constant.Consumer)).String()
+			imported := consumerCredentials[consumer.ResourceID]
+			var existing json.RawMessage
+			if origin, ok := existingConsumers[consumer.ResourceID]; ok {
+				_, existing = biz.SplitConsumerCredentials(origin)
+			}
+			if imported != nil && existing != nil {
+				if fields := biz.DiffCredentialPaths(imported, existing); len(fields) > 0 {
+					overwrite, decided := uploadInfo.CredentialOverwrite[consumer.ResourceID]
+					if !decided {
+						conflicts[consumer.ResourceID] = append(conflicts[consumer.ResourceID], fields...)
+					}
+					if !overwrite {
+						imported = existing
+					}
+				}
+			}
+			if imported == nil {
+				imported = existing
+			}
+			config := biz.MergeConsumerCredentials(consumer.Config, imported)
+			if pending := biz.PendingCredentialPaths(config); len(pending) > 0 {
+				report.PendingGeneration = append(report.PendingGeneration, dto.ConsumerCredentialItem{
+					ConsumerID: consumer.ResourceID,
+					Name:       consumerNames[consumer.ResourceID],
+					Fields:     pending,
+				})
+			}
+			if apply {
+				consumer.Config = config
+			}
+		}
+	}
+
+	for _, credential := range credentials {
+		status := constant.UploadStatusAdd
+		if origin, ok := existingCredentials[credential.ResourceID]; ok {
+			if len(biz.DiffCredentialPaths(credential.Config, origin)) == 0 {
+				continue
+			}
+			overwrite, decided := uploadInfo.CredentialOverwrite[credential.ConsumerID]
+			if !decided {
+				conflicts[credential.ConsumerID] = append(conflicts[credential.ConsumerID],
+					constant.CredentialDir+"/"+credential.ResourceID)
+			}
+			if !overwrite {
+				continue
+			}
+			status = constant.UploadStatusUpdate
+		}
+		if !apply {
+			continue
+		}
+		credential.Status = status
+		if status == constant.UploadStatusAdd {
+			uploadInfo.Add = appendResourceInfo(uploadInfo.Add, credential)
+		} else {
+			uploadInfo.Update = appendResourceInfo(uploadInfo.Update, credential)
+		}
+	}
+
+	for consumerID, fields := range conflicts {
+		report.Conflicts = append(report.Conflicts, dto.ConsumerCredentialItem{
+			ConsumerID: consumerID,
+			Name:       consumerNames[consumerID],
+			Fields:     fields,
+		})
+	}
+	sort.Slice(report.Conflicts, func(i, j int) bool {
+		return report.Conflicts[i].ConsumerID < report.Conflicts[j].ConsumerID
+	})
+	if apply {
+		uploadInfo.Credentials = nil
+	}
+	return report, nil
+}
+
+// resourceConfigMap 查询网关下某类资源的 ID 与配置
+func resourceConfigMap(
+	ctx context.Context,
+	resourceType constant.APISIXResource,
+) (map[string]json.RawMessage, error) {
+	resources, err := biz.BatchGetResources(ctx, resourceType, []string{})
+	if err != nil {
+		return nil, err
+	}
+	configs := make(map[string]json.RawMessage, len(resources))
+	for _, resource := range resources {
+		configs[resource.ID] = json.RawMessage(resource.Config)
+	}
+	return configs, nil
+}
+
+func appendResourceInfo(
+	resources map[constant.APISIXResource][]ResourceInfo,
+	info ResourceInfo,
+) map[constant.APISIXResource][]ResourceInfo {
+	if resources == nil {
+		resources = make(map[constant.APISIXResource][]ResourceInfo)
+	}
+	resources[info.ResourceType] = append(resources[info.ResourceType], info)
+	return resources
+}
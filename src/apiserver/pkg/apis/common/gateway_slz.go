@@ -21,13 +21,10 @@ package common
 
 import (
 	"context"
-	"fmt"
 	"strings"
 	"time"
 
 	validator "github.com/go-playground/validator/v10"
-	"github.com/pkg/errors"
-	"github.com/tidwall/gjson"
 
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
@@ -54,6 +51,10 @@ type GatewayInputInfo struct {
 	APISIXType string `json:"apisix_type" binding:"required,apisixType" enums:"apisix,tapisix,bk-apisix"`
 
 	ReadOnly bool `json:"read_only"` // 是否只读
+	// 是否允许路由 vars 使用内置变量表之外的自定义变量名
+	AllowCustomVars bool `json:"allow_custom_vars"`
+	// chash 上游重试次数超过节点数时的校验模式：disabled-不校验 lenient-按规则级别处理(默认告警) strict-报错
+	RetriesCheck constant.RetriesCheckMode `json:"retries_check" binding:"omitempty,oneof=disabled lenient strict"`
 	// etcd配置
 	EtcdConfig
 }
@@ -73,6 +74,14 @@ type GatewayOutputInfo struct {
 	UpdatedAt   int64    `json:"updated_at"`
 	Creator     string   `json:"creator"`
 	Updater     string   `json:"updater"`
+	// 是否允许路由 vars 使用自定义变量名
+	AllowCustomVars bool `json:"allow_custom_vars"`
+	// chash 上游重试次数超过节点数时的校验模式
+	RetriesCheck constant.RetriesCheckMode `json:"retries_check" enums:"disabled,lenient,strict"`
+	// 网关状态：onboarding-接入引导中 active-已启用
+	Status constant.GatewayStatus `json:"status" enums:"onboarding,active"`
+	// etcd 状态：normal-正常 auth_failed-鉴权失效且重新认证失败
+	EtcdStatus constant.GatewayEtcdStatus `json:"etcd_status" enums:"normal,auth_failed"`
 }
 
 // APISIX ...
@@ -216,62 +225,12 @@ func CheckEtcdConnAndAPISIXInstance(gatewayID int, etcdConf EtcdConfig) (string,
 	defer etcdStore.GetClient().Close()
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
-	res, err := etcdStore.List(ctx, fmt.Sprintf("%s/data_plane/server_info", etcdStoreConfig.Prefix))
-	if err != nil && !errors.Is(err, storage.KeyNotFoundError) {
-		return "", "", err
-	}
-	var apisixVersion, instanceID string
-	if len(res) > 0 {
-		resData := gjson.Parse(res[0].Value)
-		instanceID = resData.Get("id").String()
-		apisixVersion = resData.Get("version").String()
-	}
-	// 校验实例id是否存在
-	if instanceID != "" {
-		gateways, err := biz.GetGatewayEtcdConfigList(ctx, "instance_id", instanceID)
-		if err != nil {
-			return "", "", err
-		}
-		// 排除自己
-		if len(gateways) > 0 && (gatewayID != 0 && gateways[0].ID != gatewayID) {
-			return "", "", fmt.Errorf(
-				"网关 apisix 实例[%s] 已在另一个网关 [%s] 中注册, 无法重复注册托管, 请联系该网关负责人 [%s] 添加权限", instanceID,
-				gateways[0].Name, strings.Join(gateways[0].Maintainers, ","))
-		}
-	}
-
-	// 校验 prefix相同的网关
-	gateways, err := biz.GetGatewayEtcdConfigList(ctx, "prefix", etcdStoreConfig.Prefix)
+	apisixVersion, instanceID, err := biz.GetAPISIXServerInfo(ctx, etcdStore, etcdStoreConfig.Prefix)
 	if err != nil {
 		return "", "", err
 	}
-	for _, gateway := range gateways {
-		// 编辑网关时校验去重需要排除自己
-		if gatewayID != 0 && gatewayID == gateway.ID {
-			continue
-		}
-		endpoints := gateway.EtcdConfig.Endpoint.Endpoints()
-		for _, storeEndpoint := range etcdStoreConfig.Endpoint.Endpoints() {
-			for _, endpoint := range endpoints {
-				// 校验 endpoint 是否存在
-				cleanStoreEndpoint := strings.TrimPrefix(
-					strings.TrimPrefix(storeEndpoint, constant.HTTP),
-					constant.HTTPS,
-				)
-				cleanEndpoint := strings.TrimPrefix(
-					strings.TrimPrefix(endpoint, constant.HTTP),
-					constant.HTTPS,
-				)
-				if storeEndpoint != "" && endpoint != "" && cleanStoreEndpoint == cleanEndpoint {
-					err = fmt.Errorf(
-						"etcd 地址[%s] 已在另一个网关 [%s] 中存在, 请勿重复提交",
-						endpoint,
-						gateway.Name,
-					)
-					return "", "", err
-				}
-			}
-		}
+	if err := biz.CheckGatewayEtcdConflict(ctx, gatewayID, etcdStoreConfig, instanceID); err != nil {
+		return "", "", err
 	}
 	return apisixVersion, instanceID, nil
 }
@@ -288,7 +247,11 @@ func GatewayToOutputInfo(gatewayInfo *model.Gateway) GatewayOutputInfo {
 			Version: gatewayInfo.APISIXVersion,
 			Type:    gatewayInfo.APISIXType,
 		},
-		ReadOnly: gatewayInfo.ReadOnly,
+		ReadOnly:        gatewayInfo.ReadOnly,
+		AllowCustomVars: gatewayInfo.AllowCustomVars,
+		RetriesCheck:    gatewayInfo.RetriesCheck,
+		Status:          gatewayInfo.Status,
+		EtcdStatus:      gatewayInfo.EtcdStatus,
 		Etcd: EtcdInfo{
 			InstanceID: gatewayInfo.EtcdConfig.InstanceID,
 			EndPoints:  gatewayInfo.EtcdConfig.Endpoint.Endpoints(),
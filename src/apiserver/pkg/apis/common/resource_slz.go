@@ -29,23 +29,48 @@ import (
 
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/schema"
 )
 
 // ResourceInfo ...
 type ResourceInfo struct {
-	ResourceType constant.APISIXResource `json:"resource_type,omitempty"`               // 资源类型
-	ResourceID   string                  `json:"resource_id,omitempty"`                 // 资源ID
-	Name         string                  `json:"name,omitempty"`                        // 资源名称
-	Config       json.RawMessage         `json:"config,omitempty" swaggertype:"object"` // 资源配置
-	Status       constant.UploadStatus   `json:"status,omitempty"`                      // 资源导入状态(add/update)
+	ResourceType   constant.APISIXResource `json:"resource_type,omitempty"`               // 资源类型
+	ResourceID     string                  `json:"resource_id,omitempty"`                 // 资源ID
+	Name           string                  `json:"name,omitempty"`                        // 资源名称
+	Config         json.RawMessage         `json:"config,omitempty" swaggertype:"object"` // 资源配置
+	Status         constant.UploadStatus   `json:"status,omitempty"`                      // 资源导入状态(add/update)
+	Normalizations []string                `json:"normalizations,omitempty"`              // 导入时所做的规范化(如 methods 转大写)
+	ConsumerID     string                  `json:"consumer_id,omitempty"`                 // credential 所属 consumer 的 ID
 }
 
 // ResourceUploadInfo ...
 type ResourceUploadInfo struct {
 	Add    map[constant.APISIXResource][]ResourceInfo `json:"add,omitempty"`
 	Update map[constant.APISIXResource][]ResourceInfo `json:"update,omitempty"`
+	// 单独导出的 consumer 凭证字段及 credential，导入时回填到对应的 consumer
+	Credentials []ResourceInfo `json:"credentials,omitempty"`
+	// consumer ID: 导入的凭证与网关中已有凭证不一致时是否覆盖，存在冲突的 consumer 必须明确指定
+	CredentialOverwrite map[string]bool `json:"credential_overwrite,omitempty"`
+	// 凭证导入报告，仅在解析结果中返回
+	CredentialReport *dto.ConsumerCredentialImportReport `json:"credential_report,omitempty"`
+}
+
+// NormalizeImportResources 规范化待导入资源的配置，并记录每个资源所做的规范化
+func NormalizeImportResources(importDataList map[constant.APISIXResource][]ResourceInfo) error {
+	for resourceType, impList := range importDataList {
+		for i := range impList {
+			config, normalizations, err := schema.NormalizeImportConfig(resourceType, impList[i].Config)
+			if err != nil {
+				return fmt.Errorf("资源: %s %w", impList[i].ResourceID, err)
+			}
+			impList[i].Config = config
+			impList[i].Normalizations = append(impList[i].Normalizations, normalizations...)
+		}
+	}
+	return nil
 }
 
 // ClassifyImportResourceInfo 分类合并导入资源信息
@@ -87,6 +112,12 @@ func HandleImportResources(
 	ctx context.Context,
 	resourcesImport *ResourceUploadInfo,
 ) (map[constant.APISIXResource][]*model.GatewaySyncData, map[constant.APISIXResource][]*model.GatewaySyncData, error) {
+	if err := NormalizeImportResources(resourcesImport.Add); err != nil {
+		return nil, nil, err
+	}
+	if err := NormalizeImportResources(resourcesImport.Update); err != nil {
+		return nil, nil, err
+	}
 	// 分类聚合
 	allResourceIdMap := make(map[string]struct{})
 	resourceTypeAddMap, err := handleResources(ctx, resourcesImport.Add, allResourceIdMap)
@@ -127,6 +158,11 @@ func handleResources(
 				Config:    datatypes.JSON(imp.Config),
 				GatewayID: ginx.GetGatewayInfoFromContext(ctx).ID,
 			}
+			// credential 通过 etcd key 关联所属 consumer
+			if resourceType == constant.Credential && imp.ConsumerID != "" {
+				resourceImp.EtcdKeyOverride = model.CredentialEtcdKey(
+					&model.Consumer{ResourceCommonModel: model.ResourceCommonModel{ID: imp.ConsumerID}}, imp.ResourceID)
+			}
 			if _, ok := resourceTypeMap[imp.ResourceType]; !ok {
 				resourceTypeMap[resourceType] = []*model.GatewaySyncData{resourceImp}
 				continue
@@ -88,7 +88,9 @@ func GatewayCreate(c *gin.Context) {
 				CertKey:  req.EtcdCertKey,
 			},
 		},
-		Token: token,
+		Token:           token,
+		AllowCustomVars: req.AllowCustomVars,
+		RetriesCheck:    req.RetriesCheck,
 		BaseModel: model.BaseModel{
 			Creator: ginx.GetUserID(c),
 			Updater: ginx.GetUserID(c),
@@ -174,7 +176,9 @@ func GatewayUpdate(c *gin.Context) {
 			},
 			InstanceID: instanceID,
 		},
-		Token: ginx.GetGatewayInfo(c).Token,
+		Token:           ginx.GetGatewayInfo(c).Token,
+		AllowCustomVars: req.AllowCustomVars,
+		RetriesCheck:    req.RetriesCheck,
 		BaseModel: model.BaseModel{
 			Updater: ginx.GetUserID(c),
 		},
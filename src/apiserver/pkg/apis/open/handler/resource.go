@@ -367,6 +367,8 @@ func ResourceImport(c *gin.Context) {
 		ginx.SystemErrorJSONResponse(c, err)
 		return
 	}
+	credentials := resourceInfoTypeMap[common.CredentialsSection]
+	delete(resourceInfoTypeMap, common.CredentialsSection)
 	existsResourceIdList := make(map[string]struct{})
 	for resourceType := range resourceInfoTypeMap {
 		dbResources, err := biz.BatchGetResources(c.Request.Context(), resourceType, []string{})
@@ -383,6 +385,13 @@ func ResourceImport(c *gin.Context) {
 		ginx.SystemErrorJSONResponse(c, err)
 		return
 	}
+	// 凭证冲突时无法指定是否覆盖，需通过 web 导入处理
+	uploadInfo.Credentials = credentials
+	uploadInfo.CredentialReport, err = common.ApplyImportCredentials(c.Request.Context(), uploadInfo)
+	if err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
 	addResourcesMap, updateResourcesMap, err := common.HandleImportResources(c.Request.Context(), uploadInfo)
 	if err != nil {
 		ginx.SystemErrorJSONResponse(c, err)
@@ -23,6 +23,7 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/open/handler"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/config"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/middleware"
 )
 
@@ -32,6 +33,13 @@ func RegisterOpenApi(path string, router *gin.RouterGroup) {
 	// gateway
 	gatewayGroup := group.Group("/gateways/")
 	gatewayGroup.Use(middleware.OpenAPIAccess())
+	// 接口限流
+	if config.G.Service.RateLimit.Rate > 0 {
+		gatewayGroup.Use(middleware.RateLimit(middleware.RateLimitOptions{
+			Rate:  config.G.Service.RateLimit.Rate,
+			Burst: config.G.Service.RateLimit.Burst,
+		}))
+	}
 	gatewayGroup.POST("/", handler.GatewayCreate)
 	gatewayGroup.GET("/:gateway_name/", handler.GatewayGet)
 	gatewayGroup.PUT("/:gateway_name/", handler.GatewayUpdate)
@@ -0,0 +1,247 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package handler
+
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/web/serializer"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/validation"
+)
+
+// ChangeSetCreate ...
+//
+//	@ID			change_set_create
+//	@Summary	变更集 创建
+//	@Accept		json
+//	@Produce	json
+//	@Tags		webapi.change_set
+//	@Param		gateway_id	path		int							true	"网关 ID"
+//	@Param		request		body		serializer.ChangeSetInfo	true	"变更集创建参数"
+//	@Success	200			{object}	serializer.ChangeSetOutputInfo
+//	@Router		/api/v1/web/gateways/{gateway_id}/change_sets/ [post]
+func ChangeSetCreate(c *gin.Context) {
+	var req serializer.ChangeSetInfo
+	if err := validation.BindAndValidate(c, &req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	changeSet := &model.ChangeSet{
+		Name:        req.Name,
+		Description: req.Description,
+	}
+	if err := biz.CreateChangeSet(c.Request.Context(), changeSet); err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessJSONResponse(c, toChangeSetOutputInfo(changeSet))
+}
+
+// ChangeSetList ...
+//
+//	@ID			change_set_list
+//	@Summary	变更集 列表
+//	@Produce	json
+//	@Tags		webapi.change_set
+//	@Param		gateway_id	path		int								true	"网关 ID"
+//	@Param		request		query		serializer.ChangeSetListRequest	false	"查询参数"
+//	@Success	200			{object}	ginx.PaginatedResponse{results=serializer.ChangeSetListResponse}
+//	@Router		/api/v1/web/gateways/{gateway_id}/change_sets/ [get]
+func ChangeSetList(c *gin.Context) {
+	var req serializer.ChangeSetListRequest
+	if err := c.ShouldBind(&req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	changeSets, total, err := biz.ListPagedChangeSets(
+		c.Request.Context(),
+		ginx.GetGatewayInfo(c).ID,
+		req.Status,
+		biz.PageParam{
+			Offset: ginx.GetOffset(c),
+			Limit:  ginx.GetLimit(c),
+		},
+	)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	results := serializer.ChangeSetListResponse{}
+	for _, changeSet := range changeSets {
+		results = append(results, toChangeSetOutputInfo(changeSet))
+	}
+	ginx.SuccessJSONResponse(c, ginx.NewPaginatedRespData(total, results))
+}
+
+// ChangeSetGet ...
+//
+//	@ID			change_set_get
+//	@Summary	变更集 详情
+//	@Produce	json
+//	@Tags		webapi.change_set
+//	@Param		gateway_id	path		int	true	"网关 ID"
+//	@Param		id			path		int	true	"变更集 ID"
+//	@Success	200			{object}	serializer.ChangeSetDetailOutput
+//	@Router		/api/v1/web/gateways/{gateway_id}/change_sets/{id}/ [get]
+func ChangeSetGet(c *gin.Context) {
+	changeSet, ok := getChangeSet(c)
+	if !ok {
+		return
+	}
+	resources, err := biz.ListChangeSetResources(c.Request.Context(), changeSet.ID)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	output := serializer.ChangeSetDetailOutput{
+		ChangeSetOutputInfo: toChangeSetOutputInfo(changeSet),
+		Resources:           []serializer.ChangeSetResourceOutput{},
+	}
+	for _, resource := range resources {
+		output.Resources = append(output.Resources, serializer.ChangeSetResourceOutput{
+			ResourceType: resource.ResourceType,
+			ResourceID:   resource.ResourceID,
+		})
+	}
+	ginx.SuccessJSONResponse(c, output)
+}
+
+// ChangeSetResourceAdd ...
+//
+//	@ID			change_set_resource_add
+//	@Summary	变更集 加入资源
+//	@Description	资源同时只能属于一个未关闭的变更集，已属于其他变更集时返回 409
+//	@Accept		json
+//	@Produce	json
+//	@Tags		webapi.change_set
+//	@Param		gateway_id	path	int									true	"网关 ID"
+//	@Param		id			path	int									true	"变更集 ID"
+//	@Param		request		body	serializer.ChangeSetResourceRequest	true	"资源列表"
+//	@Success	201
+//	@Router		/api/v1/web/gateways/{gateway_id}/change_sets/{id}/resources/ [post]
+func ChangeSetResourceAdd(c *gin.Context) {
+	changeSet, ok := getChangeSet(c)
+	if !ok {
+		return
+	}
+	var req serializer.ChangeSetResourceRequest
+	if err := validation.BindAndValidate(c, &req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	err := biz.AddChangeSetResources(c.Request.Context(), changeSet, req.ResourceType, req.ResourceIDList)
+	if err != nil {
+		if errors.Is(err, biz.ErrChangeSetConflict) {
+			ginx.ConflictJSONResponse(c, err)
+			return
+		}
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessCreateResponse(c)
+}
+
+// ChangeSetResourceRemove ...
+//
+//	@ID			change_set_resource_remove
+//	@Summary	变更集 移出资源
+//	@Accept		json
+//	@Produce	json
+//	@Tags		webapi.change_set
+//	@Param		gateway_id	path	int									true	"网关 ID"
+//	@Param		id			path	int									true	"变更集 ID"
+//	@Param		request		body	serializer.ChangeSetResourceRequest	true	"资源列表"
+//	@Success	204
+//	@Router		/api/v1/web/gateways/{gateway_id}/change_sets/{id}/resources/ [delete]
+func ChangeSetResourceRemove(c *gin.Context) {
+	changeSet, ok := getChangeSet(c)
+	if !ok {
+		return
+	}
+	var req serializer.ChangeSetResourceRequest
+	if err := validation.BindAndValidate(c, &req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	err := biz.RemoveChangeSetResources(c.Request.Context(), changeSet, req.ResourceType, req.ResourceIDList)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessNoContentResponse(c)
+}
+
+// ChangeSetAbandon ...
+//
+//	@ID			change_set_abandon
+//	@Summary	变更集 放弃
+//	@Description	关闭变更集并释放其中的资源，资源保留为草稿，可单独发布或加入其他变更集
+//	@Produce	json
+//	@Tags		webapi.change_set
+//	@Param		gateway_id	path		int	true	"网关 ID"
+//	@Param		id			path		int	true	"变更集 ID"
+//	@Success	200			{object}	serializer.ChangeSetOutputInfo
+//	@Router		/api/v1/web/gateways/{gateway_id}/change_sets/{id}/abandon/ [post]
+func ChangeSetAbandon(c *gin.Context) {
+	changeSet, ok := getChangeSet(c)
+	if !ok {
+		return
+	}
+	if err := biz.CloseChangeSet(c.Request.Context(), changeSet, constant.ChangeSetStatusAbandoned); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessJSONResponse(c, toChangeSetOutputInfo(changeSet))
+}
+
+// getChangeSet 按路径参数查询变更集，失败时写入错误响应
+func getChangeSet(c *gin.Context) (*model.ChangeSet, bool) {
+	var pathParam serializer.ChangeSetPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return nil, false
+	}
+	changeSet, err := biz.GetChangeSet(c.Request.Context(), pathParam.GatewayID, pathParam.ID)
+	if err != nil {
+		ginx.NotFoundJSONResponse(c, err)
+		return nil, false
+	}
+	return changeSet, true
+}
+
+// toChangeSetOutputInfo 转换变更集基本信息
+func toChangeSetOutputInfo(changeSet *model.ChangeSet) serializer.ChangeSetOutputInfo {
+	return serializer.ChangeSetOutputInfo{
+		ID:          changeSet.ID,
+		GatewayID:   changeSet.GatewayID,
+		Name:        changeSet.Name,
+		Description: changeSet.Description,
+		Status:      changeSet.Status,
+		Creator:     changeSet.Creator,
+		Updater:     changeSet.Updater,
+		CreatedAt:   changeSet.CreatedAt.Unix(),
+		UpdatedAt:   changeSet.UpdatedAt.Unix(),
+	}
+}
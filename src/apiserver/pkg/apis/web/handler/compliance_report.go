@@ -0,0 +1,247 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/web/serializer"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+)
+
+// complianceReportProgressInterval 合规报告生成进度推送间隔
+const complianceReportProgressInterval = time.Second
+
+// ComplianceReportCreate ...
+//
+//	@ID			compliance_report_create
+//	@Summary	合规报告 创建
+//	@Description	异步执行 schema(db/etcd)、关联资源、证书有效期、sni 覆盖及配置漂移检查，
+//	@Description	types 不为空时只读取并检查指定类型，scanned_types 为本次检查的资源类型
+//	@Produce	json
+//	@Tags		webapi.compliance_report
+//	@Param		gateway_id	path		int								true	"网关 ID"
+//	@Param		request		query		serializer.ResourceScanRequest	false	"读取的资源类型"
+//	@Success	200			{object}	serializer.ComplianceReportOutputInfo
+//	@Router		/api/v1/web/gateways/{gateway_id}/compliance_reports/ [post]
+func ComplianceReportCreate(c *gin.Context) {
+	resourceTypes, err := bindResourceScanTypes(c)
+	if err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	report, err := biz.CreateComplianceReport(c.Request.Context(), resourceTypes)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessJSONResponse(c, toComplianceReportOutputInfo(report))
+}
+
+// ComplianceReportList ...
+//
+//	@ID			compliance_report_list
+//	@Summary	合规报告 列表
+//	@Produce	json
+//	@Tags		webapi.compliance_report
+//	@Param		gateway_id	path		int										true	"网关 ID"
+//	@Param		request		query		serializer.ComplianceReportListRequest	false	"查询参数"
+//	@Success	200			{object}	ginx.PaginatedResponse{results=serializer.ComplianceReportListResponse}
+//	@Router		/api/v1/web/gateways/{gateway_id}/compliance_reports/ [get]
+func ComplianceReportList(c *gin.Context) {
+	var req serializer.ComplianceReportListRequest
+	if err := c.ShouldBind(&req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	reports, total, err := biz.ListPagedComplianceReports(
+		c.Request.Context(),
+		ginx.GetGatewayInfo(c).ID,
+		biz.PageParam{
+			Offset: ginx.GetOffset(c),
+			Limit:  ginx.GetLimit(c),
+		},
+	)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	results := serializer.ComplianceReportListResponse{}
+	for _, report := range reports {
+		results = append(results, toComplianceReportOutputInfo(report))
+	}
+	ginx.SuccessJSONResponse(c, ginx.NewPaginatedRespData(total, results))
+}
+
+// ComplianceReportGet ...
+//
+//	@ID			compliance_report_get
+//	@Summary	合规报告 详情
+//	@Produce	json
+//	@Tags		webapi.compliance_report
+//	@Param		gateway_id	path		int	true	"网关 ID"
+//	@Param		id			path		int	true	"报告 ID"
+//	@Success	200			{object}	serializer.ComplianceReportDetailOutput
+//	@Router		/api/v1/web/gateways/{gateway_id}/compliance_reports/{id}/ [get]
+func ComplianceReportGet(c *gin.Context) {
+	var pathParam serializer.ComplianceReportPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	report, err := biz.GetComplianceReport(c.Request.Context(), pathParam.GatewayID, pathParam.ID)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessJSONResponse(c, toComplianceReportDetailOutput(report))
+}
+
+// ComplianceReportProgress ...
+//
+//	@ID			compliance_report_progress
+//	@Summary	合规报告 生成进度(SSE)
+//	@Produce	text/event-stream
+//	@Tags		webapi.compliance_report
+//	@Param		gateway_id	path		int	true	"网关 ID"
+//	@Param		id			path		int	true	"报告 ID"
+//	@Success	200			{object}	serializer.ComplianceReportProgressOutput
+//	@Router		/api/v1/web/gateways/{gateway_id}/compliance_reports/{id}/progress/ [get]
+func ComplianceReportProgress(c *gin.Context) {
+	var pathParam serializer.ComplianceReportPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	if _, err := biz.GetComplianceReport(c.Request.Context(), pathParam.GatewayID, pathParam.ID); err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	c.Stream(func(w io.Writer) bool {
+		report, err := biz.GetComplianceReport(c.Request.Context(), pathParam.GatewayID, pathParam.ID)
+		if err != nil {
+			c.SSEvent("error", err.Error())
+			return false
+		}
+		c.SSEvent("progress", serializer.ComplianceReportProgressOutput{
+			Status:    report.Status,
+			Total:     report.Total,
+			Processed: report.Processed,
+		})
+		if report.Status == constant.ComplianceReportStatusSuccess ||
+			report.Status == constant.ComplianceReportStatusFailed {
+			return false
+		}
+		select {
+		case <-c.Request.Context().Done():
+			return false
+		case <-time.After(complianceReportProgressInterval):
+			return true
+		}
+	})
+}
+
+// ComplianceReportDownload ...
+//
+//	@ID			compliance_report_download
+//	@Summary	合规报告 下载
+//	@Produce	json
+//	@Tags		webapi.compliance_report
+//	@Param		gateway_id	path	int											true	"网关 ID"
+//	@Param		id			path	int											true	"报告 ID"
+//	@Param		request		query	serializer.ComplianceReportDownloadRequest	false	"下载参数"
+//	@Router		/api/v1/web/gateways/{gateway_id}/compliance_reports/{id}/download/ [get]
+func ComplianceReportDownload(c *gin.Context) {
+	var pathParam serializer.ComplianceReportPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	var req serializer.ComplianceReportDownloadRequest
+	if err := c.ShouldBind(&req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	report, err := biz.GetComplianceReport(c.Request.Context(), pathParam.GatewayID, pathParam.ID)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	if report.Status != constant.ComplianceReportStatusSuccess {
+		ginx.BadRequestErrorJSONResponse(c,
+			fmt.Errorf("合规报告: %d 尚未生成成功，当前状态: %s", report.ID, report.Status))
+		return
+	}
+	fileName := fmt.Sprintf("%s_compliance_report_%d", ginx.GetGatewayInfo(c).Name, report.ID)
+	if req.Format == serializer.ComplianceReportFormatCSV {
+		fileData, err := biz.RenderComplianceReportCSV(report)
+		if err != nil {
+			ginx.SystemErrorJSONResponse(c, err)
+			return
+		}
+		ginx.SuccessFileResponse(c, "text/csv", fileData, fileName+".csv")
+		return
+	}
+	fileData, _ := json.MarshalIndent(toComplianceReportDetailOutput(report), "", "    ")
+	ginx.SuccessFileResponse(c, "application/json", fileData, fileName+".json")
+}
+
+// toComplianceReportOutputInfo 转换合规报告基本信息
+func toComplianceReportOutputInfo(report *model.ComplianceReport) serializer.ComplianceReportOutputInfo {
+	summary := json.RawMessage(report.Summary)
+	if len(summary) == 0 {
+		summary = json.RawMessage("{}")
+	}
+	// 报告创建时已校验过资源类型
+	resourceTypes, _ := biz.ParseResourceTypes(report.ResourceTypes)
+	return serializer.ComplianceReportOutputInfo{
+		ID:           report.ID,
+		GatewayID:    report.GatewayID,
+		Status:       report.Status,
+		ScannedTypes: biz.ScannedResourceTypes(resourceTypes),
+		Partial:      len(resourceTypes) > 0,
+		Total:        report.Total,
+		Processed:    report.Processed,
+		Summary:      summary,
+		Message:      report.Message,
+		Creator:      report.Creator,
+		CreatedAt:    report.CreatedAt.Unix(),
+		UpdatedAt:    report.UpdatedAt.Unix(),
+	}
+}
+
+// toComplianceReportDetailOutput 转换合规报告详情
+func toComplianceReportDetailOutput(report *model.ComplianceReport) serializer.ComplianceReportDetailOutput {
+	result := json.RawMessage(report.Result)
+	if len(result) == 0 {
+		result = json.RawMessage("[]")
+	}
+	return serializer.ComplianceReportDetailOutput{
+		ComplianceReportOutputInfo: toComplianceReportOutputInfo(report),
+		Result:                     result,
+	}
+}
@@ -21,11 +21,13 @@ package handler
 
 import (
 	"encoding/json"
+	"errors"
 	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/tidwall/gjson"
 	"gorm.io/datatypes"
+	"gorm.io/gorm"
 
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/web/serializer"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz"
@@ -274,6 +276,38 @@ func ConsumerDelete(c *gin.Context) {
 	ginx.SuccessNoContentResponse(c)
 }
 
+// ConsumerCredentialGenerate ...
+//
+//	@ID			consumer_credential_generate
+//	@Summary	生成 consumer 凭证
+//	@Description	为导入时未携带凭证、仍为占位值的凭证字段生成随机值，consumer 变为待发布
+//	@Produce	json
+//	@Tags		webapi.consumer
+//	@Param		gateway_id	path		int		true	"网关 id"
+//	@Param		id			path		string	true	"资源 ID"
+//	@Success	200			{object}	serializer.ConsumerCredentialGenerateResponse
+//	@Router		/api/v1/web/gateways/{gateway_id}/consumers/{id}/generate_credentials/ [post]
+func ConsumerCredentialGenerate(c *gin.Context) {
+	var pathParam serializer.ResourceCommonPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	generated, err := biz.GenerateConsumerCredentials(c.Request.Context(), pathParam.ID)
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			ginx.NotFoundJSONResponse(c, err)
+		case errors.Is(err, biz.ErrCredentialNotGeneratable):
+			ginx.BadRequestErrorJSONResponse(c, err)
+		default:
+			ginx.SystemErrorJSONResponse(c, err)
+		}
+		return
+	}
+	ginx.SuccessJSONResponse(c, serializer.ConsumerCredentialGenerateResponse{Generated: generated})
+}
+
 // ConsumerDropDownList ...
 //
 //	@ID			consumer_dropdown_list
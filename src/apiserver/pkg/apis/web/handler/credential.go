@@ -0,0 +1,315 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/datatypes"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/web/serializer"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/status"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/idx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/schema"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/validation"
+)
+
+// CredentialCreate ...
+//
+//	@ID			credential_create
+//	@Summary	credential 创建
+//	@Accept		json
+//	@Produce	json
+//	@Tags		webapi.credential
+//	@Param		gateway_id	path	int							true	"网关 ID"
+//	@Param		id			path	string						true	"consumer ID"
+//	@Param		request		body	serializer.CredentialInfo	true	"credential 创建参数"
+//	@Success	201
+//	@Router		/api/v1/web/gateways/{gateway_id}/consumers/{id}/credentials/ [post]
+func CredentialCreate(c *gin.Context) {
+	pathParam, ok := bindCredentialPathParam(c)
+	if !ok {
+		return
+	}
+	var req serializer.CredentialInfo
+	if err := validation.BindAndValidate(c, &req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	consumer, err := biz.GetConsumer(c.Request.Context(), pathParam.ConsumerID)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	if consumer.Status == constant.ResourceStatusDeleteDraft {
+		ginx.BadRequestErrorJSONResponse(c, errors.New("消费者待删除, 不能添加凭证"))
+		return
+	}
+	id := idx.GenResourceID(constant.Credential)
+	credential := model.Credential{
+		Name:       req.Name,
+		ConsumerID: consumer.ID,
+		ResourceCommonModel: model.ResourceCommonModel{
+			ID:              id,
+			GatewayID:       pathParam.GatewayID,
+			Config:          datatypes.JSON(req.Config),
+			EtcdKeyOverride: model.CredentialEtcdKey(consumer, id),
+			Status:          constant.ResourceStatusCreateDraft,
+			BaseModel: model.BaseModel{
+				Creator: ginx.GetUserID(c),
+				Updater: ginx.GetUserID(c),
+			},
+		},
+	}
+	if err := biz.CreateCredential(c.Request.Context(), credential); err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessCreateResponse(c)
+}
+
+// CredentialUpdate ...
+//
+//	@ID			credential_update
+//	@Summary	credential 更新
+//	@Accept		json
+//	@Produce	json
+//	@Tags		webapi.credential
+//	@Param		gateway_id		path	int							true	"网关ID"
+//	@Param		id				path	string						true	"consumer ID"
+//	@Param		credential_id	path	string						true	"credential ID"
+//	@Param		request			body	serializer.CredentialInfo	true	"credential 更新参数"
+//	@Success	201
+//	@Router		/api/v1/web/gateways/{gateway_id}/consumers/{id}/credentials/{credential_id}/ [put]
+func CredentialUpdate(c *gin.Context) {
+	pathParam, ok := bindCredentialPathParam(c)
+	if !ok {
+		return
+	}
+	req := serializer.CredentialInfo{ID: pathParam.CredentialID}
+	if err := validation.BindAndValidate(c, &req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	if _, ok := getConsumerCredential(c, pathParam, constant.OperationTypeUpdate); !ok {
+		return
+	}
+	updateStatus, err := biz.GetResourceUpdateStatus(c.Request.Context(), constant.Credential, pathParam.CredentialID)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	credential := model.Credential{
+		Name: req.Name,
+		ResourceCommonModel: model.ResourceCommonModel{
+			ID:        pathParam.CredentialID,
+			GatewayID: pathParam.GatewayID,
+			Config:    datatypes.JSON(req.Config),
+			Status:    updateStatus,
+			BaseModel: model.BaseModel{
+				Updater: ginx.GetUserID(c),
+			},
+		},
+	}
+	if err := biz.UpdateCredential(c.Request.Context(), credential); err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+}
+
+// CredentialGet ...
+//
+//	@ID			credential_get
+//	@Summary	credential 详情
+//	@Produce	json
+//	@Tags		webapi.credential
+//	@Param		gateway_id		path		int		true	"网关 id"
+//	@Param		id				path		string	true	"consumer ID"
+//	@Param		credential_id	path		string	true	"credential ID"
+//	@Success	200				{object}	serializer.CredentialOutputInfo
+//	@Router		/api/v1/web/gateways/{gateway_id}/consumers/{id}/credentials/{credential_id}/ [get]
+func CredentialGet(c *gin.Context) {
+	pathParam, ok := bindCredentialPathParam(c)
+	if !ok {
+		return
+	}
+	credential, ok := getConsumerCredential(c, pathParam, "")
+	if !ok {
+		return
+	}
+	ginx.SuccessJSONResponse(c, credentialOutputInfo(credential))
+}
+
+// CredentialDelete ...
+//
+//	@ID			credential_delete
+//	@Summary	credential 删除
+//	@Produce	json
+//	@Tags		webapi.credential
+//	@Param		gateway_id		path	int		true	"网关 id"
+//	@Param		id				path	string	true	"consumer ID"
+//	@Param		credential_id	path	string	true	"credential ID"
+//	@Success	204
+//	@Router		/api/v1/web/gateways/{gateway_id}/consumers/{id}/credentials/{credential_id}/ [delete]
+func CredentialDelete(c *gin.Context) {
+	pathParam, ok := bindCredentialPathParam(c)
+	if !ok {
+		return
+	}
+	credential, ok := getConsumerCredential(c, pathParam, constant.OperationTypeDelete)
+	if !ok {
+		return
+	}
+	// create_draft 状态可以直接删除
+	if credential.Status == constant.ResourceStatusCreateDraft {
+		err := biz.BatchDeleteCredentials(c.Request.Context(), []string{credential.ID})
+		if err != nil {
+			ginx.SystemErrorJSONResponse(c, err)
+			return
+		}
+		ginx.SuccessNoContentResponse(c)
+		return
+	}
+	err := biz.UpdateResourceStatusWithAuditLog(c.Request.Context(),
+		constant.Credential, credential.ID, constant.ResourceStatusDeleteDraft)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessNoContentResponse(c)
+}
+
+// CredentialList ...
+//
+//	@ID			credential_list
+//	@Summary	credential 列表
+//	@Produce	json
+//	@Tags		webapi.credential
+//	@Param		gateway_id	path		int									true	"网关 ID"
+//	@Param		id			path		string								true	"consumer ID"
+//	@Param		request		query		serializer.CredentialListRequest	false	"查询参数"
+//	@Success	200			{object}	ginx.PaginatedResponse{results=serializer.CredentialListResponse}
+//	@Router		/api/v1/web/gateways/{gateway_id}/consumers/{id}/credentials/ [get]
+func CredentialList(c *gin.Context) {
+	pathParam, ok := bindCredentialPathParam(c)
+	if !ok {
+		return
+	}
+	var req serializer.CredentialListRequest
+	if err := c.ShouldBind(&req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	queryParam := map[string]interface{}{}
+	queryParam["gateway_id"] = pathParam.GatewayID
+	queryParam["consumer_id"] = pathParam.ConsumerID
+	if req.ID != "" {
+		queryParam["id"] = req.ID
+	}
+	credentialList, total, err := biz.ListPagedCredentials(
+		c.Request.Context(),
+		queryParam,
+		strings.Split(req.Status, ","),
+		req.Name,
+		req.Updater,
+		req.OrderBy,
+		biz.PageParam{
+			Offset: ginx.GetOffset(c),
+			Limit:  ginx.GetLimit(c),
+		},
+	)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	var results serializer.CredentialListResponse
+	for _, credential := range credentialList {
+		results = append(results, credentialOutputInfo(credential))
+	}
+	ginx.SuccessJSONResponse(c, ginx.NewPaginatedRespData(total, results))
+}
+
+// bindCredentialPathParam 绑定凭证路径参数，并校验网关的 apisix 版本是否支持 credential
+func bindCredentialPathParam(c *gin.Context) (serializer.CredentialPathParam, bool) {
+	var pathParam serializer.CredentialPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return pathParam, false
+	}
+	version := ginx.GetGatewayInfo(c).GetAPISIXVersionX()
+	if !schema.SupportsCredential(version) {
+		ginx.BadRequestErrorJSONResponse(c,
+			fmt.Errorf("当前网关的 APISIX 版本 %s 不支持 credential, 需要 3.11 及以上版本", version))
+		return pathParam, false
+	}
+	return pathParam, true
+}
+
+// getConsumerCredential 获取所属 consumer 下的凭证，op 非空时校验凭证当前状态能否执行该操作
+func getConsumerCredential(
+	c *gin.Context,
+	pathParam serializer.CredentialPathParam,
+	op constant.OperationType,
+) (*model.Credential, bool) {
+	credential, err := biz.GetCredential(c.Request.Context(), pathParam.CredentialID)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return nil, false
+	}
+	if credential.ConsumerID != pathParam.ConsumerID {
+		ginx.NotFoundJSONResponse(c, fmt.Errorf("消费者 %s 下不存在凭证 %s", pathParam.ConsumerID, credential.ID))
+		return nil, false
+	}
+	// 凭证嵌套在 consumer 路由下，不经过资源操作校验中间件，需在此校验状态变更
+	if op != "" {
+		statusOp := status.NewResourceStatusOp(credential.ResourceCommonModel)
+		if err := statusOp.CanDo(c.Request.Context(), op); err != nil {
+			ginx.BadRequestErrorJSONResponse(c, err)
+			return nil, false
+		}
+	}
+	return credential, true
+}
+
+func credentialOutputInfo(credential *model.Credential) serializer.CredentialOutputInfo {
+	return serializer.CredentialOutputInfo{
+		AutoID:     credential.AutoID,
+		ID:         credential.ID,
+		GatewayID:  credential.GatewayID,
+		ConsumerID: credential.ConsumerID,
+		CredentialInfo: serializer.CredentialInfo{
+			ID:     credential.ID,
+			Name:   credential.Name,
+			Config: json.RawMessage(credential.Config),
+		},
+		CreatedAt: credential.CreatedAt.Unix(),
+		UpdatedAt: credential.UpdatedAt.Unix(),
+		Creator:   credential.Creator,
+		Updater:   credential.Updater,
+		Status:    credential.Status,
+	}
+}
@@ -0,0 +1,83 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/web/serializer"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+)
+
+// EtcdWriteAuditList ...
+//
+//	@ID			etcd_write_audit_list
+//	@Summary	etcd 写操作审计 列表
+//	@Description	按 etcd key 或资源 id 查询发布流程中的 etcd 写操作记录
+//	@Produce	json
+//	@Tags		webapi.etcd_write_audit
+//	@Param		gateway_id	path		int									true	"网关 ID"
+//	@Param		request		query		serializer.EtcdWriteAuditListRequest	false	"查询参数"
+//	@Success	200			{object}	ginx.PaginatedResponse{results=serializer.EtcdWriteAuditListResponse}
+//	@Router		/api/v1/web/gateways/{gateway_id}/etcd_write_audits/ [get]
+func EtcdWriteAuditList(c *gin.Context) {
+	var req serializer.EtcdWriteAuditListRequest
+	if err := c.ShouldBind(&req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	audits, total, err := biz.ListPagedEtcdWriteAudits(
+		c.Request.Context(),
+		ginx.GetGatewayInfo(c).ID,
+		req.Key,
+		req.ResourceID,
+		biz.PageParam{
+			Offset: ginx.GetOffset(c),
+			Limit:  ginx.GetLimit(c),
+		},
+	)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	results := serializer.EtcdWriteAuditListResponse{}
+	for _, audit := range audits {
+		detail, err := biz.DecodeEtcdWriteAuditDetail(audit)
+		if err != nil {
+			ginx.SystemErrorJSONResponse(c, err)
+			return
+		}
+		results = append(results, serializer.EtcdWriteAuditOutputInfo{
+			ID:            audit.ID,
+			Key:           audit.Key,
+			ResourceType:  audit.ResourceType,
+			ResourceID:    audit.ResourceID,
+			Op:            audit.Op,
+			PublishTaskID: audit.PublishTaskID,
+			Success:       audit.Success,
+			PrevHash:      detail.PrevHash,
+			NewHash:       detail.NewHash,
+			Message:       detail.Message,
+			Operator:      audit.Creator,
+			CreatedAt:     audit.CreatedAt.Unix(),
+		})
+	}
+	ginx.SuccessJSONResponse(c, ginx.NewPaginatedRespData(total, results))
+}
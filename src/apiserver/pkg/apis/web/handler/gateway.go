@@ -53,12 +53,22 @@ func GatewayCreate(c *gin.Context) {
 		ginx.BadRequestErrorJSONResponse(c, err)
 		return
 	}
+	gateway := newGatewayFromInput(c, req, instanceID)
+	if err := biz.CreateGateway(c.Request.Context(), &gateway); err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessCreateResponse(c)
+}
+
+// newGatewayFromInput 根据创建参数构造网关
+func newGatewayFromInput(c *gin.Context, req common.GatewayInputInfo, instanceID string) model.Gateway {
 	// 处理 maintainer
 	if !arrutil.Contains(req.Maintainers, ginx.GetUserID(c)) {
 		req.Maintainers = append(req.Maintainers, ginx.GetUserID(c))
 	}
 	// FIXME:  common.GatewayInputInfo -> model.Gateway
-	gateway := model.Gateway{
+	return model.Gateway{
 		Name:          req.Name,
 		Mode:          req.Mode,
 		Maintainers:   req.Maintainers.Strip(),
@@ -77,18 +87,14 @@ func GatewayCreate(c *gin.Context) {
 				CertKey:  req.EtcdCertKey,
 			},
 		},
-		ReadOnly: req.ReadOnly,
+		ReadOnly:        req.ReadOnly,
+		AllowCustomVars: req.AllowCustomVars,
+		RetriesCheck:    req.RetriesCheck,
 		BaseModel: model.BaseModel{
 			Creator: ginx.GetUserID(c),
 			Updater: ginx.GetUserID(c),
 		},
 	}
-
-	if err := biz.CreateGateway(c.Request.Context(), &gateway); err != nil {
-		ginx.SystemErrorJSONResponse(c, err)
-		return
-	}
-	ginx.SuccessCreateResponse(c)
 }
 
 // GatewayUpdate ...
@@ -121,10 +127,21 @@ func GatewayUpdate(c *gin.Context) {
 	if req.EtcdCertKey == "" {
 		req.EtcdCertKey = ginx.GetGatewayInfo(c).EtcdConfig.CertKey
 	}
-	_, instanceID, err := common.CheckEtcdConnAndAPISIXInstance(ginx.GetGatewayInfo(c).ID, req.EtcdConfig)
-	if err != nil {
-		ginx.BadRequestErrorJSONResponse(c, err)
-		return
+	// 接入引导中的网关由引导步骤校验 etcd 配置，并允许修正 etcd 前缀及 apisix 版本
+	onboarding := ginx.GetGatewayInfo(c).Status == constant.GatewayStatusOnboarding
+	prefix := ginx.GetGatewayInfo(c).EtcdConfig.Prefix
+	apisixVersion := ginx.GetGatewayInfo(c).APISIXVersion
+	instanceID := ginx.GetGatewayInfo(c).EtcdConfig.InstanceID
+	if onboarding {
+		prefix = req.EtcdPrefix
+		apisixVersion = req.APISIXVersion
+	} else {
+		var err error
+		_, instanceID, err = common.CheckEtcdConnAndAPISIXInstance(ginx.GetGatewayInfo(c).ID, req.EtcdConfig)
+		if err != nil {
+			ginx.BadRequestErrorJSONResponse(c, err)
+			return
+		}
 	}
 	// 处理 maintainer
 	if !arrutil.Contains(req.Maintainers, ginx.GetUserID(c)) {
@@ -139,20 +156,22 @@ func GatewayUpdate(c *gin.Context) {
 		Maintainers:   req.Maintainers.Strip(),
 		Desc:          req.Description,
 		APISIXType:    ginx.GetGatewayInfo(c).APISIXType,
-		APISIXVersion: ginx.GetGatewayInfo(c).APISIXVersion,
+		APISIXVersion: apisixVersion,
 		EtcdConfig: model.EtcdConfig{
 			EtcdConfig: base.EtcdConfig{
 				Endpoint: req.EtcdEndPoints.EndpointJoin(),
 				Username: req.EtcdUsername,
 				Password: req.EtcdPassword,
-				Prefix:   ginx.GetGatewayInfo(c).EtcdConfig.Prefix, // etcd 前缀保持不变
+				Prefix:   prefix, // 已启用网关的 etcd 前缀保持不变
 				CACert:   req.EtcdCACert,
 				CertCert: req.EtcdCertCert,
 				CertKey:  req.EtcdCertKey,
 			},
 			InstanceID: instanceID,
 		},
-		ReadOnly: req.ReadOnly,
+		ReadOnly:        req.ReadOnly,
+		AllowCustomVars: req.AllowCustomVars,
+		RetriesCheck:    req.RetriesCheck,
 		BaseModel: model.BaseModel{
 			Updater: ginx.GetUserID(c),
 		},
@@ -161,6 +180,12 @@ func GatewayUpdate(c *gin.Context) {
 		ginx.SystemErrorJSONResponse(c, err)
 		return
 	}
+	if onboarding {
+		if err := biz.ResetGatewayOnboarding(c.Request.Context(), gateway.ID); err != nil {
+			ginx.SystemErrorJSONResponse(c, err)
+			return
+		}
+	}
 	gateway.RemoveSensitive()
 	ginx.SuccessJSONResponse(c, gateway)
 }
@@ -216,7 +241,9 @@ func GatewayList(c *gin.Context) {
 				Version: gateway.APISIXVersion,
 				Type:    gateway.APISIXType,
 			},
-			ReadOnly: gateway.ReadOnly,
+			ReadOnly:   gateway.ReadOnly,
+			Status:     gateway.Status,
+			EtcdStatus: gateway.EtcdStatus,
 			Etcd: common.Etcd{
 				InstanceID: gateway.EtcdConfig.InstanceID,
 				EndPoints:  gateway.EtcdConfig.Endpoint.Endpoints(),
@@ -0,0 +1,206 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package handler
+
+import (
+	"encoding/json"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/datatypes"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/web/serializer"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/schema"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/validation"
+)
+
+// GatewayDiscoveryCreate ...
+//
+//	@ID			gateway_discovery_create
+//	@Summary	网关启用服务发现
+//	@Accept		json
+//	@Produce	json
+//	@Tags		webapi.gateway
+//	@Param		gateway_id	path	int								true	"网关 ID"
+//	@Param		request		body	serializer.GatewayDiscoveryInfo	true	"服务发现配置"
+//	@Success	201
+//	@Router		/api/v1/web/gateways/{gateway_id}/discoveries/ [post]
+func GatewayDiscoveryCreate(c *gin.Context) {
+	var req serializer.GatewayDiscoveryInfo
+	if err := validation.BindAndValidate(c, &req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	if err := schema.ValidateDiscoveryConfig(req.Type, req.Config); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	err := biz.CreateGatewayDiscovery(c.Request.Context(), &model.GatewayDiscovery{
+		GatewayID: ginx.GetGatewayInfo(c).ID,
+		Type:      req.Type,
+		Config:    datatypes.JSON(req.Config),
+		BaseModel: model.BaseModel{
+			Creator: ginx.GetUserID(c),
+			Updater: ginx.GetUserID(c),
+		},
+	})
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessCreateResponse(c)
+}
+
+// GatewayDiscoveryUpdate ...
+//
+//	@ID			gateway_discovery_update
+//	@Summary	网关服务发现更新
+//	@Accept		json
+//	@Produce	json
+//	@Tags		webapi.gateway
+//	@Param		gateway_id	path	int								true	"网关 ID"
+//	@Param		id			path	int								true	"服务发现 ID"
+//	@Param		request		body	serializer.GatewayDiscoveryInfo	true	"服务发现配置"
+//	@Success	204
+//	@Router		/api/v1/web/gateways/{gateway_id}/discoveries/{id}/ [put]
+func GatewayDiscoveryUpdate(c *gin.Context) {
+	var pathParam serializer.GatewayDiscoveryPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	if _, err := biz.GetGatewayDiscovery(c.Request.Context(), pathParam.GatewayID, pathParam.ID); err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	req := serializer.GatewayDiscoveryInfo{ID: pathParam.ID}
+	if err := validation.BindAndValidate(c, &req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	if err := schema.ValidateDiscoveryConfig(req.Type, req.Config); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	err := biz.UpdateGatewayDiscovery(c.Request.Context(), model.GatewayDiscovery{
+		ID:        pathParam.ID,
+		GatewayID: pathParam.GatewayID,
+		Type:      req.Type,
+		Config:    datatypes.JSON(req.Config),
+		BaseModel: model.BaseModel{
+			Updater: ginx.GetUserID(c),
+		},
+	})
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessNoContentResponse(c)
+}
+
+// GatewayDiscoveryList ...
+//
+//	@ID			gateway_discovery_list
+//	@Summary	网关服务发现列表
+//	@Produce	json
+//	@Tags		webapi.gateway
+//	@Param		gateway_id	path		int	true	"网关 ID"
+//	@Success	200			{object}	serializer.GatewayDiscoveryListResponse
+//	@Router		/api/v1/web/gateways/{gateway_id}/discoveries/ [get]
+func GatewayDiscoveryList(c *gin.Context) {
+	discoveries, err := biz.ListGatewayDiscoveries(c.Request.Context(), ginx.GetGatewayInfo(c).ID)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	output := serializer.GatewayDiscoveryListResponse{}
+	for _, discovery := range discoveries {
+		output = append(output, toGatewayDiscoveryOutputInfo(discovery))
+	}
+	ginx.SuccessJSONResponse(c, output)
+}
+
+// GatewayDiscoveryGet ...
+//
+//	@ID			gateway_discovery_get
+//	@Summary	网关服务发现详情
+//	@Produce	json
+//	@Tags		webapi.gateway
+//	@Param		gateway_id	path		int	true	"网关 ID"
+//	@Param		id			path		int	true	"服务发现 ID"
+//	@Success	200			{object}	serializer.GatewayDiscoveryOutputInfo
+//	@Router		/api/v1/web/gateways/{gateway_id}/discoveries/{id}/ [get]
+func GatewayDiscoveryGet(c *gin.Context) {
+	var pathParam serializer.GatewayDiscoveryPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	discovery, err := biz.GetGatewayDiscovery(c.Request.Context(), pathParam.GatewayID, pathParam.ID)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessJSONResponse(c, toGatewayDiscoveryOutputInfo(discovery))
+}
+
+// GatewayDiscoveryDelete ...
+//
+//	@ID			gateway_discovery_delete
+//	@Summary	网关停用服务发现
+//	@Produce	json
+//	@Tags		webapi.gateway
+//	@Param		gateway_id	path	int	true	"网关 ID"
+//	@Param		id			path	int	true	"服务发现 ID"
+//	@Success	204
+//	@Router		/api/v1/web/gateways/{gateway_id}/discoveries/{id}/ [delete]
+func GatewayDiscoveryDelete(c *gin.Context) {
+	var pathParam serializer.GatewayDiscoveryPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	if err := biz.DeleteGatewayDiscovery(c.Request.Context(), pathParam.GatewayID, pathParam.ID); err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessNoContentResponse(c)
+}
+
+// toGatewayDiscoveryOutputInfo 转换网关服务发现信息
+func toGatewayDiscoveryOutputInfo(discovery *model.GatewayDiscovery) serializer.GatewayDiscoveryOutputInfo {
+	config := json.RawMessage(discovery.Config)
+	if len(config) == 0 {
+		config = json.RawMessage("{}")
+	}
+	return serializer.GatewayDiscoveryOutputInfo{
+		GatewayID: discovery.GatewayID,
+		GatewayDiscoveryInfo: serializer.GatewayDiscoveryInfo{
+			ID:     discovery.ID,
+			Type:   discovery.Type,
+			Config: config,
+		},
+		CreatedAt: discovery.CreatedAt.Unix(),
+		UpdatedAt: discovery.UpdatedAt.Unix(),
+		Creator:   discovery.Creator,
+		Updater:   discovery.Updater,
+	}
+}
@@ -0,0 +1,172 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/common"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/web/serializer"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/config"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/validation"
+)
+
+// GatewayOnboardingCreate ...
+//
+//	@ID			gateway_onboarding_create
+//	@Summary	以接入引导方式创建网关，网关在必要步骤完成前不允许发布
+//	@Accept		json
+//	@Produce	json
+//	@Tags		webapi.gateway
+//	@Param		request	body		common.GatewayInputInfo	true	"网关创建参数"
+//	@Success	200		{object}	dto.OnboardingSummary
+//	@Router		/api/v1/web/gateways/onboarding/ [post]
+func GatewayOnboardingCreate(c *gin.Context) {
+	var req common.GatewayInputInfo
+	if err := validation.BindAndValidate(c, &req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	// etcd 连通性及前缀冲突由引导步骤检查，创建时不做校验
+	gateway := newGatewayFromInput(c, req, "")
+	if err := biz.CreateOnboardingGateway(c.Request.Context(), &gateway); err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	summary, err := biz.GetGatewayOnboardingSummary(c.Request.Context(), &gateway)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessJSONResponse(c, summary)
+}
+
+// GatewayOnboardingGet ...
+//
+//	@ID			gateway_onboarding_get
+//	@Summary	网关接入引导进度及就绪情况
+//	@Produce	json
+//	@Tags		webapi.gateway
+//	@Param		gateway_id	path		int	true	"网关 ID"
+//	@Success	200			{object}	dto.OnboardingSummary
+//	@Router		/api/v1/web/gateways/{gateway_id}/onboarding/ [get]
+func GatewayOnboardingGet(c *gin.Context) {
+	summary, err := biz.GetGatewayOnboardingSummary(c.Request.Context(), ginx.GetGatewayInfo(c))
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessJSONResponse(c, summary)
+}
+
+// GatewayOnboardingStepRun ...
+//
+//	@ID			gateway_onboarding_step_run
+//	@Summary	执行网关接入引导步骤
+//	@Produce	json
+//	@Tags		webapi.gateway
+//	@Param		gateway_id	path		int		true	"网关 ID"
+//	@Param		step		path		string	true	"引导步骤"
+//	@Success	200			{object}	dto.OnboardingStepResult
+//	@Router		/api/v1/web/gateways/{gateway_id}/onboarding/steps/{step}/run/ [post]
+func GatewayOnboardingStepRun(c *gin.Context) {
+	step, ok := bindOnboardingStep(c)
+	if !ok {
+		return
+	}
+	result, err := biz.RunOnboardingStep(c.Request.Context(), ginx.GetGatewayInfo(c), step)
+	if err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessJSONResponse(c, result)
+}
+
+// GatewayOnboardingStepWaive ...
+//
+//	@ID			gateway_onboarding_step_waive
+//	@Summary	豁免网关接入引导中未通过的必要步骤，仅管理员可操作
+//	@Accept		json
+//	@Produce	json
+//	@Tags		webapi.gateway
+//	@Param		gateway_id	path		int										true	"网关 ID"
+//	@Param		step		path		string									true	"引导步骤"
+//	@Param		request		body		serializer.GatewayOnboardingWaiveRequest	true	"豁免参数"
+//	@Success	200			{object}	dto.OnboardingStepResult
+//	@Router		/api/v1/web/gateways/{gateway_id}/onboarding/steps/{step}/waive/ [post]
+func GatewayOnboardingStepWaive(c *gin.Context) {
+	if !config.IsAdmin(ginx.GetUserID(c)) {
+		ginx.ForbiddenJSONResponse(c, errors.New("仅管理员可以豁免接入引导步骤"))
+		return
+	}
+	step, ok := bindOnboardingStep(c)
+	if !ok {
+		return
+	}
+	var req serializer.GatewayOnboardingWaiveRequest
+	if err := validation.BindAndValidate(c, &req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	result, err := biz.WaiveOnboardingStep(c.Request.Context(), ginx.GetGatewayInfo(c), step, req.Reason)
+	if err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessJSONResponse(c, result)
+}
+
+// GatewayOnboardingActivate ...
+//
+//	@ID			gateway_onboarding_activate
+//	@Summary	必要步骤均已通过或豁免时启用网关
+//	@Produce	json
+//	@Tags		webapi.gateway
+//	@Param		gateway_id	path		int	true	"网关 ID"
+//	@Success	200			{object}	dto.OnboardingSummary
+//	@Router		/api/v1/web/gateways/{gateway_id}/onboarding/activate/ [post]
+func GatewayOnboardingActivate(c *gin.Context) {
+	summary, err := biz.ActivateOnboardingGateway(c.Request.Context(), ginx.GetGatewayInfo(c))
+	if err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessJSONResponse(c, summary)
+}
+
+// bindOnboardingStep 解析并校验路径中的接入引导步骤
+func bindOnboardingStep(c *gin.Context) (constant.OnboardingStep, bool) {
+	var pathParam serializer.GatewayOnboardingStepPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return "", false
+	}
+	if !slices.Contains(constant.OnboardingStepList, pathParam.Step) {
+		ginx.BadRequestErrorJSONResponse(c, fmt.Errorf("不支持的接入引导步骤: %s", pathParam.Step))
+		return "", false
+	}
+	return pathParam.Step, true
+}
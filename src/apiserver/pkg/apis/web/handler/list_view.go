@@ -0,0 +1,116 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package handler
+
+import (
+	"github.com/tidwall/gjson"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/web/serializer"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+)
+
+// configStrings 从摘要 config 中提取字符串列表，单值字段与多值字段合并返回
+func configStrings(config []byte, paths ...string) []string {
+	values := []string{}
+	for _, path := range paths {
+		result := gjson.GetBytes(config, path)
+		if result.IsArray() {
+			for _, item := range result.Array() {
+				values = append(values, item.String())
+			}
+			continue
+		}
+		if result.Type == gjson.String {
+			values = append(values, result.String())
+		}
+	}
+	return values
+}
+
+// configNodeCount 计算 upstream 节点数，兼容数组及 host:port->weight 映射两种写法
+func configNodeCount(config []byte) int {
+	nodes := gjson.GetBytes(config, "nodes")
+	if nodes.IsArray() {
+		return len(nodes.Array())
+	}
+	if nodes.IsObject() {
+		return len(nodes.Map())
+	}
+	return 0
+}
+
+// toRouteSummaryOutputInfo 转换路由摘要
+func toRouteSummaryOutputInfo(route *model.Route) serializer.RouteSummaryOutputInfo {
+	return serializer.RouteSummaryOutputInfo{
+		AutoID:         route.AutoID,
+		GatewayID:      route.GatewayID,
+		ID:             route.ID,
+		Name:           route.Name,
+		ServiceID:      route.ServiceID,
+		UpstreamID:     route.UpstreamID,
+		PluginConfigID: route.PluginConfigID,
+		Uris:           configStrings(route.Config, "uri", "uris"),
+		Methods:        configStrings(route.Config, "methods"),
+		Hosts:          configStrings(route.Config, "host", "hosts"),
+		ExpiresAt:      serializer.RouteExpiresAtToUnix(route.ExpiresAt),
+		CreatedAt:      route.CreatedAt.Unix(),
+		UpdatedAt:      route.UpdatedAt.Unix(),
+		Creator:        route.Creator,
+		Updater:        route.Updater,
+		Status:         route.Status,
+	}
+}
+
+// toUpstreamSummaryOutputInfo 转换 upstream 摘要
+func toUpstreamSummaryOutputInfo(upstream *model.Upstream) serializer.UpstreamSummaryOutputInfo {
+	return serializer.UpstreamSummaryOutputInfo{
+		AutoID:        upstream.AutoID,
+		GatewayID:     upstream.GatewayID,
+		ID:            upstream.ID,
+		Name:          upstream.Name,
+		SSLID:         upstream.SSLID,
+		Type:          gjson.GetBytes(upstream.Config, "type").String(),
+		Scheme:        gjson.GetBytes(upstream.Config, "scheme").String(),
+		NodeCount:     configNodeCount(upstream.Config),
+		DiscoveryType: gjson.GetBytes(upstream.Config, "discovery_type").String(),
+		ServiceName:   gjson.GetBytes(upstream.Config, "service_name").String(),
+		CreatedAt:     upstream.CreatedAt.Unix(),
+		UpdatedAt:     upstream.UpdatedAt.Unix(),
+		Creator:       upstream.Creator,
+		Updater:       upstream.Updater,
+		Status:        upstream.Status,
+	}
+}
+
+// toSSLSummaryOutputInfo 转换 ssl 摘要
+func toSSLSummaryOutputInfo(ssl *model.SSL) serializer.SSLSummaryOutputInfo {
+	return serializer.SSLSummaryOutputInfo{
+		AutoID:      ssl.AutoID,
+		GatewayID:   ssl.GatewayID,
+		ID:          ssl.ID,
+		Name:        ssl.Name,
+		Snis:        configStrings(ssl.Config, "snis"),
+		ValidityEnd: gjson.GetBytes(ssl.Config, "validity_end").Int(),
+		CreatedAt:   ssl.CreatedAt.Unix(),
+		UpdatedAt:   ssl.UpdatedAt.Unix(),
+		Creator:     ssl.Creator,
+		Updater:     ssl.Updater,
+		Status:      ssl.Status,
+	}
+}
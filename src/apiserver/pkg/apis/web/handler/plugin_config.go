@@ -20,6 +20,7 @@ package handler
 
 import (
 	"encoding/json"
+	"fmt"
 	"strings"
 
 	"github.com/gin-gonic/gin"
@@ -255,6 +256,17 @@ func PluginConfigDelete(c *gin.Context) {
 		ginx.SystemErrorJSONResponse(c, err)
 		return
 	}
+	// 被路由引用时不能删除
+	routeIDs, err := biz.GetPluginConfigReferences(c.Request.Context(), pluginConfig.ID)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	if len(routeIDs) > 0 {
+		ginx.BadRequestErrorJSONResponse(c,
+			fmt.Errorf("该资源不能删除，被: %s %s 引用", constant.Route, strings.Join(routeIDs, ",")))
+		return
+	}
 	// create_draft 状态可以直接删除
 	if pluginConfig.Status == constant.ResourceStatusCreateDraft {
 		err = biz.BatchDeletePluginConfigs(c.Request.Context(), []string{pluginConfig.ID})
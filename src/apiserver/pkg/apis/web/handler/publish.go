@@ -19,6 +19,9 @@
 package handler
 
 import (
+	"errors"
+	"io"
+
 	"github.com/gin-gonic/gin"
 
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/web/serializer"
@@ -44,7 +47,12 @@ func PublishResource(c *gin.Context) {
 		ginx.BadRequestErrorJSONResponse(c, err)
 		return
 	}
-	err := biz.PublishResource(c.Request.Context(), req.ResourceType, req.ResourceIDList)
+	err := biz.CheckResourcesNotInChangeSet(c.Request.Context(), req.ResourceType, req.ResourceIDList)
+	if err != nil {
+		ginx.ConflictJSONResponse(c, err)
+		return
+	}
+	err = biz.PublishResource(c.Request.Context(), req.ResourceType, req.ResourceIDList)
 	if err != nil {
 		ginx.SystemErrorJSONResponse(c, err)
 		return
@@ -59,10 +67,31 @@ func PublishResource(c *gin.Context) {
 //	@Accept		json
 //	@Produce	json
 //	@Tags		webapi.publish
-//	@Param		gateway_id	path	int	true	"网关 ID"
+//	@Description	不指定变更集时发布所有不属于变更集的草稿；指定变更集时只发布该变更集，成功后关闭变更集
+//	@Param		gateway_id	path	int							true	"网关 ID"
+//	@Param		request		body	serializer.PublishAllRequest	false	"一键发布请求参数"
 //	@Success	201
 //	@Router		/api/v1/web/gateways/{gateway_id}/publish/all/ [post]
 func PublishResourceAll(c *gin.Context) {
+	var req serializer.PublishAllRequest
+	// 请求体可选，兼容不传请求体的一键发布
+	if err := validation.BindAndValidate(c, &req); err != nil && !errors.Is(err, io.EOF) {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	if req.ChangeSetID != 0 {
+		changeSet, err := biz.GetChangeSet(c.Request.Context(), ginx.GetGatewayInfo(c).ID, req.ChangeSetID)
+		if err != nil {
+			ginx.NotFoundJSONResponse(c, err)
+			return
+		}
+		if err = biz.PublishChangeSet(c.Request.Context(), changeSet); err != nil {
+			ginx.SystemErrorJSONResponse(c, err)
+			return
+		}
+		ginx.SuccessCreateResponse(c)
+		return
+	}
 	err := biz.PublishAllResource(c.Request.Context(), ginx.GetGatewayInfo(c).ID)
 	if err != nil {
 		ginx.SystemErrorJSONResponse(c, err)
@@ -70,3 +99,64 @@ func PublishResourceAll(c *gin.Context) {
 	}
 	ginx.SuccessCreateResponse(c)
 }
+
+// PublishDryRun ...
+//
+//	@ID			resource_publish_dry_run
+//	@Summary	预览一键发布的变更，不写入 etcd
+//	@Description	基于发布预演的写操作汇总各资源的变更，与 POST publish/dry-run/ 的结果一致；
+//	@Description	types 不为空时只展示指定类型的变更，scanned_types 为本次展示的资源类型，发布出错的资源在 errors 中返回
+//	@Produce	json
+//	@Tags		webapi.publish
+//	@Param		gateway_id	path		int								true	"网关 ID"
+//	@Param		request		query		serializer.ResourceScanRequest	false	"读取的资源类型"
+//	@Success	200			{object}	dto.DiffResult
+//	@Router		/api/v1/web/gateways/{gateway_id}/publish/dry_run/ [get]
+func PublishDryRun(c *gin.Context) {
+	resourceTypes, err := bindResourceScanTypes(c)
+	if err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	result, err := biz.DryRunPublish(c.Request.Context(), resourceTypes)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessJSONResponse(c, result)
+}
+
+// PublishMutationDryRun ...
+//
+//	@ID			resource_publish_mutation_dry_run
+//	@Summary	预演发布，返回发布将对 etcd 进行的写操作，不写入 etcd
+//	@Description	与实际发布执行同一流程(包括 etcd 模式的 schema 校验)，未指定资源类型时预演一键发布；
+//	@Description	未通过校验的资源在 errors 中返回，不影响其他资源的预演
+//	@Accept		json
+//	@Produce	json
+//	@Tags		webapi.publish
+//	@Param		gateway_id	path		int										true	"网关 ID"
+//	@Param		request		body		serializer.PublishMutationDryRunRequest	false	"预演发布请求参数"
+//	@Success	200			{object}	dto.PublishPlan
+//	@Router		/api/v1/web/gateways/{gateway_id}/publish/dry-run/ [post]
+func PublishMutationDryRun(c *gin.Context) {
+	var req serializer.PublishMutationDryRunRequest
+	// 请求体可选，不传时预演一键发布
+	if err := validation.BindAndValidate(c, &req); err != nil && !errors.Is(err, io.EOF) {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	if req.ResourceType != "" {
+		err := biz.CheckResourcesNotInChangeSet(c.Request.Context(), req.ResourceType, req.ResourceIDList)
+		if err != nil {
+			ginx.ConflictJSONResponse(c, err)
+			return
+		}
+	}
+	result, err := biz.DryRunPublishMutations(c.Request.Context(), req.ResourceType, req.ResourceIDList)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessJSONResponse(c, result)
+}
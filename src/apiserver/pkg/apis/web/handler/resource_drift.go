@@ -0,0 +1,92 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/web/serializer"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+)
+
+// ResourceDriftGet ...
+//
+//	@ID			resource_drift_get
+//	@Summary	对比资源在编辑区与 etcd 中的配置
+//	@Description	编辑区配置按发布时的处理转换(如去掉 SSL 的 name)后与 etcd 中的配置逐字段对比，
+//	@Description	status 为 in_sync/modified/missing_in_etcd/missing_in_db
+//	@Produce	json
+//	@Tags		webapi.unify_op
+//	@Param		gateway_id	path		int		true	"网关 ID"
+//	@Param		type		path		string	true	"资源类型:route/global_rule 等"
+//	@Param		id			path		string	true	"资源 ID，资源不在编辑区时为 etcd key"
+//	@Success	200			{object}	dto.ResourceDrift
+//	@Router		/api/v1/web/gateways/{gateway_id}/resources/{type}/{id}/diff/ [get]
+func ResourceDriftGet(c *gin.Context) {
+	var pathParam serializer.ResourceCommonPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	if !slices.Contains(constant.ResourceTypeList, pathParam.Type) {
+		ginx.BadRequestErrorJSONResponse(c, fmt.Errorf("不支持的资源类型: %s", pathParam.Type))
+		return
+	}
+	result, err := biz.GetResourceDrift(c.Request.Context(), pathParam.Type, pathParam.ID)
+	if err != nil {
+		if errors.Is(err, biz.ErrDriftResourceNotFound) {
+			ginx.NotFoundJSONResponse(c, err)
+			return
+		}
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessJSONResponse(c, result)
+}
+
+// ResourceDriftSummary ...
+//
+//	@ID			resource_drift_summary
+//	@Summary	按资源类型统计编辑区与 etcd 的配置漂移
+//	@Description	每个资源类型目录只读取一次 etcd，待发布的资源不参与统计；types 不为空时只统计指定类型
+//	@Produce	json
+//	@Tags		webapi.unify_op
+//	@Param		gateway_id	path		int								true	"网关 ID"
+//	@Param		request		query		serializer.ResourceScanRequest	false	"统计的资源类型"
+//	@Success	200			{object}	dto.ResourceDriftSummary
+//	@Router		/api/v1/web/gateways/{gateway_id}/resources/-/drift_summary/ [get]
+func ResourceDriftSummary(c *gin.Context) {
+	resourceTypes, err := bindResourceScanTypes(c)
+	if err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	result, err := biz.GetResourceDriftSummary(c.Request.Context(), resourceTypes)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessJSONResponse(c, result)
+}
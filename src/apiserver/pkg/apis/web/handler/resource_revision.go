@@ -0,0 +1,139 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package handler
+
+import (
+	"encoding/json"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/web/serializer"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+)
+
+// RouteRevisionList ...
+//
+//	@ID			route_revision_list
+//	@Summary	路由历史版本列表
+//	@Description	按版本号倒序返回路由的历史版本，不包含配置内容
+//	@Produce	json
+//	@Tags		webapi.route
+//	@Param		gateway_id	path		int										true	"网关 ID"
+//	@Param		id			path		string									true	"路由 ID"
+//	@Param		request		query		serializer.ResourceRevisionListRequest	false	"查询参数"
+//	@Success	200			{object}	ginx.PaginatedResponse{results=serializer.ResourceRevisionListResponse}
+//	@Router		/api/v1/web/gateways/{gateway_id}/routes/{id}/revisions/ [get]
+func RouteRevisionList(c *gin.Context) {
+	var pathParam serializer.ResourceCommonPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	var req serializer.ResourceRevisionListRequest
+	if err := c.ShouldBind(&req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	revisions, total, err := biz.ListPagedResourceRevisions(
+		c.Request.Context(),
+		constant.Route,
+		pathParam.ID,
+		biz.PageParam{
+			Offset: ginx.GetOffset(c),
+			Limit:  ginx.GetLimit(c),
+		},
+	)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	results := serializer.ResourceRevisionListResponse{}
+	for _, revision := range revisions {
+		results = append(results, toResourceRevisionOutputInfo(revision))
+	}
+	ginx.SuccessJSONResponse(c, ginx.NewPaginatedRespData(total, results))
+}
+
+// RouteRevisionGet ...
+//
+//	@ID			route_revision_get
+//	@Summary	路由历史版本详情
+//	@Produce	json
+//	@Tags		webapi.route
+//	@Param		gateway_id	path		int		true	"网关 ID"
+//	@Param		id			path		string	true	"路由 ID"
+//	@Param		revision	path		int		true	"版本号"
+//	@Success	200			{object}	serializer.ResourceRevisionOutputInfo
+//	@Router		/api/v1/web/gateways/{gateway_id}/routes/{id}/revisions/{revision}/ [get]
+func RouteRevisionGet(c *gin.Context) {
+	var pathParam serializer.ResourceRevisionPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	revision, err := biz.GetResourceRevision(c.Request.Context(), constant.Route, pathParam.ID, pathParam.Revision)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessJSONResponse(c, toResourceRevisionOutputInfo(revision))
+}
+
+// RouteRevisionRestore ...
+//
+//	@ID			route_revision_restore
+//	@Summary	恢复路由历史版本
+//	@Description	按网关当前的 APISIX 版本校验历史配置后写回路由，恢复结果记为新版本，需重新发布生效
+//	@Produce	json
+//	@Tags		webapi.route
+//	@Param		gateway_id	path		int		true	"网关 ID"
+//	@Param		id			path		string	true	"路由 ID"
+//	@Param		revision	path		int		true	"版本号"
+//	@Success	200			{object}	serializer.ResourceRevisionOutputInfo
+//	@Router		/api/v1/web/gateways/{gateway_id}/routes/{id}/revisions/{revision}/restore/ [post]
+func RouteRevisionRestore(c *gin.Context) {
+	var pathParam serializer.ResourceRevisionPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	revision, err := biz.RestoreRouteRevision(c.Request.Context(), pathParam.ID, pathParam.Revision)
+	if err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessJSONResponse(c, toResourceRevisionOutputInfo(revision))
+}
+
+// toResourceRevisionOutputInfo 转换为资源版本信息，列表查询时配置为空
+func toResourceRevisionOutputInfo(revision *model.ResourceRevision) serializer.ResourceRevisionOutputInfo {
+	return serializer.ResourceRevisionOutputInfo{
+		Revision:      revision.Revision,
+		ResourceType:  revision.ResourceType,
+		ResourceID:    revision.ResourceID,
+		OperationType: revision.OperationType,
+		Summary:       revision.Summary,
+		Operator:      revision.Operator,
+		CreatedAt:     revision.CreatedAt.Unix(),
+		Config:        json.RawMessage(revision.Config),
+	}
+}
@@ -20,11 +20,14 @@ package handler
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/tidwall/gjson"
 	"gorm.io/datatypes"
+	"gorm.io/gorm"
 
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/web/serializer"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz"
@@ -32,6 +35,7 @@ import (
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/idx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/schema"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/validation"
 )
 
@@ -59,6 +63,7 @@ func RouteCreate(c *gin.Context) {
 		ServiceID:      req.ServiceID,
 		UpstreamID:     req.UpstreamID,
 		PluginConfigID: req.PluginConfigID,
+		ExpiresAt:      req.GetExpiresAt(),
 		ResourceCommonModel: model.ResourceCommonModel{
 			ID:        idx.GenResourceID(constant.Route),
 			GatewayID: ginx.GetGatewayInfo(c).ID,
@@ -114,6 +119,7 @@ func RouteUpdate(c *gin.Context) {
 		ServiceID:      req.ServiceID,
 		UpstreamID:     req.UpstreamID,
 		PluginConfigID: req.PluginConfigID,
+		ExpiresAt:      req.GetExpiresAt(),
 		ResourceCommonModel: model.ResourceCommonModel{
 			ID:        pathParam.ID,
 			GatewayID: pathParam.GatewayID,
@@ -136,6 +142,8 @@ func RouteUpdate(c *gin.Context) {
 //
 //	@ID			route_list
 //	@Summary	route 列表
+//	@Description	view=summary 时仅返回列表展示所需的摘要字段(由数据库从 config 中提取)，完整配置请使用 view=full 或详情接口；
+//	@Description	group_by=service 时按服务分组分页返回路由数量、待发布及已禁用的路由数量，未绑定服务的路由归入 unbound 分组
 //	@Produce	json
 //	@Tags		webapi.route
 //	@Param		gateway_id	path		int							true	"网关 ID"
@@ -153,16 +161,115 @@ func RouteList(c *gin.Context) {
 		ginx.BadRequestErrorJSONResponse(c, err)
 		return
 	}
+	listRoutes(c, pathParam.GatewayID, req)
+}
+
+// ServiceRouteList ...
+//
+//	@ID			service_route_list
+//	@Summary	service 绑定的 route 列表
+//	@Description	查询参数与 route 列表一致，service_id 及 group_by 参数不生效
+//	@Produce	json
+//	@Tags		webapi.route
+//	@Param		gateway_id	path		int							true	"网关 ID"
+//	@Param		id			path		string						true	"服务 ID"
+//	@Param		request		query		serializer.RouteListRequest	false	"查询参数"
+//	@Success	200			{object}	ginx.PaginatedResponse{results=serializer.RouteListResponse}
+//	@Router		/api/v1/web/gateways/{gateway_id}/services/{id}/routes/ [get]
+func ServiceRouteList(c *gin.Context) {
+	var pathParam serializer.ResourceCommonPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	var req serializer.RouteListRequest
+	if err := c.ShouldBind(&req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	service, err := biz.GetService(c.Request.Context(), pathParam.ID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ginx.NotFoundJSONResponse(c, err)
+			return
+		}
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	if service.GatewayID != pathParam.GatewayID {
+		ginx.NotFoundJSONResponse(c, fmt.Errorf("服务 %s 不存在", pathParam.ID))
+		return
+	}
+	req.ServiceID = service.ID
+	req.GroupBy = ""
+	listRoutes(c, pathParam.GatewayID, req)
+}
+
+// listRoutes 按查询参数分页返回网关下的路由列表、摘要列表或按服务分组的统计
+func listRoutes(c *gin.Context, gatewayID int, req serializer.RouteListRequest) {
 	labelMap, err := serializer.CheckLabel(req.Label)
 	if err != nil {
 		ginx.BadRequestErrorJSONResponse(c, err)
 		return
 	}
 	queryParam := map[string]interface{}{}
-	queryParam["gateway_id"] = pathParam.GatewayID
+	queryParam["gateway_id"] = gatewayID
 	if req.ID != "" {
 		queryParam["id"] = req.ID
 	}
+	if req.GroupBy == serializer.RouteGroupByService {
+		groups, total, err := biz.ListPagedRouteServiceGroups(
+			c.Request.Context(),
+			queryParam,
+			labelMap,
+			strings.Split(req.Status, ","),
+			req.Name,
+			req.Updater,
+			req.Path,
+			req.Method,
+			req.ServiceID,
+			req.UpstreamID,
+			biz.PageParam{
+				Offset: ginx.GetOffset(c),
+				Limit:  ginx.GetLimit(c),
+			},
+		)
+		if err != nil {
+			ginx.SystemErrorJSONResponse(c, err)
+			return
+		}
+		ginx.SuccessJSONResponse(c, ginx.NewPaginatedRespData(total, groups))
+		return
+	}
+	if req.View == serializer.ListViewSummary {
+		routes, total, err := biz.ListPagedRouteSummaries(
+			c.Request.Context(),
+			queryParam,
+			labelMap,
+			strings.Split(req.Status, ","),
+			req.Name,
+			req.Updater,
+			req.Path,
+			req.Method,
+			req.ServiceID,
+			req.UpstreamID,
+			req.OrderBy,
+			biz.PageParam{
+				Offset: ginx.GetOffset(c),
+				Limit:  ginx.GetLimit(c),
+			},
+		)
+		if err != nil {
+			ginx.SystemErrorJSONResponse(c, err)
+			return
+		}
+		results := serializer.RouteSummaryListResponse{}
+		for _, route := range routes {
+			results = append(results, toRouteSummaryOutputInfo(route))
+		}
+		ginx.SuccessJSONResponse(c, ginx.NewPaginatedRespData(total, results))
+		return
+	}
 	routes, total, err := biz.ListPagedRoutes(
 		c.Request.Context(),
 		queryParam,
@@ -196,6 +303,7 @@ func RouteList(c *gin.Context) {
 				PluginConfigID: route.PluginConfigID,
 				Config:         json.RawMessage(route.Config),
 				ID:             route.ID,
+				ExpiresAt:      serializer.RouteExpiresAtToUnix(route.ExpiresAt),
 			},
 			Status:    route.Status,
 			CreatedAt: route.CreatedAt.Unix(),
@@ -239,6 +347,7 @@ func RouteGet(c *gin.Context) {
 			UpstreamID:     route.UpstreamID,
 			PluginConfigID: route.PluginConfigID,
 			Config:         json.RawMessage(route.Config),
+			ExpiresAt:      serializer.RouteExpiresAtToUnix(route.ExpiresAt),
 		},
 		CreatedAt: route.CreatedAt.Unix(),
 		UpdatedAt: route.UpdatedAt.Unix(),
@@ -326,3 +435,20 @@ func RouteDropDownList(c *gin.Context) {
 	}
 	ginx.SuccessJSONResponse(c, output)
 }
+
+// RouteVarList ...
+//
+//	@ID			route_var_list
+//	@Summary	route vars 可用变量列表
+//	@Produce	json
+//	@Tags		webapi.route
+//	@Param		gateway_id	path		int	true	"网关 id"
+//	@Success	200			{object}	serializer.RouteVarsResponse
+//	@Router		/api/v1/web/gateways/{gateway_id}/routes/-/vars/ [get]
+func RouteVarList(c *gin.Context) {
+	ginx.SuccessJSONResponse(c, serializer.RouteVarsResponse{
+		Names:           schema.BuiltinVarNames(),
+		Prefixes:        schema.VarNamePrefixes,
+		AllowCustomVars: ginx.GetGatewayInfo(c).AllowCustomVars,
+	})
+}
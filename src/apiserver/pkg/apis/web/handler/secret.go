@@ -0,0 +1,294 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/datatypes"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/web/serializer"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/idx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/redact"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/schema"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/validation"
+)
+
+// SecretCreate ...
+//
+//	@ID			secret_create
+//	@Summary	secret 创建
+//	@Accept		json
+//	@Produce	json
+//	@Tags		webapi.secret
+//	@Param		gateway_id	path	int						true	"网关 ID"
+//	@Param		request		body	serializer.SecretInfo	true	"secret 创建参数"
+//	@Success	201
+//	@Router		/api/v1/web/gateways/{gateway_id}/secrets/ [post]
+func SecretCreate(c *gin.Context) {
+	var req serializer.SecretInfo
+	if err := validation.BindAndValidate(c, &req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	secret := model.Secret{
+		Name:    req.Name,
+		Manager: req.Manager,
+		ResourceCommonModel: model.ResourceCommonModel{
+			ID:        idx.GenResourceID(constant.Secret),
+			GatewayID: ginx.GetGatewayInfo(c).ID,
+			Config:    datatypes.JSON(req.Config),
+			Status:    constant.ResourceStatusCreateDraft,
+			BaseModel: model.BaseModel{
+				Creator: ginx.GetUserID(c),
+				Updater: ginx.GetUserID(c),
+			},
+		},
+	}
+	if err := biz.CreateSecret(c.Request.Context(), secret); err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessCreateResponse(c)
+}
+
+// SecretUpdate ...
+//
+//	@ID			secret_update
+//	@Summary	secret 更新
+//	@Accept		json
+//	@Produce	json
+//	@Tags		webapi.secret
+//	@Param		gateway_id	path	int						true	"网关ID"
+//	@Param		id			path	string					true	"secret ID"
+//	@Param		request		body	serializer.SecretInfo	true	"secret 更新参数"
+//	@Success	201
+//	@Router		/api/v1/web/gateways/{gateway_id}/secrets/{id}/ [put]
+func SecretUpdate(c *gin.Context) {
+	var pathParam serializer.ResourceCommonPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	req := serializer.SecretInfo{ID: pathParam.ID}
+	if err := validation.BindAndValidate(c, &req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	origin, err := biz.GetSecret(c.Request.Context(), pathParam.ID)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	// 密钥管理器决定了 etcd key，创建后不允许修改
+	if req.Manager != origin.Manager {
+		ginx.BadRequestErrorJSONResponse(c, errors.New("secret 创建后不允许修改密钥管理器"))
+		return
+	}
+	updateStatus, err := biz.GetResourceUpdateStatus(c.Request.Context(), constant.Secret, pathParam.ID)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	secret := model.Secret{
+		Name: req.Name,
+		ResourceCommonModel: model.ResourceCommonModel{
+			ID:        pathParam.ID,
+			GatewayID: pathParam.GatewayID,
+			// 详情中返回的是脱敏后的配置，未修改的敏感字段需要还原
+			Config: datatypes.JSON(redact.Restore(constant.Secret, req.Config, json.RawMessage(origin.Config))),
+			Status: updateStatus,
+			BaseModel: model.BaseModel{
+				Updater: ginx.GetUserID(c),
+			},
+		},
+	}
+	if err := biz.UpdateSecret(c.Request.Context(), secret); err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+}
+
+// SecretGet ...
+//
+//	@ID			secret_get
+//	@Summary	secret 详情
+//	@Produce	json
+//	@Tags		webapi.secret
+//	@Param		gateway_id	path		int		true	"网关 id"
+//	@Param		id			path		string	true	"资源 ID"
+//	@Success	200			{object}	serializer.SecretOutputInfo
+//	@Router		/api/v1/web/gateways/{gateway_id}/secrets/{id}/ [get]
+func SecretGet(c *gin.Context) {
+	var pathParam serializer.ResourceCommonPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	secret, err := biz.GetSecret(c.Request.Context(), pathParam.ID)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	output := serializer.SecretOutputInfo{
+		AutoID:    secret.AutoID,
+		ID:        secret.ID,
+		GatewayID: secret.GatewayID,
+		SecretInfo: serializer.SecretInfo{
+			ID:      secret.ID,
+			Name:    secret.Name,
+			Manager: secret.Manager,
+			Config:  redact.Config(constant.Secret, json.RawMessage(secret.Config)),
+		},
+		CreatedAt: secret.CreatedAt.Unix(),
+		UpdatedAt: secret.UpdatedAt.Unix(),
+		Creator:   secret.Creator,
+		Updater:   secret.Updater,
+		Status:    secret.Status,
+	}
+	ginx.SuccessJSONResponse(c, output)
+}
+
+// SecretDelete ...
+//
+//	@ID			secret_delete
+//	@Summary	secret 删除
+//	@Produce	json
+//	@Tags		webapi.secret
+//	@Param		gateway_id	path	int		true	"网关 id"
+//	@Param		id			path	string	true	"资源 ID"
+//	@Success	204
+//	@Router		/api/v1/web/gateways/{gateway_id}/secrets/{id}/ [delete]
+func SecretDelete(c *gin.Context) {
+	var pathParam serializer.ResourceCommonPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	secret, err := biz.GetSecret(c.Request.Context(), pathParam.ID)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	// create_draft 状态可以直接删除
+	if secret.Status == constant.ResourceStatusCreateDraft {
+		err = biz.BatchDeleteSecrets(c.Request.Context(), []string{secret.ID})
+		if err != nil {
+			ginx.SystemErrorJSONResponse(c, err)
+			return
+		}
+		ginx.SuccessNoContentResponse(c)
+		return
+	}
+	err = biz.UpdateResourceStatusWithAuditLog(c.Request.Context(),
+		constant.Secret, secret.ID, constant.ResourceStatusDeleteDraft)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessNoContentResponse(c)
+}
+
+// SecretList ...
+//
+//	@ID			secret_list
+//	@Summary	secret 列表
+//	@Produce	json
+//	@Tags		webapi.secret
+//	@Param		gateway_id	path		int							true	"网关 ID"
+//	@Param		request		query		serializer.SecretListRequest	false	"查询参数"
+//	@Success	200			{object}	ginx.PaginatedResponse{results=serializer.SecretListResponse}
+//	@Router		/api/v1/web/gateways/{gateway_id}/secrets/ [get]
+func SecretList(c *gin.Context) {
+	var pathParam serializer.ResourceCommonPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	var req serializer.SecretListRequest
+	if err := c.ShouldBind(&req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	queryParam := map[string]interface{}{}
+	queryParam["gateway_id"] = pathParam.GatewayID
+	if req.ID != "" {
+		queryParam["id"] = req.ID
+	}
+	if req.Manager != "" {
+		queryParam["manager"] = req.Manager
+	}
+	secretList, total, err := biz.ListPagedSecrets(
+		c.Request.Context(),
+		queryParam,
+		strings.Split(req.Status, ","),
+		req.Name,
+		req.Updater,
+		req.OrderBy,
+		biz.PageParam{
+			Offset: ginx.GetOffset(c),
+			Limit:  ginx.GetLimit(c),
+		},
+	)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	var results serializer.SecretListResponse
+	for _, secret := range secretList {
+		results = append(results, serializer.SecretOutputInfo{
+			ID:        secret.ID,
+			AutoID:    secret.AutoID,
+			GatewayID: secret.GatewayID,
+			SecretInfo: serializer.SecretInfo{
+				ID:      secret.ID,
+				Name:    secret.Name,
+				Manager: secret.Manager,
+				Config:  redact.Config(constant.Secret, json.RawMessage(secret.Config)),
+			},
+			Status:    secret.Status,
+			CreatedAt: secret.CreatedAt.Unix(),
+			UpdatedAt: secret.UpdatedAt.Unix(),
+			Creator:   secret.Creator,
+			Updater:   secret.Updater,
+		})
+	}
+	ginx.SuccessJSONResponse(c, ginx.NewPaginatedRespData(total, results))
+}
+
+// SecretManagerList ...
+//
+//	@ID			secret_manager_list
+//	@Summary	网关版本支持的密钥管理器列表
+//	@Produce	json
+//	@Tags		webapi.secret
+//	@Param		gateway_id	path		int	true	"网关 ID"
+//	@Success	200			{object}	serializer.SecretManagerListResponse
+//	@Router		/api/v1/web/gateways/{gateway_id}/secret-managers/ [get]
+func SecretManagerList(c *gin.Context) {
+	managers := schema.GetSecretManagers(ginx.GetGatewayInfo(c).GetAPISIXVersionX())
+	ginx.SuccessJSONResponse(c, serializer.SecretManagerListResponse(managers))
+}
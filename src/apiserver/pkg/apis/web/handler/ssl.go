@@ -28,6 +28,7 @@ import (
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/web/serializer"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	entity "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/apisix"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/idx"
@@ -63,6 +64,46 @@ func SSLCheck(c *gin.Context) {
 	})
 }
 
+// SSLParse  解析证书 snis 及有效期 ...
+//
+//	@ID			ssl_parse
+//	@Summary	证书解析
+//	@Accept		json
+//	@Produce	json
+//	@Tags		webapi.ssl
+//	@Param		gateway_id	path		int							true	"网关 ID"
+//	@Param		request		body		serializer.SSLParseRequest	true	"ssl parse请求参数"
+//	@Success	200			{object}	serializer.SSLParseResponse
+//	@Router		/api/v1/web/gateways/{gateway_id}/ssls/parse/ [post]
+func SSLParse(c *gin.Context) {
+	var req serializer.SSLParseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	snis, notBefore, notAfter, err := entity.ParseCertificateInfo(req.Cert)
+	if err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessJSONResponse(c, serializer.SSLParseResponse{
+		Snis:          snis,
+		ValidityStart: notBefore,
+		ValidityEnd:   notAfter,
+	})
+}
+
+// bindSSLInfo 绑定请求并在 schema 校验前补全证书信息
+func bindSSLInfo(c *gin.Context, req *serializer.SSLInfo) error {
+	if err := c.ShouldBindJSON(req); err != nil {
+		return err
+	}
+	if err := req.FillCertInfo(); err != nil {
+		return err
+	}
+	return validation.ValidateStruct(c.Request.Context(), req)
+}
+
 // SSLCreate  SSL证书创建 ...
 //
 //	@ID			ssl_create
@@ -76,7 +117,7 @@ func SSLCheck(c *gin.Context) {
 //	@Router		/api/v1/web/gateways/{gateway_id}/ssls/ [post]
 func SSLCreate(c *gin.Context) {
 	var req serializer.SSLInfo
-	if err := validation.BindAndValidate(c, &req); err != nil {
+	if err := bindSSLInfo(c, &req); err != nil {
 		ginx.BadRequestErrorJSONResponse(c, err)
 		return
 	}
@@ -136,7 +177,7 @@ func SSLUpdate(c *gin.Context) {
 		return
 	}
 	req := serializer.SSLInfo{ID: pathParam.ID}
-	if err := validation.BindAndValidate(c, &req); err != nil {
+	if err := bindSSLInfo(c, &req); err != nil {
 		ginx.BadRequestErrorJSONResponse(c, err)
 		return
 	}
@@ -184,6 +225,7 @@ func SSLUpdate(c *gin.Context) {
 //
 //	@ID			ssl_list
 //	@Summary	ssl 列表
+//	@Description	view=summary 时仅返回列表展示所需的摘要字段(由数据库从 config 中提取)，完整配置请使用 view=full 或详情接口
 //	@Produce	json
 //	@Tags		webapi.ssl
 //	@Param		gateway_id	path		int							true	"网关 ID"
@@ -211,6 +253,31 @@ func SSLList(c *gin.Context) {
 	if req.ID != "" {
 		queryParam["id"] = req.ID
 	}
+	if req.View == serializer.ListViewSummary {
+		ssls, total, err := biz.ListPagedSSLSummaries(
+			c.Request.Context(),
+			queryParam,
+			labelMap,
+			strings.Split(req.Status, ","),
+			req.Name,
+			req.Updater,
+			req.OrderBy,
+			biz.PageParam{
+				Offset: ginx.GetOffset(c),
+				Limit:  ginx.GetLimit(c),
+			},
+		)
+		if err != nil {
+			ginx.SystemErrorJSONResponse(c, err)
+			return
+		}
+		results := serializer.SSLSummaryListResponse{}
+		for _, ssl := range ssls {
+			results = append(results, toSSLSummaryOutputInfo(ssl))
+		}
+		ginx.SuccessJSONResponse(c, ginx.NewPaginatedRespData(total, results))
+		return
+	}
 	ssls, total, err := biz.ListPagedSSL(
 		c.Request.Context(),
 		queryParam,
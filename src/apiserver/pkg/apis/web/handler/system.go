@@ -21,6 +21,8 @@ package handler
 import (
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"slices"
 	"strings"
 
 	"github.com/gin-gonic/gin"
@@ -93,6 +95,124 @@ func ResourceSchemaGet(c *gin.Context) {
 	ginx.SuccessJSONResponse(c, schemaInfo)
 }
 
+// SchemaDefinitionGet ...
+//
+//	@ID			schema_definition_get
+//	@Summary	获取内置资源 schema 定义
+//	@Produce	json
+//	@Tags		webapi.system
+//	@Param		version		path		string						true	"APISIX 版本：3.13/3.13.X"
+//	@Param		resource	path		string						true	"资源类型:route/global_rule 等"
+//	@Param		data_type	query		string						false	"数据类型：db/etcd，默认 db"
+//	@Success	200			{object}	map[string]interface{}		"schema"
+//	@Failure	404			{object}	serializer.SchemaNotFoundInfo	"版本或资源不存在"
+//	@Router		/api/v1/schemas/{version}/{resource}/ [get]
+func SchemaDefinitionGet(c *gin.Context) {
+	var req serializer.SchemaDefinitionRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	if err := c.ShouldBindQuery(&req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	version, ok := schema.ParseSchemaVersion(req.Version)
+	if !ok {
+		schemaNotFoundResponse(c, fmt.Sprintf("不支持的版本: %s", req.Version), true)
+		return
+	}
+	resourceType := constant.APISIXResource(req.Resource)
+	if !slices.Contains(constant.ResourceTypeList, resourceType) {
+		schemaNotFoundResponse(c, fmt.Sprintf("不支持的资源类型: %s", req.Resource), true)
+		return
+	}
+	dataType := constant.DATABASE
+	if req.DataType != "" {
+		dataType = constant.DataType(req.DataType)
+	}
+	schemaDef, err := schema.ResourceSchemaDefinition(version, resourceType, dataType)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessJSONResponse(c, schemaDef)
+}
+
+// PluginSchemaDefinitionGet ...
+//
+//	@ID			plugin_schema_definition_get
+//	@Summary	获取内置插件 schema 定义
+//	@Produce	json
+//	@Tags		webapi.system
+//	@Param		version		path		string						true	"APISIX 版本：3.13/3.13.X"
+//	@Param		name		path		string						true	"插件名称"
+//	@Param		schema_type	query		string						false	"metadata/consumer/stream"
+//	@Success	200			{object}	map[string]interface{}		"schema"
+//	@Failure	404			{object}	serializer.SchemaNotFoundInfo	"版本或插件不存在"
+//	@Router		/api/v1/schemas/{version}/plugins/{name}/ [get]
+func PluginSchemaDefinitionGet(c *gin.Context) {
+	var req serializer.PluginSchemaDefinitionRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	if err := c.ShouldBindQuery(&req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	version, ok := schema.ParseSchemaVersion(req.Version)
+	if !ok {
+		schemaNotFoundResponse(c, fmt.Sprintf("不支持的版本: %s", req.Version), false)
+		return
+	}
+	schemaInfo := schema.GetPluginSchema(version, req.Name, req.SchemaType)
+	if schemaInfo == nil {
+		schemaNotFoundResponse(c, fmt.Sprintf("插件 schema 不存在: %s", req.Name), false)
+		return
+	}
+	ginx.SuccessJSONResponse(c, schemaInfo)
+}
+
+// AvailablePluginsList ...
+//
+//	@ID			available_plugins_list
+//	@Summary	获取版本内置的插件列表
+//	@Description	用于前端按网关版本过滤可选插件
+//	@Produce	json
+//	@Tags		webapi.system
+//	@Param		version	path		string						true	"APISIX 版本：3.13/3.13.X"
+//	@Success	200		{array}		string						"插件名称，按名称排序"
+//	@Failure	404		{object}	serializer.SchemaNotFoundInfo	"版本不存在"
+//	@Router		/api/v1/schemas/{version}/plugins/ [get]
+func AvailablePluginsList(c *gin.Context) {
+	var req serializer.AvailablePluginsRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	version, ok := schema.ParseSchemaVersion(req.Version)
+	if !ok {
+		schemaNotFoundResponse(c, fmt.Sprintf("不支持的版本: %s", req.Version), false)
+		return
+	}
+	plugins, err := schema.AvailablePlugins(version)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessJSONResponse(c, plugins)
+}
+
+// schemaNotFoundResponse 返回 404 及支持的版本与资源类型
+func schemaNotFoundResponse(c *gin.Context, message string, withResources bool) {
+	info := serializer.SchemaNotFoundInfo{SupportedVersions: schema.SupportedSchemaVersions()}
+	if withResources {
+		info.SupportedResources = schema.SupportedSchemaResources()
+	}
+	ginx.BaseErrorJSONResponseWithData(c, ginx.NotFoundError, message, http.StatusNotFound, info)
+}
+
 // SchemaCreate ...
 //
 //	@ID			schema_create
@@ -250,17 +370,13 @@ func SchemaDelete(c *gin.Context) {
 		ginx.SystemErrorJSONResponse(c, err)
 		return
 	}
-	// 查询是否有资源关联
-	resourceSchemas, err := biz.GetResourceSchemaAssociation(c.Request.Context(), pathParam.AutoID)
+	// 查询是否有资源引用
+	resourceList, err := biz.GetCustomPluginReferences(c.Request.Context(), schemaInfo.Name)
 	if err != nil {
-		ginx.BadRequestErrorJSONResponse(c, err)
+		ginx.SystemErrorJSONResponse(c, err)
 		return
 	}
-	if len(resourceSchemas) != 0 {
-		var resourceList []string
-		for _, s := range resourceSchemas {
-			resourceList = append(resourceList, fmt.Sprintf("%s: %s", s.ResourceType.String(), s.ResourceID))
-		}
+	if len(resourceList) != 0 {
 		ginx.BadRequestErrorJSONResponse(c,
 			fmt.Errorf("name: %s 该插件已被 [ %s ] 资源引用, 不可删除", schemaInfo.Name, strings.Join(resourceList, ", ")),
 		)
@@ -21,19 +21,24 @@ package handler
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
 	"gorm.io/datatypes"
 
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/common"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/web/serializer"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/filex"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/idx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/redact"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/schema"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/validation"
 )
 
@@ -41,11 +46,13 @@ import (
 //
 //	@ID			resource_sync
 //	@Summary	资源同步
+//	@Description	types 不为空时只按类型读取 etcd 中对应的目录并同步这些类型，响应中只包含读取的资源类型
 //	@Accept		json
 //	@Produce	json
 //	@Tags		webapi.unify_op
-//	@Param		gateway_id	path		int						true	"网关 ID"
-//	@Param		request		body		serializer.SyncRequest	true	"资源同步请求参数"
+//	@Param		gateway_id	path		int								true	"网关 ID"
+//	@Param		scan		query		serializer.ResourceScanRequest	false	"读取的资源类型"
+//	@Param		request		body		serializer.SyncRequest			true	"资源同步请求参数"
 //	@Success	200			{object}	serializer.SyncResponse
 //	@Router		/api/v1/web/gateways/{gateway_id}/sync/ [post]
 func ResourceSync(c *gin.Context) {
@@ -54,18 +61,68 @@ func ResourceSync(c *gin.Context) {
 		ginx.BadRequestErrorJSONResponse(c, err)
 		return
 	}
-	syncedResourceTypeStats, err := biz.SyncResources(c.Request.Context(), req.ResourceType)
+	resourceTypes, err := bindResourceScanTypes(c)
+	if err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	if len(resourceTypes) == 0 && req.ResourceType != "" {
+		resourceTypes = []constant.APISIXResource{req.ResourceType}
+	}
+	syncedResourceTypeStats, err := biz.SyncResources(c.Request.Context(), resourceTypes)
 	if err != nil {
 		ginx.SystemErrorJSONResponse(c, err)
 		return
 	}
 	resp := make(serializer.SyncResponse)
-	for _, resourceType := range constant.ResourceTypeList {
+	for _, resourceType := range biz.ScannedResourceTypes(resourceTypes) {
 		resp[resourceType] = syncedResourceTypeStats[resourceType]
 	}
 	ginx.SuccessJSONResponse(c, resp)
 }
 
+// ResourceSyncFromEtcd  从 etcd 导入资源 ...
+//
+//	@ID			resource_sync_from_etcd
+//	@Summary	将 etcd 中的资源导入编辑区
+//	@Description	按 etcd key 新增或覆盖编辑区中的资源，etcd key 不是编辑区生成的 ID 时生成新的资源 ID；
+//	@Description	编辑区配置与 etcd 不一致时按 strategy 处理，返回每个资源的导入结果，中断后可重新执行继续导入
+//	@Accept		json
+//	@Produce	json
+//	@Tags		webapi.unify_op
+//	@Param		gateway_id	path		int								true	"网关 ID"
+//	@Param		scan		query		serializer.ResourceScanRequest	false	"导入的资源类型"
+//	@Param		request		body		serializer.SyncFromEtcdRequest	true	"导入请求参数"
+//	@Success	200			{object}	dto.EtcdImportReport
+//	@Router		/api/v1/web/gateways/{gateway_id}/sync/from-etcd/ [post]
+func ResourceSyncFromEtcd(c *gin.Context) {
+	var req serializer.SyncFromEtcdRequest
+	if err := validation.BindAndValidate(c, &req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	resourceTypes, err := bindResourceScanTypes(c)
+	if err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	report, err := biz.SyncResourcesFromEtcd(c.Request.Context(), req.Strategy, resourceTypes)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessJSONResponse(c, report)
+}
+
+// bindResourceScanTypes 解析查询参数 types 指定的 etcd 读取范围，不传时返回 nil 表示全部类型
+func bindResourceScanTypes(c *gin.Context) ([]constant.APISIXResource, error) {
+	var req serializer.ResourceScanRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		return nil, err
+	}
+	return biz.ParseResourceTypes(req.Types)
+}
+
 // ResourceRevert  资源撤销 ...
 //
 //	@ID			resource_revert
@@ -158,9 +215,58 @@ func ResourcesDiffAll(c *gin.Context) {
 		ginx.SystemErrorJSONResponse(c, err)
 		return
 	}
+	if req.ChangeSetID != 0 {
+		result, err = filterChangesByChangeSet(c, result, req.ChangeSetID)
+		if err != nil {
+			ginx.NotFoundJSONResponse(c, err)
+			return
+		}
+	}
 	ginx.SuccessJSONResponse(c, result)
 }
 
+// filterChangesByChangeSet 只保留变更集中的资源变更
+func filterChangesByChangeSet(
+	c *gin.Context,
+	changes []dto.ResourceChangeInfo,
+	changeSetID int,
+) ([]dto.ResourceChangeInfo, error) {
+	changeSet, err := biz.GetChangeSet(c.Request.Context(), ginx.GetGatewayInfo(c).ID, changeSetID)
+	if err != nil {
+		return nil, err
+	}
+	resourceIDs, err := biz.GetChangeSetResourceIDs(c.Request.Context(), changeSet.ID)
+	if err != nil {
+		return nil, err
+	}
+	return biz.FilterResourceChanges(changes, resourceIDs.Contains), nil
+}
+
+// ResourceBatchValidate 批量校验资源 ...
+//
+//	@ID			resource_batch_validate
+//	@Summary	批量校验资源配置(不落库)
+//	@Accept		json
+//	@Produce	json
+//	@Tags		webapi.unify_op
+//	@Param		gateway_id	path		int							true	"网关 ID"
+//	@Param		request		body		[]dto.ResourceValidateItem	true	"待校验资源列表"
+//	@Success	200			{object}	[]dto.ResourceValidateResult
+//	@Router		/api/v1/web/gateways/{gateway_id}/resources/-/validate/ [post]
+func ResourceBatchValidate(c *gin.Context) {
+	var items []dto.ResourceValidateItem
+	if err := c.ShouldBindJSON(&items); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	if len(items) > biz.MaxBatchValidateSize {
+		ginx.BadRequestErrorJSONResponse(c, fmt.Errorf("单次最多校验 %d 个资源, 当前 %d 个",
+			biz.MaxBatchValidateSize, len(items)))
+		return
+	}
+	ginx.SuccessJSONResponse(c, biz.BatchValidateResources(c.Request.Context(), items))
+}
+
 // ResourcesDiff  资源对比 ...
 //
 //	@ID			resources_diff
@@ -190,6 +296,13 @@ func ResourcesDiff(c *gin.Context) {
 		ginx.SystemErrorJSONResponse(c, err)
 		return
 	}
+	if req.ChangeSetID != 0 {
+		result, err = filterChangesByChangeSet(c, result, req.ChangeSetID)
+		if err != nil {
+			ginx.NotFoundJSONResponse(c, err)
+			return
+		}
+	}
 	ginx.SuccessJSONResponse(c, result)
 }
 
@@ -280,7 +393,8 @@ func ResourceLabelsList(c *gin.Context) {
 //	@Accept		json
 //	@Produce	json
 //	@Tags		webapi.unify_op
-//	@Param		gateway_id	path	int	true	"网关 ID"
+//	@Param		gateway_id	path	int								true	"网关 ID"
+//	@Param		request		query	serializer.ResourceExportRequest	false	"导出参数"
 //	@Router		/api/v1/web/gateways/{gateway_id}/unify_op/etcd/export/ [get]
 //
 // EtcdExport handles the export of etcd resources for a specified gateway.
@@ -294,6 +408,11 @@ func EtcdExport(c *gin.Context) {
 		ginx.BadRequestErrorJSONResponse(c, err)
 		return
 	}
+	var req serializer.ResourceExportRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
 	exporter, err := biz.NewUnifyOp(ginx.GetGatewayInfo(c), false)
 	if err != nil {
 		logging.ErrorFWithContext(c.Request.Context(), "new exporter error: %s", err.Error())
@@ -306,15 +425,119 @@ func EtcdExport(c *gin.Context) {
 		ginx.SystemErrorJSONResponse(c, err)
 		return
 	}
-	outputs := handExportEtcdResources(resources)
+	outputs := handExportEtcdResources(resources, req.IncludeSecrets, req.IncludeCredentials)
 	// response json
 	fileData, _ := json.MarshalIndent(outputs, "", "    ")
 	fileName := fmt.Sprintf("%s_export_etcd_resources.json", ginx.GetGatewayInfo(c).Name)
 	ginx.SuccessFileResponse(c, "text/plain", fileData, fileName)
 }
 
-// handExportEtcdResources 处理导出etcd资源
-func handExportEtcdResources(resources []*model.GatewaySyncData) serializer.EtcdExportOutput {
+// StandaloneExport 导出 APISIX standalone 模式配置 ...
+//
+//	@ID			resources_standalone_export
+//	@Summary	导出 standalone 模式的 apisix.yaml
+//	@Description	校验失败被跳过的资源以注释形式列在文件开头
+//	@Produce	plain
+//	@Tags		webapi.unify_op
+//	@Param		gateway_id	path	int								true	"网关 ID"
+//	@Param		request		query	serializer.ResourceExportRequest	false	"导出参数"
+//	@Router		/api/v1/web/gateways/{gateway_id}/unify_op/standalone/export/ [get]
+func StandaloneExport(c *gin.Context) {
+	var req serializer.ResourceExportRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	fileData, skipped, err := biz.ExportStandaloneYAML(c.Request.Context(), req.IncludeSecrets)
+	if err != nil {
+		logging.ErrorFWithContext(c.Request.Context(), "export standalone config error: %s", err.Error())
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	var header []byte
+	for _, resource := range skipped {
+		header = fmt.Appendf(header, "# skipped %s %s(%s): %s\n",
+			resource.ResourceType, resource.ID, resource.Name, strings.ReplaceAll(resource.Reason, "\n", " "))
+	}
+	fileName := fmt.Sprintf("%s_apisix.yaml", ginx.GetGatewayInfo(c).Name)
+	ginx.SuccessFileResponse(c, "text/plain", append(header, fileData...), fileName)
+}
+
+// StandaloneUpload 导入 apisix.yaml ...
+//
+//	@ID			standalone_upload
+//	@Summary	解析 APISIX standalone 模式的 apisix.yaml
+//	@Description	按网关 apisix 版本校验各资源，返回待导入资源及校验失败的资源；确认后通过资源导入接口导入编辑区
+//	@Accept		multipart/form-data
+//	@Produce	json
+//	@Tags		webapi.unify_op
+//	@Param		gateway_id		path		int									true	"网关 ID"
+//	@Param		resource_file	formData	file								true	"apisix.yaml"
+//	@Param		request			query		serializer.StandaloneUploadRequest	false	"导入参数"
+//	@Success	200				{object}	serializer.StandaloneUploadResponse
+//	@Router		/api/v1/web/gateways/{gateway_id}/unify_op/standalone/upload/ [post]
+func StandaloneUpload(c *gin.Context) {
+	var req serializer.StandaloneUploadRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	fileHeader, err := c.FormFile("resource_file")
+	if err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	content, err := filex.ReadFile(fileHeader)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	staged, importErrors, err := biz.StageStandaloneYAML(c.Request.Context(), content, req.ResourceTypes)
+	if err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	resourceInfoTypeMap := make(map[constant.APISIXResource][]common.ResourceInfo)
+	existsResourceIdList := make(map[string]struct{})
+	for resourceType, resources := range staged {
+		dbResources, err := biz.BatchGetResources(c.Request.Context(), resourceType, []string{})
+		if err != nil {
+			ginx.SystemErrorJSONResponse(c, err)
+			return
+		}
+		for _, dbResource := range dbResources {
+			existsResourceIdList[dbResource.ID] = struct{}{}
+		}
+		for _, resource := range resources {
+			resourceInfoTypeMap[resourceType] = append(resourceInfoTypeMap[resourceType], common.ResourceInfo{
+				ResourceType: resourceType,
+				ResourceID:   resource.ID,
+				Config:       json.RawMessage(resource.Config),
+			})
+		}
+	}
+	if err := common.NormalizeImportResources(resourceInfoTypeMap); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	resources, err := common.ClassifyImportResourceInfo(resourceInfoTypeMap, existsResourceIdList)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessJSONResponse(c, serializer.StandaloneUploadResponse{
+		Resources: resources,
+		Errors:    importErrors,
+	})
+}
+
+// handExportEtcdResources 处理导出etcd资源，未指定 includeSecrets 时对敏感字段脱敏；
+// consumer 的凭证字段替换为占位值，凭证字段及 credential 仅在指定 includeCredentials 时导出到单独的凭证段
+func handExportEtcdResources(
+	resources []*model.GatewaySyncData,
+	includeSecrets bool,
+	includeCredentials bool,
+) serializer.EtcdExportOutput {
 	outputs := make(serializer.EtcdExportOutput)
 	for _, resource := range resources {
 		if resource.ID == "" {
@@ -326,6 +549,28 @@ func handExportEtcdResources(resources []*model.GatewaySyncData) serializer.Etcd
 			Name:         resource.GetName(),
 			Config:       json.RawMessage(resource.Config),
 		}
+		switch resource.Type {
+		case constant.Consumer:
+			var credentials json.RawMessage
+			resourceOutput.Config, credentials = biz.SplitConsumerCredentials(resourceOutput.Config)
+			if includeCredentials && credentials != nil {
+				outputs[common.CredentialsSection] = append(outputs[common.CredentialsSection], serializer.ResourceInfo{
+					ResourceType: resource.Type,
+					ResourceID:   resource.ID,
+					Name:         resourceOutput.Name,
+					Config:       credentials,
+				})
+			}
+		case constant.Credential:
+			if includeCredentials {
+				resourceOutput.ConsumerID = model.ConsumerIDFromCredentialEtcdKey(resource.EtcdKeyOverride)
+				outputs[common.CredentialsSection] = append(outputs[common.CredentialsSection], resourceOutput)
+			}
+			continue
+		}
+		if !includeSecrets {
+			resourceOutput.Config = redact.Config(resource.Type, resourceOutput.Config)
+		}
 		if _, ok := outputs[resource.Type]; !ok {
 			outputs[resource.Type] = []serializer.ResourceInfo{resourceOutput}
 			continue
@@ -369,6 +614,13 @@ func ResourceUpload(c *gin.Context) {
 		ginx.SystemErrorJSONResponse(c, err)
 		return
 	}
+	// 凭证段不是资源，原样返回，导入时回填到 consumer
+	credentials := resourceInfoTypeMap[common.CredentialsSection]
+	delete(resourceInfoTypeMap, common.CredentialsSection)
+	if err := common.NormalizeImportResources(resourceInfoTypeMap); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
 	// check 配置
 	resourceTypeMap := make(map[constant.APISIXResource][]*model.GatewaySyncData)
 	existsResourceIdList := make(map[string]struct{})
@@ -404,6 +656,12 @@ func ResourceUpload(c *gin.Context) {
 		ginx.SystemErrorJSONResponse(c, err)
 		return
 	}
+	resources.Credentials = credentials
+	resources.CredentialReport, err = common.CheckImportCredentials(c.Request.Context(), resources)
+	if err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
 	ginx.SuccessJSONResponse(c, resources)
 }
 
@@ -411,7 +669,9 @@ func ResourceUpload(c *gin.Context) {
 //
 //	@ID			resources_import
 //	@Summary	资源导入
+//	@Description	请求体支持 json 及 yaml(Content-Type: application/yaml，支持锚点及多文档)
 //	@Accept		json
+//	@Accept		application/yaml
 //	@Produce	json
 //	@Tags		webapi.unify_op
 //	@Param		gateway_id	path	int							true	"网关 ID"
@@ -430,7 +690,11 @@ func ResourceImport(c *gin.Context) {
 		return
 	}
 	var resourcesImport common.ResourceUploadInfo
-	if err := c.ShouldBindJSON(&resourcesImport); err != nil {
+	if err := bindResourceImport(c, &resourcesImport); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	if _, err := common.ApplyImportCredentials(c.Request.Context(), &resourcesImport); err != nil {
 		ginx.BadRequestErrorJSONResponse(c, err)
 		return
 	}
@@ -447,3 +711,125 @@ func ResourceImport(c *gin.Context) {
 	}
 	ginx.SuccessNoContentResponse(c)
 }
+
+// bindResourceImport 解析资源导入请求体，yaml 多文档时合并各文档的待导入资源
+func bindResourceImport(c *gin.Context, resourcesImport *common.ResourceUploadInfo) error {
+	contentType := c.ContentType()
+	if contentType != binding.MIMEYAML && contentType != binding.MIMEYAML2 {
+		return c.ShouldBindJSON(resourcesImport)
+	}
+	body, err := c.GetRawData()
+	if err != nil {
+		return err
+	}
+	docs, err := schema.YAMLToJSONDocuments(body)
+	if err != nil {
+		return err
+	}
+	for index, doc := range docs {
+		if doc == nil {
+			continue
+		}
+		var docImport common.ResourceUploadInfo
+		if err := json.Unmarshal(doc, &docImport); err != nil {
+			return fmt.Errorf("yaml 文档[%d] 解析失败: %w", index, err)
+		}
+		if resourcesImport.Add == nil {
+			resourcesImport.Add = make(map[constant.APISIXResource][]common.ResourceInfo)
+		}
+		if resourcesImport.Update == nil {
+			resourcesImport.Update = make(map[constant.APISIXResource][]common.ResourceInfo)
+		}
+		for resourceType, resources := range docImport.Add {
+			resourcesImport.Add[resourceType] = append(resourcesImport.Add[resourceType], resources...)
+		}
+		for resourceType, resources := range docImport.Update {
+			resourcesImport.Update[resourceType] = append(resourcesImport.Update[resourceType], resources...)
+		}
+	}
+	return nil
+}
+
+// ResourceEtcdKeyOverrideGet 获取资源自定义 etcd key ...
+//
+//	@ID			resource_etcd_key_override_get
+//	@Summary	获取资源自定义 etcd key
+//	@Accept		json
+//	@Produce	json
+//	@Tags		webapi.unify_op
+//	@Param		gateway_id	path		int		true	"网关 ID"
+//	@Param		type		path		string	true	"资源类型:route/global_rule 等"
+//	@Param		id			path		string	true	"resource ID"
+//	@Success	200			{object}	serializer.ResourceEtcdKeyOverrideResponse
+//	@Router		/api/v1/web/gateways/{gateway_id}/unify_op/resources/{type}/etcd_key_override/{id}/ [get]
+func ResourceEtcdKeyOverrideGet(c *gin.Context) {
+	var pathParam serializer.ResourceCommonPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	key, err := biz.GetResourceEtcdKeyOverride(c.Request.Context(), pathParam.Type, pathParam.ID)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessJSONResponse(c, serializer.ResourceEtcdKeyOverrideResponse{EtcdKeyOverride: key})
+}
+
+// ResourceEtcdKeyOverrideUpdate 修改资源自定义 etcd key ...
+//
+//	@ID			resource_etcd_key_override_update
+//	@Summary	修改资源自定义 etcd key
+//	@Accept		json
+//	@Produce	json
+//	@Tags		webapi.unify_op
+//	@Param		gateway_id	path	int										true	"网关 ID"
+//	@Param		type		path	string									true	"资源类型:route/global_rule 等"
+//	@Param		id			path	string									true	"resource ID"
+//	@Param		request		body	serializer.ResourceEtcdKeyOverrideRequest	true	"自定义 etcd key"
+//	@Success	204
+//	@Router		/api/v1/web/gateways/{gateway_id}/unify_op/resources/{type}/etcd_key_override/{id}/ [put]
+func ResourceEtcdKeyOverrideUpdate(c *gin.Context) {
+	var pathParam serializer.ResourceCommonPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	var req serializer.ResourceEtcdKeyOverrideRequest
+	if err := validation.BindAndValidate(c, &req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	err := biz.UpdateResourceEtcdKeyOverride(c.Request.Context(), pathParam.Type, pathParam.ID, req.EtcdKeyOverride)
+	if err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessNoContentResponse(c)
+}
+
+// ResourceEtcdKeyMigrate 资源迁移至标准 etcd key ...
+//
+//	@ID			resource_etcd_key_migrate
+//	@Summary	资源迁移至标准 etcd key
+//	@Accept		json
+//	@Produce	json
+//	@Tags		webapi.unify_op
+//	@Param		gateway_id	path	int		true	"网关 ID"
+//	@Param		type		path	string	true	"资源类型:route/global_rule 等"
+//	@Param		id			path	string	true	"resource ID"
+//	@Success	204
+//	@Router		/api/v1/web/gateways/{gateway_id}/unify_op/resources/{type}/etcd_key_override/{id}/migrate/ [post]
+func ResourceEtcdKeyMigrate(c *gin.Context) {
+	var pathParam serializer.ResourceCommonPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	err := biz.MigrateResourceEtcdKeyToStandard(c.Request.Context(), pathParam.Type, pathParam.ID)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessNoContentResponse(c)
+}
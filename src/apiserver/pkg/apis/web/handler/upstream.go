@@ -126,6 +126,7 @@ func UpstreamUpdate(c *gin.Context) {
 //
 //	@ID			upstream_list
 //	@Summary	upstream 列表
+//	@Description	view=summary 时仅返回列表展示所需的摘要字段(由数据库从 config 中提取)，完整配置请使用 view=full 或详情接口
 //	@Produce	json
 //	@Tags		webapi.upstream
 //	@Param		gateway_id	path		int								true	"网关 ID"
@@ -153,6 +154,31 @@ func UpstreamList(c *gin.Context) {
 	if req.ID != "" {
 		queryParam["id"] = req.ID
 	}
+	if req.View == serializer.ListViewSummary {
+		upstreams, total, err := biz.ListPagedUpstreamSummaries(
+			c.Request.Context(),
+			queryParam,
+			labelMap,
+			strings.Split(req.Status, ","),
+			req.Name,
+			req.Updater,
+			req.OrderBy,
+			biz.PageParam{
+				Offset: ginx.GetOffset(c),
+				Limit:  ginx.GetLimit(c),
+			},
+		)
+		if err != nil {
+			ginx.SystemErrorJSONResponse(c, err)
+			return
+		}
+		results := serializer.UpstreamSummaryListResponse{}
+		for _, upstream := range upstreams {
+			results = append(results, toUpstreamSummaryOutputInfo(upstream))
+		}
+		ginx.SuccessJSONResponse(c, ginx.NewPaginatedRespData(total, results))
+		return
+	}
 	upstreams, total, err := biz.ListPagedUpstreams(
 		c.Request.Context(),
 		queryParam,
@@ -298,3 +324,18 @@ func UpstreamDropDownList(c *gin.Context) {
 	}
 	ginx.SuccessJSONResponse(c, output)
 }
+
+// UpstreamExampleList ...
+//
+//	@ID			upstream_example_list
+//	@Summary	upstream 配置示例列表
+//	@Description	网关启用了服务发现时，额外返回基于服务发现的配置示例
+//	@Produce	json
+//	@Tags		webapi.upstream
+//	@Param		gateway_id	path		int	true	"网关 ID"
+//	@Success	200			{object}	serializer.UpstreamExampleListResponse
+//	@Router		/api/v1/web/gateways/{gateway_id}/upstreams-examples/ [get]
+func UpstreamExampleList(c *gin.Context) {
+	examples := biz.GetUpstreamExamples(c.Request.Context(), ginx.GetGatewayInfo(c).ID)
+	ginx.SuccessJSONResponse(c, serializer.UpstreamExampleListResponse(examples))
+}
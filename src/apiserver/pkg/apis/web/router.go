@@ -48,6 +48,26 @@ func RegisterWebApi(path string, router *gin.RouterGroup) {
 	authBackend := account.GetAuthBackend()
 	group.Use(middleware.UserAuth(authBackend))
 	group.Use(middleware.Permission())
+	// 接口限流
+	if config.G.Service.RateLimit.Rate > 0 {
+		group.Use(middleware.RateLimit(middleware.RateLimitOptions{
+			Rate:  config.G.Service.RateLimit.Rate,
+			Burst: config.G.Service.RateLimit.Burst,
+		}))
+	}
+	RegisterWebRoutes(group)
+}
+
+// RegisterSchemaApi 注册内置 schema 定义查询路由，schema 为公开内容，无需登录，便于前端表单与第三方工具生成
+func RegisterSchemaApi(path string, router *gin.RouterGroup) {
+	group := router.Group(path)
+	group.GET("/:version/:resource/", handler.SchemaDefinitionGet)
+	group.GET("/:version/plugins/", handler.AvailablePluginsList)
+	group.GET("/:version/plugins/:name/", handler.PluginSchemaDefinitionGet)
+}
+
+// RegisterWebRoutes 注册 web 业务路由，不包含 session、csrf 与用户认证中间件
+func RegisterWebRoutes(group *gin.RouterGroup) {
 	group.GET("/enums/", handler.Enum)
 	group.GET("/accounts/userinfo/", handler.GetUserInfo)
 	group.GET("/version-log/", handler.GetVersionLog)
@@ -58,20 +78,35 @@ func RegisterWebApi(path string, router *gin.RouterGroup) {
 	group.GET("/gateways/", handler.GatewayList)
 	group.POST("/gateways/check_name/", handler.GatewayCheckName)
 	group.POST("/gateways/etcd/test_connection/", handler.EtcdTestConnection)
+	group.POST("/gateways/onboarding/", handler.GatewayOnboardingCreate)
 
 	// gateway:gateway_id
 	gatewayGroup := group.Group("/gateways/:gateway_id")
 	gatewayGroup.Use(middleware.GatewayAccess())
 	gatewayGroup.Use(middleware.ResourceOperationCheck())
+	gatewayGroup.Use(middleware.ReadYourWrites())
 
 	gatewayGroup.PUT("/", handler.GatewayUpdate)
 
 	gatewayGroup.GET("/", handler.GatewayGet)
 	gatewayGroup.DELETE("/", handler.GatewayDelete)
 
+	// onboarding
+	gatewayGroup.GET("/onboarding/", handler.GatewayOnboardingGet)
+	gatewayGroup.POST("/onboarding/steps/:step/run/", handler.GatewayOnboardingStepRun)
+	gatewayGroup.POST("/onboarding/steps/:step/waive/", handler.GatewayOnboardingStepWaive)
+	gatewayGroup.POST("/onboarding/activate/", handler.GatewayOnboardingActivate)
+
 	// labels
 	gatewayGroup.GET("/labels/:type/", handler.GatewayLabelList)
 
+	// discovery
+	gatewayGroup.POST("/discoveries/", handler.GatewayDiscoveryCreate)
+	gatewayGroup.PUT("/discoveries/:id/", handler.GatewayDiscoveryUpdate)
+	gatewayGroup.GET("/discoveries/:id/", handler.GatewayDiscoveryGet)
+	gatewayGroup.DELETE("/discoveries/:id/", handler.GatewayDiscoveryDelete)
+	gatewayGroup.GET("/discoveries/", handler.GatewayDiscoveryList)
+
 	// route
 	gatewayGroup.POST("/routes/", handler.RouteCreate)
 	gatewayGroup.PUT("/routes/:id/", handler.RouteUpdate)
@@ -79,6 +114,10 @@ func RegisterWebApi(path string, router *gin.RouterGroup) {
 	gatewayGroup.DELETE("/routes/:id/", handler.RouteDelete)
 	gatewayGroup.GET("/routes/", handler.RouteList)
 	gatewayGroup.GET("/routes-dropdown/", handler.RouteDropDownList)
+	gatewayGroup.GET("/routes/-/vars/", handler.RouteVarList)
+	gatewayGroup.GET("/routes/:id/revisions/", handler.RouteRevisionList)
+	gatewayGroup.GET("/routes/:id/revisions/:revision/", handler.RouteRevisionGet)
+	gatewayGroup.POST("/routes/:id/revisions/:revision/restore/", handler.RouteRevisionRestore)
 
 	// service
 	gatewayGroup.POST("/services/", handler.ServiceCreate)
@@ -87,6 +126,7 @@ func RegisterWebApi(path string, router *gin.RouterGroup) {
 	gatewayGroup.DELETE("/services/:id/", handler.ServiceDelete)
 	gatewayGroup.GET("/services/", handler.ServiceList)
 	gatewayGroup.GET("/services-dropdown/", handler.ServiceDropDownList)
+	gatewayGroup.GET("/services/:id/routes/", handler.ServiceRouteList)
 
 	// upstream
 	gatewayGroup.POST("/upstreams/", handler.UpstreamCreate)
@@ -95,10 +135,12 @@ func RegisterWebApi(path string, router *gin.RouterGroup) {
 	gatewayGroup.DELETE("/upstreams/:id/", handler.UpstreamDelete)
 	gatewayGroup.GET("/upstreams/", handler.UpstreamList)
 	gatewayGroup.GET("/upstreams-dropdown/", handler.UpstreamDropDownList)
+	gatewayGroup.GET("/upstreams-examples/", handler.UpstreamExampleList)
 
 	// ssl
 	gatewayGroup.POST("/ssls/", handler.SSLCreate)
 	gatewayGroup.POST("/ssls/check/", handler.SSLCheck)
+	gatewayGroup.POST("/ssls/parse/", handler.SSLParse)
 	gatewayGroup.PUT("/ssls/:id/", handler.SSLUpdate)
 	gatewayGroup.GET("/ssls/:id/", handler.SSLGet)
 	gatewayGroup.DELETE("/ssls/:id/", handler.SSLDelete)
@@ -121,6 +163,14 @@ func RegisterWebApi(path string, router *gin.RouterGroup) {
 	gatewayGroup.DELETE("/consumers/:id/", handler.ConsumerDelete)
 	gatewayGroup.GET("/consumers/", handler.ConsumerList)
 	gatewayGroup.GET("/consumers-dropdown/", handler.ConsumerDropDownList)
+	gatewayGroup.POST("/consumers/:id/generate_credentials/", handler.ConsumerCredentialGenerate)
+
+	// credential
+	gatewayGroup.POST("/consumers/:id/credentials/", handler.CredentialCreate)
+	gatewayGroup.PUT("/consumers/:id/credentials/:credential_id/", handler.CredentialUpdate)
+	gatewayGroup.GET("/consumers/:id/credentials/:credential_id/", handler.CredentialGet)
+	gatewayGroup.DELETE("/consumers/:id/credentials/:credential_id/", handler.CredentialDelete)
+	gatewayGroup.GET("/consumers/:id/credentials/", handler.CredentialList)
 
 	// consumer_group
 	gatewayGroup.POST("/consumer_groups/", handler.ConsumerGroupCreate)
@@ -154,6 +204,14 @@ func RegisterWebApi(path string, router *gin.RouterGroup) {
 	gatewayGroup.GET("/protos/", handler.ProtoList)
 	gatewayGroup.GET("/protos-dropdown/", handler.ProtoDropDownList)
 
+	// secret
+	gatewayGroup.POST("/secrets/", handler.SecretCreate)
+	gatewayGroup.PUT("/secrets/:id/", handler.SecretUpdate)
+	gatewayGroup.GET("/secrets/:id/", handler.SecretGet)
+	gatewayGroup.DELETE("/secrets/:id/", handler.SecretDelete)
+	gatewayGroup.GET("/secrets/", handler.SecretList)
+	gatewayGroup.GET("/secret-managers/", handler.SecretManagerList)
+
 	// stream_route
 	gatewayGroup.POST("/stream_routes/", handler.StreamRouteCreate)
 	gatewayGroup.PUT("/stream_routes/:id/", handler.StreamRouteUpdate)
@@ -174,11 +232,19 @@ func RegisterWebApi(path string, router *gin.RouterGroup) {
 	gatewayGroup.POST("/unify_op/resources/:type/revert/", handler.ResourceRevert)
 	gatewayGroup.POST("/unify_op/resources/-/managed/", handler.SyncedResourceManaged)
 	gatewayGroup.POST("/unify_op/resources/-/diff/", handler.ResourcesDiffAll)
+	gatewayGroup.POST("/resources/-/validate/", handler.ResourceBatchValidate)
+	gatewayGroup.GET("/resources/-/drift_summary/", handler.ResourceDriftSummary)
+	gatewayGroup.GET("/resources/:type/:id/diff/", handler.ResourceDriftGet)
 	gatewayGroup.POST("/unify_op/resources/:type/diff/", handler.ResourcesDiff)
 	gatewayGroup.GET("/unify_op/resources/:type/diff/:id/", handler.ResourceConfigDiffDetail)
+	gatewayGroup.GET("/unify_op/resources/:type/etcd_key_override/:id/", handler.ResourceEtcdKeyOverrideGet)
+	gatewayGroup.PUT("/unify_op/resources/:type/etcd_key_override/:id/", handler.ResourceEtcdKeyOverrideUpdate)
+	gatewayGroup.POST("/unify_op/resources/:type/etcd_key_override/:id/migrate/", handler.ResourceEtcdKeyMigrate)
 	gatewayGroup.DELETE("/unify_op/resources/:type/", handler.ResourceDelete)
 	gatewayGroup.GET("/unify_op/resources/labels/:type/", handler.ResourceLabelsList)
 	gatewayGroup.GET("/unify_op/etcd/export/", handler.EtcdExport)
+	gatewayGroup.GET("/unify_op/standalone/export/", handler.StandaloneExport)
+	gatewayGroup.POST("/unify_op/standalone/upload/", handler.StandaloneUpload)
 	gatewayGroup.POST("/unify_op/resources/upload/", handler.ResourceUpload)
 	gatewayGroup.POST("/unify_op/resources/import/", handler.ResourceImport)
 
@@ -190,10 +256,37 @@ func RegisterWebApi(path string, router *gin.RouterGroup) {
 	gatewayGroup.GET("/schemas/:auto_id/", handler.SchemaGet)
 	gatewayGroup.DELETE("/schemas/:auto_id/", handler.SchemaDelete)
 	gatewayGroup.GET("/schemas/", handler.SchemaList)
+	// 自定义插件，与 schemas 等价
+	gatewayGroup.POST("/custom-plugins/", handler.SchemaCreate)
+	gatewayGroup.PUT("/custom-plugins/:auto_id/", handler.SchemaUpdate)
+	gatewayGroup.GET("/custom-plugins/:auto_id/", handler.SchemaGet)
+	gatewayGroup.DELETE("/custom-plugins/:auto_id/", handler.SchemaDelete)
+	gatewayGroup.GET("/custom-plugins/", handler.SchemaList)
 	gatewayGroup.GET("/plugins/", handler.PluginsGet)
 
+	// compliance_report
+	gatewayGroup.POST("/compliance_reports/", handler.ComplianceReportCreate)
+	gatewayGroup.GET("/compliance_reports/", handler.ComplianceReportList)
+	gatewayGroup.GET("/compliance_reports/:id/", handler.ComplianceReportGet)
+	gatewayGroup.GET("/compliance_reports/:id/progress/", handler.ComplianceReportProgress)
+	gatewayGroup.GET("/compliance_reports/:id/download/", handler.ComplianceReportDownload)
+
+	// etcd_write_audit
+	gatewayGroup.GET("/etcd_write_audits/", handler.EtcdWriteAuditList)
+
+	// change_set
+	gatewayGroup.POST("/change_sets/", handler.ChangeSetCreate)
+	gatewayGroup.GET("/change_sets/", handler.ChangeSetList)
+	gatewayGroup.GET("/change_sets/:id/", handler.ChangeSetGet)
+	gatewayGroup.POST("/change_sets/:id/resources/", handler.ChangeSetResourceAdd)
+	gatewayGroup.DELETE("/change_sets/:id/resources/", handler.ChangeSetResourceRemove)
+	gatewayGroup.POST("/change_sets/:id/abandon/", handler.ChangeSetAbandon)
+
 	// publish
 	gatewayGroup.POST("/publish/", handler.PublishResource)
 	gatewayGroup.POST("/publish/all/", handler.PublishResourceAll)
+	gatewayGroup.GET("/publish/dry_run/", handler.PublishDryRun)
+	gatewayGroup.POST("/publish/dry-run/", handler.PublishMutationDryRun)
 	gatewayGroup.POST("/sync/", handler.ResourceSync)
+	gatewayGroup.POST("/sync/from-etcd/", handler.ResourceSyncFromEtcd)
 }
@@ -0,0 +1,74 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package serializer
+
+import "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+
+// ChangeSetPathParam 变更集路径参数
+type ChangeSetPathParam struct {
+	GatewayID int `json:"gateway_id" uri:"gateway_id" binding:"required"`
+	ID        int `json:"id" uri:"id" binding:"required"`
+}
+
+// ChangeSetInfo 变更集创建请求
+type ChangeSetInfo struct {
+	Name        string `json:"name" binding:"required,max=255"` // 变更集名称
+	Description string `json:"description"`                     // 描述
+}
+
+// ChangeSetListRequest 变更集列表请求
+type ChangeSetListRequest struct {
+	Status constant.ChangeSetStatus `json:"status" form:"status" binding:"omitempty,oneof=open published abandoned"`
+	Offset int                      `json:"offset" form:"offset"`
+	Limit  int                      `json:"limit" form:"limit"`
+}
+
+// ChangeSetResourceRequest 变更集加入/移出资源请求
+type ChangeSetResourceRequest struct {
+	ResourceType   constant.APISIXResource `json:"resource_type" binding:"required"`          // 资源类型：route/upstream/...
+	ResourceIDList []string                `json:"resource_id_list" binding:"required,min=1"` // 资源ID列表
+}
+
+// ChangeSetOutputInfo 变更集基本信息
+type ChangeSetOutputInfo struct {
+	ID          int                      `json:"id"`
+	GatewayID   int                      `json:"gateway_id"`
+	Name        string                   `json:"name"`
+	Description string                   `json:"description"`
+	Status      constant.ChangeSetStatus `json:"status"` // 状态：open/published/abandoned
+	Creator     string                   `json:"creator"`
+	Updater     string                   `json:"updater"`
+	CreatedAt   int64                    `json:"created_at"`
+	UpdatedAt   int64                    `json:"updated_at"`
+}
+
+// ChangeSetListResponse 变更集列表响应
+type ChangeSetListResponse []ChangeSetOutputInfo
+
+// ChangeSetResourceOutput 变更集包含的资源
+type ChangeSetResourceOutput struct {
+	ResourceType constant.APISIXResource `json:"resource_type"`
+	ResourceID   string                  `json:"resource_id"`
+}
+
+// ChangeSetDetailOutput 变更集详情，包含持有的资源，已关闭的变更集不再持有资源
+type ChangeSetDetailOutput struct {
+	ChangeSetOutputInfo
+	Resources []ChangeSetResourceOutput `json:"resources"`
+}
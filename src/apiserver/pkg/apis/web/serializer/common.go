@@ -45,6 +45,12 @@ type ResourceCommonPathParam struct {
 	Type      constant.APISIXResource `json:"type" uri:"type"`
 }
 
+// 资源列表视图
+const (
+	ListViewFull    = "full"    // 返回完整 config
+	ListViewSummary = "summary" // 仅返回列表展示所需的摘要字段
+)
+
 // CheckAPISIXConfig 校验 APISIX 配置 schema
 func CheckAPISIXConfig(ctx context.Context, fl validator.FieldLevel) bool {
 	rawConfig, ok := fl.Field().Interface().(json.RawMessage)
@@ -55,8 +61,9 @@ func CheckAPISIXConfig(ctx context.Context, fl validator.FieldLevel) bool {
 		return false
 	}
 	resourceType := fl.Param()
-	resourceIdentification := schema.GetResourceIdentification(rawConfig)
-	if resourceIdentification == "" {
+	identifier := schema.GetResourceIdentifier(rawConfig)
+	resourceIdentification := identifier.Value
+	if identifier.IsConfigHash() {
 		// 兼容第一次创建没有id的情况以及rawConfig没有name的情况
 		resourceIdentification = getResourceNameByResourceType(resourceType, fl)
 		rawConfig, _ = sjson.SetBytes(rawConfig, model.GetResourceNameKey(constant.APISIXResource(resourceType)),
@@ -97,11 +104,27 @@ func CheckAPISIXConfig(ctx context.Context, fl validator.FieldLevel) bool {
 		logging.Errorf("new schema config validator failed, err: %v", err)
 		return false
 	}
+	schema.SetAllowCustomVars(jsonConfigValidator, gatewayInfo.AllowCustomVars)
+	schema.SetRetriesCheck(jsonConfigValidator, gatewayInfo.RetriesCheck)
 	if err = jsonConfigValidator.Validate(rawConfig); err != nil { // 校验json schema
 		ginx.GetValidateErrorInfoFromContext(ctx).Err = err
 		logging.Errorf("json schema validate failed, err: %v", err)
 		return false
 	}
+	// secret 需按所选密钥管理器的 schema 校验
+	if resourceType == constant.Secret.String() {
+		manager := constant.SecretManager(fl.Parent().FieldByName("Manager").String())
+		if err = schema.ValidateSecretConfig(gatewayInfo.GetAPISIXVersionX(), manager, rawConfig); err != nil {
+			ginx.GetValidateErrorInfoFromContext(ctx).Err = err
+			return false
+		}
+	}
+	// 服务发现类型需在网关中启用
+	err = biz.ValidateUpstreamDiscoveryType(ctx, gatewayInfo.ID, constant.APISIXResource(resourceType), rawConfig)
+	if err != nil {
+		ginx.GetValidateErrorInfoFromContext(ctx).Err = err
+		return false
+	}
 	return true
 }
 
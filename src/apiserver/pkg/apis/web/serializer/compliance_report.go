@@ -0,0 +1,78 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package serializer
+
+import (
+	"encoding/json"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+// ComplianceReportFormatCSV 合规报告 CSV 下载格式
+const ComplianceReportFormatCSV = "csv"
+
+// ComplianceReportPathParam 合规报告路径参数
+type ComplianceReportPathParam struct {
+	GatewayID int `json:"gateway_id" uri:"gateway_id" binding:"required"`
+	ID        int `json:"id" uri:"id" binding:"required"`
+}
+
+// ComplianceReportListRequest 合规报告列表请求
+type ComplianceReportListRequest struct {
+	Offset int `json:"offset" form:"offset"`
+	Limit  int `json:"limit" form:"limit"`
+}
+
+// ComplianceReportDownloadRequest 合规报告下载请求
+type ComplianceReportDownloadRequest struct {
+	Format string `json:"format" form:"format" binding:"omitempty,oneof=json csv"` // 下载格式：json/csv，默认 json
+}
+
+// ComplianceReportOutputInfo 合规报告基本信息
+type ComplianceReportOutputInfo struct {
+	ID        int                             `json:"id"`
+	GatewayID int                             `json:"gateway_id"`
+	Status    constant.ComplianceReportStatus `json:"status"` // 生成状态
+	// 检查的资源类型，Partial 为 true 时只检查了部分类型，报告不代表网关的全部资源
+	ScannedTypes []constant.APISIXResource `json:"scanned_types"`
+	Partial      bool                      `json:"partial"`
+	Total        int                       `json:"total"`     // 待检查资源总数
+	Processed    int                       `json:"processed"` // 已检查资源数
+	Summary      json.RawMessage           `json:"summary" swaggertype:"object"`
+	Message      string                    `json:"message"` // 生成失败原因
+	Creator      string                    `json:"creator"`
+	CreatedAt    int64                     `json:"created_at"`
+	UpdatedAt    int64                     `json:"updated_at"`
+}
+
+// ComplianceReportListResponse 合规报告列表响应
+type ComplianceReportListResponse []ComplianceReportOutputInfo
+
+// ComplianceReportDetailOutput 合规报告详情，包含每个资源的检查结果
+type ComplianceReportDetailOutput struct {
+	ComplianceReportOutputInfo
+	Result json.RawMessage `json:"result" swaggertype:"array,object"`
+}
+
+// ComplianceReportProgressOutput 合规报告生成进度
+type ComplianceReportProgressOutput struct {
+	Status    constant.ComplianceReportStatus `json:"status"`
+	Total     int                             `json:"total"`
+	Processed int                             `json:"processed"`
+}
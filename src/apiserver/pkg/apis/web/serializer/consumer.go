@@ -66,6 +66,11 @@ type ConsumerOutputInfo struct {
 	Status    constant.ResourceStatus `json:"status"` // 发布状态
 }
 
+// ConsumerCredentialGenerateResponse 生成的 consumer 凭证
+type ConsumerCredentialGenerateResponse struct {
+	Generated map[string]string `json:"generated"` // 凭证字段路径: 生成的值
+}
+
 // ConsumerDropDownListResponse Consumer 下拉列表
 type ConsumerDropDownListResponse []ConsumerDropDownOutputInfo
 
@@ -0,0 +1,96 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package serializer
+
+import (
+	"context"
+	"encoding/json"
+
+	validator "github.com/go-playground/validator/v10"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/validation"
+)
+
+// CredentialPathParam Credential 路径参数，凭证嵌套在所属 consumer 之下
+type CredentialPathParam struct {
+	GatewayID    int    `json:"gateway_id" uri:"gateway_id" binding:"required"`
+	ConsumerID   string `json:"consumer_id" uri:"id" binding:"required"` // 所属 consumer ID
+	CredentialID string `json:"credential_id" uri:"credential_id"`
+}
+
+// CredentialInfo Credential 基本信息
+type CredentialInfo struct {
+	ID   string `json:"-"`                                                 // 资源apisix资源id
+	Name string `json:"name" binding:"required" validate:"credentialName"` // Credential名称
+	// 配置数据(json格式)，plugins 中仅能配置一个认证插件
+	Config json.RawMessage `json:"config" validate:"apisixConfig=credential" swaggertype:"object"`
+}
+
+// CredentialListRequest ...
+type CredentialListRequest struct {
+	ID      string `json:"id,omitempty" form:"id"`
+	Name    string `json:"name,omitempty" form:"name"`
+	Updater string `json:"updater,omitempty" form:"updater"`
+	Status  string `json:"status" form:"status" binding:"resourceStatus"`
+	OrderBy string `json:"order_by" form:"order_by"`
+	Offset  int    `json:"offset" form:"offset"`
+	Limit   int    `json:"limit" form:"limit"`
+}
+
+// CredentialListResponse Credential 列表
+type CredentialListResponse []CredentialOutputInfo
+
+// CredentialOutputInfo ...
+type CredentialOutputInfo struct {
+	AutoID     int    `json:"auto_id"`
+	ID         string `json:"id"`
+	GatewayID  int    `json:"gateway_id"`  // 网关 ID
+	ConsumerID string `json:"consumer_id"` // 所属 consumer ID
+	CredentialInfo
+	CreatedAt int64                   `json:"created_at"`
+	UpdatedAt int64                   `json:"updated_at"`
+	Creator   string                  `json:"creator"`
+	Updater   string                  `json:"updater"`
+	Status    constant.ResourceStatus `json:"status"` // 发布状态
+}
+
+// ValidateCredentialName 校验 credential 名称
+func ValidateCredentialName(ctx context.Context, fl validator.FieldLevel) bool {
+	credentialName := fl.Field().String()
+	if credentialName == "" {
+		return false
+	}
+	return biz.DuplicatedResourceName(
+		ctx,
+		constant.Credential,
+		fl.Parent().FieldByName("ID").String(),
+		credentialName,
+	)
+}
+
+// 注册校验器
+func init() {
+	validation.AddBizFieldTagValidatorWithCtx(
+		"credentialName",
+		ValidateCredentialName,
+		"{0}: {1} 该资源名称已经被存在的 credential 资源占用",
+	)
+}
@@ -0,0 +1,50 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package serializer
+
+import (
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+// EtcdWriteAuditListRequest etcd 写操作审计查询请求
+type EtcdWriteAuditListRequest struct {
+	Key        string `json:"key" form:"key"`                 // etcd 完整 key
+	ResourceID string `json:"resource_id" form:"resource_id"` // 资源 id
+	Offset     int    `json:"offset" form:"offset"`
+	Limit      int    `json:"limit" form:"limit"`
+}
+
+// EtcdWriteAuditOutputInfo etcd 写操作审计信息
+type EtcdWriteAuditOutputInfo struct {
+	ID            int                     `json:"id"`
+	Key           string                  `json:"key"`
+	ResourceType  constant.APISIXResource `json:"resource_type"`
+	ResourceID    string                  `json:"resource_id"`
+	Op            constant.EtcdWriteOp    `json:"op"`              // 操作类型：put/delete
+	PublishTaskID int                     `json:"publish_task_id"` // 发布任务 id
+	Success       bool                    `json:"success"`
+	PrevHash      string                  `json:"prev_hash"` // 写入前的值的 sha256
+	NewHash       string                  `json:"new_hash"`  // 写入的值的 sha256
+	Message       string                  `json:"message"`   // 失败原因
+	Operator      string                  `json:"operator"`
+	CreatedAt     int64                   `json:"created_at"`
+}
+
+// EtcdWriteAuditListResponse etcd 写操作审计列表响应
+type EtcdWriteAuditListResponse []EtcdWriteAuditOutputInfo
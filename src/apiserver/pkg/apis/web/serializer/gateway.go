@@ -58,10 +58,14 @@ type GatewayOutputListInfo struct {
 	ReadOnly    bool          `json:"read_only"` // 是否只读
 	Etcd        common.Etcd   `json:"etcd"`
 	Count       Count         `json:"count"`
-	CreatedAt   int64         `json:"created_at"`
-	UpdatedAt   int64         `json:"updated_at"`
-	Creator     string        `json:"creator"`
-	Updater     string        `json:"updater"`
+	// 网关状态：onboarding-接入引导中 active-已启用
+	Status constant.GatewayStatus `json:"status" enums:"onboarding,active"`
+	// etcd 状态：normal-正常 auth_failed-鉴权失效且重新认证失败
+	EtcdStatus constant.GatewayEtcdStatus `json:"etcd_status" enums:"normal,auth_failed"`
+	CreatedAt  int64                      `json:"created_at"`
+	UpdatedAt  int64                      `json:"updated_at"`
+	Creator    string                     `json:"creator"`
+	Updater    string                     `json:"updater"`
 }
 
 // GatewayGetRequest 网关详情请求
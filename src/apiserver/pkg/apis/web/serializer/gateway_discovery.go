@@ -0,0 +1,79 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package serializer
+
+import (
+	"context"
+	"encoding/json"
+
+	validator "github.com/go-playground/validator/v10"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/validation"
+)
+
+// GatewayDiscoveryPathParam 网关服务发现路径参数
+type GatewayDiscoveryPathParam struct {
+	GatewayID int `json:"gateway_id" uri:"gateway_id" binding:"required"`
+	ID        int `json:"id" uri:"id" binding:"required"`
+}
+
+// GatewayDiscoveryInfo 网关服务发现基本信息
+type GatewayDiscoveryInfo struct {
+	ID     int             `json:"id"`                                               // 自增ID
+	Type   string          `json:"type" binding:"required" validate:"discoveryType"` // 服务发现类型：nacos/consul等
+	Config json.RawMessage `json:"config" swaggertype:"object"`                      // 连接配置(json格式)
+}
+
+// GatewayDiscoveryListResponse 网关服务发现列表
+type GatewayDiscoveryListResponse []GatewayDiscoveryOutputInfo
+
+// GatewayDiscoveryOutputInfo 网关服务发现信息
+type GatewayDiscoveryOutputInfo struct {
+	GatewayID int `json:"gateway_id"` // 网关 ID
+	GatewayDiscoveryInfo
+	CreatedAt int64  `json:"created_at"`
+	UpdatedAt int64  `json:"updated_at"`
+	Creator   string `json:"creator"`
+	Updater   string `json:"updater"`
+}
+
+// ValidateDiscoveryType 校验服务发现类型是否重复启用
+func ValidateDiscoveryType(ctx context.Context, fl validator.FieldLevel) bool {
+	discoveryType := fl.Field().String()
+	if discoveryType == "" {
+		return false
+	}
+	return biz.DuplicatedGatewayDiscoveryType(
+		ctx,
+		ginx.GetGatewayInfoFromContext(ctx).ID,
+		int(fl.Parent().FieldByName("ID").Int()),
+		discoveryType,
+	)
+}
+
+// 注册校验器
+func init() {
+	validation.AddBizFieldTagValidatorWithCtx(
+		"discoveryType",
+		ValidateDiscoveryType,
+		"{0}: {1} 该服务发现类型已启用",
+	)
+}
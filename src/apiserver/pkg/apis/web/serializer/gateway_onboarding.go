@@ -0,0 +1,35 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package serializer
+
+import (
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+// GatewayOnboardingStepPathParam 接入引导步骤路径参数
+type GatewayOnboardingStepPathParam struct {
+	GatewayID int `json:"gateway_id" uri:"gateway_id" binding:"required"`
+	// 引导步骤：etcd_connectivity、prefix_collision、foreign_key_scan、import_preview、version_detection
+	Step constant.OnboardingStep `json:"step" uri:"step" binding:"required"`
+}
+
+// GatewayOnboardingWaiveRequest 接入引导步骤豁免请求
+type GatewayOnboardingWaiveRequest struct {
+	Reason string `json:"reason" binding:"required"` // 豁免原因
+}
@@ -25,3 +25,15 @@ type PublishRequest struct {
 	ResourceType   constant.APISIXResource `json:"resource_type" binding:"required"`    // 资源类型：route/upstream/...
 	ResourceIDList []string                `json:"resource_id_list" binding:"required"` // 资源ID列表
 }
+
+// PublishAllRequest ...
+type PublishAllRequest struct {
+	ChangeSetID int `json:"change_set_id"` // 变更集ID，指定时只发布该变更集并在成功后关闭变更集
+}
+
+// PublishMutationDryRunRequest ...
+type PublishMutationDryRunRequest struct {
+	// 资源类型，为空时预演一键发布
+	ResourceType   constant.APISIXResource `json:"resource_type"`
+	ResourceIDList []string                `json:"resource_id_list" binding:"required_with=ResourceType"` // 资源ID列表
+}
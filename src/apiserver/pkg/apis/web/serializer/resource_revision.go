@@ -0,0 +1,54 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package serializer
+
+import (
+	"encoding/json"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+// ResourceRevisionPathParam 资源版本路径参数
+type ResourceRevisionPathParam struct {
+	GatewayID int    `json:"gateway_id" uri:"gateway_id" binding:"required"`
+	ID        string `json:"id" uri:"id" binding:"required"`             // 资源 id
+	Revision  int    `json:"revision" uri:"revision" binding:"required"` // 版本号
+}
+
+// ResourceRevisionListRequest 资源版本列表请求
+type ResourceRevisionListRequest struct {
+	Offset int `json:"offset" form:"offset"`
+	Limit  int `json:"limit" form:"limit"`
+}
+
+// ResourceRevisionOutputInfo 资源版本信息
+type ResourceRevisionOutputInfo struct {
+	Revision      int                     `json:"revision"`
+	ResourceType  constant.APISIXResource `json:"resource_type"`
+	ResourceID    string                  `json:"resource_id"`
+	OperationType constant.OperationType  `json:"operation_type"` // 操作类型：create/update/delete
+	Summary       string                  `json:"summary"`        // 变更说明
+	Operator      string                  `json:"operator"`
+	CreatedAt     int64                   `json:"created_at"`
+	// 该版本的完整配置，列表中不返回
+	Config json.RawMessage `json:"config,omitempty" swaggertype:"object"`
+}
+
+// ResourceRevisionListResponse 资源版本列表响应
+type ResourceRevisionListResponse []ResourceRevisionOutputInfo
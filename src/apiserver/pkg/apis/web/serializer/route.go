@@ -21,6 +21,7 @@ package serializer
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	validator "github.com/go-playground/validator/v10"
 
@@ -38,6 +39,25 @@ type RouteInfo struct {
 	UpstreamID     string          `json:"upstream_id" validate:"upstreamID"`                         // 上游服务地址ID
 	PluginConfigID string          `json:"plugin_config_id" validate:"pluginConfigID"`                // 插件配置ID
 	Config         json.RawMessage `json:"config" validate:"apisixConfig=route" swaggertype:"object"` // 路由配置(json格式)
+	// 临时路由过期时间(unix 时间戳，秒)，为空表示长期有效
+	ExpiresAt int64 `json:"expires_at,omitempty" validate:"routeExpiresAt"`
+}
+
+// GetExpiresAt 获取过期时间，未设置时返回 nil
+func (r RouteInfo) GetExpiresAt() *time.Time {
+	if r.ExpiresAt == 0 {
+		return nil
+	}
+	expiresAt := time.Unix(r.ExpiresAt, 0)
+	return &expiresAt
+}
+
+// RouteExpiresAtToUnix 过期时间转换为 unix 时间戳，未设置时返回 0
+func RouteExpiresAtToUnix(expiresAt *time.Time) int64 {
+	if expiresAt == nil {
+		return 0
+	}
+	return expiresAt.Unix()
 }
 
 // RouteListRequest ...
@@ -54,8 +74,15 @@ type RouteListRequest struct {
 	OrderBy    string `json:"order_by" form:"order_by"`
 	Offset     int    `json:"offset" form:"offset"`
 	Limit      int    `json:"limit" form:"limit"`
+	// 列表视图: full(默认) 返回完整 config，summary 仅返回摘要字段
+	View string `json:"view" form:"view" binding:"omitempty,oneof=full summary"`
+	// 分组方式: service 按服务分组返回 dto.RouteServiceGroup 统计，不传则返回路由列表
+	GroupBy string `json:"group_by" form:"group_by" binding:"omitempty,oneof=service"`
 }
 
+// RouteGroupByService 路由列表按服务分组
+const RouteGroupByService = "service"
+
 // RouteListResponse route 列表
 type RouteListResponse []RouteOutputInfo
 
@@ -71,6 +98,29 @@ type RouteOutputInfo struct {
 	Status    constant.ResourceStatus `json:"status"` // 发布状态
 }
 
+// RouteSummaryListResponse route 摘要列表
+type RouteSummaryListResponse []RouteSummaryOutputInfo
+
+// RouteSummaryOutputInfo route 摘要，从 config 中提取 uris/methods/hosts
+type RouteSummaryOutputInfo struct {
+	AutoID         int                     `json:"auto_id"`
+	GatewayID      int                     `json:"gateway_id"`
+	ID             string                  `json:"id"`
+	Name           string                  `json:"name"`
+	ServiceID      string                  `json:"service_id"`
+	UpstreamID     string                  `json:"upstream_id"`
+	PluginConfigID string                  `json:"plugin_config_id"`
+	Uris           []string                `json:"uris"`
+	Methods        []string                `json:"methods"`
+	Hosts          []string                `json:"hosts"`
+	ExpiresAt      int64                   `json:"expires_at,omitempty"`
+	CreatedAt      int64                   `json:"created_at"`
+	UpdatedAt      int64                   `json:"updated_at"`
+	Creator        string                  `json:"creator"`
+	Updater        string                  `json:"updater"`
+	Status         constant.ResourceStatus `json:"status"`
+}
+
 // RouteDropDownListResponse route 下拉列表
 type RouteDropDownListResponse []RouteDropDownOutputInfo
 
@@ -83,6 +133,13 @@ type RouteDropDownOutputInfo struct {
 	Desc   string   `json:"desc"`    // 路由描述
 }
 
+// RouteVarsResponse route vars 可用变量，供前端自动补全
+type RouteVarsResponse struct {
+	Names           []string `json:"names"`             // nginx/apisix 内置变量
+	Prefixes        []string `json:"prefixes"`          // 动态变量前缀，如 arg_、http_
+	AllowCustomVars bool     `json:"allow_custom_vars"` // 网关是否允许使用自定义变量
+}
+
 // ValidationRouteName ...
 func ValidationRouteName(ctx context.Context, fl validator.FieldLevel) bool {
 	routeName := fl.Field().String()
@@ -97,6 +154,15 @@ func ValidationRouteName(ctx context.Context, fl validator.FieldLevel) bool {
 	)
 }
 
+// ValidationRouteExpiresAt 校验临时路由过期时间必须晚于当前时间
+func ValidationRouteExpiresAt(ctx context.Context, fl validator.FieldLevel) bool {
+	expiresAt := fl.Field().Int()
+	if expiresAt == 0 {
+		return true
+	}
+	return time.Unix(expiresAt, 0).After(time.Now())
+}
+
 // 注册校验器
 func init() {
 	validation.AddBizFieldTagValidatorWithCtx(
@@ -104,4 +170,9 @@ func init() {
 		ValidationRouteName,
 		"{0}: {1} 该资源名称已经被存在的 route 资源占用",
 	)
+	validation.AddBizFieldTagValidatorWithCtx(
+		"routeExpiresAt",
+		ValidationRouteExpiresAt,
+		"{0}: {1} 过期时间必须晚于当前时间",
+	)
 }
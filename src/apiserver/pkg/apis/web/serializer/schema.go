@@ -46,12 +46,39 @@ type ResourceSchemaRequest struct {
 	Type string `json:"type" uri:"type" binding:"required"` // 资源名称:service/route/global_rule等
 }
 
+// SchemaDefinitionRequest 内置资源 schema 定义查询参数
+type SchemaDefinitionRequest struct {
+	Version  string `json:"version" uri:"version" binding:"required"`   // APISIX 版本：3.13 / 3.13.X
+	Resource string `json:"resource" uri:"resource" binding:"required"` // 资源类型：route/service 等
+	// 数据类型：db 允许附加属性，etcd 与下发校验一致不允许附加属性，默认 db
+	DataType string `json:"data_type" form:"data_type" binding:"omitempty,oneof=db etcd"`
+}
+
+// PluginSchemaDefinitionRequest 内置插件 schema 定义查询参数
+type PluginSchemaDefinitionRequest struct {
+	Version string `json:"version" uri:"version" binding:"required"` // APISIX 版本：3.13 / 3.13.X
+	Name    string `json:"name" uri:"name" binding:"required"`       // 插件名称
+	// 插件schema类型：metadata/consumer/stream/不传就获取常规schema
+	SchemaType string `json:"schema_type" form:"schema_type"`
+}
+
+// AvailablePluginsRequest 版本内置插件列表查询参数
+type AvailablePluginsRequest struct {
+	Version string `json:"version" uri:"version" binding:"required"` // APISIX 版本：3.13 / 3.13.X
+}
+
+// SchemaNotFoundInfo schema 不存在时返回的可选值
+type SchemaNotFoundInfo struct {
+	SupportedVersions  []string `json:"supported_versions"`
+	SupportedResources []string `json:"supported_resources,omitempty"`
+}
+
 // SchemaInfo ...
 type SchemaInfo struct {
 	AutoID  int             `json:"auto_id"`                                       // 自增ID
 	Name    string          `json:"name" binding:"required" validate:"schemaName"` // 插件名称
 	Schema  json.RawMessage `json:"schema"  swaggertype:"object"`                  // 插件 schema (json格式)
-	Example json.RawMessage `json:"example" swaggertype:"object"`                  // 插件示例 (json格式)
+	Example json.RawMessage `json:"example" swaggertype:"object"`                  // 插件示例 (json格式，可选)
 }
 
 // SchemaListRequest ...
@@ -101,13 +128,14 @@ func CheckPluginSchemaAndExample(schema json.RawMessage, example json.RawMessage
 	if schema == nil || jsonx.IsJSONEmpty(schemaRaw) {
 		return fmt.Errorf("schema 不可为空")
 	}
-	if example == nil || jsonx.IsJSONEmpty(exampleRaw) {
-		return fmt.Errorf("插件示例不可为空")
-	}
 	s, err := gojsonschema.NewSchema(gojsonschema.NewStringLoader(string(schemaRaw)))
 	if err != nil {
 		return fmt.Errorf("实例化 schema 失败: %s", err)
 	}
+	// 插件示例可选，未提供时不校验
+	if example == nil || jsonx.IsJSONEmpty(exampleRaw) {
+		return nil
+	}
 	ret, err := s.Validate(gojsonschema.NewBytesLoader(exampleRaw))
 	if err != nil {
 		return fmt.Errorf("插件示例验证失败: %s", err)
@@ -23,6 +23,8 @@ import (
 	"encoding/json"
 
 	validator "github.com/go-playground/validator/v10"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
 
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
@@ -58,6 +60,48 @@ func (s *SSLInfo) ToEntity() (*entity.SSL, error) {
 	return &ssl, nil
 }
 
+// FillCertInfo 未填写 snis、validity_start、validity_end 时从证书中自动提取
+func (s *SSLInfo) FillCertInfo() error {
+	cert := gjson.GetBytes(s.Config, "cert").String()
+	if cert == "" {
+		return nil
+	}
+	snis, notBefore, notAfter, err := entity.ParseCertificateInfo(cert)
+	if err != nil {
+		// 证书格式错误交由 schema 校验报错
+		return nil
+	}
+	if !gjson.GetBytes(s.Config, "sni").Exists() && !gjson.GetBytes(s.Config, "snis").Exists() &&
+		gjson.GetBytes(s.Config, "type").String() != "client" && len(snis) > 0 {
+		if s.Config, err = sjson.SetBytes(s.Config, "snis", snis); err != nil {
+			return err
+		}
+	}
+	if !gjson.GetBytes(s.Config, "validity_start").Exists() {
+		if s.Config, err = sjson.SetBytes(s.Config, "validity_start", notBefore); err != nil {
+			return err
+		}
+	}
+	if !gjson.GetBytes(s.Config, "validity_end").Exists() {
+		if s.Config, err = sjson.SetBytes(s.Config, "validity_end", notAfter); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SSLParseRequest 证书解析请求
+type SSLParseRequest struct {
+	Cert string `json:"cert" binding:"required"` // 证书
+}
+
+// SSLParseResponse 证书解析结果
+type SSLParseResponse struct {
+	Snis          []string `json:"snis"`
+	ValidityStart int64    `json:"validity_start"`
+	ValidityEnd   int64    `json:"validity_end"`
+}
+
 // SSLCheckResponse ...
 type SSLCheckResponse struct {
 	Name string `json:"name" `
@@ -74,6 +118,26 @@ type SSLListRequest struct {
 	OrderBy string `json:"order_by" form:"order_by"`
 	Offset  int    `json:"offset" form:"offset"`
 	Limit   int    `json:"limit" form:"limit"`
+	// 列表视图: full(默认) 返回完整 config，summary 仅返回摘要字段
+	View string `json:"view" form:"view" binding:"omitempty,oneof=full summary"`
+}
+
+// SSLSummaryListResponse ssl 摘要列表
+type SSLSummaryListResponse []SSLSummaryOutputInfo
+
+// SSLSummaryOutputInfo ssl 摘要，从 config 中提取 snis 及过期时间
+type SSLSummaryOutputInfo struct {
+	AutoID      int                     `json:"auto_id"`
+	GatewayID   int                     `json:"gateway_id"`
+	ID          string                  `json:"id"`
+	Name        string                  `json:"name"`
+	Snis        []string                `json:"snis"`
+	ValidityEnd int64                   `json:"validity_end"`
+	CreatedAt   int64                   `json:"created_at"`
+	UpdatedAt   int64                   `json:"updated_at"`
+	Creator     string                  `json:"creator"`
+	Updater     string                  `json:"updater"`
+	Status      constant.ResourceStatus `json:"status"`
 }
 
 // SSLDropDownListResponse ...
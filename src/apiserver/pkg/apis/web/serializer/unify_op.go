@@ -23,6 +23,7 @@ import (
 
 	validator "github.com/go-playground/validator/v10"
 
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/common"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/validation"
@@ -33,9 +34,20 @@ type SyncRequest struct {
 	ResourceType constant.APISIXResource `json:"resource_type"` // 资源类型：route/upstream/...如果为空，则同步所有资源
 }
 
-// SyncResponse ...
+// SyncResponse 各资源类型新同步的数量，只包含本次读取的资源类型
 type SyncResponse map[constant.APISIXResource]int
 
+// ResourceScanRequest etcd 资源读取范围
+type ResourceScanRequest struct {
+	Types string `json:"types" form:"types"` // 读取的资源类型，逗号分隔，如 route,upstream；不传则读取全部类型
+}
+
+// SyncFromEtcdRequest ...
+type SyncFromEtcdRequest struct {
+	// 编辑区已有资源且配置不一致时的处理策略：skip/overwrite/fail
+	Strategy constant.EtcdImportStrategy `json:"strategy" binding:"required,oneof=skip overwrite fail"`
+}
+
 // RevertRequest ...
 type RevertRequest struct {
 	ResourceType   constant.APISIXResource `json:"resource_type" binding:"required"`    // 资源类型：route/upstream/...
@@ -62,6 +74,7 @@ type ResourceDiffAllRequest struct {
 	Name          string                   `json:"name"`                                               // 资源名称
 	ResourceType  constant.APISIXResource  `json:"resource_type"`                                      // 资源类型
 	OperationType []constant.OperationType `json:"operation_type" binding:"resourceDiffOperationType"` // 操作类型
+	ChangeSetID   int                      `json:"change_set_id"`                                      // 变更集ID，只返回该变更集中的变更
 }
 
 // ResourceDiffRequest ...
@@ -69,6 +82,7 @@ type ResourceDiffRequest struct {
 	ResourceIDList []string                 `json:"resource_id_list"`                                   // 资源ID列表, 比对必须传入ID
 	Name           string                   `json:"name"`                                               // 资源名称
 	OperationType  []constant.OperationType `json:"operation_type" binding:"resourceDiffOperationType"` // 操作类型
+	ChangeSetID    int                      `json:"change_set_id"`                                      // 变更集ID，只返回该变更集中的变更
 }
 
 // ResourceDiffResponse ...
@@ -84,6 +98,7 @@ type ResourceInfo struct {
 	Name         string                  `json:"name,omitempty"`                        // 资源名称
 	Config       json.RawMessage         `json:"config,omitempty" swaggertype:"object"` // 资源配置
 	Status       constant.UploadStatus   `json:"status,omitempty"`                      // 资源导入状态(add/update)
+	ConsumerID   string                  `json:"consumer_id,omitempty"`                 // credential 所属 consumer 的 ID
 }
 
 // OperationTypeToResourceStatus 操作类型转换资源状态
@@ -125,3 +140,31 @@ func init() {
 		"{0}:{1} must be update,create,delete",
 	)
 }
+
+// ResourceEtcdKeyOverrideRequest ...
+type ResourceEtcdKeyOverrideRequest struct {
+	EtcdKeyOverride string `json:"etcd_key_override"` // 自定义 etcd key，为空表示使用 {资源类型}/{id}
+}
+
+// ResourceEtcdKeyOverrideResponse ...
+type ResourceEtcdKeyOverrideResponse struct {
+	EtcdKeyOverride string `json:"etcd_key_override"` // 自定义 etcd key(相对网关前缀)
+}
+
+// ResourceExportRequest ...
+type ResourceExportRequest struct {
+	IncludeSecrets bool `json:"include_secrets" form:"include_secrets"` // 是否导出敏感字段原值，默认脱敏
+	// 是否将 consumer 的凭证字段及 credential 导出到单独的凭证段，默认不导出，导入后需重新生成凭证；仅 etcd 资源导出支持
+	IncludeCredentials bool `json:"include_credentials" form:"include_credentials"`
+}
+
+// StandaloneUploadRequest ...
+type StandaloneUploadRequest struct {
+	ResourceTypes []constant.APISIXResource `json:"resource_types" form:"resource_types"` // 导入的资源类型，不传则导入全部类型
+}
+
+// StandaloneUploadResponse ...
+type StandaloneUploadResponse struct {
+	Resources *common.ResourceUploadInfo  `json:"resources"` // 校验通过的待导入资源，确认后通过资源导入接口导入
+	Errors    []dto.StandaloneImportError `json:"errors"`    // 格式错误或校验失败的资源
+}
@@ -48,6 +48,8 @@ type UpstreamListRequest struct {
 	OrderBy string `json:"order_by" form:"order_by"`
 	Offset  int    `json:"offset" form:"offset"`
 	Limit   int    `json:"limit" form:"limit"`
+	// 列表视图: full(默认) 返回完整 config，summary 仅返回摘要字段
+	View string `json:"view" form:"view" binding:"omitempty,oneof=full summary"`
 }
 
 // UpstreamListResponse Upstream 列表
@@ -65,6 +67,28 @@ type UpstreamOutputInfo struct {
 	Status    constant.ResourceStatus `json:"status"` // 发布状态
 }
 
+// UpstreamSummaryListResponse Upstream 摘要列表
+type UpstreamSummaryListResponse []UpstreamSummaryOutputInfo
+
+// UpstreamSummaryOutputInfo Upstream 摘要，从 config 中提取负载均衡类型、协议及节点数
+type UpstreamSummaryOutputInfo struct {
+	AutoID        int                     `json:"auto_id"`
+	GatewayID     int                     `json:"gateway_id"`
+	ID            string                  `json:"id"`
+	Name          string                  `json:"name"`
+	SSLID         string                  `json:"ssl_id"`
+	Type          string                  `json:"type"`
+	Scheme        string                  `json:"scheme"`
+	NodeCount     int                     `json:"node_count"`
+	DiscoveryType string                  `json:"discovery_type,omitempty"`
+	ServiceName   string                  `json:"service_name,omitempty"`
+	CreatedAt     int64                   `json:"created_at"`
+	UpdatedAt     int64                   `json:"updated_at"`
+	Creator       string                  `json:"creator"`
+	Updater       string                  `json:"updater"`
+	Status        constant.ResourceStatus `json:"status"`
+}
+
 // UpstreamDropDownListResponse Upstream 下拉列表
 type UpstreamDropDownListResponse []UpstreamDropDownOutputInfo
 
@@ -76,6 +100,9 @@ type UpstreamDropDownOutputInfo struct {
 	Desc   string `json:"desc"`    // 路由描述
 }
 
+// UpstreamExampleListResponse upstream 配置示例列表
+type UpstreamExampleListResponse []map[string]interface{}
+
 // ValidateUpstreamID 校验 upstreamID
 func ValidateUpstreamID(ctx context.Context, fl validator.FieldLevel) bool {
 	upstreamID := fl.Field().String()
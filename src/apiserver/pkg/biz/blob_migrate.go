@@ -0,0 +1,126 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/blobstore"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+)
+
+// BlobMigrateStats 大对象迁移统计
+type BlobMigrateStats struct {
+	Scanned  int // 扫描的报告数
+	Copied   int // 复制到目标后端的对象数
+	Skipped  int // 目标后端已存在且校验通过的对象数
+	Imported int // 由 result 字段内联内容导入的对象数
+	Failed   int // 迁移失败的报告数
+}
+
+// MigrateComplianceReportBlobs 将合规报告的检查明细从 from 迁移到 to
+//   - 按 ID 分批处理，每个对象写入 to 后读回校验 sha256
+//   - 历史报告内联在 result 字段中的明细导入 to 后清空该字段
+//   - deleteSource 为 true 时，校验通过后删除 from 中的对象
+//
+// 单个报告失败不中断迁移，失败数记录在统计中，可重复执行
+func MigrateComplianceReportBlobs(
+	ctx context.Context,
+	from blobstore.Store,
+	to blobstore.Store,
+	batchSize int,
+	deleteSource bool,
+) (*BlobMigrateStats, error) {
+	if from.Name() == to.Name() {
+		return nil, fmt.Errorf("migrate source and target are the same backend: %s", from.Name())
+	}
+	u := repo.ComplianceReport
+	stats := &BlobMigrateStats{}
+	lastID := 0
+	for {
+		reports, err := u.WithContext(ctx).Where(u.ID.Gt(lastID)).Order(u.ID).Limit(batchSize).Find()
+		if err != nil {
+			return stats, err
+		}
+		for _, report := range reports {
+			lastID = report.ID
+			stats.Scanned++
+			switch {
+			case report.ResultKey != "":
+				err = migrateBlob(ctx, from, to, report.ResultKey, deleteSource, stats)
+			case len(report.Result) != 0:
+				err = importComplianceReportResult(ctx, to, report.ID, report.Result)
+				if err == nil {
+					stats.Imported++
+				}
+			default:
+				continue
+			}
+			if err != nil {
+				stats.Failed++
+				logging.Errorf("migrate compliance report:%d result error: %s", report.ID, err.Error())
+			}
+		}
+		logging.Infof("migrate compliance report blobs: %+v", *stats)
+		if len(reports) < batchSize {
+			return stats, nil
+		}
+	}
+}
+
+// migrateBlob 迁移单个对象，目标后端已存在(如被其他报告引用且已迁移)时跳过复制
+func migrateBlob(
+	ctx context.Context,
+	from blobstore.Store,
+	to blobstore.Store,
+	key string,
+	deleteSource bool,
+	stats *BlobMigrateStats,
+) error {
+	_, err := to.Get(ctx, key)
+	switch {
+	case err == nil:
+		stats.Skipped++
+	case errors.Is(err, blobstore.ErrNotFound):
+		if err := blobstore.Copy(ctx, from, to, key); err != nil {
+			return err
+		}
+		stats.Copied++
+	default:
+		return err
+	}
+	if deleteSource {
+		return from.Delete(ctx, key)
+	}
+	return nil
+}
+
+// importComplianceReportResult 将内联的检查明细写入 to，校验通过后回写 key 并清空 result 字段
+func importComplianceReportResult(ctx context.Context, to blobstore.Store, reportID int, result []byte) error {
+	key, err := blobstore.Import(ctx, to, complianceReportBlobNamespace, result)
+	if err != nil {
+		return err
+	}
+	u := repo.ComplianceReport
+	_, err = u.WithContext(ctx).Where(u.ID.Eq(reportID)).UpdateSimple(u.ResultKey.Value(key), u.Result.Null())
+	return err
+}
@@ -0,0 +1,127 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package biz
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/datatypes"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/blobstore"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+)
+
+// memoryBlobStore 内存大对象存储，模拟迁移目标后端
+type memoryBlobStore struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func (s *memoryBlobStore) Name() string {
+	return blobstore.BackendS3
+}
+
+func (s *memoryBlobStore) Put(_ context.Context, namespace string, data []byte) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := blobstore.ContentKey(namespace, data)
+	s.objects[key] = data
+	return key, nil
+}
+
+func (s *memoryBlobStore) Get(_ context.Context, key string) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, ok := s.objects[key]
+	if !ok {
+		return nil, blobstore.ErrNotFound
+	}
+	return data, nil
+}
+
+func (s *memoryBlobStore) Delete(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.objects, key)
+	return nil
+}
+
+func TestMigrateComplianceReportBlobs(t *testing.T) {
+	ctx := context.Background()
+	// 使用独立的网关 ID，避免影响其他合规报告用例
+	gatewayID := gatewayInfo.ID + 1000
+	u := repo.ComplianceReport
+	defer func() {
+		_, _ = u.WithContext(ctx).Where(u.GatewayID.Eq(gatewayID)).Delete()
+	}()
+	from := blobstore.NewDBStore()
+	to := &memoryBlobStore{objects: map[string][]byte{}}
+
+	// 两个报告引用同一对象
+	sharedKey, err := from.Put(ctx, complianceReportBlobNamespace, []byte(`["shared"]`))
+	require.NoError(t, err)
+	inline := datatypes.JSON(`["inline"]`)
+	reports := []*model.ComplianceReport{
+		{GatewayID: gatewayID, Status: constant.ComplianceReportStatusSuccess, ResultKey: sharedKey},
+		{GatewayID: gatewayID, Status: constant.ComplianceReportStatusSuccess, ResultKey: sharedKey},
+		{GatewayID: gatewayID, Status: constant.ComplianceReportStatusSuccess, Result: inline},
+		{GatewayID: gatewayID, Status: constant.ComplianceReportStatusFailed},
+		// 对象已丢失
+		{
+			GatewayID: gatewayID,
+			Status:    constant.ComplianceReportStatusSuccess,
+			ResultKey: blobstore.ContentKey(complianceReportBlobNamespace, []byte("lost")),
+		},
+	}
+	require.NoError(t, u.WithContext(ctx).Create(reports...))
+
+	stats, err := MigrateComplianceReportBlobs(ctx, from, to, 2, true)
+	require.NoError(t, err)
+	// 共享库中可能存在其他用例的报告，统计只校验下限
+	assert.GreaterOrEqual(t, stats.Scanned, len(reports))
+	assert.GreaterOrEqual(t, stats.Copied, 1)
+	assert.GreaterOrEqual(t, stats.Skipped, 1)
+	assert.GreaterOrEqual(t, stats.Imported, 1)
+	assert.GreaterOrEqual(t, stats.Failed, 1)
+
+	assert.Equal(t, `["shared"]`, string(to.objects[sharedKey]))
+	_, err = from.Get(ctx, sharedKey)
+	assert.ErrorIs(t, err, blobstore.ErrNotFound)
+
+	imported, err := u.WithContext(ctx).Where(u.ID.Eq(reports[2].ID)).First()
+	require.NoError(t, err)
+	assert.Empty(t, imported.Result)
+	assert.Equal(t, `["inline"]`, string(to.objects[imported.ResultKey]))
+
+	// 迁移期间通过回退读取
+	blobstore.SetDefault(blobstore.NewFallbackStore(to, from))
+	defer blobstore.SetDefault(blobstore.NewDBStore())
+	report, err := GetComplianceReport(ctx, gatewayID, reports[2].ID)
+	require.NoError(t, err)
+	assert.JSONEq(t, `["inline"]`, string(report.Result))
+
+	_, err = MigrateComplianceReportBlobs(ctx, from, blobstore.NewDBStore(), 2, false)
+	assert.Error(t, err)
+}
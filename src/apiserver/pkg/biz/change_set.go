@@ -0,0 +1,351 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/samber/lo"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+)
+
+// ErrChangeSetConflict 资源已属于其他未关闭的变更集
+var ErrChangeSetConflict = errors.New("资源已属于其他变更集")
+
+// ChangeSetResourceIDs 变更集资源：资源类型 -> 资源 ID 集合
+type ChangeSetResourceIDs map[constant.APISIXResource]map[string]struct{}
+
+// Contains 判断资源是否在集合中
+func (ids ChangeSetResourceIDs) Contains(resourceType constant.APISIXResource, id string) bool {
+	_, ok := ids[resourceType][id]
+	return ok
+}
+
+// CreateChangeSet 创建变更集
+func CreateChangeSet(ctx context.Context, changeSet *model.ChangeSet) error {
+	changeSet.GatewayID = ginx.GetGatewayInfoFromContext(ctx).ID
+	changeSet.Status = constant.ChangeSetStatusOpen
+	changeSet.Creator = ginx.GetUserIDFromContext(ctx)
+	changeSet.Updater = ginx.GetUserIDFromContext(ctx)
+	return repo.ChangeSet.WithContext(ctx).Create(changeSet)
+}
+
+// ListPagedChangeSets 分页查询变更集，status 为空时返回全部状态
+func ListPagedChangeSets(
+	ctx context.Context,
+	gatewayID int,
+	status constant.ChangeSetStatus,
+	page PageParam,
+) ([]*model.ChangeSet, int64, error) {
+	u := repo.ChangeSet
+	query := u.WithContext(ctx).Where(u.GatewayID.Eq(gatewayID))
+	if status != "" {
+		query = query.Where(u.Status.Eq(string(status)))
+	}
+	return query.Order(u.ID.Desc()).FindByPage(page.Offset, page.Limit)
+}
+
+// GetChangeSet 查询变更集
+func GetChangeSet(ctx context.Context, gatewayID int, id int) (*model.ChangeSet, error) {
+	u := repo.ChangeSet
+	return u.WithContext(ctx).Where(u.GatewayID.Eq(gatewayID), u.ID.Eq(id)).First()
+}
+
+// ListChangeSetResources 查询变更集包含的资源，已关闭的变更集不再持有资源
+func ListChangeSetResources(ctx context.Context, changeSetID int) ([]*model.ChangeSetResource, error) {
+	u := repo.ChangeSetResource
+	return u.WithContext(ctx).Where(u.ChangeSetID.Eq(changeSetID)).Order(u.ID).Find()
+}
+
+// GetChangeSetResourceIDs 查询变更集包含的资源 ID
+func GetChangeSetResourceIDs(ctx context.Context, changeSetID int) (ChangeSetResourceIDs, error) {
+	resources, err := ListChangeSetResources(ctx, changeSetID)
+	if err != nil {
+		return nil, err
+	}
+	return toChangeSetResourceIDs(resources), nil
+}
+
+// GetHeldResourceIDs 查询网关下被未关闭的变更集持有的资源 ID
+func GetHeldResourceIDs(ctx context.Context, gatewayID int) (ChangeSetResourceIDs, error) {
+	u := repo.ChangeSetResource
+	resources, err := u.WithContext(ctx).Where(u.GatewayID.Eq(gatewayID)).Find()
+	if err != nil {
+		return nil, err
+	}
+	return toChangeSetResourceIDs(resources), nil
+}
+
+func toChangeSetResourceIDs(resources []*model.ChangeSetResource) ChangeSetResourceIDs {
+	ids := make(ChangeSetResourceIDs)
+	for _, r := range resources {
+		if _, ok := ids[r.ResourceType]; !ok {
+			ids[r.ResourceType] = make(map[string]struct{})
+		}
+		ids[r.ResourceType][r.ResourceID] = struct{}{}
+	}
+	return ids
+}
+
+// AddChangeSetResources 将资源加入变更集，资源已属于其他未关闭的变更集时拒绝
+func AddChangeSetResources(
+	ctx context.Context,
+	changeSet *model.ChangeSet,
+	resourceType constant.APISIXResource,
+	resourceIDs []string,
+) error {
+	if changeSet.Status != constant.ChangeSetStatusOpen {
+		return fmt.Errorf("变更集: %s 已关闭，当前状态: %s", changeSet.Name, changeSet.Status)
+	}
+	resourceIDs = lo.Uniq(resourceIDs)
+	resources, err := BatchGetResources(ctx, resourceType, resourceIDs)
+	if err != nil {
+		return fmt.Errorf("%s 查询错误: %w", constant.ResourceTypeMap[resourceType], err)
+	}
+	missing, _ := lo.Difference(resourceIDs, lo.Map(resources, func(r *model.ResourceCommonModel, _ int) string {
+		return r.ID
+	}))
+	if len(missing) > 0 {
+		return fmt.Errorf("未找到指定的 %s 资源 IDs %v", constant.ResourceTypeMap[resourceType], missing)
+	}
+
+	u := repo.ChangeSetResource
+	held, err := u.WithContext(ctx).Where(
+		u.GatewayID.Eq(changeSet.GatewayID),
+		u.ResourceType.Eq(string(resourceType)),
+		u.ResourceID.In(resourceIDs...),
+	).Find()
+	if err != nil {
+		return err
+	}
+	if err = checkChangeSetConflict(ctx, changeSet, resourceType, held); err != nil {
+		return err
+	}
+
+	heldIDs := lo.Map(held, func(r *model.ChangeSetResource, _ int) string { return r.ResourceID })
+	var records []*model.ChangeSetResource
+	for _, id := range resourceIDs {
+		if lo.Contains(heldIDs, id) {
+			continue
+		}
+		records = append(records, &model.ChangeSetResource{
+			ChangeSetID:  changeSet.ID,
+			GatewayID:    changeSet.GatewayID,
+			ResourceType: resourceType,
+			ResourceID:   id,
+			BaseModel: model.BaseModel{
+				Creator: ginx.GetUserIDFromContext(ctx),
+				Updater: ginx.GetUserIDFromContext(ctx),
+			},
+		})
+	}
+	if len(records) == 0 {
+		return nil
+	}
+	// 并发加入同一资源时由唯一索引兜底
+	if err = u.WithContext(ctx).Create(records...); err != nil {
+		return fmt.Errorf("%w: %s", ErrChangeSetConflict, err.Error())
+	}
+	return nil
+}
+
+// checkChangeSetConflict 检查资源是否已被其他变更集持有，错误信息中列出冲突的资源及变更集
+func checkChangeSetConflict(
+	ctx context.Context,
+	changeSet *model.ChangeSet,
+	resourceType constant.APISIXResource,
+	held []*model.ChangeSetResource,
+) error {
+	conflicts := lo.Filter(held, func(r *model.ChangeSetResource, _ int) bool {
+		return r.ChangeSetID != changeSet.ID
+	})
+	if len(conflicts) == 0 {
+		return nil
+	}
+	u := repo.ChangeSet
+	others, err := u.WithContext(ctx).Where(u.ID.In(lo.Uniq(lo.Map(conflicts,
+		func(r *model.ChangeSetResource, _ int) int { return r.ChangeSetID }))...)).Find()
+	if err != nil {
+		return err
+	}
+	names := lo.SliceToMap(others, func(cs *model.ChangeSet) (int, string) { return cs.ID, cs.Name })
+	details := make([]string, 0, len(conflicts))
+	for _, r := range conflicts {
+		details = append(details, fmt.Sprintf("%s: %s 已属于变更集 %s(id=%d)",
+			constant.ResourceTypeMap[resourceType], r.ResourceID, names[r.ChangeSetID], r.ChangeSetID))
+	}
+	return fmt.Errorf("%w, 不能加入变更集 %s(id=%d): %s",
+		ErrChangeSetConflict, changeSet.Name, changeSet.ID, strings.Join(details, "; "))
+}
+
+// RemoveChangeSetResources 将资源移出变更集，移出后资源恢复为普通草稿
+func RemoveChangeSetResources(
+	ctx context.Context,
+	changeSet *model.ChangeSet,
+	resourceType constant.APISIXResource,
+	resourceIDs []string,
+) error {
+	if changeSet.Status != constant.ChangeSetStatusOpen {
+		return fmt.Errorf("变更集: %s 已关闭，当前状态: %s", changeSet.Name, changeSet.Status)
+	}
+	u := repo.ChangeSetResource
+	_, err := u.WithContext(ctx).Where(
+		u.ChangeSetID.Eq(changeSet.ID),
+		u.ResourceType.Eq(string(resourceType)),
+		u.ResourceID.In(resourceIDs...),
+	).Delete()
+	return err
+}
+
+// CloseChangeSet 关闭变更集(已发布或已放弃)并释放其持有的资源
+func CloseChangeSet(ctx context.Context, changeSet *model.ChangeSet, status constant.ChangeSetStatus) error {
+	if changeSet.Status != constant.ChangeSetStatusOpen {
+		return fmt.Errorf("变更集: %s 已关闭，当前状态: %s", changeSet.Name, changeSet.Status)
+	}
+	err := repo.Q.Transaction(func(tx *repo.Query) error {
+		info, err := tx.ChangeSet.WithContext(ctx).Where(
+			tx.ChangeSet.ID.Eq(changeSet.ID),
+			tx.ChangeSet.Status.Eq(string(constant.ChangeSetStatusOpen)),
+		).UpdateSimple(
+			tx.ChangeSet.Status.Value(string(status)),
+			tx.ChangeSet.Updater.Value(ginx.GetUserIDFromContext(ctx)),
+		)
+		if err != nil {
+			return err
+		}
+		if info.RowsAffected == 0 {
+			return fmt.Errorf("变更集: %s 已被关闭", changeSet.Name)
+		}
+		_, err = tx.ChangeSetResource.WithContext(ctx).Where(
+			tx.ChangeSetResource.ChangeSetID.Eq(changeSet.ID),
+		).Delete()
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	changeSet.Status = status
+	return nil
+}
+
+// PublishChangeSet 发布变更集中的待发布资源，全部发布成功后关闭变更集；
+// 发布失败时变更集保持打开，已发布成功的资源不再是草稿，重试时只发布剩余资源
+func PublishChangeSet(ctx context.Context, changeSet *model.ChangeSet) error {
+	if changeSet.Status != constant.ChangeSetStatusOpen {
+		return fmt.Errorf("变更集: %s 已关闭，当前状态: %s", changeSet.Name, changeSet.Status)
+	}
+	resourceIDs, err := GetChangeSetResourceIDs(ctx, changeSet.ID)
+	if err != nil {
+		return err
+	}
+	for _, resourceType := range constant.ResourceTypeList {
+		ids := lo.Keys(resourceIDs[resourceType])
+		if len(ids) == 0 {
+			continue
+		}
+		resources, err := QueryResource(ctx, resourceType,
+			map[string]interface{}{
+				"gateway_id": changeSet.GatewayID,
+				"id":         ids,
+				"status": []constant.ResourceStatus{
+					constant.ResourceStatusCreateDraft,
+					constant.ResourceStatusUpdateDraft,
+					constant.ResourceStatusDeleteDraft,
+				},
+			}, "")
+		if err != nil {
+			logging.ErrorFWithContext(ctx, "%s query err: %s", resourceType, err.Error())
+			return fmt.Errorf("%s 查询错误: %w", constant.ResourceTypeMap[resourceType], err)
+		}
+		if len(resources) == 0 {
+			continue
+		}
+		pendingIDs := lo.Map(resources, func(r *model.ResourceCommonModel, _ int) string { return r.ID })
+		if err = PublishResource(ctx, resourceType, pendingIDs); err != nil {
+			return fmt.Errorf("变更集: %s 发布失败: %w", changeSet.Name, err)
+		}
+	}
+	return CloseChangeSet(ctx, changeSet, constant.ChangeSetStatusPublished)
+}
+
+// CheckResourcesNotInChangeSet 检查资源未被变更集持有，变更集中的资源需通过变更集整体发布
+func CheckResourcesNotInChangeSet(
+	ctx context.Context,
+	resourceType constant.APISIXResource,
+	resourceIDs []string,
+) error {
+	u := repo.ChangeSetResource
+	held, err := u.WithContext(ctx).Where(
+		u.GatewayID.Eq(ginx.GetGatewayInfoFromContext(ctx).ID),
+		u.ResourceType.Eq(string(resourceType)),
+		u.ResourceID.In(resourceIDs...),
+	).Find()
+	if err != nil {
+		return err
+	}
+	if len(held) == 0 {
+		return nil
+	}
+	details := lo.Map(held, func(r *model.ChangeSetResource, _ int) string {
+		return fmt.Sprintf("%s(变更集 id=%d)", r.ResourceID, r.ChangeSetID)
+	})
+	return fmt.Errorf("%w, 请通过变更集发布: %s %s",
+		ErrChangeSetConflict, constant.ResourceTypeMap[resourceType], strings.Join(details, ", "))
+}
+
+// FilterResourceChanges 按资源集合过滤变更列表，并重新统计各类型的变更数量
+func FilterResourceChanges(
+	changes []dto.ResourceChangeInfo,
+	keep func(resourceType constant.APISIXResource, id string) bool,
+) []dto.ResourceChangeInfo {
+	var result []dto.ResourceChangeInfo
+	for _, change := range changes {
+		filtered := dto.ResourceChangeInfo{
+			ResourceType: change.ResourceType,
+			ChangeDetail: []dto.ResourceChangeDetail{},
+		}
+		for _, detail := range change.ChangeDetail {
+			if !keep(change.ResourceType, detail.ResourceID) {
+				continue
+			}
+			switch detail.PublishFrom {
+			case constant.OperationTypeCreate:
+				filtered.AddedCount++
+			case constant.OperationTypeDelete:
+				filtered.DeletedCount++
+			case constant.OperationTypeUpdate:
+				filtered.UpdateCount++
+			}
+			filtered.ChangeDetail = append(filtered.ChangeDetail, detail)
+		}
+		if len(filtered.ChangeDetail) > 0 {
+			result = append(result, filtered)
+		}
+	}
+	return result
+}
@@ -0,0 +1,128 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package biz
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/storage"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/data"
+)
+
+func TestChangeSetResourceConflict(t *testing.T) {
+	route := data.Route1WithNoRelationResource(gatewayInfo, constant.ResourceStatusCreateDraft)
+	route.Name = fmt.Sprintf("change-set-conflict-%d", time.Now().UnixNano())
+	assert.NoError(t, CreateRoute(gatewayCtx, *route))
+
+	feature := &model.ChangeSet{Name: "feature"}
+	assert.NoError(t, CreateChangeSet(gatewayCtx, feature))
+	other := &model.ChangeSet{Name: "other"}
+	assert.NoError(t, CreateChangeSet(gatewayCtx, other))
+
+	assert.NoError(t, AddChangeSetResources(gatewayCtx, feature, constant.Route, []string{route.ID}))
+	// 重复加入同一变更集不报错
+	assert.NoError(t, AddChangeSetResources(gatewayCtx, feature, constant.Route, []string{route.ID}))
+
+	err := AddChangeSetResources(gatewayCtx, other, constant.Route, []string{route.ID})
+	assert.ErrorIs(t, err, ErrChangeSetConflict)
+	assert.ErrorContains(t, err, route.ID)
+	assert.ErrorContains(t, err, "feature")
+
+	// 变更集中的资源不能单独发布
+	assert.ErrorIs(t, CheckResourcesNotInChangeSet(gatewayCtx, constant.Route, []string{route.ID}),
+		ErrChangeSetConflict)
+
+	err = AddChangeSetResources(gatewayCtx, other, constant.Route, []string{"not-exist"})
+	assert.ErrorContains(t, err, "not-exist")
+
+	// 放弃后释放资源
+	assert.NoError(t, CloseChangeSet(gatewayCtx, feature, constant.ChangeSetStatusAbandoned))
+	assert.NoError(t, CheckResourcesNotInChangeSet(gatewayCtx, constant.Route, []string{route.ID}))
+	assert.Error(t, AddChangeSetResources(gatewayCtx, feature, constant.Route, []string{route.ID}))
+	assert.NoError(t, AddChangeSetResources(gatewayCtx, other, constant.Route, []string{route.ID}))
+	assert.NoError(t, CloseChangeSet(gatewayCtx, other, constant.ChangeSetStatusAbandoned))
+}
+
+func TestPublishChangeSet(t *testing.T) {
+	route := data.Route1WithNoRelationResource(gatewayInfo, constant.ResourceStatusCreateDraft)
+	route.Name = fmt.Sprintf("change-set-publish-%d", time.Now().UnixNano())
+	assert.NoError(t, CreateRoute(gatewayCtx, *route))
+
+	changeSet := &model.ChangeSet{Name: "publish"}
+	assert.NoError(t, CreateChangeSet(gatewayCtx, changeSet))
+	assert.NoError(t, AddChangeSetResources(gatewayCtx, changeSet, constant.Route, []string{route.ID}))
+	assert.NoError(t, PublishChangeSet(gatewayCtx, changeSet))
+
+	changeSet, err := GetChangeSet(gatewayCtx, gatewayInfo.ID, changeSet.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, constant.ChangeSetStatusPublished, changeSet.Status)
+	resources, err := ListChangeSetResources(gatewayCtx, changeSet.ID)
+	assert.NoError(t, err)
+	assert.Empty(t, resources)
+
+	routeInfo, err := GetRoute(gatewayCtx, route.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, constant.ResourceStatusSuccess, routeInfo.Status)
+
+	// 清理 etcd 数据，避免影响其他用例的同步统计
+	etcdStore, err := storage.NewEtcdStorage(gatewayInfo.EtcdConfig.EtcdConfig)
+	assert.NoError(t, err)
+	defer etcdStore.Close()
+	_, err = etcdStore.GetClient().Delete(context.Background(), gatewayInfo.EtcdConfig.Prefix+"/routes/"+route.ID)
+	assert.NoError(t, err)
+}
+
+func TestFilterResourceChanges(t *testing.T) {
+	changes := []dto.ResourceChangeInfo{
+		{
+			ResourceType: constant.Route,
+			AddedCount:   1,
+			UpdateCount:  1,
+			ChangeDetail: []dto.ResourceChangeDetail{
+				{ResourceID: "r1", PublishFrom: constant.OperationTypeCreate},
+				{ResourceID: "r2", PublishFrom: constant.OperationTypeUpdate},
+			},
+		},
+		{
+			ResourceType: constant.Upstream,
+			DeletedCount: 1,
+			ChangeDetail: []dto.ResourceChangeDetail{
+				{ResourceID: "u1", PublishFrom: constant.OperationTypeDelete},
+			},
+		},
+	}
+	ids := ChangeSetResourceIDs{constant.Route: {"r2": {}}}
+	assert.Equal(t, []dto.ResourceChangeInfo{
+		{
+			ResourceType: constant.Route,
+			UpdateCount:  1,
+			ChangeDetail: []dto.ResourceChangeDetail{
+				{ResourceID: "r2", PublishFrom: constant.OperationTypeUpdate},
+			},
+		},
+	}, FilterResourceChanges(changes, ids.Contains))
+}
@@ -36,6 +36,7 @@ import (
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/database"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/status"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/schema"
@@ -59,6 +60,8 @@ var resourceTableMap = map[constant.APISIXResource]string{
 	constant.Proto:          model.Proto{}.TableName(),
 	constant.SSL:            model.SSL{}.TableName(),
 	constant.StreamRoute:    model.StreamRoute{}.TableName(),
+	constant.Secret:         model.Secret{}.TableName(),
+	constant.Credential:     model.Credential{}.TableName(),
 }
 
 var resourceModelSliceMap = map[constant.APISIXResource]interface{}{
@@ -73,6 +76,8 @@ var resourceModelSliceMap = map[constant.APISIXResource]interface{}{
 	constant.Proto:          &[]model.Proto{},
 	constant.SSL:            &[]model.SSL{},
 	constant.StreamRoute:    &[]model.StreamRoute{},
+	constant.Secret:         &[]model.Secret{},
+	constant.Credential:     &[]model.Credential{},
 }
 
 var resourceModelMap = map[constant.APISIXResource]interface{}{
@@ -87,6 +92,8 @@ var resourceModelMap = map[constant.APISIXResource]interface{}{
 	constant.Proto:          &model.Proto{},
 	constant.SSL:            &model.SSL{},
 	constant.StreamRoute:    &model.StreamRoute{},
+	constant.Secret:         &model.Secret{},
+	constant.Credential:     &model.Credential{},
 }
 
 // Labels ...
@@ -154,6 +161,10 @@ func BatchDeleteResourceWithAuditLog(
 		return BatchDeletePluginConfigs(ctx, ids)
 	case constant.StreamRoute:
 		return BatchDeleteStreamRoutes(ctx, ids)
+	case constant.Secret:
+		return BatchDeleteSecrets(ctx, ids)
+	case constant.Credential:
+		return BatchDeleteCredentials(ctx, ids)
 	}
 	return nil
 }
@@ -461,6 +472,20 @@ func QueryResource(
 	return res, err
 }
 
+// ReadYourWrites 查询资源前确保能读到 token 对应的写入，未配置只读副本时不做处理
+func ReadYourWrites(
+	ctx context.Context,
+	resourceType constant.APISIXResource,
+	gatewayID int,
+	token int64,
+) context.Context {
+	table, ok := resourceTableMap[resourceType]
+	if !ok {
+		return ctx
+	}
+	return repo.ReadYourWrites(ctx, table, gatewayID, token)
+}
+
 // LabelConditionList 标签查询条件列表
 func LabelConditionList(
 	labelList map[string][]string,
@@ -630,6 +655,14 @@ func ValidateResource(
 ) error {
 	// Extract gateway information from context
 	gatewayInfo := ginx.GetGatewayInfoFromContext(ctx)
+	snapshot, err := GetCustomSchemaSnapshot(ctx, gatewayInfo.ID)
+	if err != nil {
+		logging.Errorf("get gateway:%d custom plugin schema error: %s", gatewayInfo.ID, err.Error())
+	}
+	customizePluginSchemaMap := snapshot.PluginSchemaMap()
+	// 未变化的配置复用缓存的校验结果
+	cache := newValidationCache(ctx, gatewayInfo, snapshot)
+	defer cache.flush(ctx)
 	// Iterate through each resource type and its associated data
 	for resourceType, resource := range resources {
 		// Create schema validator for the resource type
@@ -640,21 +673,34 @@ func ValidateResource(
 		}
 		// Validate each resource instance
 		for _, r := range resource {
-			// Validate resource against schema
-			if err = schemaValidator.Validate(json.RawMessage(r.Config)); err != nil {
-				logging.Errorf("schema validate failed, err: %v", err)
-				return err
-			}
-			// 配置校验
-			customizePluginSchemaMap := GetCustomizePluginSchemaMap(ctx, gatewayInfo.ID)
-			jsonConfigValidator, err := schema.NewAPISIXJsonSchemaValidator(gatewayInfo.GetAPISIXVersionX(),
-				resourceType, "main."+string(resourceType), customizePluginSchemaMap, constant.DATABASE)
+			_, err = cache.validate(validationKindResource, resourceType, constant.DATABASE, json.RawMessage(r.Config),
+				func() ([]string, error) {
+					// Validate resource against schema
+					if err := schemaValidator.Validate(json.RawMessage(r.Config)); err != nil {
+						logging.Errorf("schema validate failed, err: %v", err)
+						return nil, err
+					}
+					// 配置校验
+					jsonConfigValidator, err := schema.NewAPISIXJsonSchemaValidator(gatewayInfo.GetAPISIXVersionX(),
+						resourceType, "main."+string(resourceType), customizePluginSchemaMap, constant.DATABASE)
+					if err != nil {
+						return nil, err
+					}
+					schema.SetAllowCustomVars(jsonConfigValidator, gatewayInfo.AllowCustomVars)
+					schema.SetRetriesCheck(jsonConfigValidator, gatewayInfo.RetriesCheck)
+					if err = jsonConfigValidator.Validate(json.RawMessage(r.Config)); err != nil { // 校验json schema
+						return nil, fmt.Errorf("resource config:%s validate failed, err: %v",
+							r.Config, err)
+					}
+					return nil, nil
+				})
 			if err != nil {
 				return err
 			}
-			if err = jsonConfigValidator.Validate(json.RawMessage(r.Config)); err != nil { // 校验json schema
-				return fmt.Errorf("resource config:%s validate failed, err: %v",
-					r.Config, err)
+			// 服务发现类型需在网关中启用
+			err = ValidateUpstreamDiscoveryType(ctx, gatewayInfo.ID, resourceType, json.RawMessage(r.Config))
+			if err != nil {
+				return fmt.Errorf("resource config:%s validate failed, err: %v", r.Config, err)
 			}
 
 			// 校验关联数据是否存在
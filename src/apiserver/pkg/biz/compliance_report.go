@@ -0,0 +1,673 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package biz
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+	"gorm.io/datatypes"
+	"gorm.io/gen"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	entity "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/apisix"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/blobstore"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/publisher"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/goroutinex"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/schema"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/sslx"
+)
+
+const (
+	// DefaultComplianceReportRetentionDays 合规报告默认保留天数，覆盖跨年度的季度对比
+	DefaultComplianceReportRetentionDays = 400
+	// complianceSSLExpiryThreshold 证书剩余有效期低于该阈值视为不合规
+	complianceSSLExpiryThreshold = 30 * 24 * time.Hour
+	// complianceProgressBatchSize 每检查多少个资源更新一次进度
+	complianceProgressBatchSize = 50
+	// complianceReportBlobNamespace 合规报告检查明细在大对象存储中的命名空间
+	complianceReportBlobNamespace = "compliance_report"
+)
+
+// complianceReportRetentionDays 合规报告保留天数，小于等于 0 时不清理
+var complianceReportRetentionDays atomic.Int64
+
+func init() {
+	complianceReportRetentionDays.Store(DefaultComplianceReportRetentionDays)
+}
+
+// SetComplianceReportRetentionDays 设置合规报告保留天数，小于等于 0 时不清理过期报告
+func SetComplianceReportRetentionDays(days int) {
+	complianceReportRetentionDays.Store(int64(days))
+}
+
+// ComplianceReportRetention 合规报告保留时长，为 0 时不清理
+func ComplianceReportRetention() time.Duration {
+	return time.Duration(max(complianceReportRetentionDays.Load(), 0)) * 24 * time.Hour
+}
+
+// complianceReportCSVHeader 合规报告 CSV 表头
+var complianceReportCSVHeader = []string{"resource_type", "resource_id", "name", "status", "result", "findings"}
+
+// CreateComplianceReport 创建合规报告，报告在后台异步生成；resourceTypes 为空时检查全部类型
+func CreateComplianceReport(
+	ctx context.Context,
+	resourceTypes []constant.APISIXResource,
+) (*model.ComplianceReport, error) {
+	gatewayInfo := ginx.GetGatewayInfoFromContext(ctx)
+	if err := CleanExpiredComplianceReports(ctx, gatewayInfo.ID); err != nil {
+		return nil, err
+	}
+	report := &model.ComplianceReport{
+		GatewayID:     gatewayInfo.ID,
+		Status:        constant.ComplianceReportStatusPending,
+		ResourceTypes: joinResourceTypes(resourceTypes),
+		BaseModel: model.BaseModel{
+			Creator: ginx.GetUserIDFromContext(ctx),
+			Updater: ginx.GetUserIDFromContext(ctx),
+		},
+	}
+	if err := repo.ComplianceReport.WithContext(ctx).Create(report); err != nil {
+		return nil, err
+	}
+	reportCtx := ginx.CloneCtx(ctx)
+	reportID := report.ID
+	goroutinex.GoroutineWithRecovery(reportCtx, func() {
+		GenerateComplianceReport(reportCtx, reportID, resourceTypes)
+	})
+	return report, nil
+}
+
+// ListPagedComplianceReports 分页查询网关下的合规报告
+func ListPagedComplianceReports(
+	ctx context.Context,
+	gatewayID int,
+	page PageParam,
+) ([]*model.ComplianceReport, int64, error) {
+	u := repo.ComplianceReport
+	// 列表不返回检查明细，避免数据量过大
+	return u.WithContext(ctx).Omit(u.Result).
+		Where(u.GatewayID.Eq(gatewayID)).
+		Order(u.ID.Desc()).
+		FindByPage(page.Offset, page.Limit)
+}
+
+// GetComplianceReport 查询合规报告，检查明细存放于大对象存储时一并读取
+func GetComplianceReport(ctx context.Context, gatewayID int, id int) (*model.ComplianceReport, error) {
+	u := repo.ComplianceReport
+	report, err := u.WithContext(ctx).Where(u.GatewayID.Eq(gatewayID), u.ID.Eq(id)).First()
+	if err != nil {
+		return nil, err
+	}
+	if report.ResultKey != "" {
+		result, err := blobstore.Default().Get(ctx, report.ResultKey)
+		if err != nil {
+			return nil, fmt.Errorf("read compliance report:%d result failed: %w", report.ID, err)
+		}
+		report.Result = datatypes.JSON(result)
+	}
+	return report, nil
+}
+
+// CleanExpiredComplianceReports 清理超过保留时长的合规报告及其不再被引用的检查明细
+func CleanExpiredComplianceReports(ctx context.Context, gatewayID int) error {
+	retention := ComplianceReportRetention()
+	if retention == 0 {
+		return nil
+	}
+	u := repo.ComplianceReport
+	expiredConds := []gen.Condition{
+		u.GatewayID.Eq(gatewayID),
+		u.CreatedAt.Lt(time.Now().Add(-retention)),
+	}
+	var resultKeys []string
+	err := u.WithContext(ctx).Where(expiredConds...).Where(u.ResultKey.Neq("")).
+		Distinct(u.ResultKey).Pluck(u.ResultKey, &resultKeys)
+	if err != nil {
+		return err
+	}
+	if _, err := u.WithContext(ctx).Where(expiredConds...).Delete(); err != nil {
+		return err
+	}
+	for _, key := range resultKeys {
+		// 内容寻址，相同的检查明细可能被其他报告引用
+		count, err := u.WithContext(ctx).Where(u.ResultKey.Eq(key)).Count()
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			continue
+		}
+		if err := blobstore.Default().Delete(ctx, key); err != nil {
+			logging.Errorf("delete compliance report result blob:%s error: %s", key, err.Error())
+		}
+	}
+	return nil
+}
+
+// GenerateComplianceReport 执行合规检查并回填报告
+func GenerateComplianceReport(ctx context.Context, reportID int, resourceTypes []constant.APISIXResource) {
+	u := repo.ComplianceReport
+	_, err := u.WithContext(ctx).Where(u.ID.Eq(reportID)).
+		UpdateSimple(u.Status.Value(string(constant.ComplianceReportStatusRunning)))
+	if err != nil {
+		logging.Errorf("update compliance report:%d status error: %s", reportID, err.Error())
+		return
+	}
+	summary, results, err := RunComplianceChecks(ctx, resourceTypes, func(processed, total int) {
+		_, err := u.WithContext(ctx).Where(u.ID.Eq(reportID)).
+			UpdateSimple(u.Processed.Value(processed), u.Total.Value(total))
+		if err != nil {
+			logging.Errorf("update compliance report:%d progress error: %s", reportID, err.Error())
+		}
+	})
+	if err != nil {
+		logging.Errorf("generate compliance report:%d error: %s", reportID, err.Error())
+		_, err = u.WithContext(ctx).Where(u.ID.Eq(reportID)).UpdateSimple(
+			u.Status.Value(string(constant.ComplianceReportStatusFailed)),
+			u.Message.Value(err.Error()),
+		)
+		if err != nil {
+			logging.Errorf("update compliance report:%d status error: %s", reportID, err.Error())
+		}
+		return
+	}
+	summaryRaw, _ := json.Marshal(summary)
+	resultRaw, _ := json.Marshal(results)
+	resultKey, err := blobstore.Default().Put(ctx, complianceReportBlobNamespace, resultRaw)
+	if err != nil {
+		logging.Errorf("save compliance report:%d result error: %s", reportID, err.Error())
+		_, err = u.WithContext(ctx).Where(u.ID.Eq(reportID)).UpdateSimple(
+			u.Status.Value(string(constant.ComplianceReportStatusFailed)),
+			u.Message.Value(err.Error()),
+		)
+		if err != nil {
+			logging.Errorf("update compliance report:%d status error: %s", reportID, err.Error())
+		}
+		return
+	}
+	_, err = u.WithContext(ctx).Where(u.ID.Eq(reportID)).UpdateSimple(
+		u.Status.Value(string(constant.ComplianceReportStatusSuccess)),
+		u.Summary.Value(datatypes.JSON(summaryRaw)),
+		u.ResultKey.Value(resultKey),
+	)
+	if err != nil {
+		logging.Errorf("update compliance report:%d result error: %s", reportID, err.Error())
+	}
+}
+
+// RunComplianceChecks 对网关下编辑区及 etcd 中的资源执行合规检查，resourceTypes 为空时检查全部类型
+func RunComplianceChecks(
+	ctx context.Context,
+	resourceTypes []constant.APISIXResource,
+	progress func(processed, total int),
+) (*dto.ComplianceReportSummary, []dto.ComplianceResourceResult, error) {
+	checker, err := newComplianceChecker(ctx, resourceTypes)
+	if err != nil {
+		return nil, nil, err
+	}
+	rows := checker.rows()
+	summary := &dto.ComplianceReportSummary{
+		Total:          len(rows),
+		FindingCounts:  make(map[constant.ComplianceCheck]int),
+		SeverityCounts: make(map[constant.ComplianceSeverity]int),
+	}
+	results := make([]dto.ComplianceResourceResult, 0, len(rows))
+	progress(0, len(rows))
+	for i, row := range rows {
+		result := checker.check(row)
+		if result.Passed {
+			summary.Passed++
+		} else {
+			summary.Failed++
+		}
+		for _, finding := range result.Findings {
+			summary.FindingCounts[finding.Check]++
+			summary.SeverityCounts[finding.Severity]++
+		}
+		results = append(results, result)
+		if (i+1)%complianceProgressBatchSize == 0 {
+			progress(i+1, len(rows))
+		}
+	}
+	progress(len(rows), len(rows))
+	checker.cache.flush(ctx)
+	return summary, results, nil
+}
+
+// RenderComplianceReportCSV 将合规报告的检查明细渲染为 CSV
+func RenderComplianceReportCSV(report *model.ComplianceReport) ([]byte, error) {
+	var results []dto.ComplianceResourceResult
+	if len(report.Result) != 0 {
+		if err := json.Unmarshal(report.Result, &results); err != nil {
+			return nil, err
+		}
+	}
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	if err := writer.Write(complianceReportCSVHeader); err != nil {
+		return nil, err
+	}
+	for _, result := range results {
+		passed := "pass"
+		if !result.Passed {
+			passed = "fail"
+		}
+		findings := make([]string, 0, len(result.Findings))
+		for _, finding := range result.Findings {
+			findings = append(findings, fmt.Sprintf("[%s][%s] %s", finding.Severity, finding.Check, finding.Message))
+		}
+		if err := writer.Write([]string{
+			result.ResourceType.String(),
+			result.ResourceID,
+			result.Name,
+			string(result.Status),
+			passed,
+			strings.Join(findings, "; "),
+		}); err != nil {
+			return nil, err
+		}
+	}
+	writer.Flush()
+	return buf.Bytes(), writer.Error()
+}
+
+// complianceRow 待检查的资源：编辑区与 etcd 中的同一资源合并为一行
+type complianceRow struct {
+	resourceType constant.APISIXResource
+	db           *model.ResourceCommonModel
+	etcd         json.RawMessage
+	etcdKey      string
+}
+
+// complianceChecker 合规检查器
+type complianceChecker struct {
+	gatewayInfo              *model.Gateway
+	customizePluginSchemaMap map[string]interface{}
+	now                      time.Time
+	// 待检查的资源类型
+	resourceTypes []constant.APISIXResource
+	// 编辑区资源(不含已删除的资源)：type -> etcd key -> resource
+	dbResources map[constant.APISIXResource]map[string]*model.ResourceCommonModel
+	// etcd 中生效的资源：type -> etcd key -> config
+	etcdResources map[constant.APISIXResource]map[string]json.RawMessage
+	// 未被证书覆盖的 stream route sni：etcd key -> sni
+	dbUncoveredSNIs   map[string]string
+	etcdUncoveredSNIs map[string]string
+	validators        map[string]schema.Validator
+	cache             *validationCache
+}
+
+// newComplianceChecker 加载编辑区及 etcd 中的资源：编辑区加载全部类型用于关联检查，
+// etcd 只读取待检查的资源类型
+func newComplianceChecker(
+	ctx context.Context,
+	resourceTypes []constant.APISIXResource,
+) (*complianceChecker, error) {
+	gatewayInfo := ginx.GetGatewayInfoFromContext(ctx)
+	snapshot, err := GetCustomSchemaSnapshot(ctx, gatewayInfo.ID)
+	if err != nil {
+		logging.Errorf("get gateway:%d custom plugin schema error: %s", gatewayInfo.ID, err.Error())
+	}
+	checker := &complianceChecker{
+		gatewayInfo:              gatewayInfo,
+		customizePluginSchemaMap: snapshot.PluginSchemaMap(),
+		now:                      time.Now(),
+		resourceTypes:            ScannedResourceTypes(resourceTypes),
+		dbResources:              make(map[constant.APISIXResource]map[string]*model.ResourceCommonModel),
+		validators:               make(map[string]schema.Validator),
+		cache:                    newValidationCache(ctx, gatewayInfo, snapshot),
+	}
+	for _, resourceType := range constant.ResourceTypeList {
+		resources, err := QueryResource(ctx, resourceType, map[string]interface{}{"gateway_id": gatewayInfo.ID}, "")
+		if err != nil {
+			return nil, err
+		}
+		checker.dbResources[resourceType] = make(map[string]*model.ResourceCommonModel)
+		for _, resource := range resources {
+			checker.dbResources[resourceType][complianceEtcdKey(resourceType, resource)] = resource
+		}
+	}
+	etcdResources, err := listEtcdResources(ctx, gatewayInfo, resourceTypes)
+	if err != nil {
+		return nil, err
+	}
+	checker.etcdResources = etcdResources
+
+	dbStreamRoutes := make(map[string]json.RawMessage)
+	for key, resource := range checker.dbResources[constant.StreamRoute] {
+		dbStreamRoutes[key] = json.RawMessage(resource.Config)
+	}
+	var dbSSLs []json.RawMessage
+	for _, resource := range checker.dbResources[constant.SSL] {
+		dbSSLs = append(dbSSLs, json.RawMessage(resource.Config))
+	}
+	checker.dbUncoveredSNIs = uncoveredStreamRouteSNIs(dbStreamRoutes, dbSSLs)
+	// 未读取 etcd 中的证书时无法判断 sni 是否被覆盖
+	if checker.scanned(constant.SSL) {
+		var etcdSSLs []json.RawMessage
+		for _, config := range etcdResources[constant.SSL] {
+			etcdSSLs = append(etcdSSLs, config)
+		}
+		checker.etcdUncoveredSNIs = uncoveredStreamRouteSNIs(etcdResources[constant.StreamRoute], etcdSSLs)
+	}
+	return checker, nil
+}
+
+// complianceEtcdKey 编辑区资源在 etcd 中对应的 key 去掉资源类型目录后的部分，与 listEtcdResources 的 key 对应；
+// 插件元数据以插件名作为 key，配置了自定义 etcd key 的资源以自定义 key 为准
+func complianceEtcdKey(resourceType constant.APISIXResource, resource *model.ResourceCommonModel) string {
+	key := resource.ID
+	if resourceType == constant.PluginMetadata {
+		key = resource.GetName(resourceType)
+	}
+	return resourceKeyWithoutTypeDir(publisher.ResourceOperation{
+		Key:         key,
+		KeyOverride: resource.EtcdKeyOverride,
+		Type:        resourceType,
+	})
+}
+
+// resourceKeyWithoutTypeDir 资源发布到 etcd 的 key 去掉资源类型目录后的部分
+func resourceKeyWithoutTypeDir(op publisher.ResourceOperation) string {
+	_, key, _ := publisher.ParseResourceKey(op.GetKey())
+	return key
+}
+
+// listEtcdResources 读取 etcd 中生效的原始资源配置，resourceTypes 为空时读取全部类型
+func listEtcdResources(
+	ctx context.Context,
+	gatewayInfo *model.Gateway,
+	resourceTypes []constant.APISIXResource,
+) (map[constant.APISIXResource]map[string]json.RawMessage, error) {
+	etcdStore, err := publisher.NewGatewayEtcdStorage(gatewayInfo)
+	if err != nil {
+		return nil, err
+	}
+	defer etcdStore.Close()
+	prefix := strings.TrimSuffix(gatewayInfo.EtcdConfig.Prefix, "/") + "/"
+	kvList, err := listEtcdKeyValues(ctx, etcdStore, prefix, resourceTypes)
+	if err != nil {
+		return nil, err
+	}
+	resources := make(map[constant.APISIXResource]map[string]json.RawMessage)
+	for _, kv := range kvList {
+		// 自定义 key 及凭证等带有子目录的 key 与发布时使用同一映射
+		resourceType, key, ok := publisher.ParseResourceKey(strings.TrimPrefix(kv.Key, prefix))
+		if !ok {
+			continue
+		}
+		// consumer 与凭证位于同一目录，只保留待读取的类型
+		if len(resourceTypes) > 0 && !slices.Contains(resourceTypes, resourceType) {
+			continue
+		}
+		if resources[resourceType] == nil {
+			resources[resourceType] = make(map[string]json.RawMessage)
+		}
+		resources[resourceType][key] = json.RawMessage(kv.Value)
+	}
+	return resources, nil
+}
+
+// uncoveredStreamRouteSNIs 获取未被证书覆盖的 stream route sni
+func uncoveredStreamRouteSNIs(streamRoutes map[string]json.RawMessage, ssls []json.RawMessage) map[string]string {
+	var streamRouteEntities []*entity.StreamRoute
+	for key, config := range streamRoutes {
+		var streamRoute entity.StreamRoute
+		if err := json.Unmarshal(config, &streamRoute); err != nil {
+			continue
+		}
+		streamRoute.ID = key
+		streamRouteEntities = append(streamRouteEntities, &streamRoute)
+	}
+	var sslEntities []*entity.SSL
+	for _, config := range ssls {
+		var ssl entity.SSL
+		if err := json.Unmarshal(config, &ssl); err != nil {
+			continue
+		}
+		sslEntities = append(sslEntities, &ssl)
+	}
+	uncovered := make(map[string]string)
+	for _, item := range schema.CheckStreamRouteSNI(streamRouteEntities, sslEntities) {
+		uncovered[item.StreamRouteID] = item.SNI
+	}
+	return uncovered
+}
+
+// rows 按资源类型及 ID 排序后的待检查资源
+func (c *complianceChecker) rows() []complianceRow {
+	var rows []complianceRow
+	for _, resourceType := range c.resourceTypes {
+		var typeRows []complianceRow
+		for key, resource := range c.dbResources[resourceType] {
+			row := complianceRow{resourceType: resourceType, db: resource, etcdKey: key}
+			if config, ok := c.etcdResources[resourceType][key]; ok {
+				row.etcd = config
+			}
+			typeRows = append(typeRows, row)
+		}
+		for key, config := range c.etcdResources[resourceType] {
+			if _, ok := c.dbResources[resourceType][key]; ok {
+				continue
+			}
+			typeRows = append(typeRows, complianceRow{resourceType: resourceType, etcd: config, etcdKey: key})
+		}
+		sort.Slice(typeRows, func(i, j int) bool {
+			return typeRows[i].etcdKey < typeRows[j].etcdKey
+		})
+		rows = append(rows, typeRows...)
+	}
+	return rows
+}
+
+// check 检查单个资源
+func (c *complianceChecker) check(row complianceRow) dto.ComplianceResourceResult {
+	result := dto.ComplianceResourceResult{
+		ResourceType: row.resourceType,
+		ResourceID:   row.etcdKey,
+		Findings:     []dto.ComplianceFinding{},
+	}
+	addFinding := func(check constant.ComplianceCheck, format string, args ...interface{}) {
+		result.Findings = append(result.Findings, dto.ComplianceFinding{
+			Check:    check,
+			Severity: constant.GetComplianceCheckSeverity(check),
+			Message:  fmt.Sprintf(format, args...),
+		})
+	}
+	if row.db != nil {
+		result.ResourceID = row.db.ID
+		result.Name = row.db.GetName(row.resourceType)
+		result.Status = row.db.Status
+		// 待删除的资源不再校验编辑区配置
+		if row.db.Status != constant.ResourceStatusDeleteDraft {
+			if err := c.validate(row.resourceType, c.databaseConfig(row), constant.DATABASE); err != nil {
+				addFinding(constant.ComplianceCheckSchemaDatabase, "编辑区配置校验失败: %s", err.Error())
+			}
+			for _, msg := range missingReferences(json.RawMessage(row.db.Config), c.existsInDatabase) {
+				addFinding(constant.ComplianceCheckReference, "编辑区%s", msg)
+			}
+			c.checkSSLExpiry(row.resourceType, json.RawMessage(row.db.Config), "编辑区", addFinding)
+			if sni, ok := c.dbUncoveredSNIs[row.etcdKey]; ok {
+				addFinding(constant.ComplianceCheckSNI, "编辑区 sni: %s 未被任何 server 类型的 SSL 证书覆盖", sni)
+			}
+		}
+	}
+	if row.etcd != nil {
+		if result.Name == "" {
+			result.Name = gjson.GetBytes(row.etcd, model.GetResourceNameKey(row.resourceType)).String()
+		}
+		if err := c.validate(row.resourceType, row.etcd, constant.ETCD); err != nil {
+			addFinding(constant.ComplianceCheckSchemaEtcd, "etcd 生效配置校验失败: %s", err.Error())
+		}
+		// 依赖在 etcd 中缺失时数据面会直接报错，与编辑区的关联缺失区分开
+		for _, msg := range missingReferences(row.etcd, c.existsInEtcd) {
+			addFinding(constant.ComplianceCheckDataPlaneReference, "etcd 中%s", msg)
+		}
+		c.checkSSLExpiry(row.resourceType, row.etcd, "etcd", addFinding)
+		if sni, ok := c.etcdUncoveredSNIs[row.etcdKey]; ok {
+			addFinding(constant.ComplianceCheckSNI, "etcd sni: %s 未被任何 server 类型的 SSL 证书覆盖", sni)
+		}
+	}
+	switch {
+	case row.db == nil:
+		addFinding(constant.ComplianceCheckDrift, "etcd 中存在未纳管的资源")
+	case row.db.Status == constant.ResourceStatusConflict:
+		addFinding(constant.ComplianceCheckDrift, "编辑区配置与 etcd 生效配置冲突")
+	case row.db.Status == constant.ResourceStatusSuccess && row.etcd == nil:
+		addFinding(constant.ComplianceCheckDrift, "资源已发布，但 etcd 中不存在")
+	}
+	result.Passed = len(result.Findings) == 0
+	return result
+}
+
+// existsInDatabase 编辑区中是否存在该资源
+func (c *complianceChecker) existsInDatabase(resourceType constant.APISIXResource, id string) bool {
+	_, ok := c.dbResources[resourceType][id]
+	return ok
+}
+
+// existsInEtcd etcd 中是否存在该资源，未读取的资源类型无法判断，视为存在
+func (c *complianceChecker) existsInEtcd(resourceType constant.APISIXResource, id string) bool {
+	if !c.scanned(resourceType) {
+		return true
+	}
+	_, ok := c.etcdResources[resourceType][id]
+	return ok
+}
+
+// scanned 是否读取了 etcd 中该类型的资源
+func (c *complianceChecker) scanned(resourceType constant.APISIXResource) bool {
+	return slices.Contains(c.resourceTypes, resourceType)
+}
+
+// databaseConfig 编辑区配置，插件元数据需要带上插件名作为 id
+func (c *complianceChecker) databaseConfig(row complianceRow) json.RawMessage {
+	config := json.RawMessage(row.db.Config)
+	if row.resourceType == constant.PluginMetadata {
+		config, _ = sjson.SetBytes(config, "id", row.etcdKey)
+	}
+	return config
+}
+
+// validate 使用对应数据类型的 schema 校验资源配置，未变化的配置复用缓存的校验结果
+func (c *complianceChecker) validate(
+	resourceType constant.APISIXResource,
+	config json.RawMessage,
+	dataType constant.DataType,
+) error {
+	_, err := c.cache.validate(validationKindConfig, resourceType, dataType, config, func() ([]string, error) {
+		return nil, c.validateConfig(resourceType, config, dataType)
+	})
+	return err
+}
+
+// validateConfig 使用对应数据类型的 schema 校验资源配置
+func (c *complianceChecker) validateConfig(
+	resourceType constant.APISIXResource,
+	config json.RawMessage,
+	dataType constant.DataType,
+) error {
+	key := resourceType.String() + ":" + string(dataType)
+	validator, ok := c.validators[key]
+	if !ok {
+		var err error
+		validator, err = schema.NewAPISIXJsonSchemaValidator(
+			c.gatewayInfo.GetAPISIXVersionX(),
+			resourceType,
+			"main."+resourceType.String(),
+			c.customizePluginSchemaMap,
+			dataType,
+		)
+		if err != nil {
+			return err
+		}
+		schema.SetAllowCustomVars(validator, c.gatewayInfo.AllowCustomVars)
+		schema.SetRetriesCheck(validator, c.gatewayInfo.RetriesCheck)
+		c.validators[key] = validator
+	}
+	return validator.Validate(config)
+}
+
+// checkSSLExpiry 检查证书有效期
+func (c *complianceChecker) checkSSLExpiry(
+	resourceType constant.APISIXResource,
+	config json.RawMessage,
+	source string,
+	addFinding func(check constant.ComplianceCheck, format string, args ...interface{}),
+) {
+	if resourceType != constant.SSL {
+		return
+	}
+	validity, err := sslx.X509CertValidity(gjson.GetBytes(config, "cert").String())
+	if err != nil {
+		addFinding(constant.ComplianceCheckSSLExpiry, "%s证书解析失败: %s", source, err.Error())
+		return
+	}
+	notAfter := time.Unix(validity.NotAfter, 0)
+	if notAfter.Before(c.now) {
+		addFinding(constant.ComplianceCheckSSLExpiry, "%s证书已于 %s 过期", source, notAfter.Format(time.DateTime))
+		return
+	}
+	if notAfter.Before(c.now.Add(complianceSSLExpiryThreshold)) {
+		addFinding(constant.ComplianceCheckSSLExpiry, "%s证书将于 %s 过期", source, notAfter.Format(time.DateTime))
+	}
+}
+
+// missingReferences 检查关联资源是否存在，返回缺失的关联资源
+func missingReferences(
+	config json.RawMessage,
+	exists func(resourceType constant.APISIXResource, id string) bool,
+) []string {
+	var missing []string
+	references := []struct {
+		resourceType constant.APISIXResource
+		id           string
+	}{
+		{constant.Service, gjson.GetBytes(config, "service_id").String()},
+		{constant.Upstream, gjson.GetBytes(config, "upstream_id").String()},
+		{constant.PluginConfig, gjson.GetBytes(config, "plugin_config_id").String()},
+		{constant.ConsumerGroup, gjson.GetBytes(config, "group_id").String()},
+		{constant.SSL, gjson.GetBytes(config, "tls.client_cert_id").String()},
+	}
+	for _, reference := range references {
+		if reference.id == "" {
+			continue
+		}
+		if !exists(reference.resourceType, reference.id) {
+			missing = append(missing, fmt.Sprintf("关联的 %s [id:%s] 不存在", reference.resourceType, reference.id))
+		}
+	}
+	return missing
+}
@@ -0,0 +1,248 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package biz
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"gorm.io/datatypes"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/blobstore"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/storage"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/data"
+)
+
+func TestRunComplianceChecks(t *testing.T) {
+	// 已发布且合规的路由
+	publishedRoute := data.Route1WithNoRelationResource(gatewayInfo, constant.ResourceStatusCreateDraft)
+	publishedRoute.Name = fmt.Sprintf("compliance-published-%d", time.Now().UnixNano())
+	assert.NoError(t, CreateRoute(gatewayCtx, *publishedRoute))
+	assert.NoError(t, PublishRoutes(gatewayCtx, []string{publishedRoute.ID}))
+
+	// 关联了不存在的上游
+	brokenRoute := data.Route1WithNoRelationResource(gatewayInfo, constant.ResourceStatusCreateDraft)
+	brokenRoute.Name = fmt.Sprintf("compliance-broken-%d", time.Now().UnixNano())
+	brokenRoute.UpstreamID = "not-exist-upstream"
+	brokenRoute.Config = datatypes.JSON(`{"uris":["/broken"],"upstream_id":"not-exist-upstream"}`)
+	assert.NoError(t, CreateRoute(gatewayCtx, *brokenRoute))
+	defer func() { assert.NoError(t, BatchDeleteRoutes(gatewayCtx, []string{brokenRoute.ID})) }()
+
+	// 已发布但 etcd 中被删除
+	driftRoute := data.Route1WithNoRelationResource(gatewayInfo, constant.ResourceStatusCreateDraft)
+	driftRoute.Name = fmt.Sprintf("compliance-drift-%d", time.Now().UnixNano())
+	assert.NoError(t, CreateRoute(gatewayCtx, *driftRoute))
+	assert.NoError(t, PublishRoutes(gatewayCtx, []string{driftRoute.ID}))
+
+	// etcd 中未纳管且不合法的路由
+	etcdStore, err := storage.NewEtcdStorage(gatewayInfo.EtcdConfig.EtcdConfig)
+	assert.NoError(t, err)
+	defer etcdStore.Close()
+	client := etcdStore.GetClient()
+	routeKeyPrefix := gatewayInfo.EtcdConfig.Prefix + "/routes/"
+	unmanagedID := fmt.Sprintf("compliance-unmanaged-%d", time.Now().UnixNano())
+	_, err = client.Put(context.Background(), routeKeyPrefix+unmanagedID,
+		`{"id":"`+unmanagedID+`","uri":"/unmanaged","unknown_field":1}`)
+	assert.NoError(t, err)
+	_, err = client.Delete(context.Background(), routeKeyPrefix+driftRoute.ID)
+	assert.NoError(t, err)
+
+	var progressed []int
+	summary, results, err := RunComplianceChecks(gatewayCtx, nil, func(processed, total int) {
+		progressed = append(progressed, processed)
+		assert.LessOrEqual(t, processed, total)
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, summary.Total, len(results))
+	assert.Equal(t, summary.Total, summary.Passed+summary.Failed)
+	assert.Equal(t, summary.Total, progressed[len(progressed)-1])
+
+	resultMap := make(map[string]dto.ComplianceResourceResult)
+	for _, result := range results {
+		resultMap[result.ResourceID] = result
+	}
+	findingChecks := func(id string) []constant.ComplianceCheck {
+		var checks []constant.ComplianceCheck
+		for _, finding := range resultMap[id].Findings {
+			checks = append(checks, finding.Check)
+		}
+		return checks
+	}
+
+	assert.True(t, resultMap[publishedRoute.ID].Passed, resultMap[publishedRoute.ID].Findings)
+	assert.Equal(t, publishedRoute.Name, resultMap[publishedRoute.ID].Name)
+
+	assert.False(t, resultMap[brokenRoute.ID].Passed)
+	assert.Contains(t, findingChecks(brokenRoute.ID), constant.ComplianceCheckReference)
+	assert.NotContains(t, findingChecks(brokenRoute.ID), constant.ComplianceCheckDrift)
+
+	assert.False(t, resultMap[driftRoute.ID].Passed)
+	assert.Equal(t, []constant.ComplianceCheck{constant.ComplianceCheckDrift}, findingChecks(driftRoute.ID))
+
+	assert.False(t, resultMap[unmanagedID].Passed)
+	assert.Contains(t, findingChecks(unmanagedID), constant.ComplianceCheckSchemaEtcd)
+	assert.Contains(t, findingChecks(unmanagedID), constant.ComplianceCheckDrift)
+	assert.Empty(t, resultMap[unmanagedID].Status)
+
+	// 只检查路由时不读取其他类型，漂移照常检出
+	_, routeResults, err := RunComplianceChecks(gatewayCtx, []constant.APISIXResource{constant.Route},
+		func(int, int) {})
+	assert.NoError(t, err)
+	var routeCount int
+	for _, result := range results {
+		if result.ResourceType == constant.Route {
+			routeCount++
+		}
+	}
+	assert.Len(t, routeResults, routeCount)
+	for _, result := range routeResults {
+		assert.Equal(t, constant.Route, result.ResourceType)
+		if result.ResourceID == driftRoute.ID {
+			assert.Equal(t, resultMap[driftRoute.ID].Findings, result.Findings)
+		}
+	}
+
+	// 清理 etcd 数据，避免影响其他用例的同步统计
+	for _, id := range []string{unmanagedID, publishedRoute.ID} {
+		_, err = client.Delete(context.Background(), routeKeyPrefix+id)
+		assert.NoError(t, err)
+	}
+}
+
+func TestComplianceReportLifecycle(t *testing.T) {
+	// 超过保留时长的报告在创建新报告时被清理
+	u := repo.ComplianceReport
+	expiredResultKey, err := blobstore.Default().Put(gatewayCtx, complianceReportBlobNamespace, []byte(`["expired"]`))
+	assert.NoError(t, err)
+	expiredReport := &model.ComplianceReport{
+		GatewayID: gatewayInfo.ID,
+		Status:    constant.ComplianceReportStatusSuccess,
+		ResultKey: expiredResultKey,
+	}
+	assert.NoError(t, u.WithContext(gatewayCtx).Create(expiredReport))
+	_, err = u.WithContext(gatewayCtx).Where(u.ID.Eq(expiredReport.ID)).
+		UpdateSimple(u.CreatedAt.Value(time.Now().Add(-ComplianceReportRetention() - time.Hour)))
+	assert.NoError(t, err)
+
+	report, err := CreateComplianceReport(gatewayCtx, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, constant.ComplianceReportStatusPending, report.Status)
+
+	_, err = GetComplianceReport(gatewayCtx, gatewayInfo.ID, expiredReport.ID)
+	assert.Error(t, err)
+	_, err = blobstore.Default().Get(gatewayCtx, expiredResultKey)
+	assert.ErrorIs(t, err, blobstore.ErrNotFound)
+
+	reportID := report.ID
+	assert.Eventually(t, func() bool {
+		report, err = GetComplianceReport(gatewayCtx, gatewayInfo.ID, reportID)
+		return err == nil && report.Status == constant.ComplianceReportStatusSuccess
+	}, 10*time.Second, 100*time.Millisecond)
+	assert.Equal(t, report.Total, report.Processed)
+	// 检查明细存放于大对象存储
+	assert.NotEmpty(t, report.ResultKey)
+	assert.NotEmpty(t, report.Result)
+
+	var summary dto.ComplianceReportSummary
+	assert.NoError(t, json.Unmarshal(report.Summary, &summary))
+	assert.Equal(t, report.Total, summary.Total)
+
+	csvData, err := RenderComplianceReportCSV(report)
+	assert.NoError(t, err)
+	records, err := csv.NewReader(bytes.NewReader(csvData)).ReadAll()
+	assert.NoError(t, err)
+	assert.Equal(t, complianceReportCSVHeader, records[0])
+	assert.Len(t, records, summary.Total+1)
+
+	reports, total, err := ListPagedComplianceReports(gatewayCtx, gatewayInfo.ID, PageParam{Offset: 0, Limit: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.Equal(t, report.ID, reports[0].ID)
+	assert.Empty(t, reports[0].Result)
+
+	// 其他网关无法查询
+	_, err = GetComplianceReport(gatewayCtx, gatewayInfo.ID+1, report.ID)
+	assert.Error(t, err)
+}
+
+func TestListEtcdResourcesNestedKeys(t *testing.T) {
+	etcdStore, err := storage.NewEtcdStorage(gatewayInfo.EtcdConfig.EtcdConfig)
+	assert.NoError(t, err)
+	defer etcdStore.Close()
+	client := etcdStore.GetClient()
+
+	// 自定义 key 带子目录的 consumer 及其下的凭证
+	consumer := &model.Consumer{ResourceCommonModel: model.ResourceCommonModel{
+		ID:              fmt.Sprintf("nested-consumer-%d", time.Now().UnixNano()),
+		EtcdKeyOverride: "consumers/team/nested-consumer",
+	}}
+	credential := &model.ResourceCommonModel{ID: "nested-credential"}
+	credential.EtcdKeyOverride = model.CredentialEtcdKey(consumer, credential.ID)
+	prefix := gatewayInfo.EtcdConfig.Prefix + "/"
+	for _, key := range []string{consumer.EtcdKeyOverride, credential.EtcdKeyOverride} {
+		_, err = client.Put(context.Background(), prefix+key, `{"username":"nested"}`)
+		assert.NoError(t, err)
+	}
+	defer func() {
+		_, err := client.Delete(context.Background(), prefix+consumer.EtcdKeyOverride, clientv3.WithPrefix())
+		assert.NoError(t, err)
+	}()
+
+	resources, err := listEtcdResources(gatewayCtx, gatewayInfo,
+		[]constant.APISIXResource{constant.Consumer, constant.Credential})
+	assert.NoError(t, err)
+	consumerKey := complianceEtcdKey(constant.Consumer, &consumer.ResourceCommonModel)
+	credentialKey := complianceEtcdKey(constant.Credential, credential)
+	assert.Equal(t, "team/nested-consumer", consumerKey)
+	assert.Equal(t, "team/nested-consumer/credentials/nested-credential", credentialKey)
+	assert.Contains(t, resources[constant.Consumer], consumerKey)
+	assert.NotContains(t, resources[constant.Consumer], credentialKey)
+	assert.Contains(t, resources[constant.Credential], credentialKey)
+}
+
+func TestComplianceReportRetentionDisabled(t *testing.T) {
+	SetComplianceReportRetentionDays(-1)
+	defer SetComplianceReportRetentionDays(DefaultComplianceReportRetentionDays)
+	assert.Zero(t, ComplianceReportRetention())
+
+	u := repo.ComplianceReport
+	oldReport := &model.ComplianceReport{GatewayID: gatewayInfo.ID, Status: constant.ComplianceReportStatusSuccess}
+	assert.NoError(t, u.WithContext(gatewayCtx).Create(oldReport))
+	_, err := u.WithContext(gatewayCtx).Where(u.ID.Eq(oldReport.ID)).
+		UpdateSimple(u.CreatedAt.Value(time.Now().AddDate(-10, 0, 0)))
+	assert.NoError(t, err)
+
+	// 不清理时保留任意时间之前的报告
+	assert.NoError(t, CleanExpiredComplianceReports(gatewayCtx, gatewayInfo.ID))
+	_, err = GetComplianceReport(gatewayCtx, gatewayInfo.ID, oldReport.ID)
+	assert.NoError(t, err)
+	_, err = u.WithContext(gatewayCtx).Where(u.ID.Eq(oldReport.ID)).Delete()
+	assert.NoError(t, err)
+}
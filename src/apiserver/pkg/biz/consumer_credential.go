@@ -0,0 +1,174 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/redact"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/schema"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/stringx"
+)
+
+// ErrCredentialNotGeneratable 凭证字段无法自动生成
+var ErrCredentialNotGeneratable = errors.New("凭证字段无法自动生成，请手动填写")
+
+// generatableCredentialFields 可自动生成的凭证字段(相对插件配置)，
+// 其余凭证字段(如 jwt-auth 的 private_key)有格式要求，需手动填写
+var generatableCredentialFields = map[string]map[string]bool{
+	"key-auth":   {"key": true},
+	"basic-auth": {"password": true},
+	"jwt-auth":   {"secret": true},
+	"hmac-auth":  {"secret_key": true},
+}
+
+// ConsumerCredentialPaths 返回配置中认证插件凭证字段的路径，适用于 consumer 及 credential 的配置
+func ConsumerCredentialPaths(config json.RawMessage) []string {
+	var paths []string
+	gjson.GetBytes(config, "plugins").ForEach(func(name, _ gjson.Result) bool {
+		if !schema.ConsumerAuthPlugins[name.String()] {
+			return true
+		}
+		for _, path := range redact.PluginSensitivePaths[name.String()] {
+			fullPath := "plugins." + gjson.Escape(name.String()) + "." + path
+			if gjson.GetBytes(config, fullPath).Exists() {
+				paths = append(paths, fullPath)
+			}
+		}
+		return true
+	})
+	return paths
+}
+
+// SplitConsumerCredentials 拆分 consumer 配置：identity 为凭证字段替换为占位值后的配置，
+// credentials 仅包含凭证字段，没有凭证字段时为 nil；已是占位值的字段不计入 credentials
+func SplitConsumerCredentials(config json.RawMessage) (identity json.RawMessage, credentials json.RawMessage) {
+	identity = append(json.RawMessage{}, config...)
+	for _, path := range ConsumerCredentialPaths(config) {
+		value := gjson.GetBytes(config, path)
+		if value.String() == constant.CredentialPlaceholder {
+			continue
+		}
+		if credentials == nil {
+			credentials = json.RawMessage(`{}`)
+		}
+		credentials, _ = sjson.SetRawBytes(credentials, path, []byte(value.Raw))
+		identity, _ = sjson.SetBytes(identity, path, constant.CredentialPlaceholder)
+	}
+	return identity, credentials
+}
+
+// MergeConsumerCredentials 将 credentials 中的凭证字段回填到 config 中仍为占位值的字段，其余字段保持不变
+func MergeConsumerCredentials(config json.RawMessage, credentials json.RawMessage) json.RawMessage {
+	for _, path := range PendingCredentialPaths(config) {
+		value := gjson.GetBytes(credentials, path)
+		if !value.Exists() {
+			continue
+		}
+		merged, err := sjson.SetRawBytes(config, path, []byte(value.Raw))
+		if err == nil {
+			config = merged
+		}
+	}
+	return config
+}
+
+// PendingCredentialPaths 返回配置中仍为占位值、需要生成或填写的凭证字段
+func PendingCredentialPaths(config json.RawMessage) []string {
+	var paths []string
+	for _, path := range ConsumerCredentialPaths(config) {
+		if gjson.GetBytes(config, path).String() == constant.CredentialPlaceholder {
+			paths = append(paths, path)
+		}
+	}
+	return paths
+}
+
+// DiffCredentialPaths 返回 credentials 中与 origin 均存在但值不同的凭证字段
+func DiffCredentialPaths(credentials json.RawMessage, origin json.RawMessage) []string {
+	var paths []string
+	for _, path := range ConsumerCredentialPaths(credentials) {
+		originValue := gjson.GetBytes(origin, path)
+		if originValue.Exists() && originValue.Raw != gjson.GetBytes(credentials, path).Raw {
+			paths = append(paths, path)
+		}
+	}
+	return paths
+}
+
+// GenerateConsumerCredentials 为 consumer 中仍为占位值的凭证字段生成随机值并保存为待发布，
+// 返回生成的字段及其值；存在无法自动生成的字段时不做任何修改
+func GenerateConsumerCredentials(ctx context.Context, consumerID string) (map[string]string, error) {
+	consumer, err := GetConsumer(ctx, consumerID)
+	if err != nil {
+		return nil, err
+	}
+	if consumer.GatewayID != ginx.GetGatewayInfoFromContext(ctx).ID {
+		return nil, gorm.ErrRecordNotFound
+	}
+	pending := PendingCredentialPaths(json.RawMessage(consumer.Config))
+	generated := make(map[string]string, len(pending))
+	if len(pending) == 0 {
+		return generated, nil
+	}
+	config := json.RawMessage(consumer.Config)
+	for _, path := range pending {
+		if !isGeneratableCredentialPath(path) {
+			return nil, fmt.Errorf("%w: %s", ErrCredentialNotGeneratable, path)
+		}
+		value := stringx.RandString(constant.GeneratedCredentialLength)
+		config, err = sjson.SetBytes(config, path, value)
+		if err != nil {
+			return nil, err
+		}
+		generated[path] = value
+	}
+	consumer.Status, err = GetResourceUpdateStatus(ctx, constant.Consumer, consumerID)
+	if err != nil {
+		return nil, err
+	}
+	consumer.Config = datatypes.JSON(config)
+	consumer.Updater = ginx.GetUserIDFromContext(ctx)
+	if err := UpdateConsumer(ctx, *consumer); err != nil {
+		return nil, err
+	}
+	return generated, nil
+}
+
+// isGeneratableCredentialPath 判断凭证字段是否可自动生成
+func isGeneratableCredentialPath(path string) bool {
+	for name, fields := range generatableCredentialFields {
+		for field := range fields {
+			if path == "plugins."+gjson.Escape(name)+"."+field {
+				return true
+			}
+		}
+	}
+	return false
+}
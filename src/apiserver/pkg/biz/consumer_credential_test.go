@@ -0,0 +1,93 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package biz
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
+	"gorm.io/datatypes"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/data"
+)
+
+func TestSplitAndMergeConsumerCredentials(t *testing.T) {
+	config := json.RawMessage(`{"username":"jack","plugins":{"key-auth":{"key":"k1"},
+		"jwt-auth":{"key":"jack","secret":"s1"},"limit-count":{"count":1,"time_window":1}}}`)
+	identity, credentials := SplitConsumerCredentials(config)
+	assert.Equal(t, "k1", gjson.GetBytes(config, "plugins.key-auth.key").String())
+	assert.Equal(t, constant.CredentialPlaceholder, gjson.GetBytes(identity, "plugins.key-auth.key").String())
+	assert.Equal(t, constant.CredentialPlaceholder, gjson.GetBytes(identity, "plugins.jwt-auth.secret").String())
+	// jwt-auth 的 key 用于标识 consumer，不属于凭证
+	assert.Equal(t, "jack", gjson.GetBytes(identity, "plugins.jwt-auth.key").String())
+	assert.JSONEq(t, `{"plugins":{"key-auth":{"key":"k1"},"jwt-auth":{"secret":"s1"}}}`, string(credentials))
+	assert.ElementsMatch(t, []string{"plugins.key-auth.key", "plugins.jwt-auth.secret"},
+		PendingCredentialPaths(identity))
+
+	// 只回填占位值，部分回填后剩余字段仍待生成
+	merged := MergeConsumerCredentials(identity, json.RawMessage(`{"plugins":{"key-auth":{"key":"k2"}}}`))
+	assert.Equal(t, "k2", gjson.GetBytes(merged, "plugins.key-auth.key").String())
+	assert.Equal(t, []string{"plugins.jwt-auth.secret"}, PendingCredentialPaths(merged))
+	assert.JSONEq(t, string(config), string(MergeConsumerCredentials(identity, credentials)))
+	assert.JSONEq(t, string(config), string(MergeConsumerCredentials(config, merged)))
+
+	assert.Equal(t, []string{"plugins.key-auth.key"},
+		DiffCredentialPaths(json.RawMessage(`{"plugins":{"key-auth":{"key":"k2"}}}`), credentials))
+	assert.Empty(t, DiffCredentialPaths(credentials, credentials))
+
+	_, credentials = SplitConsumerCredentials(identity)
+	assert.Nil(t, credentials)
+}
+
+func TestGenerateConsumerCredentials(t *testing.T) {
+	var consumerIDs []string
+	defer func() { assert.NoError(t, BatchDeleteConsumers(gatewayCtx, consumerIDs)) }()
+	createConsumer := func(name string, config string) *model.Consumer {
+		consumer := data.Consumer1WithNoRelation(gatewayInfo, constant.ResourceStatusCreateDraft)
+		consumer.Username = name
+		consumer.Config = datatypes.JSON(config)
+		assert.NoError(t, CreateConsumer(gatewayCtx, *consumer))
+		consumerIDs = append(consumerIDs, consumer.ID)
+		return consumer
+	}
+	consumer := createConsumer("generate",
+		`{"plugins":{"key-auth":{"key":"`+constant.CredentialPlaceholder+`"}}}`)
+	generated, err := GenerateConsumerCredentials(gatewayCtx, consumer.ID)
+	assert.NoError(t, err)
+	assert.Len(t, generated["plugins.key-auth.key"], constant.GeneratedCredentialLength)
+	updated, err := GetConsumer(gatewayCtx, consumer.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, generated["plugins.key-auth.key"], gjson.GetBytes(updated.Config, "plugins.key-auth.key").String())
+	assert.Equal(t, constant.ResourceStatusCreateDraft, updated.Status)
+
+	// 没有待生成的字段时不做修改
+	generated, err = GenerateConsumerCredentials(gatewayCtx, consumer.ID)
+	assert.NoError(t, err)
+	assert.Empty(t, generated)
+
+	// private_key 等字段无法自动生成
+	consumer = createConsumer("generate-rsa", `{"plugins":{"jwt-auth":{"key":"rsa",
+		"algorithm":"RS256","public_key":"pub","private_key":"`+constant.CredentialPlaceholder+`"}}}`)
+	_, err = GenerateConsumerCredentials(gatewayCtx, consumer.ID)
+	assert.ErrorIs(t, err, ErrCredentialNotGeneratable)
+}
@@ -0,0 +1,218 @@
+/*
+* TencentBlueKing is pleased to support the open source community by making
+* 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+* Copyright (C) 2025 Tencent. All rights reserved.
+* Licensed under the MIT License (the "License"); you may not use this file except
+* in compliance with the License. You may obtain a copy of the License at
+*
+*     http://opensource.org/licenses/MIT
+*
+* Unless required by applicable law or agreed to in writing, software distributed under
+* the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+* either express or implied. See the License for the specific language governing permissions and
+* limitations under the License.
+*
+* We undertake not to change the open source license (MIT license) applicable
+* to the current version of the project delivered to anyone in the future.
+ */
+
+package biz
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"gorm.io/gen/field"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+)
+
+// ListCredentials 查询网关 Credential 列表
+func ListCredentials(ctx context.Context, gatewayID int) ([]*model.Credential, error) {
+	u := repo.Credential
+	return repo.Credential.WithContext(ctx).Where(u.GatewayID.Eq(gatewayID)).Order(u.UpdatedAt.Desc()).Find()
+}
+
+// GetCredentialOrderExprList 获取 Credential 排序字段列表
+func GetCredentialOrderExprList(orderBy string) []field.Expr {
+	u := repo.Credential
+	ascFieldMap := map[string]field.Expr{
+		"name":       u.Name,
+		"updated_at": u.UpdatedAt,
+	}
+	descFieldMap := map[string]field.Expr{
+		"name":       u.Name.Desc(),
+		"updated_at": u.UpdatedAt.Desc(),
+	}
+	orderByExprList := ParseOrderByExprList(ascFieldMap, descFieldMap, orderBy)
+	if len(orderByExprList) == 0 {
+		orderByExprList = append(orderByExprList, u.UpdatedAt.Desc())
+	}
+	return orderByExprList
+}
+
+// ListPagedCredentials 分页查询 Credential
+func ListPagedCredentials(
+	ctx context.Context,
+	param map[string]interface{},
+	status []string,
+	name string,
+	updater string,
+	orderBy string,
+	page PageParam,
+) ([]*model.Credential, int64, error) {
+	u := repo.Credential
+	query := u.WithContext(ctx)
+	if name != "" {
+		query = query.Where(u.Name.Like("%" + name + "%"))
+	}
+	if updater != "" {
+		query = query.Where(u.Updater.Like("%" + updater + "%"))
+	}
+	if len(status) > 1 || status[0] != "" {
+		query = query.Where(u.Status.In(status...))
+	}
+	orderByExprs := GetCredentialOrderExprList(orderBy)
+	return query.Where(field.Attrs(param)).
+		Order(orderByExprs...).
+		FindByPage(page.Offset, page.Limit)
+}
+
+// CreateCredential 创建 Credential
+func CreateCredential(ctx context.Context, credential model.Credential) error {
+	return repo.Credential.WithContext(ctx).Create(&credential)
+}
+
+// BatchCreateCredentials 批量创建 Credential
+func BatchCreateCredentials(ctx context.Context, credentials []*model.Credential) error {
+	if ginx.GetTx(ctx) != nil {
+		return ginx.GetTx(ctx).Credential.WithContext(ctx).Create(credentials...)
+	}
+	return repo.Credential.WithContext(ctx).Create(credentials...)
+}
+
+// UpdateCredential 更新 Credential
+func UpdateCredential(ctx context.Context, credential model.Credential) error {
+	u := repo.Credential
+	_, err := u.WithContext(ctx).Where(u.ID.Eq(credential.ID)).Select(
+		u.Name,
+		u.Config,
+		u.Status,
+		u.Updater,
+	).Updates(credential)
+	return err
+}
+
+// GetCredential 查询 Credential 详情
+func GetCredential(ctx context.Context, id string) (*model.Credential, error) {
+	u := repo.Credential
+	return u.WithContext(ctx).Where(u.ID.Eq(id)).First()
+}
+
+// QueryCredentials 搜索 Credential
+func QueryCredentials(ctx context.Context, param map[string]interface{}) ([]*model.Credential, error) {
+	u := repo.Credential
+	return u.WithContext(ctx).Where(field.Attrs(param)).Find()
+}
+
+// ExistsCredential 查询 Credential 是否存在
+func ExistsCredential(ctx context.Context, id string) bool {
+	u := repo.Credential
+	credential, err := u.WithContext(ctx).Where(
+		u.ID.Eq(id),
+		u.GatewayID.Eq(ginx.GetGatewayInfoFromContext(ctx).ID),
+	).Find()
+	if err != nil {
+		return false
+	}
+	if len(credential) == 0 {
+		return false
+	}
+	return true
+}
+
+// BatchDeleteCredentials 批量删除 Credential 并添加审计日志
+func BatchDeleteCredentials(ctx context.Context, ids []string) error {
+	u := repo.Credential
+	err := repo.Q.Transaction(func(tx *repo.Query) error {
+		ctx = ginx.SetTx(ctx, tx)
+		err := AddDeleteResourceByIDAuditLog(ctx, constant.Credential, ids)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Credential.WithContext(ctx).Where(u.ID.In(ids...)).Delete()
+		return err
+	})
+	return err
+}
+
+// BatchRevertCredentials 批量回滚 Credential
+func BatchRevertCredentials(ctx context.Context, syncDataList []*model.GatewaySyncData) error {
+	var ids []string
+	syncResourceMap := make(map[string]*model.GatewaySyncData)
+	for _, syncData := range syncDataList {
+		ids = append(ids, syncData.ID)
+		syncResourceMap[syncData.ID] = syncData
+	}
+	// 查询原来的数据
+	credentials, err := QueryCredentials(ctx, map[string]interface{}{
+		"id": ids,
+		"status": []constant.ResourceStatus{
+			constant.ResourceStatusDeleteDraft,
+			constant.ResourceStatusUpdateDraft,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	afterResources := make([]*model.ResourceCommonModel, 0, len(credentials))
+	for _, s := range credentials {
+		// 标识此次更新的操作类型为撤销
+		s.OperationType = constant.OperationTypeRevert
+		if s.Status == constant.ResourceStatusDeleteDraft {
+			// 删除待发布回滚只需要更新状态即可
+			s.Status = constant.ResourceStatusSuccess
+			// 用于审计日志更新，只需要补充 ID, Config, Status 即可
+			afterResources = append(afterResources, &model.ResourceCommonModel{
+				ID:     s.ID,
+				Config: s.Config,
+				Status: s.Status,
+			})
+			continue
+		}
+		// 同步更新配置
+		if syncData, ok := syncResourceMap[s.ID]; ok {
+			s.Name = syncData.GetName()
+			s.Config = syncData.Config
+			s.Status = constant.ResourceStatusSuccess
+			// 用于审计日志更新，只需要补充 ID, Config, Status 即可
+			afterResources = append(afterResources, &model.ResourceCommonModel{
+				ID:     s.ID,
+				Config: s.Config,
+				Status: s.Status,
+			})
+			continue
+		} else {
+			return errors.New("can not find sync data for Credential id:" + s.ID)
+		}
+	}
+	err = repo.Q.Transaction(func(tx *repo.Query) error {
+		ctx = ginx.SetTx(ctx, tx)
+		// 添加撤销的审计日志
+		err = WrapBatchRevertResourceAddAuditLog(ctx, constant.Credential, ids, afterResources)
+		if err != nil {
+			return err
+		}
+		for _, s := range credentials {
+			_, err := tx.Credential.WithContext(ctx).Updates(s)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return err
+}
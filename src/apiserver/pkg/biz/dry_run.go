@@ -0,0 +1,235 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"slices"
+	"sort"
+
+	"github.com/tidwall/sjson"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/jsonx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/schema"
+)
+
+// dryRunIgnoredFields 发布时自动生成的字段，不参与对比
+var dryRunIgnoredFields = map[string]bool{
+	"create_time": true,
+	"update_time": true,
+}
+
+// DryRunPublish 预览一键发布的变更，不写入任何数据：
+// 基于发布预演(DryRunPublishMutations)的写操作，按资源汇总写入前后的配置差异，与预演的结果保持一致；
+// resourceTypes 不为空时只展示指定类型的变更，关联资源检查仍基于编辑区的全部资源
+func DryRunPublish(ctx context.Context, resourceTypes []constant.APISIXResource) (*dto.DiffResult, error) {
+	plan, err := DryRunPublishMutations(ctx, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	scannedTypes := ScannedResourceTypes(resourceTypes)
+	staged := make(map[constant.APISIXResource][]json.RawMessage)
+	current := make(map[constant.APISIXResource][]json.RawMessage)
+	for _, mutation := range plan.Mutations {
+		if !slices.Contains(scannedTypes, mutation.ResourceType) {
+			continue
+		}
+		if mutation.Before != nil {
+			current[mutation.ResourceType] = append(current[mutation.ResourceType], mutation.Before)
+		}
+		if mutation.Op == constant.EtcdWriteOpPut {
+			staged[mutation.ResourceType] = append(staged[mutation.ResourceType], mutation.After)
+		}
+	}
+	published, err := publishedResources(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := DiffPublishPlan(staged, current)
+	result.ScannedTypes = scannedTypes
+	result.Errors = append(result.Errors, plan.Errors...)
+	result.ReferenceErrors = append(result.ReferenceErrors, schema.CheckReferences(published)...)
+	result.RoutePriorityConflicts = append(result.RoutePriorityConflicts,
+		schema.CheckRoutePriorityConflicts(published[constant.Route])...)
+	return result, nil
+}
+
+// publishedResources 发布后编辑区中的全部资源，用于检查关联资源是否存在
+func publishedResources(ctx context.Context) (schema.ResourceSet, error) {
+	gatewayInfo := ginx.GetGatewayInfoFromContext(ctx)
+	published := make(schema.ResourceSet)
+	for _, resourceType := range constant.ResourceTypeList {
+		resources, err := QueryResource(ctx, resourceType, map[string]interface{}{
+			"gateway_id": gatewayInfo.ID,
+			"status": []constant.ResourceStatus{
+				constant.ResourceStatusCreateDraft,
+				constant.ResourceStatusUpdateDraft,
+				constant.ResourceStatusSuccess,
+			},
+		}, "")
+		if err != nil {
+			return nil, err
+		}
+		for _, resource := range resources {
+			config, err := publishedConfig(resourceType, resource)
+			if err != nil {
+				return nil, err
+			}
+			published[resourceType] = append(published[resourceType], config)
+		}
+	}
+	return published, nil
+}
+
+// DiffPublishPlan 对比编辑区(DATABASE)与 etcd(ETCD)中的资源配置生成发布计划，
+// 资源以 GetResourceIdentification 作为标识：仅在编辑区中的为新增，仅在 etcd 中的为删除，两侧配置不一致的为更新
+func DiffPublishPlan(databaseResources, etcdResources map[constant.APISIXResource][]json.RawMessage) *dto.DiffResult {
+	result := &dto.DiffResult{
+		Resources:              []dto.ResourceTypeDiff{},
+		Errors:                 []dto.PublishPlanError{},
+		ReferenceErrors:        []schema.ReferenceError{},
+		RoutePriorityConflicts: []schema.RouteOverlap{},
+	}
+	for _, resourceType := range constant.ResourceTypeList {
+		staged := keyByIdentification(databaseResources[resourceType])
+		current := keyByIdentification(etcdResources[resourceType])
+		diff := dto.ResourceTypeDiff{
+			ResourceType: resourceType,
+			Creates:      []dto.ResourceDiffItem{},
+			Updates:      []dto.ResourceDiffItem{},
+			Deletes:      []dto.ResourceDiffItem{},
+		}
+		for _, key := range sortedKeys(staged) {
+			before, ok := current[key]
+			if !ok {
+				diff.Creates = append(diff.Creates, dto.ResourceDiffItem{Key: key, Config: staged[key]})
+				continue
+			}
+			if deltas := diffConfigFields(before, staged[key]); len(deltas) > 0 {
+				diff.Updates = append(diff.Updates, dto.ResourceDiffItem{Key: key, Deltas: deltas})
+			}
+		}
+		for _, key := range sortedKeys(current) {
+			if _, ok := staged[key]; !ok {
+				diff.Deletes = append(diff.Deletes, dto.ResourceDiffItem{Key: key, Config: current[key]})
+			}
+		}
+		if len(diff.Creates)+len(diff.Updates)+len(diff.Deletes) > 0 {
+			result.Resources = append(result.Resources, diff)
+		}
+	}
+	return result
+}
+
+// publishedConfig 编辑区配置发布到 etcd 后的形式，与 put* 使用同一转换；发布时生成的时间字段不参与对比
+func publishedConfig(
+	resourceType constant.APISIXResource,
+	resource *model.ResourceCommonModel,
+) (json.RawMessage, error) {
+	config, err := etcdConfig(resourceType, *resource)
+	if err != nil {
+		return nil, err
+	}
+	for field := range dryRunIgnoredFields {
+		config, _ = sjson.DeleteBytes(config, field)
+	}
+	return config, nil
+}
+
+// keyByIdentification 以资源标识为 key 索引资源配置
+func keyByIdentification(configs []json.RawMessage) map[string]json.RawMessage {
+	result := make(map[string]json.RawMessage, len(configs))
+	for _, config := range configs {
+		result[schema.GetResourceIdentification(config)] = config
+	}
+	return result
+}
+
+// diffConfigFields 对比两份配置，对象逐字段递归对比，数组及标量整体对比
+func diffConfigFields(before, after json.RawMessage) []dto.FieldDelta {
+	var beforeValue, afterValue interface{}
+	_ = jsonx.UnmarshalUseNumber(before, &beforeValue)
+	_ = jsonx.UnmarshalUseNumber(after, &afterValue)
+	beforeMap, beforeIsMap := beforeValue.(map[string]interface{})
+	afterMap, afterIsMap := afterValue.(map[string]interface{})
+	if !beforeIsMap || !afterIsMap {
+		if reflect.DeepEqual(beforeValue, afterValue) {
+			return nil
+		}
+		return []dto.FieldDelta{{Before: before, After: after}}
+	}
+	var deltas []dto.FieldDelta
+	diffFields("", beforeMap, afterMap, &deltas)
+	return deltas
+}
+
+// diffFields 递归对比对象字段，path 为父字段路径
+func diffFields(path string, before, after map[string]interface{}, deltas *[]dto.FieldDelta) {
+	keys := make(map[string]json.RawMessage, len(before)+len(after))
+	for key := range before {
+		keys[key] = nil
+	}
+	for key := range after {
+		keys[key] = nil
+	}
+	for _, key := range sortedKeys(keys) {
+		if path == "" && dryRunIgnoredFields[key] {
+			continue
+		}
+		fieldPath := key
+		if path != "" {
+			fieldPath = path + "." + key
+		}
+		beforeValue, inBefore := before[key]
+		afterValue, inAfter := after[key]
+		beforeMap, beforeIsMap := beforeValue.(map[string]interface{})
+		afterMap, afterIsMap := afterValue.(map[string]interface{})
+		if beforeIsMap && afterIsMap {
+			diffFields(fieldPath, beforeMap, afterMap, deltas)
+			continue
+		}
+		if inBefore && inAfter && reflect.DeepEqual(beforeValue, afterValue) {
+			continue
+		}
+		delta := dto.FieldDelta{Path: fieldPath}
+		if inBefore {
+			delta.Before, _ = json.Marshal(beforeValue)
+		}
+		if inAfter {
+			delta.After, _ = json.Marshal(afterValue)
+		}
+		*deltas = append(*deltas, delta)
+	}
+}
+
+// sortedKeys 返回排序后的 key 列表
+func sortedKeys(m map[string]json.RawMessage) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,237 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+	"gorm.io/datatypes"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/storage"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/schema"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/data"
+)
+
+func TestDiffPublishPlan(t *testing.T) {
+	result := DiffPublishPlan(
+		map[constant.APISIXResource][]json.RawMessage{
+			constant.Route: {
+				json.RawMessage(`{"id":"r1","uris":["/a"]}`),
+				json.RawMessage(`{"id":"r2","uris":["/b"],"upstream":{"type":"chash","timeout":{"read":3}}}`),
+				json.RawMessage(`{"id":"r3","uris":["/c"]}`),
+			},
+			constant.PluginMetadata: {json.RawMessage(`{"id":"syslog","log_format":{"host":"$host"}}`)},
+		},
+		map[constant.APISIXResource][]json.RawMessage{
+			constant.Route: {
+				json.RawMessage(`{"id":"r2","uris":["/b"],"upstream":{"type":"roundrobin","timeout":{"read":3}},` +
+					`"desc":"old","create_time":1,"update_time":1}`),
+				json.RawMessage(`{"id":"r3","uris":["/c"],"create_time":1,"update_time":2}`),
+				json.RawMessage(`{"id":"r4","uris":["/d"]}`),
+			},
+		},
+	)
+	assert.Equal(t, []dto.ResourceTypeDiff{
+		{
+			ResourceType: constant.Route,
+			Creates:      []dto.ResourceDiffItem{{Key: "r1", Config: json.RawMessage(`{"id":"r1","uris":["/a"]}`)}},
+			Updates: []dto.ResourceDiffItem{{Key: "r2", Deltas: []dto.FieldDelta{
+				{Path: "desc", Before: json.RawMessage(`"old"`)},
+				{Path: "upstream.type", Before: json.RawMessage(`"roundrobin"`), After: json.RawMessage(`"chash"`)},
+			}}},
+			Deletes: []dto.ResourceDiffItem{{Key: "r4", Config: json.RawMessage(`{"id":"r4","uris":["/d"]}`)}},
+		},
+		{
+			ResourceType: constant.PluginMetadata,
+			Creates: []dto.ResourceDiffItem{{
+				Key:    "syslog",
+				Config: json.RawMessage(`{"id":"syslog","log_format":{"host":"$host"}}`),
+			}},
+			Updates: []dto.ResourceDiffItem{},
+			Deletes: []dto.ResourceDiffItem{},
+		},
+	}, result.Resources)
+
+	assert.Empty(t, DiffPublishPlan(nil, nil).Resources)
+}
+
+// findDiffItem 在发布计划中查找资源，返回变更类型
+func findDiffItem(
+	result *dto.DiffResult,
+	resourceType constant.APISIXResource,
+	key string,
+) (string, *dto.ResourceDiffItem) {
+	for _, diff := range result.Resources {
+		if diff.ResourceType != resourceType {
+			continue
+		}
+		for action, items := range map[string][]dto.ResourceDiffItem{
+			"create": diff.Creates, "update": diff.Updates, "delete": diff.Deletes,
+		} {
+			for i := range items {
+				if items[i].Key == key {
+					return action, &items[i]
+				}
+			}
+		}
+	}
+	return "", nil
+}
+
+func TestDryRunPublish(t *testing.T) {
+	route := data.Route1WithNoRelationResource(gatewayInfo, constant.ResourceStatusCreateDraft)
+	route.Name = fmt.Sprintf("dry-run-%d", time.Now().UnixNano())
+	assert.NoError(t, CreateRoute(gatewayCtx, *route))
+
+	result, err := DryRunPublish(gatewayCtx, nil)
+	assert.NoError(t, err)
+	action, item := findDiffItem(result, constant.Route, route.ID)
+	assert.Equal(t, "create", action)
+	assert.Equal(t, route.ID, gjson.GetBytes(item.Config, "id").String())
+
+	// 发布后无变更
+	assert.NoError(t, PublishRoutes(gatewayCtx, []string{route.ID}))
+	result, err = DryRunPublish(gatewayCtx, nil)
+	assert.NoError(t, err)
+	action, _ = findDiffItem(result, constant.Route, route.ID)
+	assert.Empty(t, action)
+
+	// 修改后只展示字段级变更，不写入 etcd
+	route.Config = datatypes.JSON(`{"uris":["/dry-run"],"upstream":{"type":"roundrobin",` +
+		`"nodes":[{"host":"httpbin.org","port":80,"weight":1}],"scheme":"http"}}`)
+	route.Status = constant.ResourceStatusUpdateDraft
+	assert.NoError(t, UpdateRoute(gatewayCtx, *route))
+	result, err = DryRunPublish(gatewayCtx, nil)
+	assert.NoError(t, err)
+	action, item = findDiffItem(result, constant.Route, route.ID)
+	assert.Equal(t, "update", action)
+	var paths []string
+	for _, delta := range item.Deltas {
+		paths = append(paths, delta.Path)
+	}
+	assert.Contains(t, paths, "uris")
+	etcdStore, err := storage.NewEtcdStorage(gatewayInfo.EtcdConfig.EtcdConfig)
+	assert.NoError(t, err)
+	defer etcdStore.Close()
+	value, err := etcdStore.Get(context.Background(), "routes/"+route.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "/get", gjson.Get(value, "uris.0").String())
+
+	assert.NoError(t, UpdateResourceStatus(gatewayCtx, constant.Route, route.ID, constant.ResourceStatusDeleteDraft))
+	result, err = DryRunPublish(gatewayCtx, nil)
+	assert.NoError(t, err)
+	action, _ = findDiffItem(result, constant.Route, route.ID)
+	assert.Equal(t, "delete", action)
+
+	assert.NoError(t, deleteRoutes(gatewayCtx, []string{route.ID}))
+}
+
+func TestDryRunPublishReferenceErrors(t *testing.T) {
+	route := data.Route1WithNoRelationResource(gatewayInfo, constant.ResourceStatusCreateDraft)
+	route.Name = fmt.Sprintf("dry-run-ref-%d", time.Now().UnixNano())
+	route.ServiceID = "dry-run-missing-service"
+	route.Config, _ = sjson.SetBytes(route.Config, "service_id", route.ServiceID)
+	assert.NoError(t, CreateRoute(gatewayCtx, *route))
+	defer func() { assert.NoError(t, BatchDeleteRoutes(gatewayCtx, []string{route.ID})) }()
+
+	result, err := DryRunPublish(gatewayCtx, nil)
+	assert.NoError(t, err)
+	assert.Contains(t, result.ReferenceErrors, schema.ReferenceError{
+		ResourceType: constant.Route,
+		ResourceID:   route.ID,
+		Field:        "service_id",
+		TargetType:   constant.Service,
+		TargetID:     route.ServiceID,
+	})
+}
+
+func TestDryRunPublishRoutePriorityConflicts(t *testing.T) {
+	var routeIDs []string
+	for i := 0; i < 2; i++ {
+		route := data.Route1WithNoRelationResource(gatewayInfo, constant.ResourceStatusCreateDraft)
+		route.Name = fmt.Sprintf("dry-run-priority-%d-%d", i, time.Now().UnixNano())
+		route.Config = datatypes.JSON(`{"uris":["/dry-run-priority"],"upstream":{"type":"roundrobin",` +
+			`"nodes":{"127.0.0.1:80":1}}}`)
+		assert.NoError(t, CreateRoute(gatewayCtx, *route))
+		routeIDs = append(routeIDs, route.ID)
+	}
+	defer func() { assert.NoError(t, BatchDeleteRoutes(gatewayCtx, routeIDs)) }()
+
+	result, err := DryRunPublish(gatewayCtx, nil)
+	assert.NoError(t, err)
+	var pairs [][2]string
+	for _, conflict := range result.RoutePriorityConflicts {
+		pairs = append(pairs, [2]string{conflict.RouteID, conflict.OtherRouteID})
+	}
+	assert.True(t, slices.Contains(pairs, [2]string{routeIDs[0], routeIDs[1]}) ||
+		slices.Contains(pairs, [2]string{routeIDs[1], routeIDs[0]}), pairs)
+}
+
+func TestDryRunPublishMatchesEtcd(t *testing.T) {
+	consumer := data.Consumer1WithNoRelation(gatewayInfo, constant.ResourceStatusCreateDraft)
+	consumer.Username = fmt.Sprintf("dry_run_consumer_%d", time.Now().UnixNano())
+	consumer.Config, _ = sjson.SetBytes(consumer.Config, "username", consumer.Username)
+	consumer.Config, _ = sjson.SetBytes(consumer.Config, "plugins.key-auth.key", consumer.Username)
+	assert.NoError(t, CreateConsumer(gatewayCtx, *consumer))
+	assert.NoError(t, PublishConsumers(gatewayCtx, []string{consumer.ID}))
+
+	// 配置未变化的待发布资源与 etcd 中的配置一致，不展示变更
+	assert.NoError(t, UpdateResourceStatus(gatewayCtx, constant.Consumer, consumer.ID,
+		constant.ResourceStatusUpdateDraft))
+	result, err := DryRunPublish(gatewayCtx, []constant.APISIXResource{constant.Consumer})
+	assert.NoError(t, err)
+	action, _ := findDiffItem(result, constant.Consumer, consumer.Username)
+	assert.Empty(t, action)
+
+	assert.NoError(t, UpdateResourceStatus(gatewayCtx, constant.Consumer, consumer.ID,
+		constant.ResourceStatusDeleteDraft))
+	assert.NoError(t, PublishConsumers(gatewayCtx, []string{consumer.ID}))
+}
+
+func TestDryRunPublishConsistentWithPublishPlan(t *testing.T) {
+	consumer := data.Consumer1WithNoRelation(gatewayInfo, constant.ResourceStatusCreateDraft)
+	consumer.Username = fmt.Sprintf("dry_run_pending_%d", time.Now().UnixNano())
+	consumer.Config = datatypes.JSON(`{"username":"` + consumer.Username +
+		`","plugins":{"key-auth":{"key":"` + constant.CredentialPlaceholder + `"}}}`)
+	assert.NoError(t, CreateConsumer(gatewayCtx, *consumer))
+	defer func() { assert.NoError(t, BatchDeleteConsumers(gatewayCtx, []string{consumer.ID})) }()
+
+	// 凭证尚未生成的 consumer 发布会失败，两种预览都返回错误且不展示新增
+	plan, err := DryRunPublishMutations(gatewayCtx, "", nil)
+	assert.NoError(t, err)
+	result, err := DryRunPublish(gatewayCtx, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, plan.Errors, result.Errors)
+	assert.True(t, slices.ContainsFunc(result.Errors, func(planErr dto.PublishPlanError) bool {
+		return strings.Contains(planErr.Message, consumer.Username)
+	}), result.Errors)
+	action, _ := findDiffItem(result, constant.Consumer, consumer.Username)
+	assert.Empty(t, action)
+}
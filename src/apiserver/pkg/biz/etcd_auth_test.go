@@ -0,0 +1,109 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package biz
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/base"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/data"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/util"
+)
+
+func TestGatewayEtcdAuthStatus(t *testing.T) {
+	// token 有效期足够长，测试过程中不会自然过期，过期场景通过重新开启鉴权使 token 失效来模拟
+	const tokenTTL = 300
+	rootClient, server, err := util.StartEmbedEtcdWithAuth(context.Background(), tokenTTL)
+	if server != nil {
+		defer server.Close()
+	}
+	require.NoError(t, err)
+	defer rootClient.Close()
+	_, err = rootClient.UserAdd(context.Background(), "gateway", "gateway-password")
+	require.NoError(t, err)
+	_, err = rootClient.UserGrantRole(context.Background(), "gateway", util.EmbedEtcdRootUser)
+	require.NoError(t, err)
+
+	gateway := data.Gateway1WithBkAPISIX()
+	gateway.Name = fmt.Sprintf("etcd-auth-gateway-%d", time.Now().UnixNano())
+	gateway.EtcdConfig.Endpoint = base.Endpoint(server.Clients[0].Addr().String())
+	gateway.EtcdConfig.Username = "gateway"
+	gateway.EtcdConfig.Password = "gateway-password"
+	gateway.EtcdConfig.Prefix = "/etcd-auth"
+	require.NoError(t, CreateGateway(context.Background(), gateway))
+
+	loadGateway := func() (*model.Gateway, context.Context) {
+		gw, err := GetGateway(context.Background(), gateway.ID)
+		assert.NoError(t, err)
+		return gw, ginx.SetGatewayInfoToContext(context.Background(), gw)
+	}
+	publishRoute := func(ctx context.Context, gw *model.Gateway, name string) error {
+		route := data.Route1WithNoRelationResource(gw, constant.ResourceStatusCreateDraft)
+		route.Name = name
+		assert.NoError(t, CreateRoute(ctx, *route))
+		return PublishRoutes(ctx, []string{route.ID})
+	}
+
+	gw, ctx := loadGateway()
+	assert.Equal(t, constant.GatewayEtcdStatusNormal, gw.EtcdStatus)
+
+	// token 过期后发布不受影响：关闭再开启鉴权会使已签发的 simple token 全部失效，与 token 过期时服务端的表现一致
+	_, err = rootClient.AuthDisable(context.Background())
+	require.NoError(t, err)
+	_, err = rootClient.AuthEnable(context.Background())
+	require.NoError(t, err)
+	assert.NoError(t, publishRoute(ctx, gw, "etcd-auth-route-1"))
+	gw, ctx = loadGateway()
+	assert.Equal(t, constant.GatewayEtcdStatusNormal, gw.EtcdStatus)
+
+	// etcd 密码被修改，重新认证失败，网关 etcd 状态标记为 auth_failed
+	_, err = rootClient.UserChangePassword(context.Background(), "gateway", "changed-password")
+	assert.NoError(t, err)
+	assert.Error(t, publishRoute(ctx, gw, "etcd-auth-route-2"))
+	gw, _ = loadGateway()
+	assert.Equal(t, constant.GatewayEtcdStatusAuthFailed, gw.EtcdStatus)
+
+	// 网关 etcd 密码更新后状态恢复
+	gw.EtcdConfig.Password = "changed-password"
+	assert.NoError(t, UpdateGateway(context.Background(), *gw))
+	gw, ctx = loadGateway()
+	assert.Equal(t, constant.GatewayEtcdStatusNormal, gw.EtcdStatus)
+	assert.NoError(t, publishRoute(ctx, gw, "etcd-auth-route-3"))
+
+	// 密码恢复后重新建立连接时状态同样会恢复
+	_, err = rootClient.UserChangePassword(context.Background(), "gateway", "gateway-password")
+	assert.NoError(t, err)
+	assert.Error(t, publishRoute(ctx, gw, "etcd-auth-route-4"))
+	gw, ctx = loadGateway()
+	assert.Equal(t, constant.GatewayEtcdStatusAuthFailed, gw.EtcdStatus)
+	_, err = rootClient.UserChangePassword(context.Background(), "gateway", "changed-password")
+	assert.NoError(t, err)
+	assert.NoError(t, publishRoute(ctx, gw, "etcd-auth-route-5"))
+	gw, _ = loadGateway()
+	assert.Equal(t, constant.GatewayEtcdStatusNormal, gw.EtcdStatus)
+}
@@ -0,0 +1,89 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package biz
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/storage"
+)
+
+// etcdHealthCheckTimeout 单个 etcd 节点的健康检查超时
+const etcdHealthCheckTimeout = 2 * time.Second
+
+// CheckGatewaysEtcdHealth 检查网关 etcd 各节点的连通性及鉴权，多数节点检查通过时网关 etcd 可用；
+// gatewayID 为 0 时检查全部网关
+func CheckGatewaysEtcdHealth(ctx context.Context, gatewayID int) ([]dto.GatewayEtcdHealth, error) {
+	var gateways []*model.Gateway
+	if gatewayID != 0 {
+		gateway, err := GetGateway(ctx, gatewayID)
+		if err != nil {
+			return nil, err
+		}
+		gateways = append(gateways, gateway)
+	} else {
+		var err error
+		gateways, err = ListGateways(ctx, 0)
+		if err != nil {
+			return nil, err
+		}
+	}
+	results := make([]dto.GatewayEtcdHealth, len(gateways))
+	group := errgroup.Group{}
+	group.SetLimit(etcdScanConcurrency)
+	for i, gateway := range gateways {
+		group.Go(func() error {
+			results[i] = checkGatewayEtcdHealth(ctx, gateway)
+			return nil
+		})
+	}
+	_ = group.Wait()
+	return results, nil
+}
+
+// checkGatewayEtcdHealth 检查单个网关 etcd 的各节点
+func checkGatewayEtcdHealth(ctx context.Context, gateway *model.Gateway) dto.GatewayEtcdHealth {
+	result := dto.GatewayEtcdHealth{
+		GatewayID:   gateway.ID,
+		GatewayName: gateway.Name,
+		Endpoints:   []dto.EtcdEndpointHealth{},
+	}
+	var healthy int
+	for _, endpoint := range storage.CheckEndpointsHealth(ctx, gateway.EtcdConfig.EtcdConfig, etcdHealthCheckTimeout) {
+		endpointHealth := dto.EtcdEndpointHealth{
+			Endpoint: endpoint.Endpoint,
+			Healthy:  endpoint.Healthy,
+			RTTMs:    float64(endpoint.RTT.Microseconds()) / 1000,
+		}
+		if endpoint.Err != nil {
+			endpointHealth.Error = endpoint.Err.Error()
+		}
+		if endpoint.Healthy {
+			healthy++
+		}
+		result.Endpoints = append(result.Endpoints, endpointHealth)
+	}
+	result.Healthy = healthy > len(result.Endpoints)/2
+	return result
+}
@@ -0,0 +1,234 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/publisher"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/idx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/schema"
+)
+
+// etcdImportEntry 单个 etcd 资源的导入计划
+type etcdImportEntry struct {
+	item     *model.GatewaySyncData
+	existing *model.ResourceCommonModel // 编辑区中 etcd key 相同的资源
+	result   dto.EtcdImportResult
+}
+
+// SyncResourcesFromEtcd 将 etcd 中的资源导入编辑区：按资源类型目录读取 etcd，使用网关版本的 etcd schema 校验后，
+// 按 etcd key 新增或覆盖编辑区中的资源，导入的资源状态为已发布；
+// etcd key 不是编辑区生成的 ID 时生成新的资源 ID，并将原 key 记录为自定义 etcd key，配置中的关联 id 保持不变。
+// 每个资源单独写入，与编辑区配置一致的资源结果为 unchanged，因此中断后重新执行即可继续导入；
+// fail 策略下存在冲突时不导入任何资源。resourceTypes 为空时导入全部类型
+func SyncResourcesFromEtcd(
+	ctx context.Context,
+	strategy constant.EtcdImportStrategy,
+	resourceTypes []constant.APISIXResource,
+) (*dto.EtcdImportReport, error) {
+	gatewayInfo := ginx.GetGatewayInfoFromContext(ctx)
+	scannedTypes := ScannedResourceTypes(resourceTypes)
+	syncer, err := NewUnifyOp(gatewayInfo, false)
+	if err != nil {
+		return nil, err
+	}
+	defer syncer.etcdStore.Close()
+	prefix := strings.TrimSuffix(gatewayInfo.EtcdConfig.Prefix, "/") + "/"
+	kvList, err := listEtcdKeyValues(ctx, syncer.etcdStore, prefix, resourceTypes)
+	if err != nil {
+		return nil, err
+	}
+	rawValues := make(map[string]string, len(kvList))
+	for _, kv := range kvList {
+		rawValues[strings.TrimPrefix(kv.Key, prefix)] = kv.Value
+	}
+	typeItems := make(map[constant.APISIXResource][]*model.GatewaySyncData)
+	for _, item := range syncer.kvToResource(kvList) {
+		typeItems[item.Type] = append(typeItems[item.Type], item)
+	}
+	customizePluginSchemaMap := GetCustomizePluginSchemaMap(ctx, gatewayInfo.ID)
+
+	var entries []*etcdImportEntry
+	var conflicted bool
+	for _, resourceType := range scannedTypes {
+		if len(typeItems[resourceType]) == 0 {
+			continue
+		}
+		resources, err := QueryResource(ctx, resourceType, map[string]interface{}{"gateway_id": gatewayInfo.ID}, "")
+		if err != nil {
+			return nil, err
+		}
+		existing := make(map[string]*model.ResourceCommonModel, len(resources))
+		for _, resource := range resources {
+			existing[complianceEtcdKey(resourceType, resource)] = resource
+		}
+		validator, err := schema.NewAPISIXJsonSchemaValidator(
+			gatewayInfo.GetAPISIXVersionX(),
+			resourceType,
+			"main."+resourceType.String(),
+			customizePluginSchemaMap,
+			constant.ETCD,
+		)
+		if err != nil {
+			return nil, err
+		}
+		schema.SetAllowCustomVars(validator, gatewayInfo.AllowCustomVars)
+		schema.SetRetriesCheck(validator, gatewayInfo.RetriesCheck)
+		dir := constant.ResourceTypePrefixMap[resourceType]
+		for _, item := range typeItems[resourceType] {
+			etcdKey := syncDataEtcdKey(item)
+			entry := &etcdImportEntry{
+				item:   item,
+				result: dto.EtcdImportResult{ResourceType: resourceType, EtcdKey: etcdKey},
+			}
+			entries = append(entries, entry)
+			rawValue := json.RawMessage(rawValues[dir+"/"+etcdKey])
+			if err := validator.Validate(rawValue); err != nil {
+				entry.result.Action = constant.EtcdImportActionInvalid
+				entry.result.Message = err.Error()
+				continue
+			}
+			entry.existing = existing[etcdKey]
+			if entry.existing == nil {
+				entry.result.Action = constant.EtcdImportActionCreated
+				continue
+			}
+			entry.result.ID = entry.existing.ID
+			deltas, err := etcdImportDeltas(resourceType, entry.existing, rawValue)
+			if err != nil {
+				return nil, err
+			}
+			if len(deltas) == 0 {
+				entry.result.Action = constant.EtcdImportActionUnchanged
+				continue
+			}
+			entry.result.Action = constant.EtcdImportActionConflict
+			entry.result.Deltas = deltas
+			conflicted = true
+		}
+	}
+
+	report := &dto.EtcdImportReport{
+		Strategy:     strategy,
+		ScannedTypes: scannedTypes,
+		Aborted:      conflicted && strategy == constant.EtcdImportStrategyFail,
+		Stats:        make(map[constant.EtcdImportAction]int),
+		Results:      make([]dto.EtcdImportResult, 0, len(entries)),
+	}
+	for _, entry := range entries {
+		switch {
+		case report.Aborted:
+			if entry.result.Action == constant.EtcdImportActionCreated {
+				entry.result.Action = constant.EtcdImportActionAborted
+			}
+		case entry.result.Action == constant.EtcdImportActionCreated,
+			entry.result.Action == constant.EtcdImportActionConflict &&
+				strategy == constant.EtcdImportStrategyOverwrite:
+			importEtcdResource(ctx, entry)
+		}
+		report.Stats[entry.result.Action]++
+		report.Results = append(report.Results, entry.result)
+	}
+	return report, nil
+}
+
+// importEtcdResource 在单独的事务中将资源写入编辑区，覆盖时沿用编辑区中的资源 ID 及自定义 etcd key
+func importEtcdResource(ctx context.Context, entry *etcdImportEntry) {
+	item := entry.item
+	switch {
+	case entry.existing != nil:
+		item.ID = entry.existing.ID
+		item.EtcdKeyOverride = entry.existing.EtcdKeyOverride
+	case item.EtcdKeyOverride == "" && item.Type != constant.PluginMetadata && item.Type != constant.Consumer &&
+		idx.GetResourceTypeFromID(item.ID) == "":
+		// 插件元数据以插件名、consumer 以 username 作为 etcd key，不生成资源 ID
+		item.EtcdKeyOverride = constant.ResourceTypePrefixMap[item.Type] + "/" + item.ID
+		item.ID = idx.GenResourceID(item.Type)
+	}
+	if item.GetConfigID() != "" && item.Type != constant.PluginMetadata && item.Type != constant.Secret {
+		item.Config, _ = sjson.SetBytes(item.Config, "id", item.ID)
+	}
+	err := repo.Q.Transaction(func(tx *repo.Query) error {
+		txCtx := ginx.SetTx(ctx, tx)
+		if entry.existing != nil {
+			if err := DeleteResourceByIDs(txCtx, item.Type, []string{entry.existing.ID}); err != nil {
+				return err
+			}
+		}
+		return insertSyncedResourcesModel(
+			txCtx,
+			map[constant.APISIXResource][]*model.GatewaySyncData{item.Type: {item}},
+			constant.ResourceStatusSuccess,
+			false,
+		)
+	})
+	if err != nil {
+		entry.result.Action = constant.EtcdImportActionFailed
+		entry.result.Message = err.Error()
+		return
+	}
+	if entry.existing != nil {
+		entry.result.Action = constant.EtcdImportActionOverwritten
+	}
+	entry.result.ID = item.ID
+}
+
+// syncDataEtcdKey etcd 资源去掉网关前缀及资源类型目录后的 key，与 complianceEtcdKey 对应
+func syncDataEtcdKey(item *model.GatewaySyncData) string {
+	key := item.ID
+	if item.Type == constant.PluginMetadata {
+		key = item.GetName()
+	}
+	return resourceKeyWithoutTypeDir(publisher.ResourceOperation{
+		Key:         key,
+		KeyOverride: item.EtcdKeyOverride,
+		Type:        item.Type,
+	})
+}
+
+// etcdImportDeltas 对比 etcd 中的配置与编辑区资源发布后的配置，忽略由 etcd key 决定的 id；
+// etcd 中没有名称时导入会生成默认名称，此时也不对比名称
+func etcdImportDeltas(
+	resourceType constant.APISIXResource,
+	resource *model.ResourceCommonModel,
+	etcdConfig json.RawMessage,
+) ([]dto.FieldDelta, error) {
+	config, err := publishedConfig(resourceType, resource)
+	if err != nil {
+		return nil, err
+	}
+	etcdConfig, _ = sjson.DeleteBytes(etcdConfig, "id")
+	config, _ = sjson.DeleteBytes(config, "id")
+	nameKey := model.GetResourceNameKey(resourceType)
+	if !gjson.GetBytes(etcdConfig, nameKey).Exists() {
+		config, _ = sjson.DeleteBytes(config, nameKey)
+	}
+	return diffConfigFields(etcdConfig, config), nil
+}
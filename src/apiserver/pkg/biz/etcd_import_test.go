@@ -0,0 +1,138 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package biz
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/storage"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/idx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/data"
+)
+
+func TestSyncResourcesFromEtcd(t *testing.T) {
+	// 已发布的路由，etcd 中的配置被直接修改
+	route := data.Route1WithNoRelationResource(gatewayInfo, constant.ResourceStatusCreateDraft)
+	route.Name = fmt.Sprintf("etcd-import-%d", time.Now().UnixNano())
+	assert.NoError(t, CreateRoute(gatewayCtx, *route))
+	assert.NoError(t, PublishRoutes(gatewayCtx, []string{route.ID}))
+
+	etcdStore, err := storage.NewEtcdStorage(gatewayInfo.EtcdConfig.EtcdConfig)
+	assert.NoError(t, err)
+	defer etcdStore.Close()
+	client := etcdStore.GetClient()
+	routeKeyPrefix := gatewayInfo.EtcdConfig.Prefix + "/routes/"
+	resp, err := client.Get(context.Background(), routeKeyPrefix+route.ID)
+	assert.NoError(t, err)
+	assert.Len(t, resp.Kvs, 1)
+	modified, _ := sjson.SetBytes(resp.Kvs[0].Value, "uris", []string{"/etcd-import"})
+	_, err = client.Put(context.Background(), routeKeyPrefix+route.ID, string(modified))
+	assert.NoError(t, err)
+
+	// 使用 APISIX 原始 id 的路由及不合法的路由
+	rawID := fmt.Sprintf("%d", time.Now().UnixNano())
+	invalidID := fmt.Sprintf("etcd-import-invalid-%d", time.Now().UnixNano())
+	_, err = client.Put(context.Background(), routeKeyPrefix+rawID, `{"id":"`+rawID+`","uri":"/raw",`+
+		`"upstream":{"type":"roundrobin","nodes":[{"host":"127.0.0.1","port":80,"weight":1}]}}`)
+	assert.NoError(t, err)
+	_, err = client.Put(context.Background(), routeKeyPrefix+invalidID,
+		`{"id":"`+invalidID+`","uri":"/invalid","unknown_field":1}`)
+	assert.NoError(t, err)
+
+	var importedID string
+	defer func() {
+		assert.NoError(t, BatchDeleteRoutes(gatewayCtx, []string{route.ID, importedID}))
+		for _, key := range []string{route.ID, rawID, invalidID} {
+			_, err = client.Delete(context.Background(), routeKeyPrefix+key)
+			assert.NoError(t, err)
+		}
+	}()
+
+	syncFromEtcd := func(
+		strategy constant.EtcdImportStrategy,
+	) (*dto.EtcdImportReport, map[string]dto.EtcdImportResult) {
+		report, err := SyncResourcesFromEtcd(gatewayCtx, strategy, []constant.APISIXResource{constant.Route})
+		assert.NoError(t, err)
+		assert.Equal(t, []constant.APISIXResource{constant.Route}, report.ScannedTypes)
+		var total int
+		for _, count := range report.Stats {
+			total += count
+		}
+		assert.Equal(t, len(report.Results), total)
+		results := make(map[string]dto.EtcdImportResult)
+		for _, result := range report.Results {
+			results[result.EtcdKey] = result
+		}
+		return report, results
+	}
+
+	// fail 策略下存在冲突时不导入任何资源
+	report, results := syncFromEtcd(constant.EtcdImportStrategyFail)
+	assert.True(t, report.Aborted)
+	assert.Equal(t, constant.EtcdImportActionConflict, results[route.ID].Action)
+	assert.Equal(t, "uris", results[route.ID].Deltas[0].Path)
+	assert.Equal(t, constant.EtcdImportActionAborted, results[rawID].Action)
+	assert.Equal(t, constant.EtcdImportActionInvalid, results[invalidID].Action)
+	assert.NotEmpty(t, results[invalidID].Message)
+	assert.Empty(t, results[rawID].ID)
+
+	// skip 策略保留编辑区配置，原始 id 生成编辑区资源 ID
+	report, results = syncFromEtcd(constant.EtcdImportStrategySkip)
+	assert.False(t, report.Aborted)
+	assert.Equal(t, constant.EtcdImportActionConflict, results[route.ID].Action)
+	assert.Equal(t, constant.EtcdImportActionCreated, results[rawID].Action)
+	importedID = results[rawID].ID
+	assert.Equal(t, constant.Route, idx.GetResourceTypeFromID(importedID))
+	imported, err := GetRoute(gatewayCtx, importedID)
+	assert.NoError(t, err)
+	assert.Equal(t, "routes/"+rawID, imported.EtcdKeyOverride)
+	assert.Equal(t, constant.ResourceStatusSuccess, imported.Status)
+	assert.Equal(t, "/raw", gjson.GetBytes(imported.Config, "uri").String())
+	current, err := GetRoute(gatewayCtx, route.ID)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(current.Config), "/etcd-import")
+
+	// 重新执行时已导入的资源不再重复导入
+	_, results = syncFromEtcd(constant.EtcdImportStrategySkip)
+	assert.Equal(t, constant.EtcdImportActionUnchanged, results[rawID].Action)
+	assert.Equal(t, importedID, results[rawID].ID)
+
+	// overwrite 策略以 etcd 配置覆盖编辑区
+	report, results = syncFromEtcd(constant.EtcdImportStrategyOverwrite)
+	assert.Equal(t, constant.EtcdImportActionOverwritten, results[route.ID].Action)
+	assert.Equal(t, route.ID, results[route.ID].ID)
+	assert.Equal(t, 1, report.Stats[constant.EtcdImportActionOverwritten])
+	current, err = GetRoute(gatewayCtx, route.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, constant.ResourceStatusSuccess, current.Status)
+	assert.Equal(t, "/etcd-import", gjson.GetBytes(current.Config, "uris.0").String())
+
+	_, results = syncFromEtcd(constant.EtcdImportStrategyFail)
+	assert.Equal(t, constant.EtcdImportActionUnchanged, results[route.ID].Action)
+	assert.Equal(t, constant.EtcdImportActionUnchanged, results[rawID].Action)
+}
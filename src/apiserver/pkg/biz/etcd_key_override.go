@@ -0,0 +1,244 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/database"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/publisher"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+)
+
+// etcdKeyOverrideMaxLength 自定义 etcd key 的最大长度，与数据库字段长度一致
+const etcdKeyOverrideMaxLength = 512
+
+// NormalizeEtcdKeyOverride 校验并规范化自定义 etcd key，返回相对网关前缀的 key
+// 支持传入带网关前缀的完整 key，key 必须位于资源类型目录下，传空表示清除
+func NormalizeEtcdKeyOverride(prefix string, resourceType constant.APISIXResource, key string) (string, error) {
+	key = strings.TrimSpace(key)
+	if key == "" {
+		return "", nil
+	}
+	if resourceType == constant.PluginMetadata {
+		return "", errors.New("插件元数据不支持自定义 etcd key")
+	}
+	if resourceType == constant.Secret {
+		return "", errors.New("密钥的 etcd key 由密钥管理器决定, 不支持自定义 etcd key")
+	}
+	if resourceType == constant.Credential {
+		return "", errors.New("凭证的 etcd key 由所属消费者决定, 不支持自定义 etcd key")
+	}
+	if strings.HasPrefix(key, "/") {
+		gatewayPrefix := strings.TrimSuffix(prefix, "/") + "/"
+		relativeKey, ok := strings.CutPrefix(key, gatewayPrefix)
+		if !ok {
+			return "", fmt.Errorf("自定义 etcd key 必须位于网关前缀 %s 下", gatewayPrefix)
+		}
+		key = relativeKey
+	}
+	if len(key) > etcdKeyOverrideMaxLength {
+		return "", fmt.Errorf("自定义 etcd key 长度不能超过 %d", etcdKeyOverrideMaxLength)
+	}
+	typeDir := constant.ResourceTypePrefixMap[resourceType] + "/"
+	if !strings.HasPrefix(key, typeDir) {
+		return "", fmt.Errorf("自定义 etcd key 必须位于资源目录 %s 下", typeDir)
+	}
+	for _, segment := range strings.Split(key, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return "", fmt.Errorf("自定义 etcd key 不合法: %s", key)
+		}
+	}
+	return key, nil
+}
+
+// checkEtcdKeyOverrideConflict 校验自定义 etcd key 是否与同网关下其他资源的 etcd key 冲突
+func checkEtcdKeyOverrideConflict(
+	ctx context.Context,
+	gatewayID int,
+	resourceType constant.APISIXResource,
+	id string,
+	key string,
+) error {
+	standardID := strings.TrimPrefix(key, constant.ResourceTypePrefixMap[resourceType]+"/")
+	var count int64
+	err := database.Client().WithContext(ctx).Table(resourceTableMap[resourceType]).
+		Where("gateway_id = ? AND id != ?", gatewayID, id).
+		Where(
+			database.Client().Where("etcd_key_override = ?", key).Or(
+				"id = ? AND (etcd_key_override IS NULL OR etcd_key_override = '')", standardID),
+		).Count(&count).Error
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return fmt.Errorf("etcd key %s 已被其他资源使用", key)
+	}
+	return nil
+}
+
+// getEtcdKeyOverrides 获取资源的自定义 etcd key: id->key
+func getEtcdKeyOverrides(
+	ctx context.Context,
+	resourceType constant.APISIXResource,
+	ids []string,
+) (map[string]string, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	resources, err := GetResourceByIDs(ctx, resourceType, ids)
+	if err != nil {
+		return nil, err
+	}
+	keyOverrides := make(map[string]string)
+	for _, resource := range resources {
+		if resource.EtcdKeyOverride != "" {
+			keyOverrides[resource.ID] = resource.EtcdKeyOverride
+		}
+	}
+	return keyOverrides, nil
+}
+
+// getGatewayResource 获取当前网关下的资源
+func getGatewayResource(
+	ctx context.Context,
+	resourceType constant.APISIXResource,
+	id string,
+) (model.ResourceCommonModel, error) {
+	var res model.ResourceCommonModel
+	err := database.Client().WithContext(ctx).Table(resourceTableMap[resourceType]).
+		Where("gateway_id = ? AND id = ?", ginx.GetGatewayInfoFromContext(ctx).ID, id).Take(&res).Error
+	return res, err
+}
+
+// GetResourceEtcdKeyOverride 获取资源的自定义 etcd key
+func GetResourceEtcdKeyOverride(ctx context.Context, resourceType constant.APISIXResource, id string) (string, error) {
+	resource, err := getGatewayResource(ctx, resourceType, id)
+	if err != nil {
+		return "", err
+	}
+	return resource.EtcdKeyOverride, nil
+}
+
+// UpdateResourceEtcdKeyOverride 修改资源的自定义 etcd key，仅新增待发布的资源可修改，
+// 避免 etcd 中遗留旧 key 的数据
+func UpdateResourceEtcdKeyOverride(
+	ctx context.Context,
+	resourceType constant.APISIXResource,
+	id string,
+	key string,
+) error {
+	gatewayInfo := ginx.GetGatewayInfoFromContext(ctx)
+	resource, err := getGatewayResource(ctx, resourceType, id)
+	if err != nil {
+		return err
+	}
+	key, err = NormalizeEtcdKeyOverride(gatewayInfo.EtcdConfig.Prefix, resourceType, key)
+	if err != nil {
+		return err
+	}
+	if key == resource.EtcdKeyOverride {
+		return nil
+	}
+	if resource.Status != constant.ResourceStatusCreateDraft {
+		return errors.New("仅新增待发布的资源可修改自定义 etcd key，已发布的资源请迁移至标准 key")
+	}
+	if err = checkConsumerCredentials(ctx, resourceType, id); err != nil {
+		return err
+	}
+	if key != "" {
+		if err = checkEtcdKeyOverrideConflict(ctx, gatewayInfo.ID, resourceType, id, key); err != nil {
+			return err
+		}
+	}
+	return database.Client().WithContext(ctx).Table(resourceTableMap[resourceType]).
+		Where("id = ?", id).Update("etcd_key_override", key).Error
+}
+
+// checkConsumerCredentials 凭证的 etcd key 位于所属消费者的 key 之下，存在凭证的消费者不允许修改 etcd key
+func checkConsumerCredentials(ctx context.Context, resourceType constant.APISIXResource, id string) error {
+	if resourceType != constant.Consumer {
+		return nil
+	}
+	credentials, err := QueryCredentials(ctx, map[string]interface{}{"consumer_id": id})
+	if err != nil {
+		return err
+	}
+	if len(credentials) > 0 {
+		return fmt.Errorf("消费者存在关联的凭证 %s, 不支持修改 etcd key", credentials[0].ID)
+	}
+	return nil
+}
+
+// MigrateResourceEtcdKeyToStandard 将使用自定义 etcd key 的资源迁移至标准 key: {资源类型}/{id}，
+// 先写入标准 key 再删除自定义 key，最后清除资源的自定义 etcd key
+func MigrateResourceEtcdKeyToStandard(ctx context.Context, resourceType constant.APISIXResource, id string) error {
+	if resourceType == constant.Secret {
+		return errors.New("密钥的 etcd key 由密钥管理器决定, 不支持迁移")
+	}
+	if resourceType == constant.Credential {
+		return errors.New("凭证的 etcd key 由所属消费者决定, 不支持迁移")
+	}
+	gatewayInfo := ginx.GetGatewayInfoFromContext(ctx)
+	resource, err := getGatewayResource(ctx, resourceType, id)
+	if err != nil {
+		return err
+	}
+	if err = checkConsumerCredentials(ctx, resourceType, id); err != nil {
+		return err
+	}
+	if resource.EtcdKeyOverride == "" {
+		return fmt.Errorf("资源 %s 未配置自定义 etcd key", id)
+	}
+	standardKey := constant.ResourceTypePrefixMap[resourceType] + "/" + id
+	if err = checkEtcdKeyOverrideConflict(ctx, gatewayInfo.ID, resourceType, id, standardKey); err != nil {
+		return err
+	}
+	// 未发布的资源 etcd 中没有数据，直接清除即可
+	if resource.Status != constant.ResourceStatusCreateDraft {
+		pub, err := getEtcdPublisher(ctx)
+		if err != nil {
+			return err
+		}
+		value, err := pub.Get(ctx, resource.EtcdKeyOverride)
+		if err != nil {
+			return fmt.Errorf("读取 etcd key %s 失败: %w", resource.EtcdKeyOverride, err)
+		}
+		config, _ := value.(string)
+		err = batchCreateEtcdResource(ctx, []publisher.ResourceOperation{{
+			Key:    id,
+			Config: json.RawMessage(config),
+			Type:   resourceType,
+		}})
+		if err != nil {
+			return err
+		}
+		if err = batchDeleteEtcdResource(ctx, resourceType, []string{id}); err != nil {
+			return err
+		}
+	}
+	return database.Client().WithContext(ctx).Table(resourceTableMap[resourceType]).
+		Where("id = ?", id).Update("etcd_key_override", "").Error
+}
@@ -0,0 +1,119 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package biz
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/storage"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/data"
+)
+
+func TestNormalizeEtcdKeyOverride(t *testing.T) {
+	tests := []struct {
+		name         string
+		resourceType constant.APISIXResource
+		key          string
+		want         string
+		wantErr      bool
+	}{
+		{name: "empty", resourceType: constant.Route, key: " ", want: ""},
+		{name: "relative", resourceType: constant.Route, key: "routes/legacy/1", want: "routes/legacy/1"},
+		{name: "full key", resourceType: constant.Route, key: "/apisix/routes/1", want: "routes/1"},
+		{name: "outside gateway prefix", resourceType: constant.Route, key: "/other/routes/1", wantErr: true},
+		{name: "other resource dir", resourceType: constant.Route, key: "upstreams/1", wantErr: true},
+		{name: "empty segment", resourceType: constant.Route, key: "routes//1", wantErr: true},
+		{name: "parent segment", resourceType: constant.Route, key: "routes/../upstreams/1", wantErr: true},
+		{name: "plugin metadata", resourceType: constant.PluginMetadata, key: "plugin_metadata/a", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeEtcdKeyOverride("/apisix", tt.resourceType, tt.key)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestEtcdKeyOverridePublishAndMigrate(t *testing.T) {
+	route := data.Route1WithNoRelationResource(gatewayInfo, constant.ResourceStatusCreateDraft)
+	route.Name = fmt.Sprintf("etcd-key-override-%d", time.Now().UnixNano())
+	assert.NoError(t, CreateRoute(gatewayCtx, *route))
+	other := data.Route1WithNoRelationResource(gatewayInfo, constant.ResourceStatusCreateDraft)
+	other.Name = fmt.Sprintf("etcd-key-override-other-%d", time.Now().UnixNano())
+	assert.NoError(t, CreateRoute(gatewayCtx, *other))
+
+	// 与其他资源的标准 key 冲突
+	assert.Error(t, UpdateResourceEtcdKeyOverride(gatewayCtx, constant.Route, route.ID, "routes/"+other.ID))
+
+	overrideKey := "routes/legacy/" + route.ID
+	assert.NoError(t, UpdateResourceEtcdKeyOverride(gatewayCtx, constant.Route, route.ID, overrideKey))
+	// 与其他资源的自定义 key 冲突
+	assert.Error(t, UpdateResourceEtcdKeyOverride(gatewayCtx, constant.Route, other.ID, overrideKey))
+
+	assert.NoError(t, PublishRoutes(gatewayCtx, []string{route.ID}))
+	etcdStore, err := storage.NewEtcdStorage(gatewayInfo.EtcdConfig.EtcdConfig)
+	assert.NoError(t, err)
+	defer etcdStore.Close()
+	_, err = etcdStore.Get(context.Background(), overrideKey)
+	assert.NoError(t, err)
+	_, err = etcdStore.Get(context.Background(), "routes/"+route.ID)
+	assert.ErrorIs(t, err, storage.KeyNotFoundError)
+
+	// 已发布的资源不允许直接修改
+	assert.Error(t, UpdateResourceEtcdKeyOverride(gatewayCtx, constant.Route, route.ID, "routes/new/"+route.ID))
+
+	assert.NoError(t, MigrateResourceEtcdKeyToStandard(gatewayCtx, constant.Route, route.ID))
+	_, err = etcdStore.Get(context.Background(), overrideKey)
+	assert.ErrorIs(t, err, storage.KeyNotFoundError)
+	_, err = etcdStore.Get(context.Background(), "routes/"+route.ID)
+	assert.NoError(t, err)
+	key, err := GetResourceEtcdKeyOverride(gatewayCtx, constant.Route, route.ID)
+	assert.NoError(t, err)
+	assert.Empty(t, key)
+
+	assert.NoError(t, deleteRoutes(gatewayCtx, []string{route.ID, other.ID}))
+}
+
+func TestKvToResourceWithNestedKey(t *testing.T) {
+	s := &UnifyOp{gatewayInfo: gatewayInfo}
+	prefix := gatewayInfo.EtcdConfig.Prefix
+	resources := s.kvToResource([]storage.KeyValuePair{
+		{Key: prefix + "/routes/r1", Value: `{"id":"r1","uri":"/r1"}`},
+		{Key: prefix + "/routes/legacy/00001", Value: `{"id":"legacy-1","uri":"/legacy"}`},
+		{Key: prefix + "/upstreams/legacy/u1", Value: `{"nodes":{"127.0.0.1:80":1}}`},
+	})
+	assert.Len(t, resources, 3)
+	assert.Equal(t, "r1", resources[0].ID)
+	assert.Empty(t, resources[0].EtcdKeyOverride)
+	assert.Equal(t, "legacy-1", resources[1].ID)
+	assert.Equal(t, "routes/legacy/00001", resources[1].EtcdKeyOverride)
+	assert.Equal(t, "legacy_u1", resources[2].ID)
+	assert.Equal(t, "upstreams/legacy/u1", resources[2].EtcdKeyOverride)
+}
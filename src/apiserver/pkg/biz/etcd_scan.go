@@ -0,0 +1,121 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package biz
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/storage"
+)
+
+// etcdScanConcurrency 按资源类型分别读取 etcd 时的最大并发数
+const etcdScanConcurrency = 4
+
+// ParseResourceTypes 解析逗号分隔的资源类型(如 route,upstream)，按 constant.ResourceTypeList 的顺序去重返回；
+// 为空时返回 nil，表示全部类型
+func ParseResourceTypes(raw string) ([]constant.APISIXResource, error) {
+	selected := make(map[constant.APISIXResource]bool)
+	for _, item := range strings.Split(raw, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		resourceType := constant.APISIXResource(item)
+		if !slices.Contains(constant.ResourceTypeList, resourceType) {
+			return nil, fmt.Errorf("不支持的资源类型: %s", item)
+		}
+		selected[resourceType] = true
+	}
+	if len(selected) == 0 {
+		return nil, nil
+	}
+	var resourceTypes []constant.APISIXResource
+	for _, resourceType := range constant.ResourceTypeList {
+		if selected[resourceType] {
+			resourceTypes = append(resourceTypes, resourceType)
+		}
+	}
+	return resourceTypes, nil
+}
+
+// ScannedResourceTypes 实际读取的资源类型，resourceTypes 为空时为全部类型
+func ScannedResourceTypes(resourceTypes []constant.APISIXResource) []constant.APISIXResource {
+	if len(resourceTypes) == 0 {
+		return slices.Clone(constant.ResourceTypeList)
+	}
+	return resourceTypes
+}
+
+// joinResourceTypes 将资源类型拼接为逗号分隔的字符串，与 ParseResourceTypes 互逆
+func joinResourceTypes(resourceTypes []constant.APISIXResource) string {
+	items := make([]string, 0, len(resourceTypes))
+	for _, resourceType := range resourceTypes {
+		items = append(items, resourceType.String())
+	}
+	return strings.Join(items, ",")
+}
+
+// listEtcdKeyValues 读取网关前缀下指定资源类型的 etcd 数据：resourceTypes 为空时读取整个网关前缀，
+// 否则按资源类型目录分别读取，各目录并发读取，并发数受 etcdScanConcurrency 限制；
+// 凭证位于 consumer 目录下，读取其中任一类型时返回整个 consumer 目录，由调用方按类型过滤
+func listEtcdKeyValues(
+	ctx context.Context,
+	etcdStore storage.StorageInterface,
+	prefix string,
+	resourceTypes []constant.APISIXResource,
+) ([]storage.KeyValuePair, error) {
+	prefix = strings.TrimSuffix(prefix, "/") + "/"
+	if len(resourceTypes) == 0 {
+		return etcdStore.List(ctx, prefix)
+	}
+	var dirs []string
+	for _, resourceType := range resourceTypes {
+		dir := constant.ResourceTypePrefixMap[resourceType]
+		if !slices.Contains(dirs, dir) {
+			dirs = append(dirs, dir)
+		}
+	}
+	results := make([][]storage.KeyValuePair, len(dirs))
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.SetLimit(etcdScanConcurrency)
+	for i, dir := range dirs {
+		group.Go(func() error {
+			kvList, err := etcdStore.List(groupCtx, prefix+dir+"/")
+			if err != nil {
+				return fmt.Errorf("list etcd %s failed: %w", dir, err)
+			}
+			results[i] = kvList
+			return nil
+		})
+	}
+	if err := group.Wait(); err != nil {
+		return nil, err
+	}
+	var kvList []storage.KeyValuePair
+	for _, result := range results {
+		kvList = append(kvList, result...)
+	}
+	return kvList, nil
+}
@@ -0,0 +1,94 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package biz
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/storage"
+)
+
+func TestParseResourceTypes(t *testing.T) {
+	resourceTypes, err := ParseResourceTypes("")
+	assert.NoError(t, err)
+	assert.Nil(t, resourceTypes)
+	assert.Equal(t, constant.ResourceTypeList, ScannedResourceTypes(resourceTypes))
+
+	// 按 ResourceTypeList 的顺序去重
+	resourceTypes, err = ParseResourceTypes(" upstream,route,,upstream ")
+	assert.NoError(t, err)
+	assert.Equal(t, []constant.APISIXResource{constant.Route, constant.Upstream}, resourceTypes)
+	assert.Equal(t, "route,upstream", joinResourceTypes(resourceTypes))
+
+	_, err = ParseResourceTypes("route,unknown")
+	assert.ErrorContains(t, err, "unknown")
+}
+
+func TestListEtcdKeyValues(t *testing.T) {
+	etcdStore, err := storage.NewEtcdStorage(gatewayInfo.EtcdConfig.EtcdConfig)
+	assert.NoError(t, err)
+	defer etcdStore.Close()
+	ctx := context.Background()
+	prefix := fmt.Sprintf("/etcd-scan-%d", time.Now().UnixNano())
+	kvs := map[string]string{
+		prefix + "/routes/r1":                     `{"id":"r1"}`,
+		prefix + "/upstreams/u1":                  `{"id":"u1"}`,
+		prefix + "/consumers/c1":                  `{"username":"c1"}`,
+		prefix + "/consumers/c1/credentials/cred": `{"id":"cred"}`,
+		prefix + "/ssls/s1":                       `{"id":"s1"}`,
+	}
+	for key, value := range kvs {
+		_, err := etcdStore.GetClient().Put(ctx, key, value)
+		assert.NoError(t, err)
+	}
+	defer func() {
+		for key := range kvs {
+			_, err := etcdStore.GetClient().Delete(ctx, key)
+			assert.NoError(t, err)
+		}
+	}()
+	keys := func(kvList []storage.KeyValuePair) []string {
+		var result []string
+		for _, kv := range kvList {
+			result = append(result, kv.Key)
+		}
+		return result
+	}
+
+	kvList, err := listEtcdKeyValues(ctx, etcdStore, prefix, nil)
+	assert.NoError(t, err)
+	assert.Len(t, kvList, len(kvs))
+
+	// 按类型目录读取，consumer 与凭证共用目录只读取一次
+	kvList, err = listEtcdKeyValues(ctx, etcdStore, prefix+"/", []constant.APISIXResource{
+		constant.Route, constant.Credential, constant.Consumer,
+	})
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{
+		prefix + "/routes/r1",
+		prefix + "/consumers/c1",
+		prefix + "/consumers/c1/credentials/cred",
+	}, keys(kvList))
+}
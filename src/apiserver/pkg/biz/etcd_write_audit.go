@@ -0,0 +1,188 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package biz
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/publisher"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+)
+
+// EtcdWriteAuditRetention etcd 写操作审计保留时长
+const EtcdWriteAuditRetention = 180 * 24 * time.Hour
+
+// readEtcdValues 读取 key 写入前的值，读取失败或 key 不存在时视为空
+func readEtcdValues(
+	ctx context.Context,
+	pub *publisher.EtcdPublisher,
+	ops []publisher.ResourceOperation,
+) map[string]string {
+	values := make(map[string]string, len(ops))
+	for _, op := range ops {
+		value, err := pub.Get(ctx, op.GetKey())
+		if err != nil {
+			continue
+		}
+		values[op.GetKey()], _ = value.(string)
+	}
+	return values
+}
+
+// recordEtcdWrites 记录发布流程中的 etcd 写操作，写操作失败时同样记录失败原因
+func recordEtcdWrites(
+	ctx context.Context,
+	writeOp constant.EtcdWriteOp,
+	prefix string,
+	ops []publisher.ResourceOperation,
+	prevValues map[string]string,
+	writeErr error,
+) {
+	// 发布请求被取消时仍需落库审计
+	ctx = context.WithoutCancel(ctx)
+	gatewayID := ginx.GetGatewayInfoFromContext(ctx).ID
+	message := ""
+	if writeErr != nil {
+		message = writeErr.Error()
+	}
+	audits := make([]*model.EtcdWriteAudit, 0, len(ops))
+	for _, op := range ops {
+		detail := dto.EtcdWriteAuditDetail{
+			PrevHash: hashEtcdValue(prevValues[op.GetKey()]),
+			Message:  message,
+		}
+		if writeOp == constant.EtcdWriteOpPut {
+			detail.NewHash = hashEtcdValue(string(op.Config))
+		}
+		compressed, err := compressEtcdWriteAuditDetail(detail)
+		if err != nil {
+			logging.ErrorFWithContext(ctx, "compress etcd write audit err: %s", err.Error())
+			continue
+		}
+		audits = append(audits, &model.EtcdWriteAudit{
+			GatewayID:     gatewayID,
+			Key:           prefix + "/" + op.GetKey(),
+			ResourceType:  op.Type,
+			ResourceID:    op.Key,
+			Op:            writeOp,
+			PublishTaskID: ginx.GetPublishTaskIDFromContext(ctx),
+			Success:       writeErr == nil,
+			Detail:        compressed,
+			BaseModel: model.BaseModel{
+				Creator: ginx.GetUserIDFromContext(ctx),
+				Updater: ginx.GetUserIDFromContext(ctx),
+			},
+		})
+	}
+	if len(audits) == 0 {
+		return
+	}
+	if err := repo.EtcdWriteAudit.WithContext(ctx).CreateInBatches(audits, 100); err != nil {
+		logging.ErrorFWithContext(ctx, "create etcd write audit err: %s", err.Error())
+		return
+	}
+	if err := CleanExpiredEtcdWriteAudits(ctx, gatewayID); err != nil {
+		logging.ErrorFWithContext(ctx, "clean expired etcd write audit err: %s", err.Error())
+	}
+}
+
+// hashEtcdValue 计算 etcd 值的 sha256，空值返回空字符串
+func hashEtcdValue(value string) string {
+	if value == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
+
+// compressEtcdWriteAuditDetail 序列化并 gzip 压缩审计详情
+func compressEtcdWriteAuditDetail(detail dto.EtcdWriteAuditDetail) ([]byte, error) {
+	data, err := json.Marshal(detail)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if _, err = writer.Write(data); err != nil {
+		return nil, err
+	}
+	if err = writer.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// DecodeEtcdWriteAuditDetail 解压审计详情
+func DecodeEtcdWriteAuditDetail(audit *model.EtcdWriteAudit) (*dto.EtcdWriteAuditDetail, error) {
+	reader, err := gzip.NewReader(bytes.NewReader(audit.Detail))
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	var detail dto.EtcdWriteAuditDetail
+	if err = json.Unmarshal(data, &detail); err != nil {
+		return nil, err
+	}
+	return &detail, nil
+}
+
+// ListPagedEtcdWriteAudits 按 key 或资源 id 分页查询 etcd 写操作审计
+func ListPagedEtcdWriteAudits(
+	ctx context.Context,
+	gatewayID int,
+	key string,
+	resourceID string,
+	page PageParam,
+) ([]*model.EtcdWriteAudit, int64, error) {
+	u := repo.EtcdWriteAudit
+	query := u.WithContext(ctx).Where(u.GatewayID.Eq(gatewayID))
+	if key != "" {
+		query = query.Where(u.Key.Eq(key))
+	}
+	if resourceID != "" {
+		query = query.Where(u.ResourceID.Eq(resourceID))
+	}
+	return query.Order(u.ID.Desc()).FindByPage(page.Offset, page.Limit)
+}
+
+// CleanExpiredEtcdWriteAudits 清理超过保留时长的 etcd 写操作审计
+func CleanExpiredEtcdWriteAudits(ctx context.Context, gatewayID int) error {
+	u := repo.EtcdWriteAudit
+	_, err := u.WithContext(ctx).Where(
+		u.GatewayID.Eq(gatewayID),
+		u.CreatedAt.Lt(time.Now().Add(-EtcdWriteAuditRetention)),
+	).Delete()
+	return err
+}
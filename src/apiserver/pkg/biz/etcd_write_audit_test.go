@@ -0,0 +1,113 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package biz
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/publisher"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+)
+
+func TestEtcdWriteAudit(t *testing.T) {
+	ctx := context.WithValue(gatewayCtx, constant.UserIDKey, "admin")
+	ctx = ginx.SetPublishTaskIDToContext(ctx, 42)
+	id := fmt.Sprintf("audit-upstream-%d", time.Now().UnixNano())
+	key := gatewayInfo.EtcdConfig.Prefix + "/upstreams/" + id
+	op := func(config string) []publisher.ResourceOperation {
+		return []publisher.ResourceOperation{{Key: id, Type: constant.Upstream, Config: []byte(config)}}
+	}
+	hash := func(value string) string {
+		sum := sha256.Sum256([]byte(value))
+		return hex.EncodeToString(sum[:])
+	}
+	first := `{"id":"` + id + `","type":"roundrobin","nodes":[{"host":"127.0.0.1","port":80,"weight":1}]}`
+	second := `{"id":"` + id + `","type":"roundrobin","nodes":[{"host":"127.0.0.2","port":80,"weight":1}]}`
+
+	assert.NoError(t, batchCreateEtcdResource(ctx, op(first)))
+	assert.NoError(t, batchCreateEtcdResource(ctx, op(second)))
+	assert.NoError(t, batchDeleteEtcdResource(ctx, constant.Upstream, []string{id}))
+
+	// 写操作失败同样记录
+	canceledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.Error(t, batchCreateEtcdResource(canceledCtx, op(first)))
+
+	audits, total, err := ListPagedEtcdWriteAudits(gatewayCtx, gatewayInfo.ID, key, "", PageParam{Offset: 0, Limit: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), total)
+	// 按 id 倒序返回
+	expected := []struct {
+		op       constant.EtcdWriteOp
+		success  bool
+		prevHash string
+		newHash  string
+	}{
+		{constant.EtcdWriteOpPut, false, "", hash(first)},
+		{constant.EtcdWriteOpDelete, true, hash(second), ""},
+		{constant.EtcdWriteOpPut, true, hash(first), hash(second)},
+		{constant.EtcdWriteOpPut, true, "", hash(first)},
+	}
+	for i, audit := range audits {
+		assert.Equal(t, expected[i].op, audit.Op)
+		assert.Equal(t, expected[i].success, audit.Success)
+		assert.Equal(t, id, audit.ResourceID)
+		assert.Equal(t, constant.Upstream, audit.ResourceType)
+		assert.Equal(t, 42, audit.PublishTaskID)
+		assert.Equal(t, "admin", audit.Creator)
+		detail, err := DecodeEtcdWriteAuditDetail(audit)
+		assert.NoError(t, err)
+		assert.Equal(t, expected[i].prevHash, detail.PrevHash)
+		assert.Equal(t, expected[i].newHash, detail.NewHash)
+		assert.Equal(t, !expected[i].success, detail.Message != "")
+	}
+
+	_, total, err = ListPagedEtcdWriteAudits(gatewayCtx, gatewayInfo.ID, "", id, PageParam{Offset: 0, Limit: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), total)
+	_, total, err = ListPagedEtcdWriteAudits(gatewayCtx, gatewayInfo.ID+1, key, "", PageParam{Offset: 0, Limit: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), total)
+}
+
+func TestCleanExpiredEtcdWriteAudits(t *testing.T) {
+	u := repo.EtcdWriteAudit
+	expired := &model.EtcdWriteAudit{GatewayID: gatewayInfo.ID, Key: "expired", Op: constant.EtcdWriteOpPut}
+	recent := &model.EtcdWriteAudit{GatewayID: gatewayInfo.ID, Key: "recent", Op: constant.EtcdWriteOpPut}
+	assert.NoError(t, u.WithContext(gatewayCtx).Create(expired, recent))
+	_, err := u.WithContext(gatewayCtx).Where(u.ID.Eq(expired.ID)).
+		UpdateSimple(u.CreatedAt.Value(time.Now().Add(-EtcdWriteAuditRetention - time.Hour)))
+	assert.NoError(t, err)
+
+	assert.NoError(t, CleanExpiredEtcdWriteAudits(gatewayCtx, gatewayInfo.ID))
+	_, err = u.WithContext(gatewayCtx).Where(u.ID.Eq(expired.ID)).First()
+	assert.Error(t, err)
+	_, err = u.WithContext(gatewayCtx).Where(u.ID.Eq(recent.ID)).First()
+	assert.NoError(t, err)
+}
@@ -21,8 +21,10 @@ package biz
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/tidwall/gjson"
@@ -30,8 +32,10 @@ import (
 	"gorm.io/gen"
 
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/base"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/database"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/storage"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
 )
@@ -52,6 +56,12 @@ var gatewayResourceModelNameList = []string{
 	model.StreamRoute{}.TableName(),
 	model.GatewaySyncData{}.TableName(),
 	model.GatewayReleaseVersion{}.TableName(),
+	model.GatewayDiscovery{}.TableName(),
+	model.GatewayOnboarding{}.TableName(),
+	model.Secret{}.TableName(),
+	model.Credential{}.TableName(),
+	model.ValidationCache{}.TableName(),
+	model.ValidationRuleset{}.TableName(),
 }
 
 // ListGateways 查询网关列表
@@ -71,9 +81,11 @@ func CreateGateway(ctx context.Context, gateway *model.Gateway) error {
 // UpdateGateway 更新网关
 func UpdateGateway(ctx context.Context, gateway model.Gateway) error {
 	u := repo.Gateway
+	// 更新前已经校验过新的 etcd 配置可以正常连接，重置 etcd 状态
+	gateway.EtcdStatus = constant.GatewayEtcdStatusNormal
 	_, err := u.WithContext(ctx).Where(u.ID.Eq(gateway.ID)).Select(
-		u.Name, u.Mode, u.Maintainers, u.Desc,
-		u.EtcdConfig, u.Token, u.Updater, u.ReadOnly,
+		u.Name, u.Mode, u.Maintainers, u.Desc, u.APISIXVersion,
+		u.EtcdConfig, u.Token, u.Updater, u.ReadOnly, u.AllowCustomVars, u.RetriesCheck, u.EtcdStatus,
 	).Updates(&gateway)
 	return err
 }
@@ -167,6 +179,80 @@ func ListGatewayResourceLabels(
 	return labels, nil
 }
 
+// GetAPISIXServerInfo 读取 etcd 前缀下 apisix 上报的 server_info，返回 apisix 版本与实例 ID，未上报时均为空
+func GetAPISIXServerInfo(
+	ctx context.Context,
+	etcdStore storage.StorageInterface,
+	prefix string,
+) (apisixVersion string, instanceID string, err error) {
+	res, err := etcdStore.List(ctx, fmt.Sprintf("%s/data_plane/server_info", prefix))
+	if err != nil && !errors.Is(err, storage.KeyNotFoundError) {
+		return "", "", err
+	}
+	if len(res) > 0 {
+		resData := gjson.Parse(res[0].Value)
+		instanceID = resData.Get("id").String()
+		apisixVersion = resData.Get("version").String()
+	}
+	return apisixVersion, instanceID, nil
+}
+
+// CheckGatewayEtcdConflict 检查 apisix 实例或 etcd 地址与前缀是否已在其他网关中注册
+func CheckGatewayEtcdConflict(
+	ctx context.Context,
+	gatewayID int,
+	etcdConfig base.EtcdConfig,
+	instanceID string,
+) error {
+	// 校验实例id是否存在
+	if instanceID != "" {
+		gateways, err := GetGatewayEtcdConfigList(ctx, "instance_id", instanceID)
+		if err != nil {
+			return err
+		}
+		// 排除自己
+		if len(gateways) > 0 && (gatewayID != 0 && gateways[0].ID != gatewayID) {
+			return fmt.Errorf(
+				"网关 apisix 实例[%s] 已在另一个网关 [%s] 中注册, 无法重复注册托管, 请联系该网关负责人 [%s] 添加权限", instanceID,
+				gateways[0].Name, strings.Join(gateways[0].Maintainers, ","))
+		}
+	}
+
+	// 校验 prefix相同的网关
+	gateways, err := GetGatewayEtcdConfigList(ctx, "prefix", etcdConfig.Prefix)
+	if err != nil {
+		return err
+	}
+	for _, gateway := range gateways {
+		// 编辑网关时校验去重需要排除自己
+		if gatewayID != 0 && gatewayID == gateway.ID {
+			continue
+		}
+		endpoints := gateway.EtcdConfig.Endpoint.Endpoints()
+		for _, storeEndpoint := range etcdConfig.Endpoint.Endpoints() {
+			for _, endpoint := range endpoints {
+				// 校验 endpoint 是否存在
+				cleanStoreEndpoint := strings.TrimPrefix(
+					strings.TrimPrefix(storeEndpoint, constant.HTTP),
+					constant.HTTPS,
+				)
+				cleanEndpoint := strings.TrimPrefix(
+					strings.TrimPrefix(endpoint, constant.HTTP),
+					constant.HTTPS,
+				)
+				if storeEndpoint != "" && endpoint != "" && cleanStoreEndpoint == cleanEndpoint {
+					return fmt.Errorf(
+						"etcd 地址[%s] 已在另一个网关 [%s] 中存在, 请勿重复提交",
+						endpoint,
+						gateway.Name,
+					)
+				}
+			}
+		}
+	}
+	return nil
+}
+
 // DeleteGateway 删除网关
 func DeleteGateway(ctx context.Context, gateway *model.Gateway) error {
 	err := repo.Q.Transaction(func(tx *repo.Query) error {
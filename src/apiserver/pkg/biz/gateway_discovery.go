@@ -0,0 +1,122 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package biz
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/schema"
+)
+
+// ListGatewayDiscoveries 查询网关启用的服务发现列表
+func ListGatewayDiscoveries(ctx context.Context, gatewayID int) ([]*model.GatewayDiscovery, error) {
+	u := repo.GatewayDiscovery
+	return u.WithContext(ctx).Where(u.GatewayID.Eq(gatewayID)).Order(u.Type).Find()
+}
+
+// GetGatewayDiscovery 查询网关服务发现详情
+func GetGatewayDiscovery(ctx context.Context, gatewayID int, id int) (*model.GatewayDiscovery, error) {
+	u := repo.GatewayDiscovery
+	return u.WithContext(ctx).Where(u.GatewayID.Eq(gatewayID), u.ID.Eq(id)).First()
+}
+
+// CreateGatewayDiscovery 创建网关服务发现
+func CreateGatewayDiscovery(ctx context.Context, discovery *model.GatewayDiscovery) error {
+	return repo.GatewayDiscovery.WithContext(ctx).Create(discovery)
+}
+
+// UpdateGatewayDiscovery 更新网关服务发现
+func UpdateGatewayDiscovery(ctx context.Context, discovery model.GatewayDiscovery) error {
+	u := repo.GatewayDiscovery
+	_, err := u.WithContext(ctx).Where(u.GatewayID.Eq(discovery.GatewayID), u.ID.Eq(discovery.ID)).Select(
+		u.Type,
+		u.Config,
+		u.Updater,
+	).Updates(discovery)
+	return err
+}
+
+// DeleteGatewayDiscovery 删除网关服务发现
+func DeleteGatewayDiscovery(ctx context.Context, gatewayID int, id int) error {
+	u := repo.GatewayDiscovery
+	_, err := u.WithContext(ctx).Where(u.GatewayID.Eq(gatewayID), u.ID.Eq(id)).Delete()
+	return err
+}
+
+// DuplicatedGatewayDiscoveryType 查询服务发现类型是否已启用 (不包括自己)
+func DuplicatedGatewayDiscoveryType(ctx context.Context, gatewayID int, id int, discoveryType string) bool {
+	u := repo.GatewayDiscovery
+	query := u.WithContext(ctx).Where(u.GatewayID.Eq(gatewayID), u.Type.Eq(discoveryType))
+	if id != 0 {
+		query = query.Where(u.ID.Neq(id))
+	}
+	count, err := query.Count()
+	if err != nil {
+		return false
+	}
+	return count == 0
+}
+
+// GetEnabledDiscoveryTypes 获取网关启用的服务发现类型
+func GetEnabledDiscoveryTypes(ctx context.Context, gatewayID int) []string {
+	discoveries, err := ListGatewayDiscoveries(ctx, gatewayID)
+	if err != nil {
+		logging.Errorf("list gateway discoveries failed, err: %v", err)
+	}
+	discoveryTypes := []string{}
+	for _, discovery := range discoveries {
+		discoveryTypes = append(discoveryTypes, discovery.Type)
+	}
+	return discoveryTypes
+}
+
+// ValidateUpstreamDiscoveryType 校验资源配置中的 discovery_type 是否已在网关中启用
+func ValidateUpstreamDiscoveryType(
+	ctx context.Context,
+	gatewayID int,
+	resourceType constant.APISIXResource,
+	config json.RawMessage,
+) error {
+	return schema.CheckUpstreamDiscoveryType(resourceType, config, GetEnabledDiscoveryTypes(ctx, gatewayID))
+}
+
+// GetUpstreamExamples 获取 upstream 配置示例，网关启用了服务发现时附带基于服务发现的示例
+func GetUpstreamExamples(ctx context.Context, gatewayID int) []map[string]interface{} {
+	examples := []map[string]interface{}{
+		{
+			"type":      "roundrobin",
+			"scheme":    "http",
+			"pass_host": "pass",
+			"nodes": []map[string]interface{}{
+				{"host": "127.0.0.1", "port": 80, "weight": 1},
+			},
+		},
+	}
+	for _, discoveryType := range GetEnabledDiscoveryTypes(ctx, gatewayID) {
+		if example := schema.GetDiscoveryExample(discoveryType); example != nil {
+			examples = append(examples, example)
+		}
+	}
+	return examples
+}
@@ -0,0 +1,70 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package biz
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/datatypes"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+)
+
+func TestGatewayDiscovery(t *testing.T) {
+	upstreamConfig := json.RawMessage(`{"discovery_type":"nacos","service_name":"svc"}`)
+
+	// 未启用任何服务发现
+	assert.Empty(t, GetEnabledDiscoveryTypes(gatewayCtx, gatewayInfo.ID))
+	assert.Error(t, ValidateUpstreamDiscoveryType(gatewayCtx, gatewayInfo.ID, constant.Upstream, upstreamConfig))
+	assert.Len(t, GetUpstreamExamples(gatewayCtx, gatewayInfo.ID), 1)
+
+	discovery := &model.GatewayDiscovery{
+		GatewayID: gatewayInfo.ID,
+		Type:      "nacos",
+		Config:    datatypes.JSON(`{"host":["http://127.0.0.1:8848"]}`),
+	}
+	assert.NoError(t, CreateGatewayDiscovery(gatewayCtx, discovery))
+	assert.False(t, DuplicatedGatewayDiscoveryType(gatewayCtx, gatewayInfo.ID, 0, "nacos"))
+	assert.True(t, DuplicatedGatewayDiscoveryType(gatewayCtx, gatewayInfo.ID, discovery.ID, "nacos"))
+
+	assert.Equal(t, []string{"nacos"}, GetEnabledDiscoveryTypes(gatewayCtx, gatewayInfo.ID))
+	assert.NoError(t, ValidateUpstreamDiscoveryType(gatewayCtx, gatewayInfo.ID, constant.Upstream, upstreamConfig))
+	examples := GetUpstreamExamples(gatewayCtx, gatewayInfo.ID)
+	assert.Len(t, examples, 2)
+	assert.Equal(t, "nacos", examples[1]["discovery_type"])
+
+	// 切换为 consul 后 nacos 不再可用
+	discovery.Type = "consul"
+	discovery.Config = datatypes.JSON(`{"servers":["http://127.0.0.1:8500"]}`)
+	assert.NoError(t, UpdateGatewayDiscovery(gatewayCtx, *discovery))
+	err := ValidateUpstreamDiscoveryType(gatewayCtx, gatewayInfo.ID, constant.Upstream, upstreamConfig)
+	assert.ErrorContains(t, err, "[consul]")
+
+	// 其他网关不可见
+	_, err = GetGatewayDiscovery(gatewayCtx, gatewayInfo.ID+1, discovery.ID)
+	assert.Error(t, err)
+
+	assert.NoError(t, DeleteGatewayDiscovery(gatewayCtx, gatewayInfo.ID, discovery.ID))
+	discoveries, err := ListGatewayDiscoveries(gatewayCtx, gatewayInfo.ID)
+	assert.NoError(t, err)
+	assert.Empty(t, discoveries)
+}
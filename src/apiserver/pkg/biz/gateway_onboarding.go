@@ -0,0 +1,399 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"gorm.io/datatypes"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/storage"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/version"
+)
+
+// onboardingEtcdTimeout 接入引导步骤访问 etcd 的超时时间
+const onboardingEtcdTimeout = 5 * time.Second
+
+// onboardingCheck 接入引导步骤的检查逻辑，返回是否通过、结果说明及步骤明细
+type onboardingCheck func(ctx context.Context, gateway *model.Gateway) (bool, string, any)
+
+// onboardingCheckMap 各接入引导步骤对应的检查逻辑
+var onboardingCheckMap = map[constant.OnboardingStep]onboardingCheck{
+	constant.OnboardingStepEtcdConnectivity: checkOnboardingEtcdConnectivity,
+	constant.OnboardingStepPrefixCollision:  checkOnboardingPrefixCollision,
+	constant.OnboardingStepForeignKeyScan:   checkOnboardingForeignKeys,
+	constant.OnboardingStepImportPreview:    checkOnboardingImportPreview,
+	constant.OnboardingStepVersionDetection: checkOnboardingVersion,
+}
+
+// CreateOnboardingGateway 以接入引导方式创建网关，网关在必要步骤完成前处于 onboarding 状态
+func CreateOnboardingGateway(ctx context.Context, gateway *model.Gateway) error {
+	gateway.Status = constant.GatewayStatusOnboarding
+	return repo.Q.Transaction(func(tx *repo.Query) error {
+		if err := tx.Gateway.WithContext(ctx).Create(gateway); err != nil {
+			return err
+		}
+		return tx.GatewayOnboarding.WithContext(ctx).Create(&model.GatewayOnboarding{
+			GatewayID: gateway.ID,
+			Steps:     datatypes.JSON("{}"),
+			BaseModel: model.BaseModel{
+				Creator: gateway.Creator,
+				Updater: gateway.Updater,
+			},
+		})
+	})
+}
+
+// GetGatewayOnboardingSummary 查询网关接入引导进度及就绪情况
+func GetGatewayOnboardingSummary(ctx context.Context, gateway *model.Gateway) (*dto.OnboardingSummary, error) {
+	_, results, err := getGatewayOnboarding(ctx, gateway.ID)
+	if err != nil {
+		return nil, err
+	}
+	return buildOnboardingSummary(gateway, results), nil
+}
+
+// RunOnboardingStep 执行接入引导步骤并保存结果，前序必要步骤未通过或豁免时不允许执行
+func RunOnboardingStep(
+	ctx context.Context,
+	gateway *model.Gateway,
+	step constant.OnboardingStep,
+) (*dto.OnboardingStepResult, error) {
+	check, ok := onboardingCheckMap[step]
+	if !ok {
+		return nil, fmt.Errorf("不支持的接入引导步骤: %s", step)
+	}
+	if gateway.Status != constant.GatewayStatusOnboarding {
+		return nil, errors.New("网关已启用, 无需执行接入引导")
+	}
+	onboarding, results, err := getGatewayOnboarding(ctx, gateway.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, prev := range constant.OnboardingStepList {
+		if prev == step {
+			break
+		}
+		if constant.OnboardingMandatoryStepMap[prev] && !onboardingStepResolved(results[prev]) {
+			return nil, fmt.Errorf("请先完成接入引导步骤: %s", prev)
+		}
+	}
+
+	passed, message, detail := check(ctx, gateway)
+	result := dto.OnboardingStepResult{
+		Step:      step,
+		Mandatory: constant.OnboardingMandatoryStepMap[step],
+		Status:    constant.OnboardingStepStatusPassed,
+		Message:   message,
+		CheckedAt: time.Now().Unix(),
+	}
+	if !passed {
+		result.Status = constant.OnboardingStepStatusFailed
+	}
+	if detail != nil {
+		if result.Detail, err = json.Marshal(detail); err != nil {
+			return nil, err
+		}
+	}
+	results[step] = result
+	if err := saveGatewayOnboarding(ctx, onboarding, results); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// WaiveOnboardingStep 豁免未通过的必要步骤，调用方需确认操作人为管理员
+func WaiveOnboardingStep(
+	ctx context.Context,
+	gateway *model.Gateway,
+	step constant.OnboardingStep,
+	reason string,
+) (*dto.OnboardingStepResult, error) {
+	if !constant.OnboardingMandatoryStepMap[step] {
+		return nil, fmt.Errorf("接入引导步骤 %s 不是必要步骤, 无需豁免", step)
+	}
+	if gateway.Status != constant.GatewayStatusOnboarding {
+		return nil, errors.New("网关已启用, 无需执行接入引导")
+	}
+	onboarding, results, err := getGatewayOnboarding(ctx, gateway.ID)
+	if err != nil {
+		return nil, err
+	}
+	result, ok := results[step]
+	if !ok {
+		result = pendingOnboardingStep(step)
+	}
+	if result.Status == constant.OnboardingStepStatusPassed {
+		return nil, fmt.Errorf("接入引导步骤 %s 已通过, 无需豁免", step)
+	}
+	result.Status = constant.OnboardingStepStatusWaived
+	result.WaivedBy = ginx.GetUserIDFromContext(ctx)
+	result.WaiveReason = reason
+	results[step] = result
+	if err := saveGatewayOnboarding(ctx, onboarding, results); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ActivateOnboardingGateway 必要步骤均已通过或豁免时启用网关
+func ActivateOnboardingGateway(ctx context.Context, gateway *model.Gateway) (*dto.OnboardingSummary, error) {
+	if gateway.Status != constant.GatewayStatusOnboarding {
+		return nil, errors.New("网关已启用, 无需执行接入引导")
+	}
+	_, results, err := getGatewayOnboarding(ctx, gateway.ID)
+	if err != nil {
+		return nil, err
+	}
+	summary := buildOnboardingSummary(gateway, results)
+	if !summary.Ready {
+		blockingSteps := make([]string, 0, len(summary.BlockingSteps))
+		for _, step := range summary.BlockingSteps {
+			blockingSteps = append(blockingSteps, string(step))
+		}
+		return nil, fmt.Errorf("网关接入引导未完成, 以下必要步骤未通过: %s", strings.Join(blockingSteps, ", "))
+	}
+
+	activated := *gateway
+	activated.Status = constant.GatewayStatusActive
+	activated.Updater = ginx.GetUserIDFromContext(ctx)
+	// 版本探测时读取到的实例 ID 写入网关配置，用于后续的重复注册校验
+	if result, ok := results[constant.OnboardingStepVersionDetection]; ok && len(result.Detail) > 0 {
+		var detail dto.OnboardingVersionDetail
+		if err := json.Unmarshal(result.Detail, &detail); err == nil && detail.InstanceID != "" {
+			activated.EtcdConfig.InstanceID = detail.InstanceID
+		}
+	}
+	u := repo.Gateway
+	_, err = u.WithContext(ctx).Where(u.ID.Eq(gateway.ID)).Select(u.Status, u.EtcdConfig, u.Updater).
+		Updates(&activated)
+	if err != nil {
+		return nil, err
+	}
+	summary.GatewayStatus = constant.GatewayStatusActive
+	return summary, nil
+}
+
+// ResetGatewayOnboarding 清空接入引导的步骤结果，网关 etcd 配置变更后需重新执行
+func ResetGatewayOnboarding(ctx context.Context, gatewayID int) error {
+	u := repo.GatewayOnboarding
+	_, err := u.WithContext(ctx).Where(u.GatewayID.Eq(gatewayID)).Updates(map[string]any{
+		"steps":   datatypes.JSON("{}"),
+		"updater": ginx.GetUserIDFromContext(ctx),
+	})
+	return err
+}
+
+// getGatewayOnboarding 查询网关接入引导记录及已执行步骤的结果
+func getGatewayOnboarding(
+	ctx context.Context,
+	gatewayID int,
+) (*model.GatewayOnboarding, map[constant.OnboardingStep]dto.OnboardingStepResult, error) {
+	u := repo.GatewayOnboarding
+	onboarding, err := u.WithContext(ctx).Where(u.GatewayID.Eq(gatewayID)).First()
+	if err != nil {
+		return nil, nil, err
+	}
+	results := make(map[constant.OnboardingStep]dto.OnboardingStepResult)
+	if len(onboarding.Steps) > 0 {
+		if err := json.Unmarshal(onboarding.Steps, &results); err != nil {
+			return nil, nil, err
+		}
+	}
+	return onboarding, results, nil
+}
+
+// saveGatewayOnboarding 保存接入引导步骤结果
+func saveGatewayOnboarding(
+	ctx context.Context,
+	onboarding *model.GatewayOnboarding,
+	results map[constant.OnboardingStep]dto.OnboardingStepResult,
+) error {
+	steps, err := json.Marshal(results)
+	if err != nil {
+		return err
+	}
+	u := repo.GatewayOnboarding
+	_, err = u.WithContext(ctx).Where(u.ID.Eq(onboarding.ID)).Updates(map[string]any{
+		"steps":   datatypes.JSON(steps),
+		"updater": ginx.GetUserIDFromContext(ctx),
+	})
+	return err
+}
+
+// buildOnboardingSummary 按引导顺序汇总各步骤结果，未执行的步骤为 pending
+func buildOnboardingSummary(
+	gateway *model.Gateway,
+	results map[constant.OnboardingStep]dto.OnboardingStepResult,
+) *dto.OnboardingSummary {
+	summary := &dto.OnboardingSummary{
+		GatewayID:     gateway.ID,
+		GatewayStatus: gateway.Status,
+		BlockingSteps: []constant.OnboardingStep{},
+		Steps:         make([]dto.OnboardingStepResult, 0, len(constant.OnboardingStepList)),
+	}
+	for _, step := range constant.OnboardingStepList {
+		result, ok := results[step]
+		if !ok {
+			result = pendingOnboardingStep(step)
+		}
+		if result.Mandatory && !onboardingStepResolved(result) {
+			summary.BlockingSteps = append(summary.BlockingSteps, step)
+		}
+		summary.Steps = append(summary.Steps, result)
+	}
+	summary.Ready = len(summary.BlockingSteps) == 0
+	return summary
+}
+
+// pendingOnboardingStep 未执行的步骤
+func pendingOnboardingStep(step constant.OnboardingStep) dto.OnboardingStepResult {
+	return dto.OnboardingStepResult{
+		Step:      step,
+		Mandatory: constant.OnboardingMandatoryStepMap[step],
+		Status:    constant.OnboardingStepStatusPending,
+	}
+}
+
+// onboardingStepResolved 步骤是否已通过或被豁免
+func onboardingStepResolved(result dto.OnboardingStepResult) bool {
+	return result.Status == constant.OnboardingStepStatusPassed || result.Status == constant.OnboardingStepStatusWaived
+}
+
+// readOnboardingServerInfo 读取网关 etcd 前缀下 apisix 上报的 server_info
+func readOnboardingServerInfo(ctx context.Context, gateway *model.Gateway) (string, string, error) {
+	etcdStore, err := storage.NewEtcdStorage(gateway.EtcdConfig.EtcdConfig)
+	if err != nil {
+		return "", "", err
+	}
+	defer etcdStore.Close()
+	ctx, cancel := context.WithTimeout(ctx, onboardingEtcdTimeout)
+	defer cancel()
+	return GetAPISIXServerInfo(ctx, etcdStore, gateway.EtcdConfig.Prefix)
+}
+
+// checkOnboardingEtcdConnectivity etcd 连通性测试
+func checkOnboardingEtcdConnectivity(ctx context.Context, gateway *model.Gateway) (bool, string, any) {
+	if _, _, err := readOnboardingServerInfo(ctx, gateway); err != nil {
+		return false, fmt.Sprintf("etcd 连接失败: %s", err.Error()), nil
+	}
+	return true, "etcd 连接正常", nil
+}
+
+// checkOnboardingPrefixCollision 检查 apisix 实例或 etcd 地址与前缀是否已被其他网关注册
+func checkOnboardingPrefixCollision(ctx context.Context, gateway *model.Gateway) (bool, string, any) {
+	_, instanceID, err := readOnboardingServerInfo(ctx, gateway)
+	if err != nil {
+		return false, fmt.Sprintf("读取 apisix 实例信息失败: %s", err.Error()), nil
+	}
+	if err := CheckGatewayEtcdConflict(ctx, gateway.ID, gateway.EtcdConfig.EtcdConfig, instanceID); err != nil {
+		return false, err.Error(), nil
+	}
+	return true, fmt.Sprintf("etcd 前缀 %s 未被其他网关使用", gateway.EtcdConfig.Prefix), nil
+}
+
+// checkOnboardingForeignKeys 扫描 etcd 中已有资源的关联资源是否存在
+func checkOnboardingForeignKeys(ctx context.Context, gateway *model.Gateway) (bool, string, any) {
+	ctx, cancel := context.WithTimeout(ctx, onboardingEtcdTimeout)
+	defer cancel()
+	resources, err := listEtcdResources(ctx, gateway, nil)
+	if err != nil {
+		return false, fmt.Sprintf("读取 etcd 资源失败: %s", err.Error()), nil
+	}
+	exists := func(resourceType constant.APISIXResource, id string) bool {
+		_, ok := resources[resourceType][id]
+		return ok
+	}
+	var total int
+	missing := []string{}
+	for _, resourceType := range constant.ResourceTypeList {
+		keys := make([]string, 0, len(resources[resourceType]))
+		for key := range resources[resourceType] {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		total += len(keys)
+		for _, key := range keys {
+			for _, msg := range missingReferences(resources[resourceType][key], exists) {
+				missing = append(missing, fmt.Sprintf("%s [id:%s] %s", resourceType, key, msg))
+			}
+		}
+	}
+	if len(missing) > 0 {
+		return false, fmt.Sprintf("etcd 中有 %d 处关联资源不存在", len(missing)), missing
+	}
+	return true, fmt.Sprintf("已扫描 etcd 中的 %d 个资源, 关联资源完整", total), nil
+}
+
+// checkOnboardingImportPreview 统计 etcd 中可导入编辑区的资源
+func checkOnboardingImportPreview(ctx context.Context, gateway *model.Gateway) (bool, string, any) {
+	ctx, cancel := context.WithTimeout(ctx, onboardingEtcdTimeout)
+	defer cancel()
+	resources, err := listEtcdResources(ctx, gateway, nil)
+	if err != nil {
+		return false, fmt.Sprintf("读取 etcd 资源失败: %s", err.Error()), nil
+	}
+	detail := dto.OnboardingImportPreviewDetail{Counts: make(map[constant.APISIXResource]int)}
+	for resourceType, items := range resources {
+		detail.Counts[resourceType] = len(items)
+		detail.Total += len(items)
+	}
+	return true, fmt.Sprintf("etcd 中共有 %d 个资源, 网关启用后可同步导入编辑区", detail.Total), detail
+}
+
+// checkOnboardingVersion 根据 apisix 上报的 server_info 探测版本，并与网关配置的版本比对
+func checkOnboardingVersion(ctx context.Context, gateway *model.Gateway) (bool, string, any) {
+	apisixVersion, instanceID, err := readOnboardingServerInfo(ctx, gateway)
+	if err != nil {
+		return false, fmt.Sprintf("读取 apisix 实例信息失败: %s", err.Error()), nil
+	}
+	if apisixVersion == "" {
+		return false, fmt.Sprintf(
+			"未在 %s/data_plane/server_info 下找到 apisix 实例信息, 请确认 etcd 前缀是否正确且 apisix 已启动",
+			gateway.EtcdConfig.Prefix,
+		), nil
+	}
+	detail := dto.OnboardingVersionDetail{
+		DetectedVersion:   apisixVersion,
+		ConfiguredVersion: gateway.GetAPISIXVersionX(),
+		InstanceID:        instanceID,
+	}
+	detail.SuggestedVersion, err = version.SuggestSupportedVersion(apisixVersion)
+	if err != nil {
+		return false, fmt.Sprintf("探测到 apisix 版本 %s, 暂无可支持的版本", apisixVersion), detail
+	}
+	if detail.SuggestedVersion != detail.ConfiguredVersion {
+		return false, fmt.Sprintf(
+			"探测到 apisix 版本 %s, 建议使用 %s, 当前网关配置为 %s",
+			apisixVersion, detail.SuggestedVersion, detail.ConfiguredVersion,
+		), detail
+	}
+	return true, fmt.Sprintf("探测到 apisix 版本 %s, 与网关配置一致", apisixVersion), detail
+}
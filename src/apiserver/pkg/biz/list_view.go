@@ -0,0 +1,119 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+)
+
+// listSummaryConfigFields 列表摘要视图中各资源类型从 config 中保留的字段
+var listSummaryConfigFields = map[constant.APISIXResource][]string{
+	constant.Route:    {"uri", "uris", "methods", "host", "hosts"},
+	constant.Upstream: {"type", "scheme", "nodes", "discovery_type", "service_name"},
+	constant.SSL:      {"snis", "validity_end"},
+}
+
+// summaryConfigExpr 构造从 config 中提取摘要字段的 SQL 表达式，mysql 与 sqlite 均支持该写法
+func summaryConfigExpr(resourceType constant.APISIXResource) string {
+	pairs := make([]string, 0, len(listSummaryConfigFields[resourceType]))
+	for _, name := range listSummaryConfigFields[resourceType] {
+		pairs = append(pairs, fmt.Sprintf("'%s', config->'$.%s'", name, name))
+	}
+	return "JSON_OBJECT(" + strings.Join(pairs, ", ") + ")"
+}
+
+// findSummaryByPage 分页查询资源摘要，config 仅包含摘要字段，由数据库完成 json 提取以减少传输量
+func findSummaryByPage[T any](
+	db *gorm.DB,
+	resourceType constant.APISIXResource,
+	page PageParam,
+) ([]*T, int64, error) {
+	var total int64
+	if err := db.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	stmt := &gorm.Statement{DB: db}
+	if err := stmt.Parse(new(T)); err != nil {
+		return nil, 0, err
+	}
+	columns := lo.Map(lo.Without(stmt.Schema.DBNames, "config"), func(name string, _ int) string {
+		return stmt.Quote(name)
+	})
+	columns = append(columns, summaryConfigExpr(resourceType)+" AS config")
+	var results []*T
+	err := db.Select(strings.Join(columns, ", ")).Offset(page.Offset).Limit(page.Limit).Find(&results).Error
+	return results, total, err
+}
+
+// ListPagedRouteSummaries 分页查询路由摘要，config 仅包含 uris/methods/hosts 等字段
+func ListPagedRouteSummaries(
+	ctx context.Context,
+	param map[string]interface{},
+	label map[string][]string,
+	status []string,
+	name string,
+	updater string,
+	path string,
+	method string,
+	serviceID string,
+	upstreamID string,
+	orderBy string,
+	page PageParam,
+) ([]*model.Route, int64, error) {
+	query := routeListQuery(ctx, param, label, status, name, updater, path, method, serviceID, upstreamID, orderBy)
+	return findSummaryByPage[model.Route](query.UnderlyingDB(), constant.Route, page)
+}
+
+// ListPagedUpstreamSummaries 分页查询 upstream 摘要，config 仅包含 type/scheme/nodes 等字段
+func ListPagedUpstreamSummaries(
+	ctx context.Context,
+	param map[string]interface{},
+	label map[string][]string,
+	status []string,
+	name string,
+	updater string,
+	orderBy string,
+	page PageParam,
+) ([]*model.Upstream, int64, error) {
+	query := upstreamListQuery(ctx, param, label, status, name, updater, orderBy)
+	return findSummaryByPage[model.Upstream](query.UnderlyingDB(), constant.Upstream, page)
+}
+
+// ListPagedSSLSummaries 分页查询 ssl 摘要，config 仅包含 snis/validity_end 字段
+func ListPagedSSLSummaries(
+	ctx context.Context,
+	param map[string]interface{},
+	label map[string][]string,
+	status []string,
+	name string,
+	updater string,
+	orderBy string,
+	page PageParam,
+) ([]*model.SSL, int64, error) {
+	query := sslListQuery(ctx, param, label, status, name, updater, orderBy)
+	return findSummaryByPage[model.SSL](query.UnderlyingDB(), constant.SSL, page)
+}
@@ -0,0 +1,140 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package biz
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+	"gorm.io/datatypes"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/data"
+)
+
+func TestListPagedRouteSummaries(t *testing.T) {
+	route := data.Route1WithNoRelationResource(gatewayInfo, constant.ResourceStatusCreateDraft)
+	route.Name = fmt.Sprintf("summary-%d", time.Now().UnixNano())
+	assert.NoError(t, CreateRoute(gatewayCtx, *route))
+
+	param := map[string]interface{}{"gateway_id": gatewayInfo.ID, "id": route.ID}
+	page := PageParam{Offset: 0, Limit: 10}
+	full, total, err := ListPagedRoutes(gatewayCtx, param, nil, []string{""}, "", "", "", "", "", "", "", page)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	summary, total, err := ListPagedRouteSummaries(
+		gatewayCtx, param, nil, []string{""}, "", "", "", "", "", "", "", page)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+
+	assert.Equal(t, route.ID, summary[0].ID)
+	assert.Equal(t, route.Name, summary[0].Name)
+	assert.Equal(t, constant.ResourceStatusCreateDraft, summary[0].Status)
+	assert.JSONEq(t, `["/get"]`, gjson.GetBytes(summary[0].Config, "uris").Raw)
+	assert.JSONEq(t, `["GET"]`, gjson.GetBytes(summary[0].Config, "methods").Raw)
+	assert.False(t, gjson.GetBytes(summary[0].Config, "upstream").Exists())
+	assert.Less(t, len(summary[0].Config), len(full[0].Config))
+	t.Logf("route config size: full=%d summary=%d", len(full[0].Config), len(summary[0].Config))
+}
+
+func TestListPagedUpstreamAndSSLSummaries(t *testing.T) {
+	upstream := data.Upstream1WithNoRelation(gatewayInfo, constant.ResourceStatusCreateDraft)
+	upstream.Name = fmt.Sprintf("summary-%d", time.Now().UnixNano())
+	upstream.Config = datatypes.JSON(`{"type":"chash","hash_on":"consumer","scheme":"https",` +
+		`"nodes":{"127.0.0.1:80":1,"127.0.0.2:80":1}}`)
+	assert.NoError(t, CreateUpstream(gatewayCtx, *upstream))
+	upstreams, total, err := ListPagedUpstreamSummaries(gatewayCtx,
+		map[string]interface{}{"gateway_id": gatewayInfo.ID, "id": upstream.ID},
+		nil, []string{""}, "", "", "", PageParam{Offset: 0, Limit: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.Equal(t, "https", gjson.GetBytes(upstreams[0].Config, "scheme").String())
+	assert.Len(t, gjson.GetBytes(upstreams[0].Config, "nodes").Map(), 2)
+
+	ssl := data.SSL1(gatewayInfo, constant.ResourceStatusCreateDraft)
+	ssl.Name = fmt.Sprintf("summary-%d", time.Now().UnixNano())
+	assert.NoError(t, CreateSSL(gatewayCtx, ssl))
+	ssls, total, err := ListPagedSSLSummaries(gatewayCtx,
+		map[string]interface{}{"gateway_id": gatewayInfo.ID, "id": ssl.ID},
+		nil, []string{""}, "", "", "", PageParam{Offset: 0, Limit: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.JSONEq(t, `["www.baidu.com"]`, gjson.GetBytes(ssls[0].Config, "snis").Raw)
+	// 证书及私钥不会出现在摘要中
+	assert.False(t, gjson.GetBytes(ssls[0].Config, "cert").Exists())
+	assert.False(t, gjson.GetBytes(ssls[0].Config, "key").Exists())
+}
+
+func TestListPagedRouteServiceGroups(t *testing.T) {
+	prefix := fmt.Sprintf("group-%d", time.Now().UnixNano())
+	service := data.Service1WithNoRelation(gatewayInfo, constant.ResourceStatusSuccess)
+	service.Name = prefix + "-service"
+	assert.NoError(t, CreateService(gatewayCtx, *service))
+
+	newRoute := func(suffix string, serviceID string, status constant.ResourceStatus) *model.Route {
+		route := data.Route1WithNoRelationResource(gatewayInfo, status)
+		route.Name = prefix + "-" + suffix
+		route.ServiceID = serviceID
+		if serviceID != "" {
+			route.Config, _ = sjson.SetBytes(route.Config, "service_id", serviceID)
+		}
+		return route
+	}
+	draftRoute := newRoute("draft", service.ID, constant.ResourceStatusCreateDraft)
+	draftRoute.Config, _ = sjson.SetBytes(draftRoute.Config, "labels.team", prefix)
+	disabledRoute := newRoute("disabled", service.ID, constant.ResourceStatusSuccess)
+	disabledRoute.Config, _ = sjson.SetBytes(disabledRoute.Config, "status", 0)
+	unboundRoute := newRoute("unbound", "", constant.ResourceStatusSuccess)
+	for _, route := range []*model.Route{draftRoute, disabledRoute, unboundRoute} {
+		assert.NoError(t, CreateRoute(gatewayCtx, *route))
+	}
+
+	param := map[string]interface{}{"gateway_id": gatewayInfo.ID}
+	listGroups := func(label map[string][]string, page PageParam) ([]*dto.RouteServiceGroup, int64) {
+		groups, total, err := ListPagedRouteServiceGroups(
+			gatewayCtx, param, label, []string{""}, prefix, "", "", "", "", "", page)
+		assert.NoError(t, err)
+		return groups, total
+	}
+	groups, total := listGroups(nil, PageParam{Offset: 0, Limit: 10})
+	assert.Equal(t, int64(2), total)
+	assert.Equal(t, []*dto.RouteServiceGroup{
+		{ServiceID: constant.EmptyAssociationFilter, Unbound: true, RouteCount: 1},
+		{ServiceID: service.ID, ServiceName: service.Name, RouteCount: 2, UnpublishedCount: 1, DisabledCount: 1},
+	}, groups)
+
+	// 分页以分组为单位
+	groups, total = listGroups(nil, PageParam{Offset: 1, Limit: 1})
+	assert.Equal(t, int64(2), total)
+	assert.Len(t, groups, 1)
+	assert.Equal(t, service.ID, groups[0].ServiceID)
+
+	// 标签过滤只统计匹配的路由
+	groups, total = listGroups(map[string][]string{"team": {prefix}}, PageParam{Offset: 0, Limit: 10})
+	assert.Equal(t, int64(1), total)
+	assert.Equal(t, []*dto.RouteServiceGroup{
+		{ServiceID: service.ID, ServiceName: service.Name, RouteCount: 1, UnpublishedCount: 1},
+	}, groups)
+}
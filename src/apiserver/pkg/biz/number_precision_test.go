@@ -0,0 +1,102 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
+	"gorm.io/datatypes"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/storage"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/data"
+)
+
+// 19 位整数及超出 float64 精度的小数
+const (
+	preciseInteger = "1690000000123456789"
+	preciseFloat   = "0.12345678901234567890123"
+)
+
+func TestPublishAndExportPreserveNumbers(t *testing.T) {
+	route := data.Route1WithNoRelationResource(gatewayInfo, constant.ResourceStatusCreateDraft)
+	route.Name = fmt.Sprintf("number-precision-%d", time.Now().UnixNano())
+	route.Config = datatypes.JSON(`{"uris":["/number-precision"],"upstream":{"type":"roundrobin",` +
+		`"nodes":{"127.0.0.1:80":1}},"plugins":{` +
+		`"limit-count":{"count":` + preciseInteger + `,"time_window":60,"key":"remote_addr"},` +
+		`"limit-req":{"rate":` + preciseFloat + `,"burst":0,"key":"remote_addr"}}}`)
+	assert.NoError(t, CreateRoute(gatewayCtx, *route))
+	assert.NoError(t, PublishRoutes(gatewayCtx, []string{route.ID}))
+
+	assertNumbers := func(config []byte) {
+		assert.Equal(t, preciseInteger, gjson.GetBytes(config, "plugins.limit-count.count").Raw)
+		assert.Equal(t, preciseFloat, gjson.GetBytes(config, "plugins.limit-req.rate").Raw)
+	}
+
+	etcdStore, err := storage.NewEtcdStorage(gatewayInfo.EtcdConfig.EtcdConfig)
+	assert.NoError(t, err)
+	defer etcdStore.Close()
+	key := gatewayInfo.EtcdConfig.Prefix + "/routes/" + route.ID
+	resp, err := etcdStore.GetClient().Get(context.Background(), key)
+	assert.NoError(t, err)
+	assert.Len(t, resp.Kvs, 1)
+	assertNumbers(resp.Kvs[0].Value)
+
+	exporter, err := NewUnifyOp(gatewayInfo, false)
+	assert.NoError(t, err)
+	resources, err := exporter.ExportEtcdResources(gatewayCtx)
+	assert.NoError(t, err)
+	var exported bool
+	for _, resource := range resources {
+		if resource.ID == route.ID {
+			exported = true
+			assertNumbers(resource.Config)
+		}
+	}
+	assert.True(t, exported)
+
+	standalone, _, err := ExportStandaloneYAML(gatewayCtx, true)
+	assert.NoError(t, err)
+	assert.Contains(t, string(standalone), "count: "+preciseInteger)
+	assert.Contains(t, string(standalone), "rate: "+preciseFloat)
+
+	// 清理数据，避免影响其他用例的同步统计
+	_, err = etcdStore.GetClient().Delete(context.Background(), key)
+	assert.NoError(t, err)
+	assert.NoError(t, BatchDeleteRoutes(gatewayCtx, []string{route.ID}))
+}
+
+func TestDiffConfigFieldsPreserveNumbers(t *testing.T) {
+	// 仅在 float64 精度之外不同的数字也视为变更，变更前后的值原样输出
+	deltas := diffConfigFields(json.RawMessage(`{"count":1690000000123456789,"name":"r1"}`),
+		json.RawMessage(`{"count":1690000000123456788,"name":"r1"}`))
+	assert.Len(t, deltas, 1)
+	assert.Equal(t, "count", deltas[0].Path)
+	assert.Equal(t, "1690000000123456789", string(deltas[0].Before))
+	assert.Equal(t, "1690000000123456788", string(deltas[0].After))
+
+	assert.Empty(t, diffConfigFields(json.RawMessage(`{"rate":`+preciseFloat+`}`),
+		json.RawMessage(`{"rate":`+preciseFloat+`}`)))
+}
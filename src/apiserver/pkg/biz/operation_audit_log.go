@@ -31,6 +31,7 @@ import (
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/redact"
 )
 
 // FuncUpdateResourceStatusByID ...
@@ -60,14 +61,14 @@ func AddBatchAuditLog(ctx context.Context, operationType constant.OperationType,
 		dataBefore = append(dataBefore, model.BatchOperationData{
 			ID:     resource.ID,
 			Status: resource.Status,
-			Config: json.RawMessage(resource.Config),
+			Config: redact.Config(resourceType, json.RawMessage(resource.Config)),
 		})
 		if operationType != constant.OperationTypeDelete {
 			dataAfter = append(dataAfter, model.BatchOperationData{
 				ID:     resource.ID,
 				Status: resourceIDStatusAfterMap[resource.ID],
 				// 配置没有改变
-				Config: json.RawMessage(resource.Config),
+				Config: redact.Config(resourceType, json.RawMessage(resource.Config)),
 			})
 		}
 	}
@@ -175,6 +176,18 @@ func AddDeleteResourceByIDAuditLog(ctx context.Context, resourceType constant.AP
 	if err != nil {
 		return err
 	}
+	// 批量删除不触发 model 钩子，在此记录删除版本
+	db := repo.ResourceRevision.WithContext(ctx).UnderlyingDB()
+	if ginx.GetTx(ctx) != nil {
+		db = ginx.GetTx(ctx).ResourceRevision.WithContext(ctx).UnderlyingDB()
+	}
+	for _, resource := range resourceList {
+		err = model.AddResourceRevision(db, resource.GatewayID, resource.ID, ginx.GetUserIDFromContext(ctx),
+			constant.OperationTypeDelete, resourceType, resource.Config, nil)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -194,7 +207,7 @@ func WrapBatchRevertResourceAddAuditLog(ctx context.Context, resourceType consta
 		dataBefore = append(dataBefore, model.BatchOperationData{
 			ID:     resource.ID,
 			Status: resource.Status,
-			Config: json.RawMessage(resource.Config),
+			Config: redact.Config(resourceType, json.RawMessage(resource.Config)),
 		})
 	}
 
@@ -202,7 +215,7 @@ func WrapBatchRevertResourceAddAuditLog(ctx context.Context, resourceType consta
 		dataAfter = append(dataAfter, model.BatchOperationData{
 			ID:     resource.ID,
 			Status: resource.Status,
-			Config: json.RawMessage(resource.Config),
+			Config: redact.Config(resourceType, json.RawMessage(resource.Config)),
 		})
 	}
 
@@ -20,8 +20,10 @@ package biz
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/pkg/errors"
+	"github.com/tidwall/gjson"
 	"gorm.io/gen/field"
 
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
@@ -143,6 +145,30 @@ func ExistsPluginConfig(ctx context.Context, id string) bool {
 	return true
 }
 
+// CheckPluginConfigInUse 返回引用了该 PluginConfig 的路由 id
+func CheckPluginConfigInUse(pluginConfigID string, routes []json.RawMessage) []string {
+	var routeIDs []string
+	for _, route := range routes {
+		if gjson.GetBytes(route, "plugin_config_id").String() == pluginConfigID {
+			routeIDs = append(routeIDs, gjson.GetBytes(route, "id").String())
+		}
+	}
+	return routeIDs
+}
+
+// GetPluginConfigReferences 查询网关下引用了该 PluginConfig 的路由 id
+func GetPluginConfigReferences(ctx context.Context, pluginConfigID string) ([]string, error) {
+	routes, err := ListRoutes(ctx, ginx.GetGatewayInfoFromContext(ctx).ID)
+	if err != nil {
+		return nil, err
+	}
+	configs := make([]json.RawMessage, 0, len(routes))
+	for _, route := range routes {
+		configs = append(configs, json.RawMessage(route.Config))
+	}
+	return CheckPluginConfigInUse(pluginConfigID, configs), nil
+}
+
 // BatchDeletePluginConfigs 批量删除 PluginConfig 并添加审计日志
 func BatchDeletePluginConfigs(ctx context.Context, ids []string) error {
 	u := repo.PluginConfig
@@ -0,0 +1,48 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package biz
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckPluginConfigInUse(t *testing.T) {
+	routes := []json.RawMessage{
+		json.RawMessage(`{"id":"r1","uri":"/a","plugin_config_id":"pc1"}`),
+		json.RawMessage(`{"id":"r2","uri":"/b","plugin_config_id":"pc2"}`),
+		json.RawMessage(`{"id":"r3","uri":"/c","plugin_config_id":"pc1"}`),
+		json.RawMessage(`{"id":"r4","uri":"/d"}`),
+	}
+	tests := []struct {
+		name           string
+		pluginConfigID string
+		want           []string
+	}{
+		{name: "in use", pluginConfigID: "pc1", want: []string{"r1", "r3"}},
+		{name: "unused", pluginConfigID: "pc3", want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, CheckPluginConfigInUse(tt.pluginConfigID, routes))
+		})
+	}
+}
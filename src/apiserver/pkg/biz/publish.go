@@ -21,13 +21,17 @@ package biz
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/samber/lo"
 	"github.com/tidwall/sjson"
 
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
 	entity "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/apisix"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/publisher"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/status"
@@ -39,8 +43,26 @@ import (
 // FuncPublishResource ...
 type FuncPublishResource func(ctx context.Context, resourceIDs []string) error
 
-// PublishResource 资源发布
+// PublishResource 资源发布，发布过程记录为发布任务，服务退出时未完成的任务会在重启后恢复
 func PublishResource(ctx context.Context, resourceType constant.APISIXResource, resourceIDs []string) error {
+	done, ok := shutdownManager.Track()
+	if !ok {
+		return ErrServiceDraining
+	}
+	defer done()
+	task, err := CreatePublishTask(ctx, resourceType, resourceIDs)
+	if err != nil {
+		logging.ErrorFWithContext(ctx, "create publish task err: %s", err.Error())
+		return fmt.Errorf("创建发布任务失败: %w", err)
+	}
+	ctx = ginx.SetPublishTaskIDToContext(ctx, task.ID)
+	err = publishResource(ctx, resourceType, resourceIDs)
+	FinishPublishTask(ctx, task.ID, err)
+	return err
+}
+
+// publishResource 按资源类型发布资源
+func publishResource(ctx context.Context, resourceType constant.APISIXResource, resourceIDs []string) error {
 	var err error
 	// 发布资源
 	switch resourceType {
@@ -52,6 +74,8 @@ func PublishResource(ctx context.Context, resourceType constant.APISIXResource,
 		err = WrapPublishResource(ctx, resourceType, resourceIDs, PublishGlobalRules)
 	case constant.Consumer:
 		err = WrapPublishResource(ctx, resourceType, resourceIDs, PublishConsumers)
+	case constant.Credential:
+		err = WrapPublishResource(ctx, resourceType, resourceIDs, PublishCredentials)
 	case constant.ConsumerGroup:
 		err = WrapPublishResource(ctx, resourceType, resourceIDs, PublishConsumerGroups)
 	case constant.PluginConfig:
@@ -66,15 +90,17 @@ func PublishResource(ctx context.Context, resourceType constant.APISIXResource,
 		err = WrapPublishResource(ctx, resourceType, resourceIDs, PublishSSLs)
 	case constant.StreamRoute:
 		err = WrapPublishResource(ctx, resourceType, resourceIDs, PublishStreamRoutes)
+	case constant.Secret:
+		err = WrapPublishResource(ctx, resourceType, resourceIDs, PublishSecrets)
 	}
-	if err != nil {
+	if err != nil || isPublishDryRun(ctx) {
 		return err
 	}
 	// 主动同步一下资源
 	goroutinex.GoroutineWithRecovery(ctx, func() {
 		// 1s 后同步资源
 		time.Sleep(time.Second * 1)
-		_, err = SyncResources(ginx.CloneCtx(ctx), resourceType)
+		_, err = SyncResources(ginx.CloneCtx(ctx), nil)
 		if err != nil {
 			logging.Errorf("sync resources failed, err: %v", err)
 		}
@@ -109,7 +135,7 @@ func WrapPublishResource(ctx context.Context, resourceType constant.APISIXResour
 		resourceStatusMap[resource.ID] = nextStatus
 	}
 	err = publishFunc(ctx, resourceIDs)
-	if err != nil {
+	if err != nil || isPublishDryRun(ctx) {
 		return err
 	}
 	err = AddBatchAuditLog(ctx, constant.OperationTypePublish, resourceType, resourceList, resourceStatusMap)
@@ -120,29 +146,20 @@ func WrapPublishResource(ctx context.Context, resourceType constant.APISIXResour
 	return nil
 }
 
-// PublishAllResource 资源一键发布
+// PublishAllResource 资源一键发布，未关闭的变更集中的资源需通过变更集发布，这里跳过
 func PublishAllResource(ctx context.Context, gatewayID int) error {
+	heldResourceIDs, err := GetHeldResourceIDs(ctx, gatewayID)
+	if err != nil {
+		return err
+	}
 	for _, resourceType := range constant.ResourceTypeList {
-		resources, err := QueryResource(ctx, resourceType,
-			map[string]interface{}{
-				"gateway_id": gatewayID,
-				"status": []constant.ResourceStatus{
-					constant.ResourceStatusCreateDraft,
-					constant.ResourceStatusUpdateDraft,
-					constant.ResourceStatusDeleteDraft,
-				},
-			}, "")
+		resourceIDs, err := publishableResourceIDs(ctx, gatewayID, resourceType, heldResourceIDs)
 		if err != nil {
-			logging.ErrorFWithContext(ctx, "%s query err: %s", resourceType, err.Error())
-			return fmt.Errorf("%s 查询错误: %w", constant.ResourceTypeMap[resourceType], err)
+			return err
 		}
-		if len(resources) == 0 {
+		if len(resourceIDs) == 0 {
 			continue
 		}
-		resourceIDs := make([]string, 0)
-		for _, resource := range resources {
-			resourceIDs = append(resourceIDs, resource.ID)
-		}
 		err = PublishResource(ctx, resourceType, resourceIDs)
 		if err != nil {
 			return err
@@ -151,6 +168,36 @@ func PublishAllResource(ctx context.Context, gatewayID int) error {
 	return nil
 }
 
+// publishableResourceIDs 一键发布时某一类型待发布的资源 ID，跳过未关闭的变更集中的资源
+func publishableResourceIDs(
+	ctx context.Context,
+	gatewayID int,
+	resourceType constant.APISIXResource,
+	heldResourceIDs ChangeSetResourceIDs,
+) ([]string, error) {
+	resources, err := QueryResource(ctx, resourceType,
+		map[string]interface{}{
+			"gateway_id": gatewayID,
+			"status": []constant.ResourceStatus{
+				constant.ResourceStatusCreateDraft,
+				constant.ResourceStatusUpdateDraft,
+				constant.ResourceStatusDeleteDraft,
+			},
+		}, "")
+	if err != nil {
+		logging.ErrorFWithContext(ctx, "%s query err: %s", resourceType, err.Error())
+		return nil, fmt.Errorf("%s 查询错误: %w", constant.ResourceTypeMap[resourceType], err)
+	}
+	resourceIDs := make([]string, 0)
+	for _, resource := range resources {
+		if heldResourceIDs.Contains(resourceType, resource.ID) {
+			continue
+		}
+		resourceIDs = append(resourceIDs, resource.ID)
+	}
+	return resourceIDs, nil
+}
+
 // PublishRoutes 路由发布
 func PublishRoutes(ctx context.Context, routeIDs []string) error {
 	routes, err := QueryRoutes(ctx, map[string]interface{}{"id": routeIDs})
@@ -309,6 +356,10 @@ func PublishConsumers(ctx context.Context, consumerIDs []string) error {
 			deleteConsumerIDs = append(deleteConsumerIDs, consumer.ID)
 			continue
 		}
+		// 导入时未携带凭证的 consumer 需先生成凭证，避免占位值作为凭证生效
+		if pending := PendingCredentialPaths(json.RawMessage(consumer.Config)); len(pending) > 0 {
+			return fmt.Errorf("消费者 %s 的凭证字段 %s 尚未生成", consumer.Username, strings.Join(pending, ", "))
+		}
 		addConsumerIDs = append(addConsumerIDs, consumer.ID)
 	}
 	if len(deleteConsumerIDs) > 0 {
@@ -326,6 +377,41 @@ func PublishConsumers(ctx context.Context, consumerIDs []string) error {
 	return nil
 }
 
+// PublishCredentials 发布 credential
+func PublishCredentials(ctx context.Context, credentialIDs []string) error {
+	credentials, err := QueryCredentials(ctx, map[string]interface{}{"id": credentialIDs})
+	if err != nil {
+		logging.ErrorFWithContext(ctx, "credentials query err: %s", err.Error())
+		return fmt.Errorf("凭证查询错误: %w", err)
+	}
+	if len(credentials) == 0 {
+		logging.ErrorFWithContext(ctx, "no credentials found for the specified credentialIDs %v", credentialIDs)
+		return fmt.Errorf("未找到指定的凭证资源 IDs %v", credentialIDs)
+	}
+	var deleteCredentialIDs []string
+	var addCredentialIDs []string
+	for _, credential := range credentials {
+		if credential.Status == constant.ResourceStatusDeleteDraft {
+			deleteCredentialIDs = append(deleteCredentialIDs, credential.ID)
+			continue
+		}
+		addCredentialIDs = append(addCredentialIDs, credential.ID)
+	}
+	if len(deleteCredentialIDs) > 0 {
+		err = deleteCredentials(ctx, deleteCredentialIDs)
+		if err != nil {
+			return err
+		}
+	}
+	if len(addCredentialIDs) > 0 {
+		err = PutCredentials(ctx, addCredentialIDs)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // PublishConsumerGroups 发布 consumerGroup
 func PublishConsumerGroups(ctx context.Context, consumerGroupIDs []string) error {
 	consumerGroups, err := QueryConsumerGroups(ctx, map[string]interface{}{"id": consumerGroupIDs})
@@ -475,6 +561,45 @@ func PublishProtos(ctx context.Context, protoIDs []string) error {
 	return nil
 }
 
+// PublishSecrets 发布 Secret
+func PublishSecrets(ctx context.Context, secretIDs []string) error {
+	secrets, err := QuerySecrets(ctx, map[string]interface{}{"id": secretIDs})
+	if err != nil {
+		logging.ErrorFWithContext(ctx, "secrets query err: %s", err.Error())
+		return fmt.Errorf("secrets 查询错误: %w", err)
+	}
+	if len(secrets) == 0 {
+		logging.ErrorFWithContext(
+			ctx,
+			"no secrets found for the specified secretIDs %v",
+			secretIDs,
+		)
+		return fmt.Errorf("未找到指定的 secrets 资源 IDs %v", secretIDs)
+	}
+	var deleteSecretIDs []string
+	var addSecretIDs []string
+	for _, secret := range secrets {
+		if secret.Status == constant.ResourceStatusDeleteDraft {
+			deleteSecretIDs = append(deleteSecretIDs, secret.ID)
+			continue
+		}
+		addSecretIDs = append(addSecretIDs, secret.ID)
+	}
+	if len(deleteSecretIDs) > 0 {
+		err = deleteSecrets(ctx, deleteSecretIDs)
+		if err != nil {
+			return err
+		}
+	}
+	if len(addSecretIDs) > 0 {
+		err = PutSecrets(ctx, addSecretIDs)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // PublishSSLs 发布 ssls
 func PublishSSLs(ctx context.Context, sslIDs []string) error {
 	ssls, err := QuerySSL(ctx, map[string]interface{}{"id": sslIDs})
@@ -548,6 +673,10 @@ func PublishStreamRoutes(ctx context.Context, streamRouteIDs []string) error {
 // getEtcdPublisher 获取 publisher
 func getEtcdPublisher(ctx context.Context) (*publisher.EtcdPublisher, error) {
 	gatewayInfo := ginx.GetGatewayInfoFromContext(ctx)
+	// 接入引导未完成的网关 etcd 配置尚未经过校验，不允许写入
+	if gatewayInfo.Status == constant.GatewayStatusOnboarding {
+		return nil, errors.New("网关尚未完成接入引导, 不允许发布")
+	}
 	pub, err := publisher.NewEtcdPublisher(ctx, gatewayInfo)
 	if err != nil {
 		return nil, err
@@ -560,7 +689,14 @@ func batchCreateEtcdResource(ctx context.Context, ops []publisher.ResourceOperat
 	if err != nil {
 		return err
 	}
-	return etcdPublisher.BatchCreate(ctx, ops)
+	if plan := publishPlanFromContext(ctx); plan != nil {
+		plan.recordPuts(ctx, etcdPublisher, ops)
+		return nil
+	}
+	prevValues := readEtcdValues(ctx, etcdPublisher, ops)
+	err = etcdPublisher.BatchCreate(ctx, ops)
+	recordEtcdWrites(ctx, constant.EtcdWriteOpPut, etcdPublisher.Prefix, ops, prevValues, err)
+	return err
 }
 
 func batchDeleteEtcdResource(ctx context.Context, resourceType constant.APISIXResource, ids []string) error {
@@ -568,14 +704,25 @@ func batchDeleteEtcdResource(ctx context.Context, resourceType constant.APISIXRe
 	if err != nil {
 		return err
 	}
+	keyOverrides, err := getEtcdKeyOverrides(ctx, resourceType, ids)
+	if err != nil {
+		return err
+	}
 	var ops []publisher.ResourceOperation
 	for _, id := range ids {
 		ops = append(ops, publisher.ResourceOperation{
-			Type: resourceType,
-			Key:  id,
+			Type:        resourceType,
+			Key:         id,
+			KeyOverride: keyOverrides[id],
 		})
 	}
+	if plan := publishPlanFromContext(ctx); plan != nil {
+		plan.recordDeletes(ctx, pub, ops)
+		return nil
+	}
+	prevValues := readEtcdValues(ctx, pub, ops)
 	err = pub.BatchDelete(ctx, ops)
+	recordEtcdWrites(ctx, constant.EtcdWriteOpDelete, pub.Prefix, ops, prevValues, err)
 	if err != nil {
 		logging.ErrorFWithContext(ctx, "etcd deletes associated data err: %s", err.Error())
 		return fmt.Errorf("etcd 删除关联数据错误: %w", err)
@@ -583,6 +730,26 @@ func batchDeleteEtcdResource(ctx context.Context, resourceType constant.APISIXRe
 	return nil
 }
 
+// updatePublishedStatus 变更发布后的资源状态，发布预演时不修改数据库
+func updatePublishedStatus(
+	ctx context.Context,
+	resourceType constant.APISIXResource, ids []string, status constant.ResourceStatus,
+) error {
+	if isPublishDryRun(ctx) {
+		return nil
+	}
+	return BatchUpdateResourceStatus(ctx, resourceType, ids, status)
+}
+
+// deleteResourceRecords 删除已从 etcd 删除的资源的数据库数据，发布预演时只记录删除的资源
+func deleteResourceRecords(ctx context.Context, resourceType constant.APISIXResource, ids []string) error {
+	if plan := publishPlanFromContext(ctx); plan != nil {
+		plan.markDeleted(resourceType, ids)
+		return nil
+	}
+	return BatchDeleteResourceWithAuditLog(ctx, resourceType, ids)
+}
+
 // deleteRoutes 删除 route
 func deleteRoutes(ctx context.Context, routeIDs []string) error {
 	// 先删除 etcd 的数据
@@ -591,7 +758,7 @@ func deleteRoutes(ctx context.Context, routeIDs []string) error {
 		return err
 	}
 	// 删除数据库数据
-	return BatchDeleteRoutes(ctx, routeIDs)
+	return deleteResourceRecords(ctx, constant.Route, routeIDs)
 }
 
 // deleteServices 删除 service
@@ -601,6 +768,7 @@ func deleteServices(ctx context.Context, serviceIDs []string) error {
 	if err != nil {
 		return err
 	}
+	routes = withoutPlannedDeletes(ctx, constant.Route, routes)
 	if len(routes) > 0 {
 		return fmt.Errorf("服务不可删除, 存在关联的路由资源 %v", routes)
 	}
@@ -609,6 +777,7 @@ func deleteServices(ctx context.Context, serviceIDs []string) error {
 	if err != nil {
 		return err
 	}
+	streamRoutes = withoutPlannedDeletes(ctx, constant.StreamRoute, streamRoutes)
 	if len(streamRoutes) > 0 {
 		return fmt.Errorf("服务不可删除, 存在关联的 streamRoute 资源 %v", streamRoutes)
 	}
@@ -618,7 +787,7 @@ func deleteServices(ctx context.Context, serviceIDs []string) error {
 		return err
 	}
 	// 删除数据库数据
-	return BatchDeleteServices(ctx, serviceIDs)
+	return deleteResourceRecords(ctx, constant.Service, serviceIDs)
 }
 
 // deleteUpstreams 删除 upstream
@@ -628,6 +797,7 @@ func deleteUpstreams(ctx context.Context, upstreamIDs []string) error {
 	if err != nil {
 		return err
 	}
+	services = withoutPlannedDeletes(ctx, constant.Service, services)
 	if len(services) > 0 {
 		return fmt.Errorf("上游不可删除, 存在关联的服务资源 %v", services)
 	}
@@ -636,6 +806,7 @@ func deleteUpstreams(ctx context.Context, upstreamIDs []string) error {
 	if err != nil {
 		return err
 	}
+	routes = withoutPlannedDeletes(ctx, constant.Route, routes)
 	if len(routes) > 0 {
 		return fmt.Errorf("上游不可删除, 存在关联的路由资源 %v", routes)
 	}
@@ -644,6 +815,7 @@ func deleteUpstreams(ctx context.Context, upstreamIDs []string) error {
 	if err != nil {
 		return err
 	}
+	streamRoutes = withoutPlannedDeletes(ctx, constant.StreamRoute, streamRoutes)
 	if len(streamRoutes) > 0 {
 		return fmt.Errorf("上游不可删除, 存在关联的 streamRoute 资源 %v", streamRoutes)
 	}
@@ -655,7 +827,7 @@ func deleteUpstreams(ctx context.Context, upstreamIDs []string) error {
 	}
 
 	// 删除数据库数据
-	return BatchDeleteUpstreams(ctx, upstreamIDs)
+	return deleteResourceRecords(ctx, constant.Upstream, upstreamIDs)
 }
 
 // deletePluginConfigs 删除 pluginConfig
@@ -665,6 +837,7 @@ func deletePluginConfigs(ctx context.Context, pluginConfigIDs []string) error {
 	if err != nil {
 		return err
 	}
+	routes = withoutPlannedDeletes(ctx, constant.Route, routes)
 	if len(routes) > 0 {
 		return fmt.Errorf("插件组不可删除, 存在关联的路由资源 %v", routes)
 	}
@@ -676,7 +849,7 @@ func deletePluginConfigs(ctx context.Context, pluginConfigIDs []string) error {
 	}
 
 	// 删除数据库数据
-	return BatchDeletePluginConfigs(ctx, pluginConfigIDs)
+	return deleteResourceRecords(ctx, constant.PluginConfig, pluginConfigIDs)
 }
 
 // deletePluginMetadatas 删除 pluginMetadata
@@ -688,19 +861,37 @@ func deletePluginMetadatas(ctx context.Context, pluginMetadataIDs []string) erro
 	}
 
 	// 删除数据库数据
-	return BatchDeletePluginMetadatas(ctx, pluginMetadataIDs)
+	return deleteResourceRecords(ctx, constant.PluginMetadata, pluginMetadataIDs)
 }
 
 // deleteConsumers 删除 consumer
 func deleteConsumers(ctx context.Context, consumerIDs []string) error {
+	credentials, err := QueryCredentials(ctx, map[string]interface{}{"consumer_id": consumerIDs})
+	if err != nil {
+		return err
+	}
+	credentials = withoutPlannedDeletes(ctx, constant.Credential, credentials)
+	if len(credentials) > 0 {
+		return fmt.Errorf("消费者不可删除, 存在关联的凭证资源 %s", credentials[0].ID)
+	}
 	// 先删除 etcd 的数据
-	err := batchDeleteEtcdResource(ctx, constant.Consumer, consumerIDs)
+	err = batchDeleteEtcdResource(ctx, constant.Consumer, consumerIDs)
 	if err != nil {
 		return err
 	}
 
 	// 删除数据库数据
-	return BatchDeleteConsumers(ctx, consumerIDs)
+	return deleteResourceRecords(ctx, constant.Consumer, consumerIDs)
+}
+
+// deleteCredentials 删除 credential
+func deleteCredentials(ctx context.Context, credentialIDs []string) error {
+	// 先删除 etcd 的数据
+	err := batchDeleteEtcdResource(ctx, constant.Credential, credentialIDs)
+	if err != nil {
+		return err
+	}
+	return deleteResourceRecords(ctx, constant.Credential, credentialIDs)
 }
 
 // deleteConsumerGroups 删除 consumerGroup
@@ -709,6 +900,7 @@ func deleteConsumerGroups(ctx context.Context, consumerGroupIDs []string) error
 	if err != nil {
 		return err
 	}
+	consumers = withoutPlannedDeletes(ctx, constant.Consumer, consumers)
 	if len(consumers) > 0 {
 		return fmt.Errorf("消费者组不可删除, 存在关联的消费者资源 %v", consumers)
 	}
@@ -718,7 +910,7 @@ func deleteConsumerGroups(ctx context.Context, consumerGroupIDs []string) error
 		return err
 	}
 
-	return BatchDeleteConsumerGroups(ctx, consumerGroupIDs)
+	return deleteResourceRecords(ctx, constant.ConsumerGroup, consumerGroupIDs)
 }
 
 // deleteGlobalRules 删除 globalRule
@@ -729,7 +921,7 @@ func deleteGlobalRules(ctx context.Context, globalRuleIDs []string) error {
 		return err
 	}
 	// 删除数据库数据
-	return BatchDeleteGlobalRules(ctx, globalRuleIDs)
+	return deleteResourceRecords(ctx, constant.GlobalRule, globalRuleIDs)
 }
 
 // deleteProtos 删除 Proto
@@ -739,7 +931,17 @@ func deleteProtos(ctx context.Context, protoIDs []string) error {
 	if err != nil {
 		return err
 	}
-	return BatchDeleteProtos(ctx, protoIDs)
+	return deleteResourceRecords(ctx, constant.Proto, protoIDs)
+}
+
+// deleteSecrets 删除 Secret
+func deleteSecrets(ctx context.Context, secretIDs []string) error {
+	// 先删除 etcd 的数据
+	err := batchDeleteEtcdResource(ctx, constant.Secret, secretIDs)
+	if err != nil {
+		return err
+	}
+	return deleteResourceRecords(ctx, constant.Secret, secretIDs)
 }
 
 // deleteSSLs 删除 SSL
@@ -748,6 +950,7 @@ func deleteSSLs(ctx context.Context, sslIDs []string) error {
 	if err != nil {
 		return err
 	}
+	ssls = withoutPlannedDeletes(ctx, constant.Upstream, ssls)
 	if len(ssls) > 0 {
 		return fmt.Errorf("ssl 不可删除, 存在关联的上游资源 %v", ssls)
 	}
@@ -756,7 +959,7 @@ func deleteSSLs(ctx context.Context, sslIDs []string) error {
 	if err != nil {
 		return err
 	}
-	return BatchDeleteSSL(ctx, sslIDs)
+	return deleteResourceRecords(ctx, constant.SSL, sslIDs)
 }
 
 // deleteStreamRoutes 删除 StreamRoute
@@ -766,7 +969,69 @@ func deleteStreamRoutes(ctx context.Context, streamRouteIDs []string) error {
 	if err != nil {
 		return err
 	}
-	return BatchDeleteStreamRoutes(ctx, streamRouteIDs)
+	return deleteResourceRecords(ctx, constant.StreamRoute, streamRouteIDs)
+}
+
+// etcdConfig 资源写入 etcd 的配置：put* 及需要与 etcd 中的配置对比的场景(发布预览、配置漂移等)共用同一转换
+func etcdConfig(resourceType constant.APISIXResource, resource model.ResourceCommonModel) ([]byte, error) {
+	baseInfo := entity.BaseInfo{
+		ID:         resource.ID,
+		CreateTime: resource.CreatedAt.Unix(),
+		UpdateTime: resource.UpdatedAt.Unix(),
+	}
+	switch resourceType {
+	case constant.Route:
+		baseInfo.Name = resource.GetName(resourceType)
+	case constant.PluginMetadata:
+		// 插件元数据以插件名作为 id
+		baseInfo.ID = resource.GetName(resourceType)
+	case constant.Consumer, constant.ConsumerGroup:
+		// 不带 id
+		baseInfo.ID = nil
+	}
+	config := []byte(resource.Config)
+	var err error
+	// 未关联上游的服务不合并基础信息
+	if resourceType != constant.Service || resource.GetUpstreamID() != "" {
+		baseConfig, _ := json.Marshal(baseInfo)
+		config, err = jsonx.MergeJson(config, baseConfig)
+		if err != nil {
+			return nil, err
+		}
+	}
+	switch resourceType {
+	case constant.Route, constant.Service:
+		// 内联 upstream 的 map 形式 nodes 统一以数组形式写入 etcd
+		return entity.FormatNodesConfig(config, "upstream.nodes")
+	case constant.StreamRoute:
+		// 需要去除 name，labels
+		config, _ = sjson.DeleteBytes(config, "name")
+		config, _ = sjson.DeleteBytes(config, "labels")
+		return entity.FormatNodesConfig(config, "upstream.nodes")
+	case constant.Upstream:
+		// map 形式的 nodes 统一以数组形式写入 etcd
+		return entity.FormatNodesConfig(config, "nodes")
+	case constant.PluginConfig, constant.GlobalRule, constant.Proto, constant.Credential:
+		// 需要去除 name
+		config, _ = sjson.DeleteBytes(config, "name")
+	case constant.Consumer:
+		config, _ = sjson.DeleteBytes(config, "id")
+	case constant.ConsumerGroup:
+		// 需要去除 id，name
+		config, _ = sjson.DeleteBytes(config, "id")
+		config, _ = sjson.DeleteBytes(config, "name")
+	case constant.SSL:
+		// 需要去除name/validity_start/validity_end
+		config, _ = sjson.DeleteBytes(config, "name")
+		config, _ = sjson.DeleteBytes(config, "validity_start")
+		config, _ = sjson.DeleteBytes(config, "validity_end")
+	case constant.Secret:
+		// 需要去除 name，且 apisix 要求 id 为 {manager}/{id}
+		config, _ = sjson.DeleteBytes(config, "name")
+		manager := model.SecretManagerFromEtcdKey(resource.EtcdKeyOverride)
+		return sjson.SetBytes(config, "id", string(manager)+"/"+resource.ID)
+	}
+	return config, nil
 }
 
 // putRoutes 发布路由
@@ -784,12 +1049,6 @@ func putRoutes(ctx context.Context, routeIDs []string) error {
 	var pluginConfigIDs []string
 	var routeOps []publisher.ResourceOperation
 	for _, route := range routes {
-		baseInfo := entity.BaseInfo{
-			ID:         route.ID,
-			Name:       route.Name,
-			CreateTime: route.CreatedAt.Unix(),
-			UpdateTime: route.UpdatedAt.Unix(),
-		}
 		if route.ServiceID != "" {
 			serviceIDs = append(serviceIDs, route.ServiceID)
 		}
@@ -799,15 +1058,21 @@ func putRoutes(ctx context.Context, routeIDs []string) error {
 		if route.PluginConfigID != "" {
 			pluginConfigIDs = append(pluginConfigIDs, route.PluginConfigID)
 		}
-		baseConfig, _ := json.Marshal(baseInfo)
-		route.Config, err = jsonx.MergeJson(route.Config, baseConfig)
+		route.Config, err = etcdConfig(constant.Route, route.ResourceCommonModel)
+		if err != nil {
+			return err
+		}
+		// 临时路由绑定 lease，到期后由 etcd 自动删除
+		ttl, err := getRouteLeaseTTL(ctx, route)
 		if err != nil {
 			return err
 		}
 		routeOps = append(routeOps, publisher.ResourceOperation{
-			Key:    route.ID,
-			Config: json.RawMessage(route.Config),
-			Type:   constant.Route,
+			Key:         route.ID,
+			KeyOverride: route.EtcdKeyOverride,
+			Config:      json.RawMessage(route.Config),
+			Type:        constant.Route,
+			TTL:         ttl,
 		})
 	}
 	// 发布 upstream
@@ -837,7 +1102,7 @@ func putRoutes(ctx context.Context, routeIDs []string) error {
 		return err
 	}
 	// 变更资源状态为发布成功
-	if err = BatchUpdateResourceStatus(
+	if err = updatePublishedStatus(
 		ctx, constant.Route, routeIDs, constant.ResourceStatusSuccess); err != nil {
 		logging.ErrorFWithContext(ctx, "routes status change err: %s", err.Error())
 		return fmt.Errorf("路由发布错误: %w", err)
@@ -857,23 +1122,18 @@ func putServices(ctx context.Context, serviceIDs []string) error {
 	var upstreamIDs []string
 	var serviceOps []publisher.ResourceOperation
 	for _, service := range services {
-		baseInfo := entity.BaseInfo{
-			ID:         service.ID,
-			CreateTime: service.CreatedAt.Unix(),
-			UpdateTime: service.UpdatedAt.Unix(),
-		}
 		if service.UpstreamID != "" {
 			upstreamIDs = append(upstreamIDs, service.UpstreamID)
-			baseConfig, _ := json.Marshal(baseInfo)
-			service.Config, err = jsonx.MergeJson(service.Config, baseConfig)
-			if err != nil {
-				return err
-			}
+		}
+		service.Config, err = etcdConfig(constant.Service, service.ResourceCommonModel)
+		if err != nil {
+			return err
 		}
 		serviceOps = append(serviceOps, publisher.ResourceOperation{
-			Key:    service.ID,
-			Config: json.RawMessage(service.Config),
-			Type:   constant.Service,
+			Key:         service.ID,
+			KeyOverride: service.EtcdKeyOverride,
+			Config:      json.RawMessage(service.Config),
+			Type:        constant.Service,
 		})
 	}
 	// 发布 upstream
@@ -890,7 +1150,7 @@ func putServices(ctx context.Context, serviceIDs []string) error {
 	}
 
 	// 变更资源状态为发布成功
-	if err = BatchUpdateResourceStatus(
+	if err = updatePublishedStatus(
 		ctx, constant.Service, serviceIDs, constant.ResourceStatusSuccess); err != nil {
 		logging.ErrorFWithContext(ctx, "services status change err: %s", err.Error())
 		return fmt.Errorf("服务发布错误: %w", err)
@@ -910,13 +1170,7 @@ func putUpstreams(ctx context.Context, upstreamIDs []string) error {
 	var upstreamOps []publisher.ResourceOperation
 	var sslIDs []string
 	for _, upstream := range upstreams {
-		baseInfo := entity.BaseInfo{
-			ID:         upstream.ID,
-			CreateTime: upstream.CreatedAt.Unix(),
-			UpdateTime: upstream.UpdatedAt.Unix(),
-		}
-		baseConfig, _ := json.Marshal(baseInfo)
-		upstream.Config, err = jsonx.MergeJson(upstream.Config, baseConfig)
+		upstream.Config, err = etcdConfig(constant.Upstream, upstream.ResourceCommonModel)
 		if err != nil {
 			return err
 		}
@@ -924,9 +1178,10 @@ func putUpstreams(ctx context.Context, upstreamIDs []string) error {
 			sslIDs = append(sslIDs, upstream.GetSSLID())
 		}
 		upstreamOps = append(upstreamOps, publisher.ResourceOperation{
-			Key:    upstream.ID,
-			Config: json.RawMessage(upstream.Config),
-			Type:   constant.Upstream,
+			Key:         upstream.ID,
+			KeyOverride: upstream.EtcdKeyOverride,
+			Config:      json.RawMessage(upstream.Config),
+			Type:        constant.Upstream,
 		})
 	}
 	if len(sslIDs) > 0 {
@@ -940,7 +1195,7 @@ func putUpstreams(ctx context.Context, upstreamIDs []string) error {
 		return err
 	}
 	// 变更资源状态为发布成功
-	if err = BatchUpdateResourceStatus(
+	if err = updatePublishedStatus(
 		ctx, constant.Upstream, upstreamIDs, constant.ResourceStatusSuccess); err != nil {
 		logging.ErrorFWithContext(ctx, "upstreams status change err: %s", err.Error())
 		return fmt.Errorf("上游发布错误: %w", err)
@@ -960,23 +1215,15 @@ func putPluginConfigs(ctx context.Context, pluginConfigIDs []string) error {
 	}
 	var pluginConfigOps []publisher.ResourceOperation
 	for _, pluginConfig := range pluginConfigs {
-		baseInfo := entity.BaseInfo{
-			ID:         pluginConfig.ID,
-			CreateTime: pluginConfig.CreatedAt.Unix(),
-			UpdateTime: pluginConfig.UpdatedAt.Unix(),
-		}
-		baseConfig, _ := json.Marshal(baseInfo)
-		pluginConfig.Config, err = jsonx.MergeJson(pluginConfig.Config, baseConfig)
-		// 需要去除 name
-		pluginConfig.Config, _ = sjson.DeleteBytes(pluginConfig.Config, "name")
-
+		pluginConfig.Config, err = etcdConfig(constant.PluginConfig, pluginConfig.ResourceCommonModel)
 		if err != nil {
 			return err
 		}
 		pluginConfigOps = append(pluginConfigOps, publisher.ResourceOperation{
-			Key:    pluginConfig.ID,
-			Config: json.RawMessage(pluginConfig.Config),
-			Type:   constant.PluginConfig,
+			Key:         pluginConfig.ID,
+			KeyOverride: pluginConfig.EtcdKeyOverride,
+			Config:      json.RawMessage(pluginConfig.Config),
+			Type:        constant.PluginConfig,
 		})
 	}
 
@@ -986,7 +1233,7 @@ func putPluginConfigs(ctx context.Context, pluginConfigIDs []string) error {
 		return err
 	}
 	// 变更资源状态为发布成功
-	if err = BatchUpdateResourceStatus(
+	if err = updatePublishedStatus(
 		ctx, constant.PluginConfig, pluginConfigIDs, constant.ResourceStatusSuccess); err != nil {
 		logging.ErrorFWithContext(ctx, "pluginConfigs status change err: %s", err.Error())
 		return fmt.Errorf("插件组发布错误: %w", err)
@@ -1010,20 +1257,15 @@ func putPluginMetadatas(ctx context.Context, pluginMetadataIDs []string) error {
 	}
 	var pluginMetadataOps []publisher.ResourceOperation
 	for _, pluginMetadata := range pluginMetadatas {
-		baseInfo := entity.BaseInfo{
-			ID:         pluginMetadata.Name, // pluginMetadata.Name 必须是 pluginName
-			CreateTime: pluginMetadata.CreatedAt.Unix(),
-			UpdateTime: pluginMetadata.UpdatedAt.Unix(),
-		}
-		baseConfig, _ := json.Marshal(baseInfo)
-		pluginMetadata.Config, err = jsonx.MergeJson(pluginMetadata.Config, baseConfig)
+		pluginMetadata.Config, err = etcdConfig(constant.PluginMetadata, pluginMetadata.ResourceCommonModel)
 		if err != nil {
 			return err
 		}
 		pluginMetadataOps = append(pluginMetadataOps, publisher.ResourceOperation{
-			Key:    pluginMetadata.Name,
-			Config: json.RawMessage(pluginMetadata.Config),
-			Type:   constant.PluginMetadata,
+			Key:         pluginMetadata.Name,
+			KeyOverride: pluginMetadata.EtcdKeyOverride,
+			Config:      json.RawMessage(pluginMetadata.Config),
+			Type:        constant.PluginMetadata,
 		})
 	}
 	// 先创建 etcd 的数据
@@ -1032,7 +1274,7 @@ func putPluginMetadatas(ctx context.Context, pluginMetadataIDs []string) error {
 		return err
 	}
 	// 变更资源状态为发布成功
-	if err = BatchUpdateResourceStatus(
+	if err = updatePublishedStatus(
 		ctx, constant.PluginMetadata, pluginMetadataIDs, constant.ResourceStatusSuccess); err != nil {
 		logging.ErrorFWithContext(ctx, "pluginMetadatas status change err: %s", err.Error())
 		return fmt.Errorf("插件元数据发布错误: %w", err)
@@ -1053,24 +1295,18 @@ func putConsumers(ctx context.Context, consumerIDs []string) error {
 	var consumerOps []publisher.ResourceOperation
 	var consumerGroupIDs []string
 	for _, consumer := range consumers {
-		baseInfo := entity.BaseInfo{
-			CreateTime: consumer.CreatedAt.Unix(),
-			UpdateTime: consumer.UpdatedAt.Unix(),
-		}
 		if consumer.GroupID != "" {
 			consumerGroupIDs = append(consumerGroupIDs, consumer.GroupID)
 		}
-		baseConfig, _ := json.Marshal(baseInfo)
-		consumer.Config, err = jsonx.MergeJson(consumer.Config, baseConfig)
-		// 需要去除 name
-		consumer.Config, _ = sjson.DeleteBytes(consumer.Config, "id")
+		consumer.Config, err = etcdConfig(constant.Consumer, consumer.ResourceCommonModel)
 		if err != nil {
 			return err
 		}
 		consumerOps = append(consumerOps, publisher.ResourceOperation{
-			Key:    consumer.ID,
-			Config: json.RawMessage(consumer.Config),
-			Type:   constant.Consumer,
+			Key:         consumer.ID,
+			KeyOverride: consumer.EtcdKeyOverride,
+			Config:      json.RawMessage(consumer.Config),
+			Type:        constant.Consumer,
 		})
 	}
 
@@ -1087,7 +1323,7 @@ func putConsumers(ctx context.Context, consumerIDs []string) error {
 		return err
 	}
 	// 变更资源状态为发布成功
-	if err = BatchUpdateResourceStatus(
+	if err = updatePublishedStatus(
 		ctx, constant.Consumer, consumerIDs, constant.ResourceStatusSuccess); err != nil {
 		logging.ErrorFWithContext(ctx, "consumers status change err: %s", err.Error())
 		return fmt.Errorf("消费者发布错误: %w", err)
@@ -1095,6 +1331,75 @@ func putConsumers(ctx context.Context, consumerIDs []string) error {
 	return nil
 }
 
+// PutCredentials ...
+func PutCredentials(ctx context.Context, credentialIDs []string) error {
+	credentials, err := QueryCredentials(ctx, map[string]interface{}{"id": credentialIDs})
+	if err != nil {
+		return err
+	}
+	if len(credentials) == 0 {
+		logging.ErrorFWithContext(ctx, "no credentials found for the specified credentialIDs %v", credentialIDs)
+		return fmt.Errorf("未找到指定的凭证资源 IDs %v", credentialIDs)
+	}
+	var credentialOps []publisher.ResourceOperation
+	var consumerIDs []string
+	for _, credential := range credentials {
+		consumerIDs = append(consumerIDs, credential.ConsumerID)
+		credential.Config, err = etcdConfig(constant.Credential, credential.ResourceCommonModel)
+		if err != nil {
+			return err
+		}
+		credentialOps = append(credentialOps, publisher.ResourceOperation{
+			Key:         credential.ID,
+			KeyOverride: credential.EtcdKeyOverride,
+			Config:      json.RawMessage(credential.Config),
+			Type:        constant.Credential,
+		})
+	}
+
+	// 凭证依赖所属的 consumer，尚未发布的 consumer 需要先发布
+	if err = putCredentialConsumers(ctx, lo.Uniq(consumerIDs)); err != nil {
+		return err
+	}
+
+	// 先创建 etcd 的数据
+	err = batchCreateEtcdResource(ctx, credentialOps)
+	if err != nil {
+		return err
+	}
+	// 变更资源状态为发布成功
+	if err = updatePublishedStatus(
+		ctx, constant.Credential, credentialIDs, constant.ResourceStatusSuccess); err != nil {
+		logging.ErrorFWithContext(ctx, "credentials status change err: %s", err.Error())
+		return fmt.Errorf("凭证发布错误: %w", err)
+	}
+	return nil
+}
+
+// putCredentialConsumers 发布凭证所属的 consumer 中尚未发布的部分，待删除的 consumer 不允许再发布凭证
+func putCredentialConsumers(ctx context.Context, consumerIDs []string) error {
+	consumers, err := QueryConsumers(ctx, map[string]interface{}{"id": consumerIDs})
+	if err != nil {
+		return err
+	}
+	if len(consumers) != len(consumerIDs) {
+		return fmt.Errorf("凭证所属的消费者不存在, 消费者 IDs %v", consumerIDs)
+	}
+	var createDraftConsumerIDs []string
+	for _, consumer := range consumers {
+		switch consumer.Status {
+		case constant.ResourceStatusDeleteDraft:
+			return fmt.Errorf("凭证所属的消费者 %s 待删除, 不能发布凭证", consumer.ID)
+		case constant.ResourceStatusCreateDraft:
+			createDraftConsumerIDs = append(createDraftConsumerIDs, consumer.ID)
+		}
+	}
+	if len(createDraftConsumerIDs) == 0 {
+		return nil
+	}
+	return putConsumers(ctx, createDraftConsumerIDs)
+}
+
 // putConsumerGroups ...
 func putConsumerGroups(ctx context.Context, consumerGroupIDs []string) error {
 	consumerGroups, err := QueryConsumerGroups(ctx, map[string]interface{}{"id": consumerGroupIDs})
@@ -1111,22 +1416,15 @@ func putConsumerGroups(ctx context.Context, consumerGroupIDs []string) error {
 	}
 	var consumerGroupOps []publisher.ResourceOperation
 	for _, consumerGroup := range consumerGroups {
-		baseInfo := entity.BaseInfo{
-			CreateTime: consumerGroup.CreatedAt.Unix(),
-			UpdateTime: consumerGroup.UpdatedAt.Unix(),
-		}
-		baseConfig, _ := json.Marshal(baseInfo)
-		consumerGroup.Config, err = jsonx.MergeJson(consumerGroup.Config, baseConfig)
-		// 需要去除 id，name
-		consumerGroup.Config, _ = sjson.DeleteBytes(consumerGroup.Config, "id")
-		consumerGroup.Config, _ = sjson.DeleteBytes(consumerGroup.Config, "name")
+		consumerGroup.Config, err = etcdConfig(constant.ConsumerGroup, consumerGroup.ResourceCommonModel)
 		if err != nil {
 			return err
 		}
 		consumerGroupOps = append(consumerGroupOps, publisher.ResourceOperation{
-			Key:    consumerGroup.ID,
-			Config: json.RawMessage(consumerGroup.Config),
-			Type:   constant.ConsumerGroup,
+			Key:         consumerGroup.ID,
+			KeyOverride: consumerGroup.EtcdKeyOverride,
+			Config:      json.RawMessage(consumerGroup.Config),
+			Type:        constant.ConsumerGroup,
 		})
 	}
 
@@ -1137,7 +1435,7 @@ func putConsumerGroups(ctx context.Context, consumerGroupIDs []string) error {
 	}
 
 	// 变更资源状态为发布成功
-	if err = BatchUpdateResourceStatus(
+	if err = updatePublishedStatus(
 		ctx, constant.ConsumerGroup, consumerGroupIDs, constant.ResourceStatusSuccess); err != nil {
 		logging.ErrorFWithContext(ctx, "consumerGroups status change err: %s", err.Error())
 		return fmt.Errorf("消费者组发布错误: %w", err)
@@ -1157,22 +1455,15 @@ func putGlobalRules(ctx context.Context, globalRuleIDs []string) error {
 	}
 	var globalRuleOps []publisher.ResourceOperation
 	for _, globalRule := range globalRules {
-		baseInfo := entity.BaseInfo{
-			ID:         globalRule.ID,
-			CreateTime: globalRule.CreatedAt.Unix(),
-			UpdateTime: globalRule.UpdatedAt.Unix(),
-		}
-		baseConfig, _ := json.Marshal(baseInfo)
-		globalRule.Config, err = jsonx.MergeJson(globalRule.Config, baseConfig)
-		// 需要去除name
-		globalRule.Config, _ = sjson.DeleteBytes(globalRule.Config, "name")
+		globalRule.Config, err = etcdConfig(constant.GlobalRule, globalRule.ResourceCommonModel)
 		if err != nil {
 			return err
 		}
 		globalRuleOps = append(globalRuleOps, publisher.ResourceOperation{
-			Key:    globalRule.ID,
-			Config: json.RawMessage(globalRule.Config),
-			Type:   constant.GlobalRule,
+			Key:         globalRule.ID,
+			KeyOverride: globalRule.EtcdKeyOverride,
+			Config:      json.RawMessage(globalRule.Config),
+			Type:        constant.GlobalRule,
 		})
 	}
 	// 先创建 etcd 的数据
@@ -1182,7 +1473,7 @@ func putGlobalRules(ctx context.Context, globalRuleIDs []string) error {
 	}
 
 	// 变更资源状态为发布成功
-	if err = BatchUpdateResourceStatus(
+	if err = updatePublishedStatus(
 		ctx, constant.GlobalRule, globalRuleIDs, constant.ResourceStatusSuccess); err != nil {
 		logging.ErrorFWithContext(ctx, "globalRules status change err: %s", err.Error())
 		return fmt.Errorf("全局规则发布错误: %w", err)
@@ -1206,22 +1497,15 @@ func PutProtos(ctx context.Context, protoIDs []string) error {
 	}
 	var protoOps []publisher.ResourceOperation
 	for _, pb := range protos {
-		baseInfo := entity.BaseInfo{
-			ID:         pb.ID,
-			CreateTime: pb.CreatedAt.Unix(),
-			UpdateTime: pb.UpdatedAt.Unix(),
-		}
-		baseConfig, _ := json.Marshal(baseInfo)
-		pb.Config, err = jsonx.MergeJson(pb.Config, baseConfig)
-		// 需要去除 name
-		pb.Config, _ = sjson.DeleteBytes(pb.Config, "name")
+		pb.Config, err = etcdConfig(constant.Proto, pb.ResourceCommonModel)
 		if err != nil {
 			return err
 		}
 		protoOps = append(protoOps, publisher.ResourceOperation{
-			Key:    pb.ID,
-			Config: json.RawMessage(pb.Config),
-			Type:   constant.Proto,
+			Key:         pb.ID,
+			KeyOverride: pb.EtcdKeyOverride,
+			Config:      json.RawMessage(pb.Config),
+			Type:        constant.Proto,
 		})
 	}
 
@@ -1231,7 +1515,7 @@ func PutProtos(ctx context.Context, protoIDs []string) error {
 		return err
 	}
 	// 变更资源状态为发布成功
-	if err = BatchUpdateResourceStatus(
+	if err = updatePublishedStatus(
 		ctx, constant.Proto, protoIDs, constant.ResourceStatusSuccess); err != nil {
 		logging.ErrorFWithContext(ctx, "Protos status change err: %s", err.Error())
 		return fmt.Errorf("protos 发布错误: %w", err)
@@ -1239,6 +1523,44 @@ func PutProtos(ctx context.Context, protoIDs []string) error {
 	return nil
 }
 
+// PutSecrets ...
+func PutSecrets(ctx context.Context, secretIDs []string) error {
+	secrets, err := QuerySecrets(ctx, map[string]interface{}{"id": secretIDs})
+	if err != nil {
+		return err
+	}
+	if len(secrets) == 0 {
+		logging.ErrorFWithContext(ctx, "no secrets found for the specified secretIDs %v", secretIDs)
+		return fmt.Errorf("未找到指定的 secrets 资源 IDs %v", secretIDs)
+	}
+	var secretOps []publisher.ResourceOperation
+	for _, secret := range secrets {
+		secret.Config, err = etcdConfig(constant.Secret, secret.ResourceCommonModel)
+		if err != nil {
+			return err
+		}
+		secretOps = append(secretOps, publisher.ResourceOperation{
+			Key:         secret.ID,
+			KeyOverride: secret.EtcdKeyOverride,
+			Config:      json.RawMessage(secret.Config),
+			Type:        constant.Secret,
+		})
+	}
+
+	// 先创建 etcd 的数据
+	err = batchCreateEtcdResource(ctx, secretOps)
+	if err != nil {
+		return err
+	}
+	// 变更资源状态为发布成功
+	if err = updatePublishedStatus(
+		ctx, constant.Secret, secretIDs, constant.ResourceStatusSuccess); err != nil {
+		logging.ErrorFWithContext(ctx, "secrets status change err: %s", err.Error())
+		return fmt.Errorf("secrets 发布错误: %w", err)
+	}
+	return nil
+}
+
 // PutSSLs ...
 func PutSSLs(ctx context.Context, sslIDs []string) error {
 	ssls, err := QuerySSL(ctx, map[string]interface{}{"id": sslIDs})
@@ -1251,24 +1573,15 @@ func PutSSLs(ctx context.Context, sslIDs []string) error {
 	}
 	var sslOps []publisher.ResourceOperation
 	for _, ssl := range ssls {
-		baseInfo := entity.BaseInfo{
-			ID:         ssl.ID,
-			CreateTime: ssl.CreatedAt.Unix(),
-			UpdateTime: ssl.UpdatedAt.Unix(),
-		}
-		baseConfig, _ := json.Marshal(baseInfo)
-		ssl.Config, err = jsonx.MergeJson(ssl.Config, baseConfig)
-		// 需要去除name/validity_start/validity_end
-		ssl.Config, _ = sjson.DeleteBytes(ssl.Config, "name")
-		ssl.Config, _ = sjson.DeleteBytes(ssl.Config, "validity_start")
-		ssl.Config, _ = sjson.DeleteBytes(ssl.Config, "validity_end")
+		ssl.Config, err = etcdConfig(constant.SSL, ssl.ResourceCommonModel)
 		if err != nil {
 			return err
 		}
 		sslOps = append(sslOps, publisher.ResourceOperation{
-			Key:    ssl.ID,
-			Config: json.RawMessage(ssl.Config),
-			Type:   constant.SSL,
+			Key:         ssl.ID,
+			KeyOverride: ssl.EtcdKeyOverride,
+			Config:      json.RawMessage(ssl.Config),
+			Type:        constant.SSL,
 		})
 	}
 
@@ -1278,7 +1591,7 @@ func PutSSLs(ctx context.Context, sslIDs []string) error {
 		return err
 	}
 	// 变更资源状态为发布成功
-	if err = BatchUpdateResourceStatus(
+	if err = updatePublishedStatus(
 		ctx, constant.SSL, sslIDs, constant.ResourceStatusSuccess); err != nil {
 		logging.ErrorFWithContext(ctx, "ssls status change err: %s", err.Error())
 		return fmt.Errorf("ssls 发布错误: %w", err)
@@ -1300,29 +1613,21 @@ func PutStreamRoutes(ctx context.Context, streamRouteIDs []string) error {
 	var serviceIDs []string
 	var streamRouteOps []publisher.ResourceOperation
 	for _, sr := range streamRoutes {
-		baseInfo := entity.BaseInfo{
-			ID:         sr.ID,
-			CreateTime: sr.CreatedAt.Unix(),
-			UpdateTime: sr.UpdatedAt.Unix(),
-		}
 		if sr.UpstreamID != "" {
 			upstreamIDs = append(upstreamIDs, sr.UpstreamID)
 		}
 		if sr.ServiceID != "" {
 			serviceIDs = append(serviceIDs, sr.ServiceID)
 		}
-		baseConfig, _ := json.Marshal(baseInfo)
-		sr.Config, err = jsonx.MergeJson(sr.Config, baseConfig)
-		// 需要去除 name，labels
-		sr.Config, _ = sjson.DeleteBytes(sr.Config, "name")
-		sr.Config, _ = sjson.DeleteBytes(sr.Config, "labels")
+		sr.Config, err = etcdConfig(constant.StreamRoute, sr.ResourceCommonModel)
 		if err != nil {
 			return err
 		}
 		streamRouteOps = append(streamRouteOps, publisher.ResourceOperation{
-			Key:    sr.ID,
-			Config: json.RawMessage(sr.Config),
-			Type:   constant.StreamRoute,
+			Key:         sr.ID,
+			KeyOverride: sr.EtcdKeyOverride,
+			Config:      json.RawMessage(sr.Config),
+			Type:        constant.StreamRoute,
 		})
 	}
 	// 发布 upstream
@@ -1344,7 +1649,7 @@ func PutStreamRoutes(ctx context.Context, streamRouteIDs []string) error {
 		return err
 	}
 	// 变更资源状态为发布成功
-	if err = BatchUpdateResourceStatus(
+	if err = updatePublishedStatus(
 		ctx, constant.StreamRoute, streamRouteIDs, constant.ResourceStatusSuccess); err != nil {
 		logging.ErrorFWithContext(ctx, "streamRoutes status change err: %s", err.Error())
 		return fmt.Errorf("streamRoutes 发布错误: %w", err)
@@ -0,0 +1,210 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/publisher"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+)
+
+// publishPlan 发布预演的记录：预演时发布流程照常执行，写入 etcd 和数据库的操作改为记录到这里
+type publishPlan struct {
+	result   dto.PublishPlan
+	keyIndex map[string]int // etcd key -> result.Mutations 下标
+	// 预演中已删除的资源，实际发布时这些资源的数据库数据已删除
+	deleted map[constant.APISIXResource]map[string]bool
+}
+
+// withPublishPlan 返回开启发布预演的 context
+func withPublishPlan(ctx context.Context) (context.Context, *publishPlan) {
+	plan := &publishPlan{
+		result: dto.PublishPlan{
+			Mutations: []dto.EtcdMutation{},
+			Errors:    []dto.PublishPlanError{},
+		},
+		keyIndex: make(map[string]int),
+		deleted:  make(map[constant.APISIXResource]map[string]bool),
+	}
+	return context.WithValue(ctx, constant.PublishPlanKey, plan), plan
+}
+
+// publishPlanFromContext 获取发布预演记录，非预演时返回 nil
+func publishPlanFromContext(ctx context.Context) *publishPlan {
+	plan, _ := ctx.Value(constant.PublishPlanKey).(*publishPlan)
+	return plan
+}
+
+// isPublishDryRun 是否处于发布预演中
+func isPublishDryRun(ctx context.Context) bool {
+	return publishPlanFromContext(ctx) != nil
+}
+
+// DryRunPublishMutations 预演发布，返回发布将对 etcd 进行的写操作，不写入 etcd 和数据库：
+// 与实际发布执行同一流程(包括 etcd 模式的 schema 校验)，resourceType 为空时预演一键发布；
+// 未通过校验的资源记录在结果中，不影响其他资源的预演
+func DryRunPublishMutations(
+	ctx context.Context,
+	resourceType constant.APISIXResource,
+	resourceIDs []string,
+) (*dto.PublishPlan, error) {
+	ctx, plan := withPublishPlan(ctx)
+	if resourceType != "" {
+		plan.recordError(resourceType, "", publishResource(ctx, resourceType, resourceIDs))
+		return plan.finish(), nil
+	}
+	gatewayID := ginx.GetGatewayInfoFromContext(ctx).ID
+	heldResourceIDs, err := GetHeldResourceIDs(ctx, gatewayID)
+	if err != nil {
+		return nil, err
+	}
+	for _, resourceType := range constant.ResourceTypeList {
+		resourceIDs, err := publishableResourceIDs(ctx, gatewayID, resourceType, heldResourceIDs)
+		if err != nil {
+			return nil, err
+		}
+		if len(resourceIDs) == 0 {
+			continue
+		}
+		plan.recordError(resourceType, "", publishResource(ctx, resourceType, resourceIDs))
+	}
+	return plan.finish(), nil
+}
+
+// recordPuts 逐个校验并记录写入，未通过 etcd 模式校验的资源记为错误，不影响同批的其他资源
+func (p *publishPlan) recordPuts(
+	ctx context.Context,
+	pub *publisher.EtcdPublisher,
+	ops []publisher.ResourceOperation,
+) {
+	validOps := make([]publisher.ResourceOperation, 0, len(ops))
+	for _, op := range ops {
+		if err := pub.Validate(op.Type, op.Config); err != nil {
+			p.recordError(op.Type, op.Key, err)
+			continue
+		}
+		validOps = append(validOps, op)
+	}
+	prevValues := readEtcdValues(ctx, pub, validOps)
+	for _, op := range validOps {
+		p.record(dto.EtcdMutation{
+			Op:           constant.EtcdWriteOpPut,
+			Key:          pub.Prefix + "/" + op.GetKey(),
+			ResourceType: op.Type,
+			ResourceID:   op.Key,
+			Before:       etcdValueJSON(prevValues[op.GetKey()]),
+			After:        op.Config,
+			TTL:          op.TTL,
+		})
+	}
+}
+
+// recordDeletes 记录删除
+func (p *publishPlan) recordDeletes(
+	ctx context.Context,
+	pub *publisher.EtcdPublisher,
+	ops []publisher.ResourceOperation,
+) {
+	prevValues := readEtcdValues(ctx, pub, ops)
+	for _, op := range ops {
+		p.record(dto.EtcdMutation{
+			Op:           constant.EtcdWriteOpDelete,
+			Key:          pub.Prefix + "/" + op.GetKey(),
+			ResourceType: op.Type,
+			ResourceID:   op.Key,
+			Before:       etcdValueJSON(prevValues[op.GetKey()]),
+		})
+	}
+}
+
+// record 记录写操作，同一 key 多次写入时(如被多个路由依赖的上游)以最后一次为准，保留首次写入前的值
+func (p *publishPlan) record(mutation dto.EtcdMutation) {
+	if i, ok := p.keyIndex[mutation.Key]; ok {
+		mutation.Before = p.result.Mutations[i].Before
+		p.result.Mutations[i] = mutation
+		return
+	}
+	p.keyIndex[mutation.Key] = len(p.result.Mutations)
+	p.result.Mutations = append(p.result.Mutations, mutation)
+}
+
+// recordError 记录出错的资源，err 为空时忽略，重复的错误只记录一次
+func (p *publishPlan) recordError(resourceType constant.APISIXResource, resourceID string, err error) {
+	if err == nil {
+		return
+	}
+	planErr := dto.PublishPlanError{ResourceType: resourceType, ResourceID: resourceID, Message: err.Error()}
+	if slices.Contains(p.result.Errors, planErr) {
+		return
+	}
+	p.result.Errors = append(p.result.Errors, planErr)
+}
+
+// markDeleted 记录已删除的资源
+func (p *publishPlan) markDeleted(resourceType constant.APISIXResource, ids []string) {
+	if p.deleted[resourceType] == nil {
+		p.deleted[resourceType] = make(map[string]bool)
+	}
+	for _, id := range ids {
+		p.deleted[resourceType][id] = true
+	}
+}
+
+// finish 计算更新的字段级变更，返回预演结果
+func (p *publishPlan) finish() *dto.PublishPlan {
+	for i := range p.result.Mutations {
+		mutation := &p.result.Mutations[i]
+		if mutation.Op == constant.EtcdWriteOpPut && mutation.Before != nil {
+			mutation.Deltas = diffConfigFields(mutation.Before, mutation.After)
+		}
+	}
+	return &p.result
+}
+
+// withoutPlannedDeletes 发布预演时过滤掉预演中已删除的资源，与实际发布中删除关联检查的结果保持一致
+func withoutPlannedDeletes[T interface{ GetID() string }](
+	ctx context.Context,
+	resourceType constant.APISIXResource,
+	resources []T,
+) []T {
+	plan := publishPlanFromContext(ctx)
+	if plan == nil {
+		return resources
+	}
+	return slices.DeleteFunc(resources, func(resource T) bool {
+		return plan.deleted[resourceType][resource.GetID()]
+	})
+}
+
+// etcdValueJSON etcd 中的值，空值返回 nil，非 JSON 的值按字符串返回
+func etcdValueJSON(value string) json.RawMessage {
+	if value == "" {
+		return nil
+	}
+	if json.Valid([]byte(value)) {
+		return json.RawMessage(value)
+	}
+	data, _ := json.Marshal(value)
+	return data
+}
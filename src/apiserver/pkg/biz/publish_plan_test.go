@@ -0,0 +1,123 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package biz
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+	"gorm.io/datatypes"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/storage"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/data"
+)
+
+// findMutation 按 etcd key 查找预演中的写操作
+func findMutation(plan *dto.PublishPlan, key string) *dto.EtcdMutation {
+	for i := range plan.Mutations {
+		if plan.Mutations[i].Key == key {
+			return &plan.Mutations[i]
+		}
+	}
+	return nil
+}
+
+func TestDryRunPublishMutations(t *testing.T) {
+	route := data.Route1WithNoRelationResource(gatewayInfo, constant.ResourceStatusCreateDraft)
+	route.Name = fmt.Sprintf("mutation-dry-run-%d", time.Now().UnixNano())
+	assert.NoError(t, CreateRoute(gatewayCtx, *route))
+	// etcd 模式校验不通过的路由
+	invalidRoute := data.Route1WithNoRelationResource(gatewayInfo, constant.ResourceStatusCreateDraft)
+	invalidRoute.Name = fmt.Sprintf("mutation-dry-run-invalid-%d", time.Now().UnixNano())
+	invalidRoute.Config, _ = sjson.SetBytes(invalidRoute.Config, "unknown_field", 1)
+	assert.NoError(t, CreateRoute(gatewayCtx, *invalidRoute))
+	defer func() { assert.NoError(t, BatchDeleteRoutes(gatewayCtx, []string{invalidRoute.ID})) }()
+
+	routeKey := gatewayInfo.EtcdConfig.Prefix + "/routes/" + route.ID
+	plan, err := DryRunPublishMutations(gatewayCtx, constant.Route, []string{route.ID, invalidRoute.ID})
+	assert.NoError(t, err)
+	mutation := findMutation(plan, routeKey)
+	assert.NotNil(t, mutation)
+	assert.Equal(t, constant.EtcdWriteOpPut, mutation.Op)
+	assert.Nil(t, mutation.Before)
+	assert.Equal(t, route.ID, gjson.GetBytes(mutation.After, "id").String())
+	assert.Nil(t, findMutation(plan, gatewayInfo.EtcdConfig.Prefix+"/routes/"+invalidRoute.ID))
+	assert.Len(t, plan.Errors, 1)
+	assert.Equal(t, invalidRoute.ID, plan.Errors[0].ResourceID)
+
+	// 预演不写入 etcd，也不变更资源状态
+	etcdStore, err := storage.NewEtcdStorage(gatewayInfo.EtcdConfig.EtcdConfig)
+	assert.NoError(t, err)
+	defer etcdStore.Close()
+	_, err = etcdStore.Get(context.Background(), "routes/"+route.ID)
+	assert.Error(t, err)
+	stored, err := GetRoute(gatewayCtx, route.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, constant.ResourceStatusCreateDraft, stored.Status)
+
+	// 更新时返回写入前的值及字段级变更
+	assert.NoError(t, PublishRoutes(gatewayCtx, []string{route.ID}))
+	route.Config = datatypes.JSON(`{"uris":["/mutation-dry-run"],"upstream":{"type":"roundrobin",` +
+		`"nodes":[{"host":"httpbin.org","port":80,"weight":1}],"scheme":"http"}}`)
+	route.Status = constant.ResourceStatusUpdateDraft
+	assert.NoError(t, UpdateRoute(gatewayCtx, *route))
+	plan, err = DryRunPublishMutations(gatewayCtx, constant.Route, []string{route.ID})
+	assert.NoError(t, err)
+	mutation = findMutation(plan, routeKey)
+	assert.NotNil(t, mutation)
+	assert.Equal(t, "/get", gjson.GetBytes(mutation.Before, "uris.0").String())
+	assert.Contains(t, mutation.Deltas, dto.FieldDelta{
+		Path:   "uris",
+		Before: []byte(`["/get"]`),
+		After:  []byte(`["/mutation-dry-run"]`),
+	})
+
+	// 删除时返回删除前的值，数据库数据保留
+	assert.NoError(t, UpdateResourceStatus(gatewayCtx, constant.Route, route.ID, constant.ResourceStatusDeleteDraft))
+	plan, err = DryRunPublishMutations(gatewayCtx, constant.Route, []string{route.ID})
+	assert.NoError(t, err)
+	mutation = findMutation(plan, routeKey)
+	assert.NotNil(t, mutation)
+	assert.Equal(t, constant.EtcdWriteOpDelete, mutation.Op)
+	assert.NotNil(t, mutation.Before)
+	assert.Nil(t, mutation.After)
+	_, err = GetRoute(gatewayCtx, route.ID)
+	assert.NoError(t, err)
+
+	assert.NoError(t, deleteRoutes(gatewayCtx, []string{route.ID}))
+}
+
+func TestWithoutPlannedDeletes(t *testing.T) {
+	route := data.Route1WithNoRelationResource(gatewayInfo, constant.ResourceStatusDeleteDraft)
+	routes := []*model.Route{route}
+	assert.Len(t, withoutPlannedDeletes(gatewayCtx, constant.Route, routes), 1)
+
+	ctx, plan := withPublishPlan(gatewayCtx)
+	assert.Len(t, withoutPlannedDeletes(ctx, constant.Route, routes), 1)
+	plan.markDeleted(constant.Route, []string{route.ID})
+	assert.Empty(t, withoutPlannedDeletes(ctx, constant.Route, routes))
+}
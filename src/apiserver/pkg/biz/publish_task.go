@@ -0,0 +1,194 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/samber/lo"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/shutdown"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/hostx"
+)
+
+// ErrServiceDraining 服务正在退出，不再接受新的发布
+var ErrServiceDraining = errors.New("服务正在退出，请稍后重试")
+
+// shutdownManager 跟踪进行中的发布，服务退出时等待其完成
+var shutdownManager = shutdown.Default()
+
+// publishInstance 当前实例标识，用于区分发布任务由哪个实例执行
+var publishInstance = fmt.Sprintf("%s_%s", hostx.GetHostname(), hostx.GetLocalIpV4())
+
+// CreatePublishTask 创建发布任务
+func CreatePublishTask(
+	ctx context.Context,
+	resourceType constant.APISIXResource,
+	resourceIDs []string,
+) (*model.PublishTask, error) {
+	ids, err := json.Marshal(resourceIDs)
+	if err != nil {
+		return nil, err
+	}
+	task := &model.PublishTask{
+		GatewayID:    ginx.GetGatewayInfoFromContext(ctx).ID,
+		ResourceType: resourceType,
+		ResourceIDs:  ids,
+		Status:       constant.PublishTaskStatusRunning,
+		Instance:     publishInstance,
+		BaseModel: model.BaseModel{
+			Creator: ginx.GetUserIDFromContext(ctx),
+			Updater: ginx.GetUserIDFromContext(ctx),
+		},
+	}
+	if err = repo.PublishTask.WithContext(ctx).Create(task); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// FinishPublishTask 根据发布结果更新任务状态
+func FinishPublishTask(ctx context.Context, id int, publishErr error) {
+	u := repo.PublishTask
+	status := constant.PublishTaskStatusSuccess
+	message := ""
+	// 已被标记为中断的任务若最终发布成功，以实际结果为准；
+	// 若发布失败(可能是退出时连接被关闭导致)，保留中断状态以便重启后恢复
+	fromStatus := []string{string(constant.PublishTaskStatusRunning), string(constant.PublishTaskStatusInterrupted)}
+	if publishErr != nil {
+		status = constant.PublishTaskStatusFailed
+		message = publishErr.Error()
+		fromStatus = fromStatus[:1]
+	}
+	_, err := u.WithContext(context.Background()).Where(u.ID.Eq(id), u.Status.In(fromStatus...)).
+		UpdateSimple(u.Status.Value(string(status)), u.Message.Value(message))
+	if err != nil {
+		logging.ErrorFWithContext(ctx, "update publish task %d err: %s", id, err.Error())
+	}
+}
+
+// GetPublishTask 获取发布任务
+func GetPublishTask(ctx context.Context, id int) (*model.PublishTask, error) {
+	u := repo.PublishTask
+	return u.WithContext(ctx).Where(u.ID.Eq(id)).First()
+}
+
+// InterruptRunningPublishTasks 将当前实例上仍在执行的发布任务标记为中断，服务退出时调用
+func InterruptRunningPublishTasks(ctx context.Context) error {
+	u := repo.PublishTask
+	info, err := u.WithContext(ctx).Where(
+		u.Instance.Eq(publishInstance),
+		u.Status.Eq(string(constant.PublishTaskStatusRunning)),
+	).UpdateSimple(
+		u.Status.Value(string(constant.PublishTaskStatusInterrupted)),
+		u.Message.Value("服务退出时发布尚未完成"),
+	)
+	if err != nil {
+		return err
+	}
+	if info.RowsAffected > 0 {
+		logging.Warnf("%d publish task(s) interrupted by shutdown", info.RowsAffected)
+	}
+	return nil
+}
+
+// ResumeInterruptedPublishTasks 恢复中断的发布任务，服务启动时调用
+func ResumeInterruptedPublishTasks(ctx context.Context) error {
+	// 当前实例上次异常退出时遗留的发布任务同样视为中断
+	if err := InterruptRunningPublishTasks(ctx); err != nil {
+		return err
+	}
+	u := repo.PublishTask
+	tasks, err := u.WithContext(ctx).Where(u.Status.Eq(string(constant.PublishTaskStatusInterrupted))).
+		Order(u.ID).Find()
+	if err != nil {
+		return err
+	}
+	for _, task := range tasks {
+		if err = resumePublishTask(ctx, task); err != nil {
+			logging.Errorf("resume publish task %d err: %s", task.ID, err.Error())
+		}
+	}
+	return nil
+}
+
+// resumePublishTask 重新发布任务中仍处于待发布状态的资源
+func resumePublishTask(ctx context.Context, task *model.PublishTask) error {
+	done, ok := shutdownManager.Track()
+	if !ok {
+		return ErrServiceDraining
+	}
+	defer done()
+
+	// 多实例同时启动时，只有认领成功的实例执行恢复
+	u := repo.PublishTask
+	info, err := u.WithContext(ctx).Where(
+		u.ID.Eq(task.ID),
+		u.Status.Eq(string(constant.PublishTaskStatusInterrupted)),
+	).UpdateSimple(
+		u.Status.Value(string(constant.PublishTaskStatusRunning)),
+		u.Instance.Value(publishInstance),
+	)
+	if err != nil {
+		return err
+	}
+	if info.RowsAffected == 0 {
+		return nil
+	}
+
+	gateway, err := GetGateway(ctx, task.GatewayID)
+	if err != nil {
+		FinishPublishTask(ctx, task.ID, err)
+		return err
+	}
+	ctx = ginx.SetGatewayInfoToContext(ctx, gateway)
+	ctx = context.WithValue(ctx, constant.UserIDKey, task.Creator)
+	ctx = ginx.SetPublishTaskIDToContext(ctx, task.ID)
+
+	var resourceIDs []string
+	if err = json.Unmarshal(task.ResourceIDs, &resourceIDs); err != nil {
+		FinishPublishTask(ctx, task.ID, err)
+		return err
+	}
+	resources, err := BatchGetResources(ctx, task.ResourceType, resourceIDs)
+	if err != nil {
+		FinishPublishTask(ctx, task.ID, err)
+		return err
+	}
+	// 中断前已发布成功的资源无需重复发布
+	pendingIDs := lo.FilterMap(resources, func(r *model.ResourceCommonModel, _ int) (string, bool) {
+		return r.ID, r.Status == constant.ResourceStatusCreateDraft ||
+			r.Status == constant.ResourceStatusUpdateDraft ||
+			r.Status == constant.ResourceStatusDeleteDraft
+	})
+	if len(pendingIDs) > 0 {
+		err = publishResource(ctx, task.ResourceType, pendingIDs)
+	}
+	FinishPublishTask(ctx, task.ID, err)
+	logging.Infof("resume publish task %d, %d resource(s) republished", task.ID, len(pendingIDs))
+	return err
+}
@@ -0,0 +1,93 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package biz
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/shutdown"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/storage"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/data"
+)
+
+func TestPublishTaskInterruptedByShutdown(t *testing.T) {
+	originManager := shutdownManager
+	defer func() { shutdownManager = originManager }()
+	clock := clockwork.NewFakeClock()
+	shutdownManager = shutdown.NewManager(clock)
+	shutdownManager.OnShutdown("publish", InterruptRunningPublishTasks)
+
+	route := data.Route1WithNoRelationResource(gatewayInfo, constant.ResourceStatusCreateDraft)
+	route.Name = fmt.Sprintf("publish-task-%d", time.Now().UnixNano())
+	assert.NoError(t, CreateRoute(gatewayCtx, *route))
+
+	// 模拟一个进行中的发布
+	done, ok := shutdownManager.Track()
+	assert.True(t, ok)
+	task, err := CreatePublishTask(gatewayCtx, constant.Route, []string{route.ID})
+	assert.NoError(t, err)
+
+	shutdownErr := make(chan error)
+	go func() {
+		shutdownErr <- shutdownManager.Shutdown(context.Background(), 10*time.Second)
+	}()
+	// 等待 drain 超时
+	clock.BlockUntil(1)
+	assert.True(t, shutdownManager.Draining())
+	clock.Advance(10 * time.Second)
+	assert.NoError(t, <-shutdownErr)
+	done()
+
+	// 退出过程中不再接受新的发布
+	assert.ErrorIs(t, PublishResource(gatewayCtx, constant.Route, []string{route.ID}), ErrServiceDraining)
+
+	task, err = GetPublishTask(gatewayCtx, task.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, constant.PublishTaskStatusInterrupted, task.Status)
+
+	// 重启后恢复发布
+	shutdownManager = shutdown.NewManager(clock)
+	assert.NoError(t, ResumeInterruptedPublishTasks(context.Background()))
+	task, err = GetPublishTask(gatewayCtx, task.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, constant.PublishTaskStatusSuccess, task.Status)
+
+	routeInfo, err := GetRoute(gatewayCtx, route.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, constant.ResourceStatusSuccess, routeInfo.Status)
+
+	etcdStore, err := storage.NewEtcdStorage(gatewayInfo.EtcdConfig.EtcdConfig)
+	assert.NoError(t, err)
+	defer etcdStore.Close()
+	routeKey := gatewayInfo.EtcdConfig.Prefix + "/routes/" + route.ID
+	resp, err := etcdStore.GetClient().Get(context.Background(), routeKey)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), resp.Count)
+
+	// 清理 etcd 数据，避免影响其他用例的同步统计
+	_, err = etcdStore.GetClient().Delete(context.Background(), routeKey)
+	assert.NoError(t, err)
+}
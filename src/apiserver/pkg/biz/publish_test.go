@@ -20,13 +20,19 @@ package biz
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
+	"gorm.io/datatypes"
 
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/storage"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/cryptography"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/data"
@@ -108,7 +114,7 @@ func TestPublishRoutes(t *testing.T) {
 			}
 
 			// sync resource
-			syncedResourceTypeStats, err := SyncResources(tt.args.ctx, constant.Route)
+			syncedResourceTypeStats, err := SyncResources(tt.args.ctx, []constant.APISIXResource{constant.Route})
 
 			assert.NoError(t, err)
 
@@ -170,7 +176,7 @@ func TestPublishService(t *testing.T) {
 			}
 
 			// sync resource
-			syncedResourceTypeStats, err := SyncResources(tt.args.ctx, constant.Service)
+			syncedResourceTypeStats, err := SyncResources(tt.args.ctx, []constant.APISIXResource{constant.Service})
 
 			assert.NoError(t, err)
 
@@ -232,7 +238,7 @@ func TestPublishUpstreams(t *testing.T) {
 			}
 
 			// sync resource
-			syncedResourceTypeStats, err := SyncResources(tt.args.ctx, constant.Upstream)
+			syncedResourceTypeStats, err := SyncResources(tt.args.ctx, []constant.APISIXResource{constant.Upstream})
 
 			assert.NoError(t, err)
 
@@ -262,6 +268,32 @@ func TestPublishUpstreams(t *testing.T) {
 	}
 }
 
+func TestPublishUpstreamMapNodes(t *testing.T) {
+	upstream := data.Upstream1WithNoRelation(gatewayInfo, constant.ResourceStatusCreateDraft)
+	upstream.Name = fmt.Sprintf("upstream-map-nodes-%d", time.Now().UnixNano())
+	upstream.Config = datatypes.JSON(`{"type":"roundrobin","nodes":{"[::1]:8080":10,"127.0.0.1:80":0}}`)
+	assert.NoError(t, CreateUpstream(gatewayCtx, *upstream))
+	assert.NoError(t, PublishUpstreams(gatewayCtx, []string{upstream.ID}))
+
+	etcdStore, err := storage.NewEtcdStorage(gatewayInfo.EtcdConfig.EtcdConfig)
+	assert.NoError(t, err)
+	defer etcdStore.Close()
+	key := gatewayInfo.EtcdConfig.Prefix + "/upstreams/" + upstream.ID
+	resp, err := etcdStore.GetClient().Get(context.Background(), key)
+	assert.NoError(t, err)
+	assert.Len(t, resp.Kvs, 1)
+	// etcd 中写入数组形式的 nodes, ipv6 host 不带方括号
+	assert.JSONEq(t,
+		`[{"host":"127.0.0.1","port":80,"weight":0},{"host":"::1","port":8080,"weight":10}]`,
+		gjson.GetBytes(resp.Kvs[0].Value, "nodes").Raw)
+
+	// 清理数据，避免影响其他用例的同步统计
+	_, err = etcdStore.GetClient().Delete(context.Background(), key)
+	assert.NoError(t, err)
+	_, err = repo.Upstream.WithContext(gatewayCtx).Where(repo.Upstream.ID.Eq(upstream.ID)).Delete()
+	assert.NoError(t, err)
+}
+
 func TestPublishConsumer(t *testing.T) {
 	type args struct {
 		ctx      context.Context
@@ -294,7 +326,7 @@ func TestPublishConsumer(t *testing.T) {
 			}
 
 			// sync resource
-			syncedResourceTypeStats, err := SyncResources(tt.args.ctx, constant.Consumer)
+			syncedResourceTypeStats, err := SyncResources(tt.args.ctx, []constant.APISIXResource{constant.Consumer})
 
 			assert.NoError(t, err)
 
@@ -356,7 +388,7 @@ func TestPublishPluginConfigs(t *testing.T) {
 			}
 
 			// sync resource
-			syncedResourceTypeStats, err := SyncResources(tt.args.ctx, constant.PluginConfig)
+			syncedResourceTypeStats, err := SyncResources(tt.args.ctx, []constant.APISIXResource{constant.PluginConfig})
 			assert.NoError(t, err)
 
 			// assert sync resource count
@@ -414,7 +446,7 @@ func TestPublishGlobalRules(t *testing.T) {
 			}
 
 			// sync resource
-			syncedResourceTypeStats, err := SyncResources(tt.args.ctx, constant.GlobalRule)
+			syncedResourceTypeStats, err := SyncResources(tt.args.ctx, []constant.APISIXResource{constant.GlobalRule})
 			assert.NoError(t, err)
 
 			// assert sync resource count
@@ -472,7 +504,7 @@ func TestPublishProtos(t *testing.T) {
 			}
 
 			// sync resource
-			syncedResourceTypeStats, err := SyncResources(tt.args.ctx, constant.Proto)
+			syncedResourceTypeStats, err := SyncResources(tt.args.ctx, []constant.APISIXResource{constant.Proto})
 			assert.NoError(t, err)
 
 			// assert sync resource count
@@ -530,7 +562,8 @@ func TestPublishPluginMetadatas(t *testing.T) {
 			}
 
 			// sync resource
-			syncedResourceTypeStats, err := SyncResources(tt.args.ctx, constant.PluginMetadata)
+			syncedResourceTypeStats, err := SyncResources(
+				tt.args.ctx, []constant.APISIXResource{constant.PluginMetadata})
 			assert.NoError(t, err)
 
 			// assert sync resource count
@@ -588,7 +621,8 @@ func TestPublishConsumerGroups(t *testing.T) {
 			}
 
 			// sync resource
-			syncedResourceTypeStats, err := SyncResources(tt.args.ctx, constant.ConsumerGroup)
+			syncedResourceTypeStats, err := SyncResources(
+				tt.args.ctx, []constant.APISIXResource{constant.ConsumerGroup})
 			assert.NoError(t, err)
 
 			// assert sync resource count
@@ -646,7 +680,7 @@ func TestPublishSSLs(t *testing.T) {
 			}
 
 			// sync resource
-			syncedResourceTypeStats, err := SyncResources(tt.args.ctx, constant.SSL)
+			syncedResourceTypeStats, err := SyncResources(tt.args.ctx, []constant.APISIXResource{constant.SSL})
 			assert.NoError(t, err)
 
 			// assert sync resource count
@@ -704,7 +738,7 @@ func TestPublishStreamRoutes(t *testing.T) {
 			}
 
 			// sync resource
-			syncedResourceTypeStats, err := SyncResources(tt.args.ctx, constant.StreamRoute)
+			syncedResourceTypeStats, err := SyncResources(tt.args.ctx, []constant.APISIXResource{constant.StreamRoute})
 
 			assert.NoError(t, err)
 
@@ -0,0 +1,167 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/storage"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/publisher"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+)
+
+// ErrDriftResourceNotFound 编辑区及 etcd 中均不存在该资源
+var ErrDriftResourceNotFound = errors.New("编辑区及 etcd 中均不存在该资源")
+
+// driftPendingStatuses 待发布的资源与 etcd 不一致是预期的，不计入漂移统计
+var driftPendingStatuses = map[constant.ResourceStatus]bool{
+	constant.ResourceStatusCreateDraft: true,
+	constant.ResourceStatusUpdateDraft: true,
+	constant.ResourceStatusDeleteDraft: true,
+}
+
+// GetResourceDrift 对比资源在编辑区与 etcd 中的配置：编辑区配置按发布时的处理转换后与 etcd 中的配置逐字段对比，
+// 资源不在编辑区时 id 即为 etcd key
+func GetResourceDrift(
+	ctx context.Context,
+	resourceType constant.APISIXResource,
+	id string,
+) (*dto.ResourceDrift, error) {
+	gatewayInfo := ginx.GetGatewayInfoFromContext(ctx)
+	result := &dto.ResourceDrift{ResourceType: resourceType, ID: id, EtcdKey: id}
+	var dbResource *model.ResourceCommonModel
+	resource, err := GetResourceByID(ctx, resourceType, id)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	if err == nil && resource.GatewayID == gatewayInfo.ID {
+		dbResource = &resource
+		result.EtcdKey = complianceEtcdKey(resourceType, dbResource)
+		result.ResourceStatus = resource.Status
+	}
+	etcdStore, err := publisher.NewGatewayEtcdStorage(gatewayInfo)
+	if err != nil {
+		return nil, err
+	}
+	defer etcdStore.Close()
+	var etcdConfig json.RawMessage
+	value, err := etcdStore.Get(ctx, constant.ResourceTypePrefixMap[resourceType]+"/"+result.EtcdKey)
+	if err != nil && !errors.Is(err, storage.KeyNotFoundError) {
+		return nil, err
+	}
+	if err == nil {
+		etcdConfig = json.RawMessage(value)
+	}
+	if dbResource == nil && etcdConfig == nil {
+		return nil, ErrDriftResourceNotFound
+	}
+	result.Status, result.Deltas, err = compareResourceDrift(resourceType, dbResource, etcdConfig)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetResourceDriftSummary 按资源类型统计编辑区与 etcd 的配置漂移，每个资源类型目录只读取一次 etcd；
+// resourceTypes 为空时统计全部类型
+func GetResourceDriftSummary(
+	ctx context.Context,
+	resourceTypes []constant.APISIXResource,
+) (*dto.ResourceDriftSummary, error) {
+	gatewayInfo := ginx.GetGatewayInfoFromContext(ctx)
+	scannedTypes := ScannedResourceTypes(resourceTypes)
+	etcdResources, err := listEtcdResources(ctx, gatewayInfo, scannedTypes)
+	if err != nil {
+		return nil, err
+	}
+	summary := &dto.ResourceDriftSummary{ScannedTypes: scannedTypes, Resources: []dto.ResourceTypeDriftSummary{}}
+	for _, resourceType := range scannedTypes {
+		resources, err := QueryResource(ctx, resourceType, map[string]interface{}{"gateway_id": gatewayInfo.ID}, "")
+		if err != nil {
+			return nil, err
+		}
+		typeSummary := dto.ResourceTypeDriftSummary{ResourceType: resourceType}
+		managed := make(map[string]bool, len(resources))
+		for _, resource := range resources {
+			etcdKey := complianceEtcdKey(resourceType, resource)
+			managed[etcdKey] = true
+			if driftPendingStatuses[resource.Status] {
+				continue
+			}
+			status, _, err := compareResourceDrift(resourceType, resource, etcdResources[resourceType][etcdKey])
+			if err != nil {
+				return nil, err
+			}
+			countResourceDrift(&typeSummary, status)
+		}
+		for etcdKey := range etcdResources[resourceType] {
+			if !managed[etcdKey] {
+				countResourceDrift(&typeSummary, constant.ResourceDriftStatusMissingInDB)
+			}
+		}
+		summary.Resources = append(summary.Resources, typeSummary)
+	}
+	return summary, nil
+}
+
+// compareResourceDrift 对比编辑区资源与 etcd 中的配置，两者为空表示不存在，不能同时为空
+func compareResourceDrift(
+	resourceType constant.APISIXResource,
+	dbResource *model.ResourceCommonModel,
+	etcdConfig json.RawMessage,
+) (constant.ResourceDriftStatus, []dto.FieldDelta, error) {
+	if dbResource == nil {
+		return constant.ResourceDriftStatusMissingInDB, []dto.FieldDelta{}, nil
+	}
+	if etcdConfig == nil {
+		return constant.ResourceDriftStatusMissingInEtcd, []dto.FieldDelta{}, nil
+	}
+	config, err := publishedConfig(resourceType, dbResource)
+	if err != nil {
+		return "", nil, err
+	}
+	deltas := diffConfigFields(etcdConfig, config)
+	if len(deltas) == 0 {
+		return constant.ResourceDriftStatusInSync, []dto.FieldDelta{}, nil
+	}
+	return constant.ResourceDriftStatusModified, deltas, nil
+}
+
+// countResourceDrift 累加资源类型的漂移统计
+func countResourceDrift(summary *dto.ResourceTypeDriftSummary, status constant.ResourceDriftStatus) {
+	switch status {
+	case constant.ResourceDriftStatusInSync:
+		summary.InSync++
+		return
+	case constant.ResourceDriftStatusModified:
+		summary.Modified++
+	case constant.ResourceDriftStatusMissingInEtcd:
+		summary.MissingInEtcd++
+	case constant.ResourceDriftStatusMissingInDB:
+		summary.MissingInDB++
+	}
+	summary.Drifted++
+}
@@ -0,0 +1,110 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package biz
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/storage"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/data"
+)
+
+func TestResourceDrift(t *testing.T) {
+	route := data.Route1WithNoRelationResource(gatewayInfo, constant.ResourceStatusCreateDraft)
+	route.Name = fmt.Sprintf("drift-%d", time.Now().UnixNano())
+	assert.NoError(t, CreateRoute(gatewayCtx, *route))
+	assert.NoError(t, PublishRoutes(gatewayCtx, []string{route.ID}))
+	defer func() {
+		assert.NoError(t, BatchDeleteRoutes(gatewayCtx, []string{route.ID}))
+	}()
+
+	routeSummary := func() dto.ResourceTypeDriftSummary {
+		summary, err := GetResourceDriftSummary(gatewayCtx, []constant.APISIXResource{constant.Route})
+		assert.NoError(t, err)
+		assert.Equal(t, []constant.APISIXResource{constant.Route}, summary.ScannedTypes)
+		assert.Len(t, summary.Resources, 1)
+		return summary.Resources[0]
+	}
+	before := routeSummary()
+
+	drift, err := GetResourceDrift(gatewayCtx, constant.Route, route.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, constant.ResourceDriftStatusInSync, drift.Status)
+	assert.Equal(t, constant.ResourceStatusSuccess, drift.ResourceStatus)
+	assert.Empty(t, drift.Deltas)
+
+	etcdStore, err := storage.NewEtcdStorage(gatewayInfo.EtcdConfig.EtcdConfig)
+	assert.NoError(t, err)
+	defer etcdStore.Close()
+	client := etcdStore.GetClient()
+	routeKeyPrefix := gatewayInfo.EtcdConfig.Prefix + "/routes/"
+
+	// 直接修改 etcd 中的配置
+	resp, err := client.Get(context.Background(), routeKeyPrefix+route.ID)
+	assert.NoError(t, err)
+	assert.Len(t, resp.Kvs, 1)
+	_, err = client.Put(context.Background(), routeKeyPrefix+route.ID,
+		`{"id":"`+route.ID+`","uris":["/drift"],"name":"`+route.Name+`"}`)
+	assert.NoError(t, err)
+	drift, err = GetResourceDrift(gatewayCtx, constant.Route, route.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, constant.ResourceDriftStatusModified, drift.Status)
+	var paths []string
+	for _, delta := range drift.Deltas {
+		paths = append(paths, delta.Path)
+	}
+	assert.Contains(t, paths, "uris")
+	assert.Equal(t, before.Modified+1, routeSummary().Modified)
+
+	// etcd 中被删除
+	_, err = client.Delete(context.Background(), routeKeyPrefix+route.ID)
+	assert.NoError(t, err)
+	drift, err = GetResourceDrift(gatewayCtx, constant.Route, route.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, constant.ResourceDriftStatusMissingInEtcd, drift.Status)
+
+	// etcd 中未纳管的资源
+	unmanagedID := fmt.Sprintf("drift-unmanaged-%d", time.Now().UnixNano())
+	_, err = client.Put(context.Background(), routeKeyPrefix+unmanagedID, `{"id":"`+unmanagedID+`","uri":"/unmanaged"}`)
+	assert.NoError(t, err)
+	defer func() {
+		_, err = client.Delete(context.Background(), routeKeyPrefix+unmanagedID)
+		assert.NoError(t, err)
+	}()
+	drift, err = GetResourceDrift(gatewayCtx, constant.Route, unmanagedID)
+	assert.NoError(t, err)
+	assert.Equal(t, constant.ResourceDriftStatusMissingInDB, drift.Status)
+	assert.Empty(t, drift.ResourceStatus)
+
+	after := routeSummary()
+	assert.Equal(t, before.InSync-1, after.InSync)
+	assert.Equal(t, before.MissingInEtcd+1, after.MissingInEtcd)
+	assert.Equal(t, before.MissingInDB+1, after.MissingInDB)
+	assert.Equal(t, after.Modified+after.MissingInEtcd+after.MissingInDB, after.Drifted)
+
+	_, err = GetResourceDrift(gatewayCtx, constant.Route, "not-exist-route")
+	assert.ErrorIs(t, err, ErrDriftResourceNotFound)
+}
@@ -0,0 +1,109 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+)
+
+// ListPagedResourceRevisions 分页查询资源的历史版本，按版本号倒序
+func ListPagedResourceRevisions(
+	ctx context.Context,
+	resourceType constant.APISIXResource,
+	resourceID string,
+	page PageParam,
+) ([]*model.ResourceRevision, int64, error) {
+	u := repo.ResourceRevision
+	// 列表不返回配置，避免数据量过大
+	return u.WithContext(ctx).Omit(u.Config).Where(
+		u.GatewayID.Eq(ginx.GetGatewayInfoFromContext(ctx).ID),
+		u.ResourceType.Eq(resourceType.String()),
+		u.ResourceID.Eq(resourceID),
+	).Order(u.Revision.Desc()).FindByPage(page.Offset, page.Limit)
+}
+
+// GetResourceRevision 查询资源的指定版本
+func GetResourceRevision(
+	ctx context.Context,
+	resourceType constant.APISIXResource,
+	resourceID string,
+	revision int,
+) (*model.ResourceRevision, error) {
+	u := repo.ResourceRevision
+	return u.WithContext(ctx).Where(
+		u.GatewayID.Eq(ginx.GetGatewayInfoFromContext(ctx).ID),
+		u.ResourceType.Eq(resourceType.String()),
+		u.ResourceID.Eq(resourceID),
+		u.Revision.Eq(revision),
+	).First()
+}
+
+// RestoreRouteRevision 将路由恢复为指定版本的配置，恢复前按网关当前的 APISIX 版本重新校验，返回恢复后产生的新版本
+func RestoreRouteRevision(ctx context.Context, routeID string, revision int) (*model.ResourceRevision, error) {
+	current, err := GetRoute(ctx, routeID)
+	if err != nil {
+		return nil, err
+	}
+	history, err := GetResourceRevision(ctx, constant.Route, routeID, revision)
+	if err != nil {
+		return nil, err
+	}
+	results := BatchValidateResources(ctx, []dto.ResourceValidateItem{
+		{Type: constant.Route, Config: []byte(history.Config)},
+	})
+	if !results[0].Valid {
+		return nil, fmt.Errorf("版本 %d 的配置不符合 APISIX %s 的校验: %s", revision,
+			ginx.GetGatewayInfoFromContext(ctx).APISIXVersion, strings.Join(results[0].Errors, "; "))
+	}
+	updateStatus, err := GetResourceUpdateStatus(ctx, constant.Route, routeID)
+	if err != nil {
+		return nil, err
+	}
+	route, ok := model.ResourceCommonModel{
+		ID:        routeID,
+		GatewayID: current.GatewayID,
+		Config:    history.Config,
+		Status:    updateStatus,
+		BaseModel: model.BaseModel{Updater: ginx.GetUserIDFromContext(ctx)},
+	}.ToResourceModel(constant.Route).(model.Route)
+	if !ok {
+		return nil, errors.New("convert route model failed")
+	}
+	// 过期时间不属于配置，保持当前值
+	route.ExpiresAt = current.ExpiresAt
+	ctx = context.WithValue(ctx, constant.RevisionSummaryKey, fmt.Sprintf("恢复自版本 %d", revision))
+	if err := UpdateRoute(ctx, route); err != nil {
+		return nil, err
+	}
+	u := repo.ResourceRevision
+	return u.WithContext(ctx).Where(
+		u.GatewayID.Eq(current.GatewayID),
+		u.ResourceType.Eq(constant.Route.String()),
+		u.ResourceID.Eq(routeID),
+	).Order(u.Revision.Desc()).First()
+}
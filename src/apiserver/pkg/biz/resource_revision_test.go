@@ -0,0 +1,121 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package biz
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
+	"gorm.io/datatypes"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/data"
+)
+
+func TestRouteRevisionLifecycle(t *testing.T) {
+	route := data.Route1WithNoRelationResource(gatewayInfo, constant.ResourceStatusCreateDraft)
+	route.Name = fmt.Sprintf("revision-%d", time.Now().UnixNano())
+	assert.NoError(t, CreateRoute(gatewayCtx, *route))
+	createdConfig := gjson.GetBytes(mustGetRoute(t, route.ID).Config, "uris").Raw
+
+	route.Config = datatypes.JSON(`{"uris":["/revision-v2"],"methods":["GET"],"upstream":{"type":"roundrobin",` +
+		`"nodes":[{"host":"httpbin.org","port":80,"weight":1}],"scheme":"http"}}`)
+	assert.NoError(t, UpdateRoute(gatewayCtx, *route))
+	// 配置未变化的修改不产生新版本
+	assert.NoError(t, UpdateRoute(gatewayCtx, *route))
+
+	revisions, total, err := ListPagedResourceRevisions(gatewayCtx, constant.Route, route.ID,
+		PageParam{Offset: 0, Limit: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	assert.Equal(t, 2, revisions[0].Revision)
+	assert.Equal(t, constant.OperationTypeUpdate, revisions[0].OperationType)
+	assert.Equal(t, "修改 labels, uris", revisions[0].Summary)
+	assert.Empty(t, revisions[0].Config)
+	assert.Equal(t, "创建", revisions[1].Summary)
+
+	first, err := GetResourceRevision(gatewayCtx, constant.Route, route.ID, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, createdConfig, gjson.GetBytes(first.Config, "uris").Raw)
+
+	// 恢复为第一个版本，记为新版本
+	restored, err := RestoreRouteRevision(gatewayCtx, route.ID, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, restored.Revision)
+	assert.Equal(t, "恢复自版本 1", restored.Summary)
+	current := mustGetRoute(t, route.ID)
+	assert.Equal(t, createdConfig, gjson.GetBytes(current.Config, "uris").Raw)
+	assert.Equal(t, route.Name, current.Name)
+
+	// 不符合当前 APISIX 版本校验的历史配置无法恢复
+	invalid := &model.ResourceRevision{
+		GatewayID:     gatewayInfo.ID,
+		ResourceType:  constant.Route,
+		ResourceID:    route.ID,
+		Revision:      100,
+		OperationType: constant.OperationTypeUpdate,
+		Config:        datatypes.JSON(`{"uris":["/invalid"],"unknown_field":1}`),
+		CreatedAt:     time.Now(),
+	}
+	assert.NoError(t, repo.ResourceRevision.WithContext(gatewayCtx).Create(invalid))
+	_, err = RestoreRouteRevision(gatewayCtx, route.ID, 100)
+	assert.Error(t, err)
+	assert.Equal(t, createdConfig, gjson.GetBytes(mustGetRoute(t, route.ID).Config, "uris").Raw)
+
+	// 删除时记录删除前的配置
+	assert.NoError(t, BatchDeleteRoutes(gatewayCtx, []string{route.ID}))
+	revisions, _, err = ListPagedResourceRevisions(gatewayCtx, constant.Route, route.ID,
+		PageParam{Offset: 0, Limit: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, constant.OperationTypeDelete, revisions[0].OperationType)
+	assert.Equal(t, 101, revisions[0].Revision)
+}
+
+func TestResourceRevisionRetention(t *testing.T) {
+	model.SetResourceRevisionRetention(2)
+	defer model.SetResourceRevisionRetention(model.DefaultResourceRevisionRetention)
+
+	route := data.Route1WithNoRelationResource(gatewayInfo, constant.ResourceStatusCreateDraft)
+	route.Name = fmt.Sprintf("revision-retention-%d", time.Now().UnixNano())
+	assert.NoError(t, CreateRoute(gatewayCtx, *route))
+	for i := 0; i < 3; i++ {
+		route.Config = datatypes.JSON(fmt.Sprintf(`{"uris":["/retention-%d"]}`, i))
+		assert.NoError(t, UpdateRoute(gatewayCtx, *route))
+	}
+
+	revisions, total, err := ListPagedResourceRevisions(gatewayCtx, constant.Route, route.ID,
+		PageParam{Offset: 0, Limit: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	assert.Equal(t, 4, revisions[0].Revision)
+	assert.Equal(t, 3, revisions[1].Revision)
+	_, err = GetResourceRevision(gatewayCtx, constant.Route, route.ID, 1)
+	assert.Error(t, err)
+}
+
+func mustGetRoute(t *testing.T, id string) *model.Route {
+	route, err := GetRoute(gatewayCtx, id)
+	assert.NoError(t, err)
+	return route
+}
@@ -0,0 +1,139 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/jsonx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/schema"
+)
+
+// MaxBatchValidateSize 单次批量校验的资源数量上限
+const MaxBatchValidateSize = 1000
+
+// resourceValidators 同一资源类型的校验器，批量校验时复用
+type resourceValidators struct {
+	schemaValidator schema.Validator
+	configValidator schema.Validator
+	err             error
+}
+
+// BatchValidateResources 按网关的 APISIX 版本逐个校验资源配置，不落库；单个资源校验失败不影响其他资源
+func BatchValidateResources(ctx context.Context, items []dto.ResourceValidateItem) []dto.ResourceValidateResult {
+	gatewayInfo := ginx.GetGatewayInfoFromContext(ctx)
+	// 同一批资源使用同一个自定义插件 schema 快照校验
+	snapshot, err := GetCustomSchemaSnapshot(ctx, gatewayInfo.ID)
+	if err != nil {
+		logging.Errorf("get gateway:%d custom plugin schema error: %s", gatewayInfo.ID, err.Error())
+	}
+	customizePluginSchemaMap := snapshot.PluginSchemaMap()
+	validators := make(map[constant.APISIXResource]*resourceValidators)
+	getValidators := func(resourceType constant.APISIXResource) *resourceValidators {
+		if v, ok := validators[resourceType]; ok {
+			return v
+		}
+		v := &resourceValidators{}
+		v.schemaValidator, v.err = schema.NewAPISIXSchemaValidator(gatewayInfo.GetAPISIXVersionX(),
+			"main."+resourceType.String())
+		if v.err == nil {
+			v.configValidator, v.err = schema.NewAPISIXJsonSchemaValidator(gatewayInfo.GetAPISIXVersionX(),
+				resourceType, "main."+resourceType.String(), customizePluginSchemaMap, constant.DATABASE)
+		}
+		if v.err == nil {
+			schema.SetAllowCustomVars(v.configValidator, gatewayInfo.AllowCustomVars)
+			schema.SetRetriesCheck(v.configValidator, gatewayInfo.RetriesCheck)
+		}
+		validators[resourceType] = v
+		return v
+	}
+
+	cache := newValidationCache(ctx, gatewayInfo, snapshot)
+	results := make([]dto.ResourceValidateResult, 0, len(items))
+	for i, item := range items {
+		result := dto.ResourceValidateResult{Index: i, Errors: []string{}}
+		if err := validateResourceItem(ctx, gatewayInfo.ID, item, getValidators, cache, &result); err != nil {
+			result.Errors = append(result.Errors, err.Error())
+		}
+		result.Valid = len(result.Errors) == 0
+		results = append(results, result)
+	}
+	cache.flush(ctx)
+	return results
+}
+
+// validateResourceItem 校验单个资源，校验器异常时转换为该资源的错误
+func validateResourceItem(
+	ctx context.Context,
+	gatewayID int,
+	item dto.ResourceValidateItem,
+	getValidators func(constant.APISIXResource) *resourceValidators,
+	cache *validationCache,
+	result *dto.ResourceValidateResult,
+) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("校验异常: %v", r)
+		}
+	}()
+	if !slices.Contains(constant.ResourceTypeList, item.Type) {
+		return fmt.Errorf("不支持的资源类型: %s", item.Type)
+	}
+	if jsonx.IsJSONEmpty(item.Config) {
+		return fmt.Errorf("资源配置不能为空")
+	}
+	v := getValidators(item.Type)
+	if v.err != nil {
+		return v.err
+	}
+	config, normalizations, err := schema.NormalizeImportConfig(item.Type, item.Config)
+	if err != nil {
+		return err
+	}
+	item.Config = config
+	result.Normalizations = normalizations
+	warnings, err := cache.validate(validationKindResource, item.Type, constant.DATABASE, item.Config,
+		func() ([]string, error) {
+			if err := v.schemaValidator.Validate(item.Config); err != nil {
+				return nil, err
+			}
+			if err := v.configValidator.Validate(item.Config); err != nil {
+				return nil, err
+			}
+			var warnings []string
+			if w, ok := v.configValidator.(interface{ Warnings() []schema.Warning }); ok {
+				for _, warning := range w.Warnings() {
+					warnings = append(warnings, warning.Message)
+				}
+			}
+			return warnings, nil
+		})
+	if err != nil {
+		return err
+	}
+	result.Warnings = append(result.Warnings, warnings...)
+	return ValidateUpstreamDiscoveryType(ctx, gatewayID, item.Type, json.RawMessage(item.Config))
+}
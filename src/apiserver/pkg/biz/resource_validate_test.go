@@ -0,0 +1,79 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package biz
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+)
+
+func TestBatchValidateResources(t *testing.T) {
+	items := []dto.ResourceValidateItem{
+		{
+			Type: constant.Route,
+			Config: json.RawMessage(`{"name":"r1","uri":"/a","upstream":{"type":"roundrobin",` +
+				`"nodes":[{"host":"1.1.1.1","port":80,"weight":1}]}}`),
+		},
+		// 缺少 uri
+		{Type: constant.Route, Config: json.RawMessage(`{"name":"r2","methods":["GET"]}`)},
+		{Type: constant.APISIXResource("unknown"), Config: json.RawMessage(`{"name":"x"}`)},
+		{Type: constant.Upstream, Config: json.RawMessage(`{}`)},
+		{
+			Type: constant.Upstream,
+			Config: json.RawMessage(`{"name":"u1","type":"roundrobin",` +
+				`"nodes":[{"host":"1.1.1.1","port":80,"weight":1}]}`),
+		},
+	}
+	results := BatchValidateResources(gatewayCtx, items)
+	assert.Len(t, results, len(items))
+	for i, result := range results {
+		assert.Equal(t, i, result.Index)
+	}
+	assert.True(t, results[0].Valid, results[0].Errors)
+	assert.Empty(t, results[0].Errors)
+	assert.False(t, results[1].Valid)
+	assert.NotEmpty(t, results[1].Errors)
+	assert.False(t, results[2].Valid)
+	assert.Contains(t, results[2].Errors[0], "不支持的资源类型")
+	assert.False(t, results[3].Valid)
+	// 前面的失败不影响后续资源
+	assert.True(t, results[4].Valid, results[4].Errors)
+}
+
+func TestBatchValidateResourcesNormalization(t *testing.T) {
+	items := []dto.ResourceValidateItem{
+		{
+			Type: constant.Route,
+			Config: json.RawMessage(`{"name":"r1","uri":"/a","methods":["get","Post"],"hosts":["Example.COM"],` +
+				`"upstream":{"type":"roundrobin","nodes":[{"host":"1.1.1.1","port":80,"weight":1}]}}`),
+		},
+	}
+	results := BatchValidateResources(gatewayCtx, items)
+	// schema 只接受大写的 methods，规范化后校验通过
+	assert.True(t, results[0].Valid, results[0].Errors)
+	assert.Equal(t, []string{
+		`methods: ["get","Post"] 规范化为 ["GET","POST"]`,
+		`hosts: ["Example.COM"] 规范化为 ["example.com"]`,
+	}, results[0].Normalizations)
+}
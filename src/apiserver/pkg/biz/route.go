@@ -26,8 +26,10 @@ import (
 	"gorm.io/datatypes"
 	"gorm.io/gen"
 	"gorm.io/gen/field"
+	"gorm.io/gorm"
 
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
@@ -72,6 +74,41 @@ func ListPagedRoutes(
 	orderBy string,
 	page PageParam,
 ) ([]*model.Route, int64, error) {
+	query := routeListQuery(ctx, param, label, status, name, updater, path, method, serviceID, upstreamID, orderBy)
+	return query.FindByPage(page.Offset, page.Limit)
+}
+
+// routeListQuery 构造路由列表查询条件
+func routeListQuery(
+	ctx context.Context,
+	param map[string]interface{},
+	label map[string][]string,
+	status []string,
+	name string,
+	updater string,
+	path string,
+	method string,
+	serviceID string,
+	upstreamID string,
+	orderBy string,
+) repo.IRouteDo {
+	query := routeFilterQuery(ctx, param, label, status, name, updater, path, method, serviceID, upstreamID)
+	return query.Order(GetRouteOrderExprList(orderBy)...)
+}
+
+// routeFilterQuery 构造路由列表的过滤条件，不包含排序
+func routeFilterQuery(
+	ctx context.Context,
+	param map[string]interface{},
+	label map[string][]string,
+	status []string,
+	name string,
+	updater string,
+	path string,
+	method string,
+	serviceID string,
+	upstreamID string,
+) repo.IRouteDo {
 	u := repo.Route
 	query := u.WithContext(ctx)
 	if len(status) > 1 || status[0] != "" {
@@ -116,7 +153,6 @@ func ListPagedRoutes(
 			)
 		}
 	}
-	orderByExprs := GetRouteOrderExprList(orderBy)
 	cond := u.WithContext(ctx).Clauses()
 	conditions := LabelConditionList(label)
 	if len(conditions) > 0 {
@@ -127,9 +163,77 @@ func ListPagedRoutes(
 	return query.Where(cond).
 		Where(methodCond).
 		Where(associationIDCond).
-		Where(field.Attrs(param)).
-		Order(orderByExprs...).
-		FindByPage(page.Offset, page.Limit)
+		Where(field.Attrs(param))
+}
+
+// routeUnpublishedStatuses 有待发布变更的路由状态
+var routeUnpublishedStatuses = []string{
+	constant.ResourceStatusCreateDraft.String(),
+	constant.ResourceStatusUpdateDraft.String(),
+	constant.ResourceStatusDeleteDraft.String(),
+}
+
+// ListPagedRouteServiceGroups 按服务分组分页统计路由，过滤条件与路由列表一致：
+// 每页的分组统计与服务名称各通过一次查询获取，分组按服务 ID 排序，未绑定服务的分组排在最前
+func ListPagedRouteServiceGroups(
+	ctx context.Context,
+	param map[string]interface{},
+	label map[string][]string,
+	status []string,
+	name string,
+	updater string,
+	path string,
+	method string,
+	serviceID string,
+	upstreamID string,
+	page PageParam,
+) ([]*dto.RouteServiceGroup, int64, error) {
+	groupExpr := "COALESCE(service_id, '')"
+	groupQuery := routeFilterQuery(ctx, param, label, status, name, updater, path, method, serviceID, upstreamID).
+		UnderlyingDB().
+		Select(groupExpr+" AS service_id, COUNT(*) AS route_count, "+
+			"SUM(CASE WHEN status IN ? THEN 1 ELSE 0 END) AS unpublished_count, "+
+			"SUM(CASE WHEN JSON_EXTRACT(config, '$.status') = 0 THEN 1 ELSE 0 END) AS disabled_count",
+			routeUnpublishedStatuses).
+		Group(groupExpr).
+		Session(&gorm.Session{})
+	var total int64
+	err := repo.Route.WithContext(ctx).UnderlyingDB().
+		Table("(?) AS route_groups", groupQuery).
+		Count(&total).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	var groups []*dto.RouteServiceGroup
+	err = groupQuery.Order("service_id").Offset(page.Offset).Limit(page.Limit).Scan(&groups).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	var serviceIDs []string
+	for _, group := range groups {
+		if group.ServiceID != "" {
+			serviceIDs = append(serviceIDs, group.ServiceID)
+		}
+	}
+	serviceNames := make(map[string]string, len(serviceIDs))
+	if len(serviceIDs) > 0 {
+		services, err := QueryServices(ctx, map[string]interface{}{"id": serviceIDs})
+		if err != nil {
+			return nil, 0, err
+		}
+		for _, service := range services {
+			serviceNames[service.ID] = service.Name
+		}
+	}
+	for _, group := range groups {
+		if group.ServiceID == "" {
+			group.ServiceID = constant.EmptyAssociationFilter
+			group.Unbound = true
+			continue
+		}
+		group.ServiceName = serviceNames[group.ServiceID]
+	}
+	return groups, total, nil
 }
 
 // CreateRoute 创建路由
@@ -153,6 +257,7 @@ func UpdateRoute(ctx context.Context, route model.Route) error {
 		u.PluginConfigID,
 		u.ServiceID,
 		u.UpstreamID,
+		u.ExpiresAt,
 		u.Config,
 		u.Status,
 		u.Updater,
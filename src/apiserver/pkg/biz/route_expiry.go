@@ -0,0 +1,171 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package biz
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/storage"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/publisher"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+)
+
+const (
+	// routeExpiryCheckInterval 临时路由过期检查间隔
+	routeExpiryCheckInterval = time.Minute
+	// routeExpiryOperator 临时路由过期删除时审计日志记录的操作人
+	routeExpiryOperator = "system"
+)
+
+// CheckRouteLeaseSupported 检查网关是否支持为临时路由绑定 etcd lease
+func CheckRouteLeaseSupported(gateway *model.Gateway) error {
+	if gateway == nil {
+		return errors.New("网关信息不存在")
+	}
+	// 纳管模式下 etcd 中的数据同时会被其他控制面写入，对方不带 lease 的覆盖写会解除 lease 绑定，
+	// 无法保证临时路由按时过期，因此不允许在 APISIX prefix 下使用 lease
+	if gateway.Mode == constant.GatewayControlModeInDirect {
+		return fmt.Errorf("网关: %s 为纳管模式，不支持设置临时路由过期时间", gateway.Name)
+	}
+	return nil
+}
+
+// getRouteLeaseTTL 计算临时路由发布时需要绑定的 lease TTL(秒)，非临时路由返回 0
+func getRouteLeaseTTL(ctx context.Context, route *model.Route) (int64, error) {
+	if route.ExpiresAt == nil {
+		return 0, nil
+	}
+	ttl := int64(math.Ceil(time.Until(*route.ExpiresAt).Seconds()))
+	if ttl <= 0 {
+		return 0, fmt.Errorf("临时路由: %s 已过期，不能发布", route.Name)
+	}
+	if err := CheckRouteLeaseSupported(ginx.GetGatewayInfoFromContext(ctx)); err != nil {
+		return 0, err
+	}
+	return ttl, nil
+}
+
+// RunRouteExpiryChecker 定时检查已过期的临时路由
+func RunRouteExpiryChecker(ctx context.Context) {
+	ticker := time.NewTicker(routeExpiryCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := CleanExpiredRoutes(ctx); err != nil {
+				logging.Errorf("clean expired routes error: %s", err.Error())
+			}
+		}
+	}
+}
+
+// CleanExpiredRoutes 清理已过期的临时路由：轮询确认 etcd 中的 key 已随 lease 过期删除后，删除数据库记录
+func CleanExpiredRoutes(ctx context.Context) error {
+	u := repo.Route
+	routes, err := u.WithContext(ctx).Where(u.ExpiresAt.Lte(time.Now())).Find()
+	if err != nil {
+		return err
+	}
+	gatewayRoutesMap := make(map[int][]*model.Route)
+	for _, route := range routes {
+		gatewayRoutesMap[route.GatewayID] = append(gatewayRoutesMap[route.GatewayID], route)
+	}
+	for gatewayID, gatewayRoutes := range gatewayRoutesMap {
+		if err := cleanGatewayExpiredRoutes(ctx, gatewayID, gatewayRoutes); err != nil {
+			logging.Errorf("clean gateway:%d expired routes error: %s", gatewayID, err.Error())
+		}
+	}
+	return nil
+}
+
+// cleanGatewayExpiredRoutes 清理单个网关下已过期的临时路由
+func cleanGatewayExpiredRoutes(ctx context.Context, gatewayID int, routes []*model.Route) error {
+	gateway, err := GetGateway(ctx, gatewayID)
+	if err != nil {
+		return err
+	}
+	etcdStore, err := publisher.NewGatewayEtcdStorage(gateway)
+	if err != nil {
+		return err
+	}
+	defer etcdStore.Close()
+
+	var expiredRoutes []*model.Route
+	for _, route := range routes {
+		op := publisher.ResourceOperation{Key: route.ID, KeyOverride: route.EtcdKeyOverride, Type: constant.Route}
+		_, err := etcdStore.Get(ctx, op.GetKey())
+		if err == nil {
+			// key 仍然存在说明 lease 还未触发（如时钟偏差），留待下次检查
+			continue
+		}
+		if !errors.Is(err, storage.KeyNotFoundError) {
+			return err
+		}
+		expiredRoutes = append(expiredRoutes, route)
+	}
+	if len(expiredRoutes) == 0 {
+		return nil
+	}
+	ctx = ginx.SetGatewayInfoToContext(ctx, gateway)
+	ctx = context.WithValue(ctx, constant.UserIDKey, routeExpiryOperator)
+	return deleteExpiredRoutes(ctx, expiredRoutes)
+}
+
+// deleteExpiredRoutes 删除过期的临时路由，释放路由名称及对其他资源的关联，并记录过期审计日志
+func deleteExpiredRoutes(ctx context.Context, routes []*model.Route) error {
+	u := repo.Route
+	return repo.Q.Transaction(func(tx *repo.Query) error {
+		ctx = ginx.SetTx(ctx, tx)
+		var resources []*model.ResourceCommonModel
+		var routeIDs []string
+		resourceStatusMap := make(map[string]constant.ResourceStatus)
+		for _, route := range routes {
+			info, err := tx.Route.WithContext(ctx).Where(u.ID.Eq(route.ID)).Delete()
+			if err != nil {
+				return err
+			}
+			// 多实例部署时可能已被其他实例处理
+			if info.RowsAffected == 0 {
+				continue
+			}
+			routeIDs = append(routeIDs, route.ID)
+			resources = append(resources, &route.ResourceCommonModel)
+			resourceStatusMap[route.ID] = constant.ResourceStatusDeleted
+		}
+		if len(routeIDs) == 0 {
+			return nil
+		}
+		// 删除路由关联的自定义插件记录
+		if err := BatchDeleteResourceSchemaAssociation(ctx, routeIDs, constant.Route); err != nil {
+			return err
+		}
+		return AddBatchAuditLog(ctx, constant.OperationTypeExpire, constant.Route, resources, resourceStatusMap)
+	})
+}
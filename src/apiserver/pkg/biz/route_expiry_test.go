@@ -0,0 +1,199 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package biz
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/storage"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/data"
+)
+
+func TestCheckRouteLeaseSupported(t *testing.T) {
+	assert.NoError(t, CheckRouteLeaseSupported(&model.Gateway{Mode: constant.GatewayControlModeDirect}))
+	assert.Error(t, CheckRouteLeaseSupported(&model.Gateway{Mode: constant.GatewayControlModeInDirect}))
+	assert.Error(t, CheckRouteLeaseSupported(nil))
+}
+
+func TestTemporaryRouteLifecycle(t *testing.T) {
+	etcdStore, err := storage.NewEtcdStorage(gatewayInfo.EtcdConfig.EtcdConfig)
+	assert.NoError(t, err)
+	defer etcdStore.Close()
+	client := etcdStore.GetClient()
+
+	getRouteKV := func(id string) *clientv3.GetResponse {
+		resp, err := client.Get(context.Background(), gatewayInfo.EtcdConfig.Prefix+"/routes/"+id)
+		assert.NoError(t, err)
+		return resp
+	}
+
+	route := data.Route1WithNoRelationResource(gatewayInfo, constant.ResourceStatusCreateDraft)
+	route.Name = fmt.Sprintf("temporary-route-%d", time.Now().UnixNano())
+	expiresAt := time.Now().Add(time.Hour)
+	route.ExpiresAt = &expiresAt
+	assert.NoError(t, CreateRoute(gatewayCtx, *route))
+
+	// 发布时绑定 lease
+	assert.NoError(t, PublishRoutes(gatewayCtx, []string{route.ID}))
+	resp := getRouteKV(route.ID)
+	assert.Equal(t, int64(1), resp.Count)
+	oldLease := clientv3.LeaseID(resp.Kvs[0].Lease)
+	assert.NotEqual(t, clientv3.NoLease, oldLease)
+	ttlResp, err := client.TimeToLive(context.Background(), oldLease)
+	assert.NoError(t, err)
+	assert.InDelta(t, time.Hour.Seconds(), float64(ttlResp.GrantedTTL), 5)
+
+	// 续期会重新授予 lease 并回收旧 lease
+	expiresAt = time.Now().Add(2 * time.Hour)
+	route.ExpiresAt = &expiresAt
+	route.Status = constant.ResourceStatusUpdateDraft
+	assert.NoError(t, UpdateRoute(gatewayCtx, *route))
+	assert.NoError(t, PublishRoutes(gatewayCtx, []string{route.ID}))
+	resp = getRouteKV(route.ID)
+	assert.Equal(t, int64(1), resp.Count)
+	newLease := clientv3.LeaseID(resp.Kvs[0].Lease)
+	assert.NotEqual(t, oldLease, newLease)
+	ttlResp, err = client.TimeToLive(context.Background(), newLease)
+	assert.NoError(t, err)
+	assert.InDelta(t, (2 * time.Hour).Seconds(), float64(ttlResp.GrantedTTL), 5)
+	ttlResp, err = client.TimeToLive(context.Background(), oldLease)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(-1), ttlResp.TTL)
+
+	// lease 未触发前不会被清理
+	u := repo.Route
+	_, err = u.WithContext(gatewayCtx).Where(u.ID.Eq(route.ID)).
+		UpdateSimple(u.ExpiresAt.Value(time.Now().Add(-time.Minute)))
+	assert.NoError(t, err)
+	assert.NoError(t, CleanExpiredRoutes(context.Background()))
+	_, err = GetRoute(gatewayCtx, route.ID)
+	assert.NoError(t, err)
+
+	// lease 触发后删除并记录审计日志
+	_, err = client.Revoke(context.Background(), newLease)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), getRouteKV(route.ID).Count)
+	assert.NoError(t, CleanExpiredRoutes(context.Background()))
+	_, err = GetRoute(gatewayCtx, route.ID)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+
+	auditLogs, err := ListOperationAuditLogs(gatewayCtx, map[string]interface{}{
+		"gateway_id":     gatewayInfo.ID,
+		"operation_type": constant.OperationTypeExpire,
+	}, route.ID, "", 0, 0)
+	assert.NoError(t, err)
+	assert.Len(t, auditLogs, 1)
+	assert.Equal(t, routeExpiryOperator, auditLogs[0].Operator)
+
+	// 已经过期的路由不能发布
+	route.Name = fmt.Sprintf("expired-route-%d", time.Now().UnixNano())
+	route.ID = route.ID + "-expired"
+	route.Status = constant.ResourceStatusCreateDraft
+	expiresAt = time.Now().Add(-time.Minute)
+	route.ExpiresAt = &expiresAt
+	assert.NoError(t, CreateRoute(gatewayCtx, *route))
+	assert.Error(t, PublishRoutes(gatewayCtx, []string{route.ID}))
+}
+
+func TestExpiredRouteReleasesNameAndReferences(t *testing.T) {
+	etcdStore, err := storage.NewEtcdStorage(gatewayInfo.EtcdConfig.EtcdConfig)
+	assert.NoError(t, err)
+	defer etcdStore.Close()
+	client := etcdStore.GetClient()
+
+	upstream := data.Upstream1WithNoRelation(gatewayInfo, constant.ResourceStatusCreateDraft)
+	upstream.Name = fmt.Sprintf("expiry-upstream-%d", time.Now().UnixNano())
+	assert.NoError(t, CreateUpstream(gatewayCtx, *upstream))
+	route := data.Route1WithNoRelationResource(gatewayInfo, constant.ResourceStatusCreateDraft)
+	route.Name = fmt.Sprintf("expiry-route-%d", time.Now().UnixNano())
+	route.UpstreamID = upstream.ID
+	route.Config = datatypes.JSON(`{"uris":["/expiry"],"upstream_id":"` + upstream.ID + `"}`)
+	expiresAt := time.Now().Add(time.Hour)
+	route.ExpiresAt = &expiresAt
+	assert.NoError(t, CreateRoute(gatewayCtx, *route))
+	assert.NoError(t, PublishRoutes(gatewayCtx, []string{route.ID}))
+
+	// 路由存在时上游不能删除，名称被占用
+	assert.False(t, DuplicatedResourceName(gatewayCtx, constant.Route, "", route.Name))
+	assert.NoError(t, UpdateResourceStatus(gatewayCtx, constant.Upstream, upstream.ID,
+		constant.ResourceStatusDeleteDraft))
+	assert.Error(t, PublishUpstreams(gatewayCtx, []string{upstream.ID}))
+
+	// 过期清理后名称可以复用，上游可以删除
+	resp, err := client.Get(context.Background(), gatewayInfo.EtcdConfig.Prefix+"/routes/"+route.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), resp.Count)
+	_, err = client.Revoke(context.Background(), clientv3.LeaseID(resp.Kvs[0].Lease))
+	assert.NoError(t, err)
+	u := repo.Route
+	_, err = u.WithContext(gatewayCtx).Where(u.ID.Eq(route.ID)).
+		UpdateSimple(u.ExpiresAt.Value(time.Now().Add(-time.Minute)))
+	assert.NoError(t, err)
+	assert.NoError(t, CleanExpiredRoutes(context.Background()))
+
+	assert.True(t, DuplicatedResourceName(gatewayCtx, constant.Route, "", route.Name))
+	assert.NoError(t, PublishUpstreams(gatewayCtx, []string{upstream.ID}))
+	_, err = GetUpstream(gatewayCtx, upstream.ID)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+}
+
+func TestCleanExpiredRoutesWithKeyOverride(t *testing.T) {
+	etcdStore, err := storage.NewEtcdStorage(gatewayInfo.EtcdConfig.EtcdConfig)
+	assert.NoError(t, err)
+	defer etcdStore.Close()
+	client := etcdStore.GetClient()
+
+	route := data.Route1WithNoRelationResource(gatewayInfo, constant.ResourceStatusCreateDraft)
+	route.Name = fmt.Sprintf("override-route-%d", time.Now().UnixNano())
+	route.EtcdKeyOverride = "routes/override-" + route.ID
+	expiresAt := time.Now().Add(time.Hour)
+	route.ExpiresAt = &expiresAt
+	assert.NoError(t, CreateRoute(gatewayCtx, *route))
+	assert.NoError(t, PublishRoutes(gatewayCtx, []string{route.ID}))
+	resp, err := client.Get(context.Background(), gatewayInfo.EtcdConfig.Prefix+"/"+route.EtcdKeyOverride)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), resp.Count)
+
+	u := repo.Route
+	_, err = u.WithContext(gatewayCtx).Where(u.ID.Eq(route.ID)).
+		UpdateSimple(u.ExpiresAt.Value(time.Now().Add(-time.Minute)))
+	assert.NoError(t, err)
+
+	// 自定义 key 仍然存在时不能删除
+	assert.NoError(t, CleanExpiredRoutes(context.Background()))
+	_, err = GetRoute(gatewayCtx, route.ID)
+	assert.NoError(t, err)
+
+	_, err = client.Revoke(context.Background(), clientv3.LeaseID(resp.Kvs[0].Lease))
+	assert.NoError(t, err)
+	assert.NoError(t, CleanExpiredRoutes(context.Background()))
+	_, err = GetRoute(gatewayCtx, route.ID)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+}
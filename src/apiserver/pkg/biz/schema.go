@@ -21,13 +21,16 @@ package biz
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
+	"github.com/tidwall/gjson"
 	"gorm.io/gen/field"
 
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/jsonx"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/schema"
 )
 
@@ -81,7 +84,11 @@ func ListPagedSchema(
 
 // CreateSchema 创建 schema
 func CreateSchema(ctx context.Context, schema *model.GatewayCustomPluginSchema) error {
-	return repo.GatewayCustomPluginSchema.WithContext(ctx).Create(schema)
+	if err := repo.GatewayCustomPluginSchema.WithContext(ctx).Create(schema); err != nil {
+		return err
+	}
+	// 自定义插件 schema 变化后已缓存的校验结果失效
+	return bumpCustomSchemaRevision(ctx, schema.GatewayID)
 }
 
 // UpdateSchema 更新 schema
@@ -93,7 +100,10 @@ func UpdateSchema(ctx context.Context, schema model.GatewayCustomPluginSchema) e
 		u.Example,
 		u.Updater,
 	).Updates(schema)
-	return err
+	if err != nil {
+		return err
+	}
+	return bumpCustomSchemaRevision(ctx, ginx.GetGatewayInfoFromContext(ctx).ID)
 }
 
 // GetSchemaByName 根据 name 查询 schema 详情
@@ -117,7 +127,10 @@ func GetSchemaByID(ctx context.Context, id int) (*model.GatewayCustomPluginSchem
 func DeleteSchema(ctx context.Context, schemaID int) error {
 	_, err := repo.GatewayCustomPluginSchema.WithContext(ctx).
 		Delete(&model.GatewayCustomPluginSchema{AutoID: schemaID, GatewayID: ginx.GetGatewayInfoFromContext(ctx).ID})
-	return err
+	if err != nil {
+		return err
+	}
+	return bumpCustomSchemaRevision(ctx, ginx.GetGatewayInfoFromContext(ctx).ID)
 }
 
 // DuplicatedSchemaName 查询插件名称是否重复
@@ -154,9 +167,11 @@ func GetCustomizePluginExampleList(ctx context.Context, gatewayID int) ([]*schem
 	for _, s := range schemaList {
 		var plugin schema.Plugin
 		var exampleMap map[string]interface{}
-		err := json.Unmarshal(s.Example, &exampleMap)
-		if err != nil {
-			return nil, err
+		// 插件示例可选
+		if len(s.Example) != 0 {
+			if err := jsonx.UnmarshalUseNumber(s.Example, &exampleMap); err != nil {
+				return nil, err
+			}
 		}
 		plugin.Name = s.Name
 		plugin.Example = exampleMap
@@ -166,22 +181,96 @@ func GetCustomizePluginExampleList(ctx context.Context, gatewayID int) ([]*schem
 	return plugins, nil
 }
 
-// GetCustomizePluginSchemaMap 查询自定义插件 schema map
+// customSchemaRegistry 各网关自定义插件 schema 的快照
+var customSchemaRegistry = schema.NewCustomSchemaRegistry()
+
+// GetCustomizePluginSchemaMap 查询自定义插件 schema map，返回的 map 只读
 func GetCustomizePluginSchemaMap(ctx context.Context, gatewayID int) map[string]interface{} {
-	schemaList, err := ListSchema(ctx, gatewayID)
+	snapshot, err := GetCustomSchemaSnapshot(ctx, gatewayID)
 	if err != nil {
 		return nil
 	}
+	return snapshot.PluginSchemaMap()
+}
+
+// GetCustomSchemaSnapshot 获取网关自定义插件 schema 快照，
+// 快照的修订号落后于网关的校验规则修订号(其他实例更新了自定义插件 schema)时从数据库重新加载
+func GetCustomSchemaSnapshot(ctx context.Context, gatewayID int) (*schema.CustomSchemaSnapshot, error) {
+	// 先读修订号再读 schema：schema 写入先于修订号递增，读到的 schema 不会旧于修订号
+	revision, err := GetValidationRevision(ctx, gatewayID)
+	if err != nil {
+		return nil, err
+	}
+	if snapshot := customSchemaRegistry.Load(gatewayID); snapshot != nil && snapshot.Revision == revision {
+		return snapshot, nil
+	}
+	return customSchemaRegistry.Update(gatewayID,
+		func(current *schema.CustomSchemaSnapshot) (*schema.CustomSchemaSnapshot, error) {
+			// 并发的请求已加载
+			if current != nil && current.Revision >= revision {
+				return current, nil
+			}
+			return loadCustomSchemaSnapshot(ctx, gatewayID, revision)
+		})
+}
+
+// bumpCustomSchemaRevision 自定义插件 schema 变化后递增校验规则修订号(使已缓存的校验结果失效)并发布新快照
+func bumpCustomSchemaRevision(ctx context.Context, gatewayID int) error {
+	_, err := customSchemaRegistry.Update(gatewayID,
+		func(*schema.CustomSchemaSnapshot) (*schema.CustomSchemaSnapshot, error) {
+			if err := BumpValidationRevision(ctx, gatewayID); err != nil {
+				return nil, err
+			}
+			revision, err := GetValidationRevision(ctx, gatewayID)
+			if err != nil {
+				return nil, err
+			}
+			return loadCustomSchemaSnapshot(ctx, gatewayID, revision)
+		})
+	return err
+}
+
+// loadCustomSchemaSnapshot 从数据库加载网关的自定义插件 schema
+func loadCustomSchemaSnapshot(
+	ctx context.Context,
+	gatewayID int,
+	revision int,
+) (*schema.CustomSchemaSnapshot, error) {
+	schemaList, err := ListSchema(ctx, gatewayID)
+	if err != nil {
+		return nil, err
+	}
 	pluginSchemaMap := map[string]interface{}{}
 	for _, s := range schemaList {
 		var schemaInfo map[string]interface{}
-		err = json.Unmarshal(s.Schema, &schemaInfo)
-		if err != nil {
-			return nil
+		if err := json.Unmarshal(s.Schema, &schemaInfo); err != nil {
+			return nil, fmt.Errorf("自定义插件 %s 的 schema 解析失败: %w", s.Name, err)
 		}
 		pluginSchemaMap[s.Name] = schemaInfo
 	}
-	return pluginSchemaMap
+	return schema.NewCustomSchemaSnapshot(revision, pluginSchemaMap), nil
+}
+
+// GetCustomPluginReferences 查询网关下引用了该自定义插件的资源，格式为 {资源类型}: {资源 id}，
+// 包括 plugins 中配置了该插件的资源及该插件的 plugin_metadata
+func GetCustomPluginReferences(ctx context.Context, name string) ([]string, error) {
+	var references []string
+	for _, resourceType := range constant.ResourceTypeList {
+		resources, err := BatchGetResources(ctx, resourceType, []string{})
+		if err != nil {
+			return nil, err
+		}
+		for _, resource := range resources {
+			referenced := gjson.GetBytes(resource.Config, "plugins."+gjson.Escape(name)).Exists()
+			if resourceType == constant.PluginMetadata {
+				referenced = resource.ID == name
+			}
+			if referenced {
+				references = append(references, fmt.Sprintf("%s: %s", resourceType, resource.ID))
+			}
+		}
+	}
+	return references, nil
 }
 
 // GetResourceSchemaAssociation 查询资源与自定义插件的关联记录
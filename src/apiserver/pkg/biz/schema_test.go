@@ -0,0 +1,87 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package biz
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/sjson"
+	"gorm.io/datatypes"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/data"
+)
+
+func TestGetCustomPluginReferences(t *testing.T) {
+	pluginName := fmt.Sprintf("custom-plugin-%d", time.Now().UnixNano())
+	references, err := GetCustomPluginReferences(gatewayCtx, pluginName)
+	assert.NoError(t, err)
+	assert.Empty(t, references)
+
+	route := data.Route1WithNoRelationResource(gatewayInfo, constant.ResourceStatusCreateDraft)
+	route.Name = pluginName
+	config, err := sjson.SetBytes(route.Config, "plugins."+pluginName, map[string]interface{}{"foo": "bar"})
+	assert.NoError(t, err)
+	route.Config = datatypes.JSON(config)
+	assert.NoError(t, CreateRoute(gatewayCtx, *route))
+	defer func() {
+		assert.NoError(t, deleteRoutes(gatewayCtx, []string{route.ID}))
+	}()
+
+	references, err = GetCustomPluginReferences(gatewayCtx, pluginName)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"route: " + route.ID}, references)
+}
+
+func TestGetCustomSchemaSnapshot(t *testing.T) {
+	pluginSchema := &model.GatewayCustomPluginSchema{
+		GatewayID: gatewayInfo.ID,
+		Name:      fmt.Sprintf("snapshot-plugin-%d", time.Now().UnixNano()),
+		Schema:    datatypes.JSON(`{"type":"object"}`),
+	}
+	assert.NoError(t, CreateSchema(gatewayCtx, pluginSchema))
+	defer func() {
+		assert.NoError(t, DeleteSchema(gatewayCtx, pluginSchema.AutoID))
+	}()
+
+	// 创建后立即发布包含新插件的快照
+	revision, err := GetValidationRevision(gatewayCtx, gatewayInfo.ID)
+	assert.NoError(t, err)
+	snapshot := customSchemaRegistry.Load(gatewayInfo.ID)
+	if assert.NotNil(t, snapshot) {
+		assert.Equal(t, revision, snapshot.Revision)
+		assert.Contains(t, snapshot.PluginSchemaMap(), pluginSchema.Name)
+	}
+	current, err := GetCustomSchemaSnapshot(gatewayCtx, gatewayInfo.ID)
+	assert.NoError(t, err)
+	assert.Same(t, snapshot, current)
+
+	// 其他实例更新后修订号落后，重新加载快照，已获取的快照不变
+	assert.NoError(t, BumpValidationRevision(gatewayCtx, gatewayInfo.ID))
+	reloaded, err := GetCustomSchemaSnapshot(gatewayCtx, gatewayInfo.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, revision+1, reloaded.Revision)
+	assert.NotSame(t, snapshot, reloaded)
+	assert.Equal(t, revision, snapshot.Revision)
+	assert.Equal(t, GetCustomizePluginSchemaMap(gatewayCtx, gatewayInfo.ID), reloaded.PluginSchemaMap())
+}
@@ -0,0 +1,218 @@
+/*
+* TencentBlueKing is pleased to support the open source community by making
+* 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+* Copyright (C) 2025 Tencent. All rights reserved.
+* Licensed under the MIT License (the "License"); you may not use this file except
+* in compliance with the License. You may obtain a copy of the License at
+*
+*     http://opensource.org/licenses/MIT
+*
+* Unless required by applicable law or agreed to in writing, software distributed under
+* the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+* either express or implied. See the License for the specific language governing permissions and
+* limitations under the License.
+*
+* We undertake not to change the open source license (MIT license) applicable
+* to the current version of the project delivered to anyone in the future.
+ */
+
+package biz
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"gorm.io/gen/field"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+)
+
+// ListSecrets 查询网关 Secret 列表
+func ListSecrets(ctx context.Context, gatewayID int) ([]*model.Secret, error) {
+	u := repo.Secret
+	return repo.Secret.WithContext(ctx).Where(u.GatewayID.Eq(gatewayID)).Order(u.UpdatedAt.Desc()).Find()
+}
+
+// GetSecretOrderExprList 获取 Secret 排序字段列表
+func GetSecretOrderExprList(orderBy string) []field.Expr {
+	u := repo.Secret
+	ascFieldMap := map[string]field.Expr{
+		"name":       u.Name,
+		"updated_at": u.UpdatedAt,
+	}
+	descFieldMap := map[string]field.Expr{
+		"name":       u.Name.Desc(),
+		"updated_at": u.UpdatedAt.Desc(),
+	}
+	orderByExprList := ParseOrderByExprList(ascFieldMap, descFieldMap, orderBy)
+	if len(orderByExprList) == 0 {
+		orderByExprList = append(orderByExprList, u.UpdatedAt.Desc())
+	}
+	return orderByExprList
+}
+
+// ListPagedSecrets 分页查询 Secret
+func ListPagedSecrets(
+	ctx context.Context,
+	param map[string]interface{},
+	status []string,
+	name string,
+	updater string,
+	orderBy string,
+	page PageParam,
+) ([]*model.Secret, int64, error) {
+	u := repo.Secret
+	query := u.WithContext(ctx)
+	if name != "" {
+		query = query.Where(u.Name.Like("%" + name + "%"))
+	}
+	if updater != "" {
+		query = query.Where(u.Updater.Like("%" + updater + "%"))
+	}
+	if len(status) > 1 || status[0] != "" {
+		query = query.Where(u.Status.In(status...))
+	}
+	orderByExprs := GetSecretOrderExprList(orderBy)
+	return query.Where(field.Attrs(param)).
+		Order(orderByExprs...).
+		FindByPage(page.Offset, page.Limit)
+}
+
+// CreateSecret 创建 Secret
+func CreateSecret(ctx context.Context, secret model.Secret) error {
+	return repo.Secret.WithContext(ctx).Create(&secret)
+}
+
+// BatchCreateSecrets 批量创建 Secret
+func BatchCreateSecrets(ctx context.Context, secrets []*model.Secret) error {
+	if ginx.GetTx(ctx) != nil {
+		return ginx.GetTx(ctx).Secret.WithContext(ctx).Create(secrets...)
+	}
+	return repo.Secret.WithContext(ctx).Create(secrets...)
+}
+
+// UpdateSecret 更新 Secret
+func UpdateSecret(ctx context.Context, secret model.Secret) error {
+	u := repo.Secret
+	_, err := u.WithContext(ctx).Where(u.ID.Eq(secret.ID)).Select(
+		u.Name,
+		u.Config,
+		u.Status,
+		u.Updater,
+	).Updates(secret)
+	return err
+}
+
+// GetSecret 查询 Secret 详情
+func GetSecret(ctx context.Context, id string) (*model.Secret, error) {
+	u := repo.Secret
+	return u.WithContext(ctx).Where(u.ID.Eq(id)).First()
+}
+
+// QuerySecrets 搜索 Secret
+func QuerySecrets(ctx context.Context, param map[string]interface{}) ([]*model.Secret, error) {
+	u := repo.Secret
+	return u.WithContext(ctx).Where(field.Attrs(param)).Find()
+}
+
+// ExistsSecret 查询 Secret 是否存在
+func ExistsSecret(ctx context.Context, id string) bool {
+	u := repo.Secret
+	secret, err := u.WithContext(ctx).Where(
+		u.ID.Eq(id),
+		u.GatewayID.Eq(ginx.GetGatewayInfoFromContext(ctx).ID),
+	).Find()
+	if err != nil {
+		return false
+	}
+	if len(secret) == 0 {
+		return false
+	}
+	return true
+}
+
+// BatchDeleteSecrets 批量删除 Secret 并添加审计日志
+func BatchDeleteSecrets(ctx context.Context, ids []string) error {
+	u := repo.Secret
+	err := repo.Q.Transaction(func(tx *repo.Query) error {
+		ctx = ginx.SetTx(ctx, tx)
+		err := AddDeleteResourceByIDAuditLog(ctx, constant.Secret, ids)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Secret.WithContext(ctx).Where(u.ID.In(ids...)).Delete()
+		return err
+	})
+	return err
+}
+
+// BatchRevertSecrets 批量回滚 Secret
+func BatchRevertSecrets(ctx context.Context, syncDataList []*model.GatewaySyncData) error {
+	var ids []string
+	syncResourceMap := make(map[string]*model.GatewaySyncData)
+	for _, syncData := range syncDataList {
+		ids = append(ids, syncData.ID)
+		syncResourceMap[syncData.ID] = syncData
+	}
+	// 查询原来的数据
+	secrets, err := QuerySecrets(ctx, map[string]interface{}{
+		"id": ids,
+		"status": []constant.ResourceStatus{
+			constant.ResourceStatusDeleteDraft,
+			constant.ResourceStatusUpdateDraft,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	afterResources := make([]*model.ResourceCommonModel, 0, len(secrets))
+	for _, s := range secrets {
+		// 标识此次更新的操作类型为撤销
+		s.OperationType = constant.OperationTypeRevert
+		if s.Status == constant.ResourceStatusDeleteDraft {
+			// 删除待发布回滚只需要更新状态即可
+			s.Status = constant.ResourceStatusSuccess
+			// 用于审计日志更新，只需要补充 ID, Config, Status 即可
+			afterResources = append(afterResources, &model.ResourceCommonModel{
+				ID:     s.ID,
+				Config: s.Config,
+				Status: s.Status,
+			})
+			continue
+		}
+		// 同步更新配置
+		if syncData, ok := syncResourceMap[s.ID]; ok {
+			s.Name = syncData.GetName()
+			s.Config = syncData.Config
+			s.Status = constant.ResourceStatusSuccess
+			// 用于审计日志更新，只需要补充 ID, Config, Status 即可
+			afterResources = append(afterResources, &model.ResourceCommonModel{
+				ID:     s.ID,
+				Config: s.Config,
+				Status: s.Status,
+			})
+			continue
+		} else {
+			return errors.New("can not find sync data for Secret id:" + s.ID)
+		}
+	}
+	err = repo.Q.Transaction(func(tx *repo.Query) error {
+		ctx = ginx.SetTx(ctx, tx)
+		// 添加撤销的审计日志
+		err = WrapBatchRevertResourceAddAuditLog(ctx, constant.Secret, ids, afterResources)
+		if err != nil {
+			return err
+		}
+		for _, s := range secrets {
+			_, err := tx.Secret.WithContext(ctx).Updates(s)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return err
+}
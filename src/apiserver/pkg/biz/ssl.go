@@ -68,6 +68,19 @@ func ListPagedSSL(
 	orderBy string,
 	page PageParam,
 ) ([]*model.SSL, int64, error) {
+	return sslListQuery(ctx, param, label, status, name, updater, orderBy).FindByPage(page.Offset, page.Limit)
+}
+
+// sslListQuery 构造 ssl 列表查询条件
+func sslListQuery(
+	ctx context.Context,
+	param map[string]interface{},
+	label map[string][]string,
+	status []string,
+	name string,
+	updater string,
+	orderBy string,
+) repo.ISSLDo {
 	u := repo.SSL
 	query := u.WithContext(ctx)
 	if name != "" {
@@ -89,8 +102,7 @@ func ListPagedSSL(
 	}
 	return query.Where(cond).
 		Where(field.Attrs(param)).
-		Order(orderByExprs...).
-		FindByPage(page.Offset, page.Limit)
+		Order(orderByExprs...)
 }
 
 // ParseCert 解析证书
@@ -0,0 +1,354 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package biz
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/samber/lo"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+	yamlv2 "gopkg.in/yaml.v2"
+	yamlv3 "gopkg.in/yaml.v3"
+	"gorm.io/datatypes"
+	"sigs.k8s.io/yaml"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/idx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/redact"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/schema"
+)
+
+// standaloneEndMarker APISIX standalone 模式要求 apisix.yaml 以该标记结尾
+const standaloneEndMarker = "#END\n"
+
+// ExportStandaloneYAML 将编辑区资源(不含待删除资源)导出为 APISIX standalone 模式的 apisix.yaml：
+// 资源按发布时的处理转换并以 etcd 的 schema 校验，校验失败的资源跳过并在返回值中说明原因；
+// 顶层 key 与 etcd 前缀一致(routes/upstreams/ssls/...)，同类资源按 id 排序；未指定 includeSecrets 时对敏感字段脱敏
+func ExportStandaloneYAML(
+	ctx context.Context,
+	includeSecrets bool,
+) ([]byte, []dto.StandaloneSkippedResource, error) {
+	gatewayInfo := ginx.GetGatewayInfoFromContext(ctx)
+	customizePluginSchemaMap := GetCustomizePluginSchemaMap(ctx, gatewayInfo.ID)
+	doc := &yamlv3.Node{Kind: yamlv3.MappingNode, Tag: "!!map"}
+	skipped := []dto.StandaloneSkippedResource{}
+	for _, resourceType := range constant.ResourceTypeList {
+		resources, err := QueryResource(ctx, resourceType, map[string]interface{}{
+			"gateway_id": gatewayInfo.ID,
+			"status": []constant.ResourceStatus{
+				constant.ResourceStatusCreateDraft,
+				constant.ResourceStatusUpdateDraft,
+				constant.ResourceStatusSuccess,
+			},
+		}, "")
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(resources) == 0 {
+			continue
+		}
+		validator, err := schema.NewAPISIXJsonSchemaValidator(gatewayInfo.GetAPISIXVersionX(), resourceType,
+			"main."+resourceType.String(), customizePluginSchemaMap, constant.ETCD)
+		if err != nil {
+			return nil, nil, err
+		}
+		schema.SetAllowCustomVars(validator, gatewayInfo.AllowCustomVars)
+		schema.SetRetriesCheck(validator, gatewayInfo.RetriesCheck)
+		sort.Slice(resources, func(i, j int) bool {
+			return resources[i].ID < resources[j].ID
+		})
+		items := make([]*yamlv3.Node, 0, len(resources))
+		for _, resource := range resources {
+			item, err := standaloneItem(resourceType, resource, validator, includeSecrets)
+			if err != nil {
+				skipped = append(skipped, dto.StandaloneSkippedResource{
+					ResourceType: resourceType,
+					ID:           resource.ID,
+					Name:         resource.GetName(resourceType),
+					Reason:       err.Error(),
+				})
+				continue
+			}
+			items = append(items, item)
+		}
+		if len(items) > 0 {
+			doc = appendStandaloneSection(doc, constant.ResourceTypePrefixMap[resourceType], items)
+		}
+	}
+	var buf bytes.Buffer
+	if len(doc.Content) > 0 {
+		encoder := yamlv3.NewEncoder(&buf)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(doc); err != nil {
+			return nil, nil, err
+		}
+		if err := encoder.Close(); err != nil {
+			return nil, nil, err
+		}
+	}
+	buf.WriteString(standaloneEndMarker)
+	return buf.Bytes(), skipped, nil
+}
+
+// appendStandaloneSection 追加资源条目，credential 与 consumer 共用 consumers 段
+func appendStandaloneSection(doc *yamlv3.Node, key string, items []*yamlv3.Node) *yamlv3.Node {
+	for i := 0; i+1 < len(doc.Content); i += 2 {
+		if doc.Content[i].Value == key {
+			doc.Content[i+1].Content = append(doc.Content[i+1].Content, items...)
+			return doc
+		}
+	}
+	doc.Content = append(doc.Content,
+		&yamlv3.Node{Kind: yamlv3.ScalarNode, Tag: "!!str", Value: key},
+		&yamlv3.Node{Kind: yamlv3.SequenceNode, Tag: "!!seq", Content: items})
+	return doc
+}
+
+// standaloneItem 转换单个资源为 apisix.yaml 中的条目：
+// 校验的配置与 put* 写入 etcd 的一致，standalone 模式下除 consumer 以 username 标识外均需带上 id
+func standaloneItem(
+	resourceType constant.APISIXResource,
+	resource *model.ResourceCommonModel,
+	validator schema.Validator,
+	includeSecrets bool,
+) (*yamlv3.Node, error) {
+	config, err := publishedConfig(resourceType, resource)
+	if err != nil {
+		return nil, err
+	}
+	switch resourceType {
+	case constant.Consumer:
+		config, _ = sjson.DeleteBytes(config, "id")
+	case constant.ConsumerGroup:
+		config, _ = sjson.DeleteBytes(config, "id")
+		config, _ = sjson.DeleteBytes(config, "name")
+	}
+	if err := validator.Validate(config); err != nil {
+		return nil, err
+	}
+	switch resourceType {
+	case constant.ConsumerGroup:
+		config, _ = sjson.SetBytes(config, "id", resource.ID)
+	case constant.Credential:
+		// standalone 模式下凭证位于 consumers 中，以 {consumer}/credentials/{id} 作为 id
+		config, _ = sjson.SetBytes(config, "id",
+			strings.TrimPrefix(resource.EtcdKeyOverride, constant.ResourceTypePrefixMap[constant.Credential]+"/"))
+	}
+	if !includeSecrets {
+		config = redact.Config(resourceType, config)
+	}
+	// 数字按原始文本输出，避免整数序列化为科学计数法及大数丢失精度
+	return schema.JSONToYAMLNode(config)
+}
+
+// StageStandaloneYAML 解析 apisix.yaml 并按当前网关的 apisix 版本校验，得到待导入编辑区的资源；
+// 插件元数据按插件名匹配已有资源的 id，其余资源直接使用文件中的 id
+func StageStandaloneYAML(
+	ctx context.Context,
+	data []byte,
+	resourceTypes []constant.APISIXResource,
+) (map[constant.APISIXResource][]*model.GatewaySyncData, []dto.StandaloneImportError, error) {
+	gatewayInfo := ginx.GetGatewayInfoFromContext(ctx)
+	resources, importErrors, err := ParseStandaloneYAML(data, gatewayInfo.GetAPISIXVersionX(),
+		GetCustomizePluginSchemaMap(ctx, gatewayInfo.ID), resourceTypes)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, list := range resources {
+		for _, resource := range list {
+			resource.GatewayID = gatewayInfo.ID
+		}
+	}
+	if metadatas := resources[constant.PluginMetadata]; len(metadatas) > 0 {
+		names := make([]string, 0, len(metadatas))
+		for _, metadata := range metadatas {
+			names = append(names, metadata.GetName())
+		}
+		existing, err := QueryPluginMetadatas(ctx, map[string]interface{}{"gateway_id": gatewayInfo.ID, "name": names})
+		if err != nil {
+			return nil, nil, err
+		}
+		nameIDMap := make(map[string]string, len(existing))
+		for _, metadata := range existing {
+			nameIDMap[metadata.Name] = metadata.ID
+		}
+		for _, metadata := range metadatas {
+			if id, ok := nameIDMap[metadata.GetName()]; ok {
+				metadata.ID = id
+				continue
+			}
+			metadata.ID = idx.GenResourceID(constant.PluginMetadata)
+		}
+	}
+	return resources, importErrors, nil
+}
+
+// ParseStandaloneYAML 解析 APISIX standalone 模式的 apisix.yaml，按顶层 key 拆分为各类资源，
+// 每个资源以指定版本编辑区(DATABASE)的 schema 校验；
+// resourceTypes 为空时导入全部类型，否则只导入指定类型；
+// 格式错误或校验失败的资源记录在返回的错误列表中，不影响其他资源，只有文件整体无法解析时才返回 error
+func ParseStandaloneYAML(
+	data []byte,
+	version constant.APISIXVersion,
+	customizePluginSchemaMap map[string]interface{},
+	resourceTypes []constant.APISIXResource,
+) (map[constant.APISIXResource][]*model.GatewaySyncData, []dto.StandaloneImportError, error) {
+	content := strings.TrimRight(string(data), " \t\r\n")
+	content = strings.TrimSuffix(content, strings.TrimSpace(standaloneEndMarker))
+	var doc yamlv2.MapSlice
+	if err := yamlv2.Unmarshal([]byte(content), &doc); err != nil {
+		return nil, nil, fmt.Errorf("apisix.yaml 解析失败: %w", err)
+	}
+
+	resources := make(map[constant.APISIXResource][]*model.GatewaySyncData)
+	importErrors := []dto.StandaloneImportError{}
+	for _, section := range doc {
+		key := fmt.Sprintf("%v", section.Key)
+		resourceType, ok := constant.ResourcePrefixTypeMap[key]
+		if !ok {
+			importErrors = append(importErrors, dto.StandaloneImportError{
+				ResourceIdentification: key,
+				Reason:                 fmt.Sprintf("不支持的资源类型: %s", key),
+			})
+			continue
+		}
+		// consumers 段中同时包含 consumer 与凭证，按条目的资源类型过滤
+		if len(resourceTypes) > 0 && !lo.Contains(resourceTypes, resourceType) &&
+			!(resourceType == constant.Consumer && lo.Contains(resourceTypes, constant.Credential)) {
+			continue
+		}
+		items, ok := section.Value.([]interface{})
+		if !ok {
+			importErrors = append(importErrors, dto.StandaloneImportError{
+				ResourceType:           resourceType,
+				ResourceIdentification: key,
+				Reason:                 fmt.Sprintf("%s 应为资源列表", key),
+			})
+			continue
+		}
+		validator, err := schema.NewAPISIXJsonSchemaValidator(version, resourceType,
+			"main."+resourceType.String(), customizePluginSchemaMap, constant.DATABASE)
+		if err != nil {
+			return nil, nil, err
+		}
+		validators := map[constant.APISIXResource]schema.Validator{resourceType: validator}
+		seen := make(map[string]struct{}, len(items))
+		for index, item := range items {
+			itemType := resourceType
+			resource, err := standaloneResource(resourceType, item)
+			identification := fmt.Sprintf("%s[%d]", key, index)
+			if resource != nil {
+				itemType = resource.Type
+				identification = resource.ID
+			}
+			if len(resourceTypes) > 0 && !lo.Contains(resourceTypes, itemType) {
+				continue
+			}
+			if err == nil {
+				if _, ok := seen[resource.ID]; ok {
+					err = fmt.Errorf("id 重复")
+				}
+			}
+			if err == nil && itemType == constant.Credential && !schema.SupportsCredential(version) {
+				err = fmt.Errorf("apisix %s 不支持 credential", version)
+			}
+			if err == nil && validators[itemType] == nil {
+				validators[itemType], err = schema.NewAPISIXJsonSchemaValidator(version, itemType,
+					"main."+itemType.String(), customizePluginSchemaMap, constant.DATABASE)
+			}
+			if err == nil {
+				err = validators[itemType].Validate(json.RawMessage(resource.Config))
+			}
+			if err != nil {
+				importErrors = append(importErrors, dto.StandaloneImportError{
+					ResourceType:           itemType,
+					ResourceIdentification: identification,
+					Reason:                 err.Error(),
+				})
+				continue
+			}
+			seen[resource.ID] = struct{}{}
+			resources[itemType] = append(resources[itemType], resource)
+		}
+	}
+	return resources, importErrors, nil
+}
+
+// standaloneResource 将 apisix.yaml 中的条目转换为编辑区资源：
+// consumer 以 username 作为 id，插件元数据以插件名作为名称，未设置名称的资源与同步时一致取 {类型}_{id}
+func standaloneResource(resourceType constant.APISIXResource, item interface{}) (*model.GatewaySyncData, error) {
+	if _, ok := item.(yamlv2.MapSlice); !ok {
+		return nil, fmt.Errorf("资源配置应为对象")
+	}
+	// 解码时已展开锚点/别名，重新序列化后再转换为 json
+	out, err := yamlv2.Marshal(item)
+	if err != nil {
+		return nil, err
+	}
+	config, err := yaml.YAMLToJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	id := gjson.GetBytes(config, "id").String()
+	// consumers 中 id 为 {consumer}/credentials/{id} 的条目为凭证
+	if resourceType == constant.Consumer && model.ConsumerIDFromCredentialEtcdKey(id) != "" {
+		credentialID := id[strings.LastIndex(id, "/")+1:]
+		config, _ = sjson.SetBytes(config, "id", credentialID)
+		resource := &model.GatewaySyncData{
+			Type:            constant.Credential,
+			ID:              credentialID,
+			Config:          datatypes.JSON(config),
+			EtcdKeyOverride: constant.ResourceTypePrefixMap[constant.Credential] + "/" + id,
+		}
+		if resource.GetName() == "" {
+			resource.SetName(fmt.Sprintf("%s_%s", constant.CredentialDir, credentialID))
+		}
+		return resource, nil
+	}
+	if resourceType == constant.Consumer {
+		id = gjson.GetBytes(config, "username").String()
+		config, _ = sjson.DeleteBytes(config, "id")
+	}
+	if id == "" {
+		if resourceType == constant.Consumer {
+			return nil, fmt.Errorf("缺少 username")
+		}
+		return nil, fmt.Errorf("缺少 id")
+	}
+	resource := &model.GatewaySyncData{
+		Type:   resourceType,
+		ID:     id,
+		Config: datatypes.JSON(config),
+	}
+	if resourceType == constant.PluginMetadata {
+		resource.SetName(id)
+	} else if resource.GetName() == "" {
+		resource.SetName(fmt.Sprintf("%s_%s", constant.ResourceTypePrefixMap[resourceType], id))
+	}
+	return resource, nil
+}
@@ -0,0 +1,139 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package biz
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	yamlv2 "gopkg.in/yaml.v2"
+	"gorm.io/datatypes"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/data"
+)
+
+func TestExportStandaloneYAML(t *testing.T) {
+	route := data.Route1WithNoRelationResource(gatewayInfo, constant.ResourceStatusCreateDraft)
+	route.Name = fmt.Sprintf("standalone-%d", time.Now().UnixNano())
+	assert.NoError(t, CreateRoute(gatewayCtx, *route))
+
+	// etcd 中不允许的字段，导出时跳过
+	brokenRoute := data.Route1WithNoRelationResource(gatewayInfo, constant.ResourceStatusCreateDraft)
+	brokenRoute.Name = fmt.Sprintf("standalone-broken-%d", time.Now().UnixNano())
+	brokenRoute.Config = datatypes.JSON(`{"name":"` + brokenRoute.Name + `","uris":["/broken"],"unknown_field":1}`)
+	assert.NoError(t, CreateRoute(gatewayCtx, *brokenRoute))
+	defer func() {
+		assert.NoError(t, BatchDeleteRoutes(gatewayCtx, []string{route.ID, brokenRoute.ID}))
+	}()
+
+	content, skipped, err := ExportStandaloneYAML(gatewayCtx, true)
+	assert.NoError(t, err)
+	assert.True(t, bytes.HasSuffix(content, []byte("\n#END\n")))
+
+	var skippedIDs []string
+	for _, resource := range skipped {
+		skippedIDs = append(skippedIDs, resource.ID)
+	}
+	assert.Contains(t, skippedIDs, brokenRoute.ID)
+	assert.NotContains(t, skippedIDs, route.ID)
+
+	var doc map[string][]map[string]interface{}
+	assert.NoError(t, yamlv2.Unmarshal(content, &doc))
+	routeIDs := map[string]bool{}
+	for i, item := range doc["routes"] {
+		routeIDs[item["id"].(string)] = true
+		if i > 0 {
+			// 同类资源按 id 排序
+			assert.Less(t, doc["routes"][i-1]["id"].(string), item["id"].(string))
+		}
+	}
+	assert.True(t, routeIDs[route.ID])
+	assert.False(t, routeIDs[brokenRoute.ID])
+
+	// 相同数据多次导出结果一致
+	again, _, err := ExportStandaloneYAML(gatewayCtx, true)
+	assert.NoError(t, err)
+	assert.Equal(t, string(content), string(again))
+}
+
+func TestParseStandaloneYAML(t *testing.T) {
+	content := []byte(`routes:
+  - id: r1
+    uris: ["/get"]
+    upstream_id: u1
+  - uris: ["/no-id"]
+  - id: r3
+    uris: ["/bad"]
+    unknown_field: 1
+  - not-an-object
+upstreams:
+  - id: u1
+    type: roundrobin
+    nodes:
+      "127.0.0.1:80": 1
+consumers:
+  - username: jack
+    plugins:
+      key-auth:
+        key: auth-jack
+plugin_metadata:
+  - id: http-logger
+    log_format:
+      host: "$host"
+unknown_things:
+  - id: x
+#END
+`)
+	resources, importErrors, err := ParseStandaloneYAML(content, constant.APISIXVersion313, nil, nil)
+	assert.NoError(t, err)
+
+	assert.Len(t, resources[constant.Route], 1)
+	assert.Equal(t, "r1", resources[constant.Route][0].ID)
+	assert.Equal(t, "routes_r1", resources[constant.Route][0].GetName())
+	assert.Len(t, resources[constant.Upstream], 1)
+	assert.Len(t, resources[constant.Consumer], 1)
+	assert.Equal(t, "jack", resources[constant.Consumer][0].ID)
+	assert.Len(t, resources[constant.PluginMetadata], 1)
+	assert.Equal(t, "http-logger", resources[constant.PluginMetadata][0].GetName())
+
+	identifications := map[string]string{}
+	for _, importErr := range importErrors {
+		identifications[importErr.ResourceIdentification] = importErr.Reason
+	}
+	assert.Len(t, identifications, 4)
+	assert.Contains(t, identifications["routes[1]"], "缺少 id")
+	assert.Contains(t, identifications, "r3")
+	assert.Contains(t, identifications["routes[3]"], "对象")
+	assert.Contains(t, identifications["unknown_things"], "不支持的资源类型")
+
+	// 只导入指定类型
+	resources, importErrors, err = ParseStandaloneYAML(content, constant.APISIXVersion313, nil,
+		[]constant.APISIXResource{constant.Upstream})
+	assert.NoError(t, err)
+	assert.Len(t, resources, 1)
+	assert.Len(t, resources[constant.Upstream], 1)
+	assert.Len(t, importErrors, 1)
+
+	_, _, err = ParseStandaloneYAML([]byte("routes: [\n#END\n"), constant.APISIXVersion313, nil, nil)
+	assert.Error(t, err)
+}
@@ -24,10 +24,12 @@ import (
 	"fmt"
 	"log"
 	"math/rand"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/tidwall/gjson"
 	"github.com/tidwall/sjson"
 	"gorm.io/datatypes"
 	"gorm.io/gorm"
@@ -39,6 +41,7 @@ import (
 	election "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/leaderelection"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/storage"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/publisher"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/status"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
@@ -53,6 +56,12 @@ type UnifyOpInterface interface {
 	SyncerRun(ctx context.Context, resourceChan chan []*model.GatewaySyncData)
 	// SyncWithPrefix 同步指定前缀的资源
 	SyncWithPrefix(ctx context.Context, prefix string) (map[constant.APISIXResource]int, error)
+	// SyncWithResourceTypes 同步指定前缀下指定类型的资源
+	SyncWithResourceTypes(
+		ctx context.Context,
+		prefix string,
+		resourceTypes []constant.APISIXResource,
+	) (map[constant.APISIXResource]int, error)
 	// SyncWithPrefixWithChannel 同步指定前缀的资源，使用 channel 进行落库
 	SyncWithPrefixWithChannel(ctx context.Context, prefix string, resourceChan chan []*model.GatewaySyncData) error
 	// RevertConfigByIDList 回滚指定资源
@@ -274,6 +283,16 @@ func insertSyncedResourcesModel(
 			if err != nil {
 				return err
 			}
+		case constant.Secret:
+			err := BatchCreateSecrets(ctx, resourceList.([]*model.Secret))
+			if err != nil {
+				return err
+			}
+		case constant.Credential:
+			err := BatchCreateCredentials(ctx, resourceList.([]*model.Credential))
+			if err != nil {
+				return err
+			}
 		}
 	}
 	return nil
@@ -367,25 +386,18 @@ func GetSyncItemsAssociatedResources(
 	return nil, nil
 }
 
-// SyncResources 同步资源
+// SyncResources 同步资源，resourceTypes 为空时同步全部类型
 func SyncResources(
 	ctx context.Context,
-	resourceType constant.APISIXResource,
+	resourceTypes []constant.APISIXResource,
 ) (map[constant.APISIXResource]int, error) {
 	gatewayInfo := ginx.GetGatewayInfoFromContext(ctx)
-	prefix := gatewayInfo.EtcdConfig.Prefix
-	if !strings.HasPrefix(gatewayInfo.EtcdConfig.Prefix, "/") {
-		prefix = fmt.Sprintf("%s/", prefix)
-	}
-	if resourceType == "" {
-		prefix = fmt.Sprintf("%s/%s", prefix, constant.ResourceTypePrefixMap[resourceType])
-	}
 	syncer, err := NewUnifyOp(gatewayInfo, false)
 	if err != nil {
 		logging.ErrorFWithContext(ctx, "new syncer error: %s", err.Error())
 		return nil, err
 	}
-	syncedResourceTypeStats, err := syncer.SyncWithPrefix(ctx, prefix)
+	syncedResourceTypeStats, err := syncer.SyncWithResourceTypes(ctx, gatewayInfo.EtcdConfig.Prefix, resourceTypes)
 	if err != nil {
 		logging.ErrorFWithContext(ctx, "sync all error: %s", err.Error())
 		return nil, err
@@ -395,7 +407,7 @@ func SyncResources(
 
 // NewUnifyOp 创建 UnifyOp
 func NewUnifyOp(gatewayInfo *model.Gateway, needElector bool) (*UnifyOp, error) {
-	etcdStore, err := storage.NewEtcdStorage(gatewayInfo.EtcdConfig.EtcdConfig)
+	etcdStore, err := publisher.NewGatewayEtcdStorage(gatewayInfo)
 	if err != nil {
 		return nil, err
 	}
@@ -444,15 +456,31 @@ func (s *UnifyOp) SyncerRun(ctx context.Context, resourceChan chan []*model.Gate
 
 // SyncWithPrefix 同步 prefix 下面的所有资源
 func (s *UnifyOp) SyncWithPrefix(ctx context.Context, prefix string) (map[constant.APISIXResource]int, error) {
+	return s.SyncWithResourceTypes(ctx, prefix, nil)
+}
+
+// SyncWithResourceTypes 同步 prefix 下指定类型的资源，resourceTypes 为空时同步全部资源；
+// 只按类型读取 etcd 中对应的目录，并只替换已同步数据中的这些类型
+func (s *UnifyOp) SyncWithResourceTypes(
+	ctx context.Context,
+	prefix string,
+	resourceTypes []constant.APISIXResource,
+) (map[constant.APISIXResource]int, error) {
 	if !s.isLeader {
 		return nil, nil
 	}
 	logging.Infof("syncer[gateway:%s] start", s.gatewayInfo.Name)
-	kvList, err := s.etcdStore.List(ctx, prefix)
+	kvList, err := listEtcdKeyValues(ctx, s.etcdStore, prefix, resourceTypes)
 	if err != nil {
 		return nil, err
 	}
 	resourceList := s.kvToResource(kvList)
+	if len(resourceTypes) > 0 {
+		// consumer 与凭证位于同一目录，只保留待同步的类型
+		resourceList = slices.DeleteFunc(resourceList, func(resource *model.GatewaySyncData) bool {
+			return !slices.Contains(resourceTypes, resource.Type)
+		})
+	}
 
 	// 获取已同步资源
 	items, err := QuerySyncedItems(ctx, map[string]interface{}{"gateway_id": s.gatewayInfo.ID})
@@ -476,7 +504,15 @@ func (s *UnifyOp) SyncWithPrefix(ctx context.Context, prefix string) (map[consta
 	u := repo.GatewaySyncData
 	err = repo.Q.Transaction(func(tx *repo.Query) error {
 		// 先删除后插入
-		_, err := tx.GatewaySyncData.WithContext(ctx).Where(u.GatewayID.Eq(s.gatewayInfo.ID)).Delete()
+		query := tx.GatewaySyncData.WithContext(ctx).Where(u.GatewayID.Eq(s.gatewayInfo.ID))
+		if len(resourceTypes) > 0 {
+			typeValues := make([]string, 0, len(resourceTypes))
+			for _, resourceType := range resourceTypes {
+				typeValues = append(typeValues, resourceType.String())
+			}
+			query = query.Where(u.Type.In(typeValues...))
+		}
+		_, err := query.Delete()
 		if err != nil {
 			return err
 		}
@@ -530,6 +566,8 @@ var revertConfigByIDListFunc = map[constant.APISIXResource]func(ctx context.Cont
 	constant.SSL:            BatchRevertSSLs,
 	constant.Proto:          BatchRevertProtos,
 	constant.StreamRoute:    BatchRevertStreamRoutes,
+	constant.Secret:         BatchRevertSecrets,
+	constant.Credential:     BatchRevertCredentials,
 }
 
 // RevertConfigByIDList 根据 ID 列表，回滚配置
@@ -580,15 +618,19 @@ func (s *UnifyOp) kvToResource(kvList []storage.KeyValuePair) []*model.GatewaySy
 	consumerGroupIdMap := make(map[string]*model.GatewaySyncData)
 	protoIdMap := make(map[string]*model.GatewaySyncData)
 	streamRouteIdMap := make(map[string]*model.GatewaySyncData)
+	secretIdMap := make(map[string]*model.GatewaySyncData)
+	credentialIdMap := make(map[string]*model.GatewaySyncData)
 	var globalRuleIDs []string
 	var pluginConfigIDs []string
 	var consumerGroupIDs []string
 	var protoIDs []string
 	var streamRouteIDs []string
+	var secretIDs []string
+	var credentialIDs []string
 	for _, kv := range kvList {
 		resourceKeyWithoutPrefix := strings.ReplaceAll(kv.Key, s.gatewayInfo.EtcdConfig.Prefix, "")
 		resourceKeyList := strings.Split(resourceKeyWithoutPrefix, "/")
-		if len(resourceKeyList) != 3 {
+		if len(resourceKeyList) < 3 {
 			// key不合法
 			logging.Errorf("key is not validate: %s", kv.Key)
 			continue
@@ -600,12 +642,44 @@ func (s *UnifyOp) kvToResource(kvList []storage.KeyValuePair) []*model.GatewaySy
 			logging.Errorf("key is not validate without resource type: %s", kv.Key)
 			continue
 		}
+		// consumer 的凭证位于 consumers/{consumer}/credentials/{id}
+		if resourceType == constant.Consumer && len(resourceKeyList) == 5 &&
+			resourceKeyList[3] == constant.CredentialDir {
+			resourceType = constant.Credential
+			resourceTypeValue = constant.CredentialDir
+		}
+		// 旧集群中带子目录的 key 记录为自定义 etcd key，id 优先取配置中的 id
+		var etcdKeyOverride string
+		if resourceType == constant.Credential {
+			etcdKeyOverride = strings.Join(resourceKeyList[1:], "/")
+			id = resourceKeyList[4]
+		} else if resourceType == constant.Secret {
+			// secret 的 key 固定为 secrets/{manager}/{id}，配置中的 id 为 {manager}/{id}
+			if len(resourceKeyList) != 4 {
+				logging.Errorf("key is not validate: %s", kv.Key)
+				continue
+			}
+			etcdKeyOverride = strings.Join(resourceKeyList[1:], "/")
+			id = resourceKeyList[3]
+			kv.Value, _ = sjson.Set(kv.Value, "id", id)
+		} else if len(resourceKeyList) > 3 {
+			if resourceType == constant.PluginMetadata {
+				logging.Errorf("key is not validate: %s", kv.Key)
+				continue
+			}
+			etcdKeyOverride = strings.Join(resourceKeyList[1:], "/")
+			id = gjson.Get(kv.Value, "id").String()
+			if id == "" {
+				id = strings.Join(resourceKeyList[2:], "_")
+			}
+		}
 		resourceInfo := &model.GatewaySyncData{
-			ID:          id,
-			GatewayID:   s.gatewayInfo.ID,
-			Type:        resourceType,
-			Config:      datatypes.JSON(kv.Value),
-			ModRevision: int(kv.ModRevision),
+			ID:              id,
+			GatewayID:       s.gatewayInfo.ID,
+			Type:            resourceType,
+			Config:          datatypes.JSON(kv.Value),
+			ModRevision:     int(kv.ModRevision),
+			EtcdKeyOverride: etcdKeyOverride,
 		}
 		// config 中去除 update_time/create_time，避免影响资源的 diff
 		resourceInfo.Config, _ = sjson.DeleteBytes(resourceInfo.Config, "update_time")
@@ -646,6 +720,16 @@ func (s *UnifyOp) kvToResource(kvList []storage.KeyValuePair) []*model.GatewaySy
 			streamRouteIdMap[id] = resourceInfo
 			streamRouteIDs = append(streamRouteIDs, id)
 		}
+		// Secret name 需要特殊处理
+		if resourceType == constant.Secret {
+			secretIdMap[id] = resourceInfo
+			secretIDs = append(secretIDs, id)
+		}
+		// Credential name 需要特殊处理
+		if resourceType == constant.Credential {
+			credentialIdMap[id] = resourceInfo
+			credentialIDs = append(credentialIDs, id)
+		}
 	}
 	if len(metadataNames) > 0 {
 		// 反向查找ID
@@ -759,6 +843,40 @@ func (s *UnifyOp) kvToResource(kvList []storage.KeyValuePair) []*model.GatewaySy
 			}
 		}
 	}
+
+	// 处理 Secret name
+	if len(secretIDs) > 0 {
+		secrets, err := QuerySecrets(context.Background(), map[string]interface{}{
+			"gateway_id": s.gatewayInfo.ID,
+			"id":         secretIDs,
+		})
+		if err != nil {
+			logging.Errorf("SearchSecret error: %s", err.Error())
+			return nil
+		}
+		for _, secret := range secrets {
+			if g, ok := secretIdMap[secret.ID]; ok {
+				g.Config, _ = sjson.SetBytes(g.Config, "name", secret.Name)
+			}
+		}
+	}
+
+	// 处理 Credential name
+	if len(credentialIDs) > 0 {
+		credentials, err := QueryCredentials(context.Background(), map[string]interface{}{
+			"gateway_id": s.gatewayInfo.ID,
+			"id":         credentialIDs,
+		})
+		if err != nil {
+			logging.Errorf("SearchCredential error: %s", err.Error())
+			return nil
+		}
+		for _, credential := range credentials {
+			if g, ok := credentialIdMap[credential.ID]; ok {
+				g.Config, _ = sjson.SetBytes(g.Config, "name", credential.Name)
+			}
+		}
+	}
 	return resources
 }
 
@@ -791,6 +909,10 @@ func SyncedResourceToAPISIXResource(
 		return syncedResourceToAPISIXProto(syncedResources, status)
 	case constant.StreamRoute:
 		return syncedResourceToAPISIXStreamRoute(syncedResources, status)
+	case constant.Secret:
+		return syncedResourceToAPISIXSecret(syncedResources, status)
+	case constant.Credential:
+		return syncedResourceToAPISIXCredential(syncedResources, status)
 	}
 	return nil
 }
@@ -812,10 +934,11 @@ func syncedResourceToAPISIXRoute(
 			UpstreamID:     syncedResource.GetUpstreamID(),
 			PluginConfigID: syncedResource.GetPluginConfigID(),
 			ResourceCommonModel: model.ResourceCommonModel{
-				ID:        syncedResource.ID,
-				GatewayID: syncedResource.GatewayID,
-				Config:    syncedResource.Config,
-				Status:    status,
+				ID:              syncedResource.ID,
+				GatewayID:       syncedResource.GatewayID,
+				Config:          syncedResource.Config,
+				EtcdKeyOverride: syncedResource.EtcdKeyOverride,
+				Status:          status,
 			},
 			OperationType: OperationType,
 		})
@@ -833,10 +956,11 @@ func syncedServiceToAPISIXRoute(
 			Name:       syncedResource.GetName(),
 			UpstreamID: syncedResource.GetUpstreamID(),
 			ResourceCommonModel: model.ResourceCommonModel{
-				ID:        syncedResource.ID,
-				GatewayID: syncedResource.GatewayID,
-				Config:    syncedResource.Config,
-				Status:    status,
+				ID:              syncedResource.ID,
+				GatewayID:       syncedResource.GatewayID,
+				Config:          syncedResource.Config,
+				EtcdKeyOverride: syncedResource.EtcdKeyOverride,
+				Status:          status,
 			},
 		})
 	}
@@ -852,10 +976,11 @@ func syncedResourceToAPISIXUpstream(
 		upstreams = append(upstreams, &model.Upstream{
 			Name: syncedResource.GetName(),
 			ResourceCommonModel: model.ResourceCommonModel{
-				ID:        syncedResource.ID,
-				GatewayID: syncedResource.GatewayID,
-				Config:    syncedResource.Config,
-				Status:    status,
+				ID:              syncedResource.ID,
+				GatewayID:       syncedResource.GatewayID,
+				Config:          syncedResource.Config,
+				EtcdKeyOverride: syncedResource.EtcdKeyOverride,
+				Status:          status,
 			},
 		})
 	}
@@ -871,10 +996,11 @@ func syncedResourceToAPISIXPluginConfig(
 		pluginConfigs = append(pluginConfigs, &model.PluginConfig{
 			Name: syncedResource.GetName(),
 			ResourceCommonModel: model.ResourceCommonModel{
-				ID:        syncedResource.ID,
-				GatewayID: syncedResource.GatewayID,
-				Config:    syncedResource.Config,
-				Status:    status,
+				ID:              syncedResource.ID,
+				GatewayID:       syncedResource.GatewayID,
+				Config:          syncedResource.Config,
+				EtcdKeyOverride: syncedResource.EtcdKeyOverride,
+				Status:          status,
 			},
 		})
 	}
@@ -894,10 +1020,11 @@ func syncedResourceToAPISIXPluginMetadata(
 		pluginMetadata = append(pluginMetadata, &model.PluginMetadata{
 			Name: name,
 			ResourceCommonModel: model.ResourceCommonModel{
-				ID:        syncedResource.ID,
-				GatewayID: syncedResource.GatewayID,
-				Config:    syncedResource.Config,
-				Status:    status,
+				ID:              syncedResource.ID,
+				GatewayID:       syncedResource.GatewayID,
+				Config:          syncedResource.Config,
+				EtcdKeyOverride: syncedResource.EtcdKeyOverride,
+				Status:          status,
 			},
 		})
 	}
@@ -913,10 +1040,11 @@ func syncedResourceToAPISIXConsumer(
 		consumers = append(consumers, &model.Consumer{
 			Username: syncedResource.GetName(),
 			ResourceCommonModel: model.ResourceCommonModel{
-				ID:        syncedResource.ID,
-				GatewayID: syncedResource.GatewayID,
-				Config:    syncedResource.Config,
-				Status:    constant.ResourceStatusSuccess,
+				ID:              syncedResource.ID,
+				GatewayID:       syncedResource.GatewayID,
+				Config:          syncedResource.Config,
+				EtcdKeyOverride: syncedResource.EtcdKeyOverride,
+				Status:          status,
 			},
 		})
 	}
@@ -932,10 +1060,11 @@ func syncedResourceToAPISIXConsumerGroup(
 		consumerGroups = append(consumerGroups, &model.ConsumerGroup{
 			Name: syncedResource.GetName(),
 			ResourceCommonModel: model.ResourceCommonModel{
-				ID:        syncedResource.ID,
-				GatewayID: syncedResource.GatewayID,
-				Config:    syncedResource.Config,
-				Status:    status,
+				ID:              syncedResource.ID,
+				GatewayID:       syncedResource.GatewayID,
+				Config:          syncedResource.Config,
+				EtcdKeyOverride: syncedResource.EtcdKeyOverride,
+				Status:          status,
 			},
 		})
 	}
@@ -951,10 +1080,11 @@ func syncedResourceToAPISIXGlobalRule(
 		globalRules = append(globalRules, &model.GlobalRule{
 			Name: syncedResource.GetName(),
 			ResourceCommonModel: model.ResourceCommonModel{
-				ID:        syncedResource.ID,
-				GatewayID: syncedResource.GatewayID,
-				Config:    syncedResource.Config,
-				Status:    status,
+				ID:              syncedResource.ID,
+				GatewayID:       syncedResource.GatewayID,
+				Config:          syncedResource.Config,
+				EtcdKeyOverride: syncedResource.EtcdKeyOverride,
+				Status:          status,
 			},
 		})
 	}
@@ -967,10 +1097,11 @@ func syncedResourceToAPISIXSSL(syncedResources []*model.GatewaySyncData, status
 		ssls = append(ssls, &model.SSL{
 			Name: syncedResource.GetName(),
 			ResourceCommonModel: model.ResourceCommonModel{
-				ID:        syncedResource.ID,
-				GatewayID: syncedResource.GatewayID,
-				Config:    syncedResource.Config,
-				Status:    status,
+				ID:              syncedResource.ID,
+				GatewayID:       syncedResource.GatewayID,
+				Config:          syncedResource.Config,
+				EtcdKeyOverride: syncedResource.EtcdKeyOverride,
+				Status:          status,
 			},
 		})
 	}
@@ -986,10 +1117,11 @@ func syncedResourceToAPISIXProto(
 		protos = append(protos, &model.Proto{
 			Name: syncedResource.GetName(),
 			ResourceCommonModel: model.ResourceCommonModel{
-				ID:        syncedResource.ID,
-				GatewayID: syncedResource.GatewayID,
-				Config:    syncedResource.Config,
-				Status:    status,
+				ID:              syncedResource.ID,
+				GatewayID:       syncedResource.GatewayID,
+				Config:          syncedResource.Config,
+				EtcdKeyOverride: syncedResource.EtcdKeyOverride,
+				Status:          status,
 			},
 		})
 	}
@@ -1007,16 +1139,59 @@ func syncedResourceToAPISIXStreamRoute(
 			ServiceID:  syncedResource.GetServiceID(),
 			UpstreamID: syncedResource.GetUpstreamID(),
 			ResourceCommonModel: model.ResourceCommonModel{
-				ID:        syncedResource.ID,
-				GatewayID: syncedResource.GatewayID,
-				Config:    syncedResource.Config,
-				Status:    status,
+				ID:              syncedResource.ID,
+				GatewayID:       syncedResource.GatewayID,
+				Config:          syncedResource.Config,
+				EtcdKeyOverride: syncedResource.EtcdKeyOverride,
+				Status:          status,
 			},
 		})
 	}
 	return streamRoutes
 }
 
+func syncedResourceToAPISIXSecret(
+	syncedResources []*model.GatewaySyncData,
+	status constant.ResourceStatus,
+) []*model.Secret {
+	var secrets []*model.Secret
+	for _, syncedResource := range syncedResources {
+		secrets = append(secrets, &model.Secret{
+			Name:    syncedResource.GetName(),
+			Manager: model.SecretManagerFromEtcdKey(syncedResource.EtcdKeyOverride),
+			ResourceCommonModel: model.ResourceCommonModel{
+				ID:              syncedResource.ID,
+				GatewayID:       syncedResource.GatewayID,
+				Config:          syncedResource.Config,
+				EtcdKeyOverride: syncedResource.EtcdKeyOverride,
+				Status:          status,
+			},
+		})
+	}
+	return secrets
+}
+
+func syncedResourceToAPISIXCredential(
+	syncedResources []*model.GatewaySyncData,
+	status constant.ResourceStatus,
+) []*model.Credential {
+	var credentials []*model.Credential
+	for _, syncedResource := range syncedResources {
+		credentials = append(credentials, &model.Credential{
+			Name:       syncedResource.GetName(),
+			ConsumerID: model.ConsumerIDFromCredentialEtcdKey(syncedResource.EtcdKeyOverride),
+			ResourceCommonModel: model.ResourceCommonModel{
+				ID:              syncedResource.ID,
+				GatewayID:       syncedResource.GatewayID,
+				Config:          syncedResource.Config,
+				EtcdKeyOverride: syncedResource.EtcdKeyOverride,
+				Status:          status,
+			},
+		})
+	}
+	return credentials
+}
+
 // DiffResources 对比资源数据
 func DiffResources(
 	ctx context.Context,
@@ -65,6 +65,19 @@ func ListPagedUpstreams(
 	orderBy string,
 	page PageParam,
 ) ([]*model.Upstream, int64, error) {
+	return upstreamListQuery(ctx, param, label, status, name, updater, orderBy).FindByPage(page.Offset, page.Limit)
+}
+
+// upstreamListQuery 构造 upstream 列表查询条件
+func upstreamListQuery(
+	ctx context.Context,
+	param map[string]interface{},
+	label map[string][]string,
+	status []string,
+	name string,
+	updater string,
+	orderBy string,
+) repo.IUpstreamDo {
 	u := repo.Upstream
 	query := u.WithContext(ctx)
 	if name != "" {
@@ -86,8 +99,7 @@ func ListPagedUpstreams(
 	}
 	return query.Where(cond).
 		Where(field.Attrs(param)).
-		Order(orderByExprs...).
-		FindByPage(page.Offset, page.Limit)
+		Order(orderByExprs...)
 }
 
 // CreateUpstream 创建 upstream
@@ -0,0 +1,214 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package biz
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/schema"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/version"
+)
+
+// 校验器类型
+const (
+	validationKindConfig   = "config"   // 配置的 json schema 校验
+	validationKindResource = "resource" // 资源 schema 及配置校验
+)
+
+// validationCacheFlushBatchSize 校验结果批量写入的大小
+const validationCacheFlushBatchSize = 200
+
+// validationCacheLookups 校验结果缓存的命中情况，命中率 = hit / (hit + miss)
+var validationCacheLookups = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "validation_cache_lookups_total",
+	Help: "validation result cache lookups, partitioned by result(hit/miss)",
+}, []string{"result"})
+
+func init() {
+	prometheus.MustRegister(validationCacheLookups)
+}
+
+// validationCache 批量校验的结果缓存：未变化的资源直接复用上次的校验结果
+//
+// 缓存 key 由配置 hash、APISIX 版本、校验器类型、校验配置指纹及网关的校验规则修订号组成，其中指纹包含内置 schema 摘要、
+// 服务版本、规则级别摘要及上游节点数告警阈值等，升级或调整校验配置后自动失效，失效的记录在写入新结果时清理；
+// 自定义插件 schema 变化时递增修订号使缓存失效
+type validationCache struct {
+	gatewayID     int
+	apisixVersion string
+	revision      int
+	fingerprint   string
+	entries       map[string]*model.ValidationCache // cache key -> 校验结果
+	pending       []*model.ValidationCache          // 待写入的校验结果
+	disabled      bool
+}
+
+// newValidationCache 加载快照修订号及当前指纹下的所有校验结果，快照为 nil 或加载失败时不使用缓存；
+// 修订号与校验使用的自定义插件 schema 取自同一快照，校验结果不会缓存到不匹配的修订号下
+func newValidationCache(
+	ctx context.Context,
+	gatewayInfo *model.Gateway,
+	snapshot *schema.CustomSchemaSnapshot,
+) *validationCache {
+	c := &validationCache{
+		gatewayID:     gatewayInfo.ID,
+		apisixVersion: gatewayInfo.APISIXVersion,
+		entries:       make(map[string]*model.ValidationCache),
+	}
+	// 是否允许自定义变量、重试次数校验模式、规则级别的覆盖及上游节点数告警阈值会影响校验结果
+	fingerprint := sha256.Sum256(fmt.Appendf(nil, "%s:%t:%s:%s:%s:%d",
+		schema.Digest(gatewayInfo.GetAPISIXVersionX()), gatewayInfo.AllowCustomVars, gatewayInfo.RetriesCheck,
+		version.Version+version.GitCommit, schema.RuleSeveritiesDigest(), schema.UpstreamNodesWarnThreshold()))
+	c.fingerprint = hex.EncodeToString(fingerprint[:])
+	if snapshot == nil {
+		c.disabled = true
+		return c
+	}
+	c.revision = snapshot.Revision
+	u := repo.ValidationCache
+	entries, err := u.WithContext(ctx).Where(
+		u.GatewayID.Eq(c.gatewayID),
+		u.APISIXVersion.Eq(c.apisixVersion),
+		u.Revision.Eq(c.revision),
+		u.Fingerprint.Eq(c.fingerprint),
+	).Find()
+	if err != nil {
+		logging.Errorf("load gateway:%d validation cache error: %s", gatewayInfo.ID, err.Error())
+		c.disabled = true
+		return c
+	}
+	for _, entry := range entries {
+		c.entries[entry.CacheKey] = entry
+	}
+	return c
+}
+
+// validate 优先使用缓存的校验结果，未命中时执行校验并暂存结果，返回校验告警及错误
+func (c *validationCache) validate(
+	kind string,
+	resourceType constant.APISIXResource,
+	dataType constant.DataType,
+	config json.RawMessage,
+	validateFunc func() ([]string, error),
+) ([]string, error) {
+	if c.disabled {
+		return validateFunc()
+	}
+	configHash := sha256.Sum256(config)
+	entry := &model.ValidationCache{
+		GatewayID:     c.gatewayID,
+		ConfigHash:    hex.EncodeToString(configHash[:]),
+		APISIXVersion: c.apisixVersion,
+		Kind:          strings.Join([]string{kind, resourceType.String(), string(dataType)}, ":"),
+		Fingerprint:   c.fingerprint,
+		Revision:      c.revision,
+	}
+	cacheKey := sha256.Sum256(fmt.Appendf(nil, "%d|%s|%s|%s|%s|%d",
+		entry.GatewayID, entry.ConfigHash, entry.APISIXVersion, entry.Kind, entry.Fingerprint, entry.Revision))
+	entry.CacheKey = hex.EncodeToString(cacheKey[:])
+	if cached, ok := c.entries[entry.CacheKey]; ok {
+		validationCacheLookups.WithLabelValues("hit").Inc()
+		var warnings []string
+		_ = json.Unmarshal(cached.Warnings, &warnings)
+		if cached.Message != "" {
+			return warnings, errors.New(cached.Message)
+		}
+		return warnings, nil
+	}
+	validationCacheLookups.WithLabelValues("miss").Inc()
+	warnings, err := validateFunc()
+	if err != nil {
+		entry.Message = err.Error()
+	}
+	entry.Warnings, _ = json.Marshal(warnings)
+	c.entries[entry.CacheKey] = entry
+	c.pending = append(c.pending, entry)
+	return warnings, err
+}
+
+// flush 写入新的校验结果并清理网关下已失效的校验结果，写入失败不影响校验流程
+//
+// 指纹或 APISIX 版本变化后所有资源都会重新校验，因此只在有新结果写入时清理即可覆盖失效的记录
+func (c *validationCache) flush(ctx context.Context) {
+	if c.disabled || len(c.pending) == 0 {
+		return
+	}
+	u := repo.ValidationCache
+	err := u.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).
+		CreateInBatches(c.pending, validationCacheFlushBatchSize)
+	if err != nil {
+		logging.Errorf("save gateway:%d validation cache error: %s", c.gatewayID, err.Error())
+	}
+	c.pending = nil
+	staleCond := u.WithContext(ctx).Where(u.Fingerprint.Neq(c.fingerprint)).Or(u.APISIXVersion.Neq(c.apisixVersion))
+	_, err = u.WithContext(ctx).Where(u.GatewayID.Eq(c.gatewayID)).Where(staleCond).Delete()
+	if err != nil {
+		logging.Errorf("purge gateway:%d stale validation cache error: %s", c.gatewayID, err.Error())
+	}
+}
+
+// GetValidationRevision 获取网关的校验规则修订号
+func GetValidationRevision(ctx context.Context, gatewayID int) (int, error) {
+	u := repo.ValidationRuleset
+	ruleset, err := u.WithContext(ctx).Where(u.GatewayID.Eq(gatewayID)).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return ruleset.Revision, nil
+}
+
+// BumpValidationRevision 递增网关的校验规则修订号并清理旧的校验结果缓存，校验规则变化时调用
+func BumpValidationRevision(ctx context.Context, gatewayID int) error {
+	return repo.Q.Transaction(func(tx *repo.Query) error {
+		r := tx.ValidationRuleset
+		info, err := r.WithContext(ctx).Where(r.GatewayID.Eq(gatewayID)).
+			UpdateSimple(r.Revision.Add(1), r.UpdatedAt.Value(time.Now()))
+		if err != nil {
+			return err
+		}
+		// 首次变更时创建修订号记录
+		if info.RowsAffected == 0 {
+			err = r.WithContext(ctx).Create(&model.ValidationRuleset{GatewayID: gatewayID, Revision: 1})
+			if err != nil {
+				return err
+			}
+		}
+		u := tx.ValidationCache
+		_, err = u.WithContext(ctx).Where(u.GatewayID.Eq(gatewayID)).Delete()
+		return err
+	})
+}
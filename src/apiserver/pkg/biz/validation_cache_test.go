@@ -0,0 +1,174 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package biz
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	promdto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/datatypes"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/schema"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/data"
+)
+
+// validationCacheLookupCount 校验结果缓存命中/未命中次数
+func validationCacheLookupCount(t *testing.T, result string) float64 {
+	metric := &promdto.Metric{}
+	assert.NoError(t, validationCacheLookups.WithLabelValues(result).Write(metric))
+	return metric.GetCounter().GetValue()
+}
+
+func TestComplianceValidationCache(t *testing.T) {
+	// 清空当前网关已缓存的校验结果
+	assert.NoError(t, BumpValidationRevision(gatewayCtx, gatewayInfo.ID))
+	route := data.Route1WithNoRelationResource(gatewayInfo, constant.ResourceStatusCreateDraft)
+	route.Name = fmt.Sprintf("validation-cache-%d", time.Now().UnixNano())
+	assert.NoError(t, CreateRoute(gatewayCtx, *route))
+
+	hits, misses := validationCacheLookupCount(t, "hit"), validationCacheLookupCount(t, "miss")
+	_, firstResults, err := RunComplianceChecks(gatewayCtx, nil, func(int, int) {})
+	assert.NoError(t, err)
+	firstHits := validationCacheLookupCount(t, "hit") - hits
+	firstMisses := validationCacheLookupCount(t, "miss") - misses
+	assert.Positive(t, firstMisses)
+
+	// 第二次检查时资源均未变化，全部命中缓存，不再执行校验
+	hits, misses = validationCacheLookupCount(t, "hit"), validationCacheLookupCount(t, "miss")
+	_, secondResults, err := RunComplianceChecks(gatewayCtx, nil, func(int, int) {})
+	assert.NoError(t, err)
+	assert.Zero(t, validationCacheLookupCount(t, "miss")-misses)
+	assert.Equal(t, firstHits+firstMisses, validationCacheLookupCount(t, "hit")-hits)
+	assert.Equal(t, firstResults, secondResults)
+
+	// 修改配置后仅该资源重新校验
+	route.Config = datatypes.JSON(`{"uris":["/validation-cache"],"upstream":{"type":"roundrobin",` +
+		`"nodes":[{"host":"1.1.1.1","port":80,"weight":1}]}}`)
+	assert.NoError(t, UpdateRoute(gatewayCtx, *route))
+	misses = validationCacheLookupCount(t, "miss")
+	_, _, err = RunComplianceChecks(gatewayCtx, nil, func(int, int) {})
+	assert.NoError(t, err)
+	assert.Equal(t, float64(1), validationCacheLookupCount(t, "miss")-misses)
+}
+
+func TestValidationCacheInvalidation(t *testing.T) {
+	items := []dto.ResourceValidateItem{
+		// 缺少 uri
+		{Type: constant.Route, Config: json.RawMessage(`{"name":"r","methods":["GET"]}`)},
+	}
+	first := BatchValidateResources(gatewayCtx, items)
+	hits := validationCacheLookupCount(t, "hit")
+	// 缓存的校验失败结果与实际校验一致
+	assert.Equal(t, first, BatchValidateResources(gatewayCtx, items))
+	assert.Equal(t, float64(1), validationCacheLookupCount(t, "hit")-hits)
+
+	// 自定义插件 schema 变化后修订号递增，已缓存的校验结果被清理
+	revision, err := GetValidationRevision(gatewayCtx, gatewayInfo.ID)
+	assert.NoError(t, err)
+	pluginSchema := &model.GatewayCustomPluginSchema{
+		GatewayID: gatewayInfo.ID,
+		Name:      fmt.Sprintf("validation-cache-plugin-%d", time.Now().UnixNano()),
+		Schema:    datatypes.JSON(`{"type":"object"}`),
+		Example:   datatypes.JSON(`{}`),
+	}
+	assert.NoError(t, CreateSchema(gatewayCtx, pluginSchema))
+	newRevision, err := GetValidationRevision(gatewayCtx, gatewayInfo.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, revision+1, newRevision)
+	u := repo.ValidationCache
+	count, err := u.WithContext(gatewayCtx).Where(u.GatewayID.Eq(gatewayInfo.ID)).Count()
+	assert.NoError(t, err)
+	assert.Zero(t, count)
+
+	misses := validationCacheLookupCount(t, "miss")
+	assert.Equal(t, first, BatchValidateResources(gatewayCtx, items))
+	assert.Equal(t, float64(1), validationCacheLookupCount(t, "miss")-misses)
+	assert.NoError(t, DeleteSchema(gatewayCtx, pluginSchema.AutoID))
+}
+
+func TestValidationCacheRuleSeverityOverride(t *testing.T) {
+	items := []dto.ResourceValidateItem{{Type: constant.Route, Config: json.RawMessage(
+		`{"name":"severity","uri":"/severity","vars":[],"upstream":{"type":"roundrobin",` +
+			`"nodes":[{"host":"1.1.1.1","port":80,"weight":1}]}}`)}}
+	results := BatchValidateResources(gatewayCtx, items)
+	assert.True(t, results[0].Valid, results[0].Errors)
+	assert.NotEmpty(t, results[0].Warnings)
+	hits := validationCacheLookupCount(t, "hit")
+	assert.Equal(t, results, BatchValidateResources(gatewayCtx, items))
+	assert.Equal(t, float64(1), validationCacheLookupCount(t, "hit")-hits)
+
+	// 规则提升为 error 后不再复用缓存的告警结果
+	assert.NoError(t, schema.SetRuleSeverityOverrides(map[string]string{schema.RuleEmptyMatch: "error"}))
+	defer func() {
+		assert.NoError(t, schema.SetRuleSeverityOverrides(map[string]string{}))
+	}()
+	results = BatchValidateResources(gatewayCtx, items)
+	assert.False(t, results[0].Valid)
+	assert.NotEmpty(t, results[0].Errors)
+}
+
+func TestValidationCacheUpstreamNodesThreshold(t *testing.T) {
+	items := []dto.ResourceValidateItem{{Type: constant.Upstream, Config: json.RawMessage(
+		`{"name":"nodes-threshold","type":"roundrobin","nodes":[{"host":"1.1.1.1","port":80,"weight":1},` +
+			`{"host":"1.1.1.2","port":80,"weight":1}]}`)}}
+	results := BatchValidateResources(gatewayCtx, items)
+	assert.True(t, results[0].Valid, results[0].Errors)
+	assert.Empty(t, results[0].Warnings)
+
+	// 调低阈值后不再复用缓存的无告警结果
+	schema.SetUpstreamNodesWarnThreshold(1)
+	defer schema.SetUpstreamNodesWarnThreshold(schema.DefaultUpstreamNodesWarnThreshold)
+	results = BatchValidateResources(gatewayCtx, items)
+	assert.True(t, results[0].Valid, results[0].Errors)
+	assert.NotEmpty(t, results[0].Warnings)
+}
+
+func TestValidationCachePurgeStaleEntries(t *testing.T) {
+	revision, err := GetValidationRevision(gatewayCtx, gatewayInfo.ID)
+	assert.NoError(t, err)
+	stale := &model.ValidationCache{
+		CacheKey:      fmt.Sprintf("stale-%d", time.Now().UnixNano()),
+		GatewayID:     gatewayInfo.ID,
+		APISIXVersion: gatewayInfo.APISIXVersion,
+		Kind:          validationKindResource,
+		Fingerprint:   "stale",
+		Revision:      revision,
+	}
+	u := repo.ValidationCache
+	assert.NoError(t, u.WithContext(gatewayCtx).Create(stale))
+
+	// 写入新的校验结果时清理指纹不一致的记录
+	items := []dto.ResourceValidateItem{{Type: constant.Route, Config: json.RawMessage(
+		fmt.Sprintf(`{"name":"purge","uri":"/purge-%d"}`, time.Now().UnixNano()))}}
+	BatchValidateResources(gatewayCtx, items)
+	count, err := u.WithContext(gatewayCtx).Where(u.CacheKey.Eq(stale.CacheKey)).Count()
+	assert.NoError(t, err)
+	assert.Zero(t, count)
+	count, err = u.WithContext(gatewayCtx).Where(u.GatewayID.Eq(gatewayInfo.ID)).Count()
+	assert.NoError(t, err)
+	assert.Positive(t, count)
+}
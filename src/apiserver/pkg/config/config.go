@@ -20,6 +20,8 @@
 package config
 
 import (
+	"slices"
+
 	"github.com/pkg/errors"
 	"github.com/samber/lo"
 
@@ -60,3 +62,11 @@ func IsDemoMode() bool {
 	}
 	return G.Service.DemoMode
 }
+
+// IsAdmin 是否为管理员
+func IsAdmin(userID string) bool {
+	if G == nil || userID == "" {
+		return false
+	}
+	return slices.Contains(G.Service.AdminUsers, userID)
+}
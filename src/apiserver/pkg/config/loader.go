@@ -20,6 +20,7 @@ package config
 
 import (
 	"encoding/json"
+	"net"
 	"os"
 	"path/filepath"
 	"strings"
@@ -96,6 +97,7 @@ func loadConfigFromEnv() (*Config, error) {
 		MysqlConfig:     mysqlCfg,
 		BkPlatUrlConfig: bkPlatUrl,
 		Crypto:          crypto,
+		BlobStorage:     loadBlobStorageFromEnv(),
 	}, nil
 }
 
@@ -121,7 +123,24 @@ func loadMysqlConfigFromEnv() (*MysqlConfig, error) {
 		return nil, errors.Wrapf(err, "invalid GCS_MYSQL_PORT: %s", port)
 	}
 
-	return &MysqlConfig{Host: host, Port: mysqlPort, Name: name, User: user, Password: passwd, Charset: charset}, nil
+	cfg := &MysqlConfig{Host: host, Port: mysqlPort, Name: name, User: user, Password: passwd, Charset: charset}
+
+	// 只读副本在环境变量中格式如 "replica1:3306,replica2:3306"，库名及账号与主库一致
+	if val := envx.Get("MYSQL_REPLICA_HOSTS", ""); val != "" {
+		for _, addr := range strings.Split(val, ",") {
+			replicaHost, replicaPort, err := net.SplitHostPort(strings.TrimSpace(addr))
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid MYSQL_REPLICA_HOSTS: %s", val)
+			}
+			replica := *cfg
+			replica.Host, replica.Replicas = replicaHost, nil
+			if replica.Port, err = cast.ToIntE(replicaPort); err != nil {
+				return nil, errors.Wrapf(err, "invalid MYSQL_REPLICA_HOSTS: %s", val)
+			}
+			cfg.Replicas = append(cfg.Replicas, replica)
+		}
+	}
+	return cfg, nil
 }
 
 // 从环境变量读取服务配置
@@ -134,6 +153,11 @@ func loadServiceConfigFromEnv() (ServiceConfig, error) {
 		// 允许访问的用户在环境变量中格式如 "admin,userAlpha,userBeta"
 		allowedUsers = strings.Split(val, ",")
 	}
+	adminUsers := []string{}
+	if val := envx.Get("ADMIN_USERS", ""); val != "" {
+		// 管理员在环境变量中格式如 "admin,userAlpha"
+		adminUsers = strings.Split(val, ",")
+	}
 	// 默认允许任意源访问
 	allowedOrigins := []string{"*"}
 	if val := envx.Get("ALLOWED_ORIGINS", ""); val != "" {
@@ -144,10 +168,12 @@ func loadServiceConfigFromEnv() (ServiceConfig, error) {
 		Server: ServerConfig{
 			Port:         cast.ToInt(envx.Get("PORT", "8080")),
 			GraceTimeout: cast.ToInt(envx.Get("GRACE_TIMEOUT", "30")),
+			DrainTimeout: cast.ToInt(envx.Get("DRAIN_TIMEOUT", "20")),
 			GinRunMode: envx.Get(
 				"GIN_RUN_MODE",
 				lo.Ternary[string](isLocalDev, gin.DebugMode, gin.ReleaseMode),
 			),
+			MaxDecompressedBodySize: cast.ToInt64(envx.Get("MAX_DECOMPRESSED_BODY_SIZE", "67108864")),
 		},
 		Log: LogConfig{
 			Level: envx.Get(
@@ -165,8 +191,13 @@ func loadServiceConfigFromEnv() (ServiceConfig, error) {
 				lo.Ternary(isLocalDev, "debug", "error"),
 			),
 		},
+		RateLimit: RateLimitConfig{
+			Rate:  cast.ToFloat64(envx.Get("RATE_LIMIT_RATE", "0")),
+			Burst: cast.ToInt(envx.Get("RATE_LIMIT_BURST", "0")),
+		},
 		AllowedOrigins: allowedOrigins,
 		AllowedUsers:   allowedUsers,
+		AdminUsers:     adminUsers,
 		HealthzToken:   envx.Get("HEALTHZ_TOKEN", ""),
 		MetricToken:    envx.Get("METRIC_TOKEN", "metric_token"),
 		EnableSwagger:  cast.ToBool(envx.Get("ENABLE_SWAGGER", lo.Ternary(isLocalDev, "true", "false"))),
@@ -201,6 +232,23 @@ func loadSentryFromEnv() Sentry {
 	}
 }
 
+// 从环境变量读取大对象存储配置
+func loadBlobStorageFromEnv() BlobStorageConfig {
+	return BlobStorageConfig{
+		Backend:         envx.Get("BLOB_STORAGE_BACKEND", "db"),
+		FallbackBackend: envx.Get("BLOB_STORAGE_FALLBACK_BACKEND", ""),
+		S3: S3Config{
+			Endpoint:  envx.Get("BLOB_STORAGE_S3_ENDPOINT", ""),
+			Region:    envx.Get("BLOB_STORAGE_S3_REGION", "us-east-1"),
+			Bucket:    envx.Get("BLOB_STORAGE_S3_BUCKET", ""),
+			AccessKey: envx.Get("BLOB_STORAGE_S3_ACCESS_KEY", ""),
+			SecretKey: envx.Get("BLOB_STORAGE_S3_SECRET_KEY", ""),
+			Prefix:    envx.Get("BLOB_STORAGE_S3_PREFIX", ""),
+			PathStyle: envx.GetBoolean("BLOB_STORAGE_S3_PATH_STYLE", false),
+		},
+	}
+}
+
 // 从环境变量读取trace地址 TODO： 部署文档补充相关配置/说明
 func loadTraceFromEnv() Tracing {
 	return Tracing{
@@ -245,6 +293,12 @@ func loadBizConfigFromEnv() (BizConfig, error) {
 		}
 		tokenMap[token] = true
 	}
+	// 校验规则级别覆盖在环境变量中格式如 {"empty_match": "error"}
+	ruleSeverities := make(map[string]string)
+	err = json.Unmarshal([]byte(envx.Get("RULE_SEVERITIES", "{}")), &ruleSeverities)
+	if err != nil {
+		return BizConfig{}, errors.Wrap(err, "failed to unmarshal RULE_SEVERITIES")
+	}
 	demoProtectResources := envx.Get("DEMO_PROTECT_RESOURCES", "")
 	demoProtectResourcesList := strings.Split(demoProtectResources, ";")
 	demoProtectResourceMap := make(map[string]bool)
@@ -264,6 +318,11 @@ func loadBizConfigFromEnv() (BizConfig, error) {
 			BKFeedBackLink: envx.Get("BK_FEED_BACK_LINK", ""),
 			BKGuideLink:    envx.Get("BK_GUIDE_LINK", ""),
 		},
+		SchemaDir:                     envx.Get("SCHEMA_DIR", ""),
+		RuleSeverities:                ruleSeverities,
+		UpstreamNodesWarnThreshold:    envx.GetInt("UPSTREAM_NODES_WARN_THRESHOLD", 0),
+		ResourceRevisionRetention:     envx.GetInt("RESOURCE_REVISION_RETENTION", 0),
+		ComplianceReportRetentionDays: envx.GetInt("COMPLIANCE_REPORT_RETENTION_DAYS", 0),
 	}, nil
 }
 
@@ -40,6 +40,8 @@ type Config struct {
 	Crypto Crypto
 	// 蓝鲸各平台地址
 	BkPlatUrlConfig BkPlatUrlConfig
+	// 大对象存储配置
+	BlobStorage BlobStorageConfig
 }
 
 // ServiceConfig 服务配置
@@ -48,11 +50,15 @@ type ServiceConfig struct {
 	Server ServerConfig
 	// 日志配置
 	Log LogConfig
+	// 接口限流配置
+	RateLimit RateLimitConfig
 
 	// CORS 允许来源列表
 	AllowedOrigins []string
 	// AllowedUsers 允许访问的用户列表（UserID）
 	AllowedUsers []string
+	// AdminUsers 管理员用户列表（UserID），可豁免网关接入引导中未通过的步骤
+	AdminUsers []string
 	// 健康探针 Token
 	HealthzToken string
 	// 指标 API Token
@@ -86,8 +92,20 @@ type ServerConfig struct {
 	Port int
 	// 优雅退出等待时间
 	GraceTimeout int
+	// 优雅退出时等待进行中的发布任务完成的时间，超时后任务将被标记为中断，重启后恢复
+	DrainTimeout int
 	// Gin 运行模式
 	GinRunMode string
+	// gzip 压缩的请求体解压后允许的最大字节数
+	MaxDecompressedBodySize int64
+}
+
+// RateLimitConfig 接口限流配置，登录用户按用户、其余按客户端 IP 限流
+type RateLimitConfig struct {
+	// 每秒补充的令牌数，为 0 时不限流
+	Rate float64
+	// 允许的突发请求数，为 0 时取 Rate 向上取整
+	Burst int
 }
 
 // LogConfig 日志配置
@@ -144,6 +162,8 @@ type MysqlConfig struct {
 	User     string
 	Password string
 	Charset  string
+	// 只读副本，配置后列表等读请求默认走副本
+	Replicas []MysqlConfig
 }
 
 // DSN ...
@@ -159,6 +179,30 @@ func (cfg *MysqlConfig) DSN() string {
 	)
 }
 
+// BlobStorageConfig 大对象(合规报告明细等)存储配置
+type BlobStorageConfig struct {
+	// 存储后端，可选值为：db、s3，默认 db
+	Backend string
+	// 迁移期间的回退后端，主后端读取不到时从该后端读取，为空表示不回退
+	FallbackBackend string
+	// S3 兼容对象存储配置
+	S3 S3Config
+}
+
+// S3Config S3 兼容对象存储配置
+type S3Config struct {
+	// 服务地址，如 https://cos.ap-guangzhou.myqcloud.com
+	Endpoint  string
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+	// 对象 key 前缀
+	Prefix string
+	// 使用 path-style 访问(endpoint/bucket/key)，否则使用 virtual-hosted-style(bucket.endpoint/key)
+	PathStyle bool
+}
+
 // BkPlatUrlConfig 蓝鲸各平台服务地址
 type BkPlatUrlConfig struct {
 	// 蓝鲸开发者中心地址
@@ -178,6 +222,14 @@ type BizConfig struct {
 	OpenApiTokenWhitelist map[string]bool   // OpenAPI 接口token白名单
 	DemoProtectResources  map[string]bool   // demo模式保护资源列表
 	Links                 LinkConfig        // 前端需要的链接相关配置
+	SchemaDir             string            `mapstructure:"schema_dir"`      // 外部 schema 目录，覆盖内置 schema 并监听变化
+	RuleSeverities        map[string]string `mapstructure:"rule_severities"` // 校验规则级别覆盖：rule id -> error/warning
+	// 上游静态节点数告警阈值，为 0 时使用默认值，小于 0 时不检查
+	UpstreamNodesWarnThreshold int `mapstructure:"upstream_nodes_warn_threshold"`
+	// 每个资源保留的历史版本数，为 0 时使用默认值，小于 0 时不清理
+	ResourceRevisionRetention int `mapstructure:"resource_revision_retention"`
+	// 合规报告保留天数，为 0 时使用默认值，小于 0 时不清理
+	ComplianceReportRetentionDays int `mapstructure:"compliance_report_retention_days"`
 }
 
 type LinkConfig struct {
@@ -30,17 +30,20 @@ const (
 	PluginConfig   APISIXResource = "plugin_config"
 	PluginMetadata APISIXResource = "plugin_metadata"
 	Consumer       APISIXResource = "consumer"
+	Credential     APISIXResource = "credential" // consumer 的凭证，apisix 3.11 起支持
 	ConsumerGroup  APISIXResource = "consumer_group"
 	GlobalRule     APISIXResource = "global_rule"
 	Proto          APISIXResource = "proto"
 	SSL            APISIXResource = "ssl"
 	StreamRoute    APISIXResource = "stream_route"
+	Secret         APISIXResource = "secret"
 	Schema         APISIXResource = "schema"  // 操作审计场景使用
 	Gateway        APISIXResource = "gateway" // 操作审计场景使用
 )
 
 // RelationIDFiledMap ...
 var RelationIDFiledMap = map[APISIXResource]string{
+	Consumer:      "consumer_id",
 	Service:       "service_id",
 	Upstream:      "upstream_id",
 	PluginConfig:  "plugin_config_id",
@@ -76,10 +79,12 @@ var ResourceTypeMap = map[APISIXResource]string{
 	Proto:          "proto",
 	SSL:            "证书",
 	Consumer:       "消费者",
+	Credential:     "凭证",
 	ConsumerGroup:  "消费者组",
 	PluginMetadata: "插件元数据",
 	GlobalRule:     "全局规则",
 	PluginConfig:   "插件组",
+	Secret:         "密钥",
 	Schema:         "自定义插件",
 	Gateway:        "网关",
 }
@@ -93,10 +98,12 @@ var ResourceTypeOrder = []APISIXResource{
 	Proto,
 	SSL,
 	Consumer,
+	Credential,
 	ConsumerGroup,
 	PluginMetadata,
 	GlobalRule,
 	PluginConfig,
+	Secret,
 	Schema,
 	Gateway,
 }
@@ -108,12 +115,14 @@ var ResourceTypeList = []APISIXResource{
 	Upstream,
 	PluginConfig,
 	PluginMetadata,
+	Credential, // 先于 consumer 发布: 删除时需先删除凭证，新增时会先发布所属 consumer
 	Consumer,
 	ConsumerGroup,
 	GlobalRule,
 	Proto,
 	SSL,
 	StreamRoute,
+	Secret,
 }
 
 // APISIXVersion ...
@@ -144,6 +153,7 @@ var ResourceRelationMap = map[APISIXResource][]APISIXResource{
 	Upstream:      {Route, Service, StreamRoute},
 	SSL:           {Upstream},
 	ConsumerGroup: {Consumer},
+	Consumer:      {Credential},
 }
 
 // PluginsMustResourceMap 必须要配置插件的资源
@@ -152,4 +162,5 @@ var PluginsMustResourceMap = map[APISIXResource]bool{
 	PluginMetadata: true,
 	ConsumerGroup:  true,
 	GlobalRule:     true,
+	Credential:     true,
 }
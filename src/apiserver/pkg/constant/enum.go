@@ -99,6 +99,7 @@ const (
 	Protos          ResourcePath = "protos"
 	SSLs            ResourcePath = "ssls"
 	StreamRoutes    ResourcePath = "stream_routes"
+	Secrets         ResourcePath = "secrets"
 )
 
 // ResourcePathToTypeMap ...
@@ -114,6 +115,7 @@ var ResourcePathToTypeMap = map[ResourcePath]APISIXResource{
 	Protos:          Proto,
 	SSLs:            SSL,
 	StreamRoutes:    StreamRoute,
+	Secrets:         Secret,
 }
 
 // ResourceTypePrefixMap ...
@@ -122,6 +124,7 @@ var ResourceTypePrefixMap = map[APISIXResource]string{
 	Upstream:       "upstreams",
 	Service:        "services",
 	Consumer:       "consumers",
+	Credential:     "consumers", // 凭证位于所属 consumer 的子目录: consumers/{consumer}/credentials/{id}
 	GlobalRule:     "global_rules",
 	ConsumerGroup:  "consumer_groups",
 	PluginConfig:   "plugin_configs",
@@ -129,8 +132,12 @@ var ResourceTypePrefixMap = map[APISIXResource]string{
 	Proto:          "protos",
 	SSL:            "ssls",
 	StreamRoute:    "stream_routes",
+	Secret:         "secrets",
 }
 
+// CredentialDir consumer 下存放凭证的子目录
+const CredentialDir = "credentials"
+
 // ResourcePrefixTypeMap ...
 var ResourcePrefixTypeMap = map[string]APISIXResource{
 	"routes":          Route,
@@ -144,6 +151,7 @@ var ResourcePrefixTypeMap = map[string]APISIXResource{
 	"protos":          Proto,
 	"ssls":            SSL,
 	"stream_routes":   StreamRoute,
+	"secrets":         Secret,
 }
 
 // SyncStatus 资源同步状态
@@ -197,6 +205,7 @@ const (
 	OperationTypePublish     OperationType = "publish"           // 同步
 	OperationTypeRevert      OperationType = "revert"            // 撤销
 	OperationTypeFixConflict OperationType = "fix_conflict"      // 解决冲突
+	OperationTypeExpire      OperationType = "expire"            // 临时资源过期
 	OperationOneClickManaged OperationType = "one_click_managed" // 一键同步（数据量太大，不添加审计）
 )
 
@@ -208,6 +217,7 @@ var OperationTypeMap = map[OperationType]string{
 	OperationTypePublish:     "发布",
 	OperationTypeRevert:      "撤销",
 	OperationTypeFixConflict: "解决冲突",
+	OperationTypeExpire:      "过期删除",
 }
 
 // HTTP ...
@@ -244,3 +254,178 @@ const (
 	DATABASE DataType = "db"
 	ETCD     DataType = "etcd"
 )
+
+// ComplianceReportStatus 合规报告生成状态
+type ComplianceReportStatus string
+
+const (
+	ComplianceReportStatusPending ComplianceReportStatus = "pending" // 等待生成
+	ComplianceReportStatusRunning ComplianceReportStatus = "running" // 生成中
+	ComplianceReportStatusSuccess ComplianceReportStatus = "success" // 生成成功
+	ComplianceReportStatusFailed  ComplianceReportStatus = "failed"  // 生成失败
+)
+
+// PublishTaskStatus 发布任务状态
+type PublishTaskStatus string
+
+const (
+	PublishTaskStatusRunning     PublishTaskStatus = "running"     // 发布中
+	PublishTaskStatusSuccess     PublishTaskStatus = "success"     // 发布成功
+	PublishTaskStatusFailed      PublishTaskStatus = "failed"      // 发布失败
+	PublishTaskStatusInterrupted PublishTaskStatus = "interrupted" // 服务退出导致中断，等待恢复
+)
+
+// ChangeSetStatus 变更集状态
+type ChangeSetStatus string
+
+const (
+	ChangeSetStatusOpen      ChangeSetStatus = "open"      // 进行中
+	ChangeSetStatusPublished ChangeSetStatus = "published" // 已发布
+	ChangeSetStatusAbandoned ChangeSetStatus = "abandoned" // 已放弃
+)
+
+// EtcdWriteOp etcd 写操作类型
+type EtcdWriteOp string
+
+const (
+	EtcdWriteOpPut    EtcdWriteOp = "put"    // 写入
+	EtcdWriteOpDelete EtcdWriteOp = "delete" // 删除
+)
+
+// ComplianceCheck 合规检查项
+type ComplianceCheck string
+
+const (
+	ComplianceCheckSchemaDatabase     ComplianceCheck = "schema_database"      // 编辑区配置 schema 校验
+	ComplianceCheckSchemaEtcd         ComplianceCheck = "schema_etcd"          // etcd 生效配置 schema 校验
+	ComplianceCheckReference          ComplianceCheck = "reference"            // 关联资源完整性
+	ComplianceCheckSSLExpiry          ComplianceCheck = "ssl_expiry"           // 证书有效期
+	ComplianceCheckSNI                ComplianceCheck = "sni"                  // stream route sni 证书覆盖
+	ComplianceCheckDrift              ComplianceCheck = "drift"                // 编辑区与 etcd 配置漂移
+	ComplianceCheckDataPlaneReference ComplianceCheck = "data_plane_reference" // etcd 生效配置的依赖缺失，数据面直接 5xx
+)
+
+// ComplianceSeverity 合规问题严重程度
+type ComplianceSeverity string
+
+const (
+	ComplianceSeverityCritical ComplianceSeverity = "critical" // 已影响数据面请求，需立即处理
+	ComplianceSeverityWarning  ComplianceSeverity = "warning"  // 存在风险，需关注
+)
+
+// ResourceDriftStatus 编辑区(DATABASE)与 etcd(ETCD)中资源配置的对比结果
+type ResourceDriftStatus string
+
+const (
+	ResourceDriftStatusInSync        ResourceDriftStatus = "in_sync"         // 配置一致
+	ResourceDriftStatusModified      ResourceDriftStatus = "modified"        // 配置不一致
+	ResourceDriftStatusMissingInEtcd ResourceDriftStatus = "missing_in_etcd" // 仅存在于编辑区
+	ResourceDriftStatusMissingInDB   ResourceDriftStatus = "missing_in_db"   // 仅存在于 etcd
+)
+
+// EtcdImportStrategy etcd 资源导入编辑区时，编辑区已有资源且配置不一致的处理策略
+type EtcdImportStrategy string
+
+const (
+	EtcdImportStrategySkip      EtcdImportStrategy = "skip"      // 跳过冲突资源，保留编辑区配置
+	EtcdImportStrategyOverwrite EtcdImportStrategy = "overwrite" // 以 etcd 中的配置覆盖编辑区
+	EtcdImportStrategyFail      EtcdImportStrategy = "fail"      // 存在冲突时不导入任何资源
+)
+
+// EtcdImportAction 单个 etcd 资源的导入结果
+type EtcdImportAction string
+
+const (
+	EtcdImportActionCreated     EtcdImportAction = "created"     // 新增到编辑区
+	EtcdImportActionOverwritten EtcdImportAction = "overwritten" // 覆盖编辑区中不一致的配置
+	EtcdImportActionUnchanged   EtcdImportAction = "unchanged"   // 编辑区配置与 etcd 一致，无需导入
+	EtcdImportActionConflict    EtcdImportAction = "conflict"    // 编辑区配置与 etcd 不一致，未导入
+	EtcdImportActionInvalid     EtcdImportAction = "invalid"     // etcd 中的配置未通过校验，未导入
+	EtcdImportActionFailed      EtcdImportAction = "failed"      // 写入编辑区失败
+	EtcdImportActionAborted     EtcdImportAction = "aborted"     // 因 fail 策略下存在冲突而未导入
+)
+
+// ComplianceCheckSeverityMap 检查项的默认严重程度，未配置的检查项为 warning
+var ComplianceCheckSeverityMap = map[ComplianceCheck]ComplianceSeverity{
+	ComplianceCheckDataPlaneReference: ComplianceSeverityCritical,
+}
+
+// GetComplianceCheckSeverity 获取检查项的默认严重程度
+func GetComplianceCheckSeverity(check ComplianceCheck) ComplianceSeverity {
+	if severity, ok := ComplianceCheckSeverityMap[check]; ok {
+		return severity
+	}
+	return ComplianceSeverityWarning
+}
+
+// GatewayStatus 网关状态
+type GatewayStatus string
+
+const (
+	GatewayStatusOnboarding GatewayStatus = "onboarding" // 接入引导中，尚不允许发布
+	GatewayStatusActive     GatewayStatus = "active"     // 已启用
+)
+
+// GatewayEtcdStatus 网关 etcd 连接状态
+type GatewayEtcdStatus string
+
+const (
+	GatewayEtcdStatusNormal     GatewayEtcdStatus = "normal"      // 正常
+	GatewayEtcdStatusAuthFailed GatewayEtcdStatus = "auth_failed" // 鉴权失效且重新认证失败
+)
+
+// RetriesCheckMode chash 上游重试次数超过节点数时的处理方式
+type RetriesCheckMode string
+
+const (
+	RetriesCheckDisabled RetriesCheckMode = "disabled" // 不校验
+	RetriesCheckLenient  RetriesCheckMode = "lenient"  // 按 upstream_retries 规则的级别处理，默认为告警
+	RetriesCheckStrict   RetriesCheckMode = "strict"   // 返回校验错误
+)
+
+// OnboardingStep 网关接入引导步骤
+type OnboardingStep string
+
+const (
+	OnboardingStepEtcdConnectivity OnboardingStep = "etcd_connectivity" // etcd 连通性测试
+	OnboardingStepPrefixCollision  OnboardingStep = "prefix_collision"  // etcd 前缀冲突检查
+	OnboardingStepForeignKeyScan   OnboardingStep = "foreign_key_scan"  // etcd 资源关联完整性扫描
+	OnboardingStepImportPreview    OnboardingStep = "import_preview"    // etcd 资源导入预览
+	OnboardingStepVersionDetection OnboardingStep = "version_detection" // apisix 版本探测
+)
+
+// OnboardingStepList 接入引导步骤，按引导顺序排列
+var OnboardingStepList = []OnboardingStep{
+	OnboardingStepEtcdConnectivity,
+	OnboardingStepPrefixCollision,
+	OnboardingStepForeignKeyScan,
+	OnboardingStepImportPreview,
+	OnboardingStepVersionDetection,
+}
+
+// OnboardingMandatoryStepMap 必须通过或由管理员豁免后网关才能启用的步骤
+var OnboardingMandatoryStepMap = map[OnboardingStep]bool{
+	OnboardingStepEtcdConnectivity: true,
+	OnboardingStepPrefixCollision:  true,
+	OnboardingStepForeignKeyScan:   true,
+	OnboardingStepVersionDetection: true,
+}
+
+// OnboardingStepStatus 接入引导步骤状态
+type OnboardingStepStatus string
+
+const (
+	OnboardingStepStatusPending OnboardingStepStatus = "pending" // 未执行
+	OnboardingStepStatusPassed  OnboardingStepStatus = "passed"  // 通过
+	OnboardingStepStatusFailed  OnboardingStepStatus = "failed"  // 未通过
+	OnboardingStepStatusWaived  OnboardingStepStatus = "waived"  // 管理员豁免
+)
+
+// SecretManager 密钥管理器类型，决定 secret 在 etcd 中的二级目录: secrets/{manager}/{id}
+type SecretManager string
+
+const (
+	SecretManagerVault SecretManager = "vault" // HashiCorp Vault
+	SecretManagerAWS   SecretManager = "aws"   // AWS Secrets Manager
+	SecretManagerGCP   SecretManager = "gcp"   // GCP Secret Manager
+)
@@ -28,6 +28,9 @@ const (
 	// RequestIDHeaderKey request_id 在 HTTP Header 中的 key
 	RequestIDHeaderKey = "X-Request-Id"
 
+	// ChangeTokenHeaderKey 写请求返回的变更令牌，列表接口可通过 min_token 参数回传以读到自己的写入
+	ChangeTokenHeaderKey = "X-Change-Token"
+
 	// OpenAPITokenHeaderKey openapi token 在 HTTP Header 中的 key
 	OpenAPITokenHeaderKey = "X-BK-API-TOKEN"
 	// ErrorCtxKey error 在 context 中的 key
@@ -41,6 +44,12 @@ const (
 
 	// AccessTokenLength access token 长度
 	AccessTokenLength = 36
+
+	// CredentialPlaceholder 导入时未携带凭证的 consumer 中凭证字段的占位值，凭证生成前 consumer 无法发布
+	CredentialPlaceholder = "__CREDENTIAL_REQUIRED__"
+
+	// GeneratedCredentialLength 自动生成的 consumer 凭证长度
+	GeneratedCredentialLength = 32
 )
 
 // CtxKey ...
@@ -61,6 +70,15 @@ const ResourceTypeKey CtxKey = "resource_type"
 // DbTxKey transaction 在 context 中的 key
 const DbTxKey CtxKey = "db_tx"
 
+// PublishTaskIDKey 发布任务 id 在 context 中的 key
+const PublishTaskIDKey CtxKey = "publish_task_id"
+
+// PublishPlanKey 发布预演记录在 context 中的 key
+const PublishPlanKey CtxKey = "publish_plan"
+
+// RevisionSummaryKey 资源版本变更说明在 context 中的 key，设置后覆盖自动生成的说明
+const RevisionSummaryKey CtxKey = "revision_summary"
+
 // SystemConfigUserWhitest system config key
 const (
 	// SystemConfigUserWhitest user whitelist
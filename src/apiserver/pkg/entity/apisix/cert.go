@@ -0,0 +1,36 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package entity
+
+import (
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/sslx"
+)
+
+// ParseCertificateInfo 从 PEM 证书中提取 snis 及有效期(unix 秒)
+func ParseCertificateInfo(certPEM string) (snis []string, notBefore, notAfter int64, err error) {
+	snis, err = sslx.ParseCertSNIs(certPEM)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	validity, err := sslx.X509CertValidity(certPEM)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	return snis, validity.NotBefore, validity.NotAfter, nil
+}
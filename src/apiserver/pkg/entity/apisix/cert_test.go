@@ -0,0 +1,73 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package entity
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func generateCertPEM(cn string, dnsNames []string, notBefore, notAfter time.Time) string {
+	priv, err := rsa.GenerateKey(rand.Reader, 2048)
+	Expect(err).NotTo(HaveOccurred())
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: cn},
+		DNSNames:     dnsNames,
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &priv.PublicKey, priv)
+	Expect(err).NotTo(HaveOccurred())
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+var _ = Describe("ParseCertificateInfo", func() {
+	notBefore := time.Unix(1700000000, 0)
+	notAfter := time.Unix(1800000000, 0)
+
+	It("extracts SANs and validity", func() {
+		cert := generateCertPEM("example.com", []string{"example.com", "*.example.com"}, notBefore, notAfter)
+		snis, start, end, err := ParseCertificateInfo(cert)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(snis).To(Equal([]string{"example.com", "*.example.com"}))
+		Expect(start).To(Equal(notBefore.Unix()))
+		Expect(end).To(Equal(notAfter.Unix()))
+	})
+
+	It("falls back to CN when there are no SANs", func() {
+		cert := generateCertPEM("cn.example.com", nil, notBefore, notAfter)
+		snis, _, _, err := ParseCertificateInfo(cert)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(snis).To(Equal([]string{"cn.example.com"}))
+	})
+
+	It("returns error for invalid cert", func() {
+		_, _, _, err := ParseCertificateInfo("invalid-cert")
+		Expect(err).To(HaveOccurred())
+	})
+})
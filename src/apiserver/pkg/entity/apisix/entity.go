@@ -58,9 +58,9 @@ type Route struct {
 	Status          Status                 `json:"status"`
 }
 
-// TimeoutValue ...
+// TimeoutValue 超时时间，单位秒，支持小数
 type (
-	TimeoutValue float32
+	TimeoutValue float64
 	Timeout      struct {
 		Connect TimeoutValue `json:"connect,omitempty"`
 		Send    TimeoutValue `json:"send,omitempty"`
@@ -77,61 +77,65 @@ type Node struct {
 	Priority int         `json:"priority,omitempty"`
 }
 
-// Healthy ...
+// 健康检查、keepalive_pool、tls 中有默认值的字段使用指针，区分未配置与显式配置为零值，保证序列化结果与原配置一致
+
+// Healthy 健康检查中判定节点健康的条件
 type Healthy struct {
-	Interval     int   `json:"interval,omitempty"`
-	HttpStatuses []int `json:"http_statuses,omitempty"`
-	Successes    int   `json:"successes,omitempty"`
+	Interval     *int  `json:"interval,omitempty"`
+	HTTPStatuses []int `json:"http_statuses,omitempty"`
+	Successes    *int  `json:"successes,omitempty"`
 }
 
-// UnHealthy ...
+// UnHealthy 健康检查中判定节点不健康的条件
 type UnHealthy struct {
-	Interval     int   `json:"interval,omitempty"`
+	Interval     *int  `json:"interval,omitempty"`
 	HTTPStatuses []int `json:"http_statuses,omitempty"`
-	TCPFailures  int   `json:"tcp_failures,omitempty"`
-	Timeouts     int   `json:"timeouts,omitempty"`
-	HTTPFailures int   `json:"http_failures,omitempty"`
+	TCPFailures  *int  `json:"tcp_failures,omitempty"`
+	Timeouts     *int  `json:"timeouts,omitempty"`
+	HTTPFailures *int  `json:"http_failures,omitempty"`
 }
 
-// Active ...
+// Active 主动健康检查
 type Active struct {
-	Type                   string       `json:"type,omitempty"`
-	Timeout                TimeoutValue `json:"timeout,omitempty"`
-	Concurrency            int          `json:"concurrency,omitempty"`
-	Host                   string       `json:"host,omitempty"`
-	Port                   int          `json:"port,omitempty"`
-	HTTPPath               string       `json:"http_path,omitempty"`
-	HTTPSVerifyCertificate bool         `json:"https_verify_certificate,omitempty"`
-	Healthy                Healthy      `json:"healthy,omitempty"`
-	UnHealthy              UnHealthy    `json:"unhealthy,omitempty"`
-	ReqHeaders             []string     `json:"req_headers,omitempty"`
+	Type                   string        `json:"type,omitempty"`
+	Timeout                *TimeoutValue `json:"timeout,omitempty"`
+	Concurrency            *int          `json:"concurrency,omitempty"`
+	Host                   string        `json:"host,omitempty"`
+	Port                   *int          `json:"port,omitempty"`
+	HTTPPath               string        `json:"http_path,omitempty"`
+	HTTPSVerifyCertificate *bool         `json:"https_verify_certificate,omitempty"`
+	Healthy                *Healthy      `json:"healthy,omitempty"`
+	UnHealthy              *UnHealthy    `json:"unhealthy,omitempty"`
+	ReqHeaders             []string      `json:"req_headers,omitempty"`
 }
 
-// Passive ...
+// Passive 被动健康检查
 type Passive struct {
-	Type      string    `json:"type,omitempty"`
-	Healthy   Healthy   `json:"healthy,omitempty"`
-	UnHealthy UnHealthy `json:"unhealthy,omitempty"`
+	Type      string     `json:"type,omitempty"`
+	Healthy   *Healthy   `json:"healthy,omitempty"`
+	UnHealthy *UnHealthy `json:"unhealthy,omitempty"`
 }
 
-// HealthChecker ...
+// HealthChecker 上游健康检查 checks
 type HealthChecker struct {
-	Active  Active  `json:"active,omitempty"`
-	Passive Passive `json:"passive,omitempty"`
+	Active  *Active  `json:"active,omitempty"`
+	Passive *Passive `json:"passive,omitempty"`
 }
 
-// UpstreamTLS ...
+// UpstreamTLS 上游 tls 配置
 type UpstreamTLS struct {
-	ClientCert   string `json:"client_cert,omitempty"`
-	ClientKey    string `json:"client_key,omitempty"`
-	ClientCertId string `json:"client_cert_id,omitempty"`
+	ClientCert string `json:"client_cert,omitempty"`
+	ClientKey  string `json:"client_key,omitempty"`
+	// ssl 资源 id，字符串或整数
+	ClientCertID interface{} `json:"client_cert_id,omitempty"`
+	Verify       *bool       `json:"verify,omitempty"`
 }
 
-// UpstreamKeepalivePool ...
+// UpstreamKeepalivePool 上游连接池配置
 type UpstreamKeepalivePool struct {
 	IdleTimeout *TimeoutValue `json:"idle_timeout,omitempty"`
-	Requests    int           `json:"requests,omitempty"`
-	Size        int           `json:"size"`
+	Requests    *int          `json:"requests,omitempty"`
+	Size        *int          `json:"size,omitempty"`
 }
 
 // UpstreamDef ...
@@ -141,7 +145,7 @@ type UpstreamDef struct {
 	Retries       *int                   `json:"retries,omitempty"`
 	Timeout       *Timeout               `json:"timeout,omitempty"`
 	Type          string                 `json:"type,omitempty"`
-	Checks        interface{}            `json:"checks,omitempty"`
+	Checks        *HealthChecker         `json:"checks,omitempty"`
 	HashOn        string                 `json:"hash_on,omitempty"`
 	Key           string                 `json:"key,omitempty"`
 	Scheme        string                 `json:"scheme,omitempty"`
@@ -172,6 +176,13 @@ type Consumer struct {
 	GroupID    string                 `json:"group_id,omitempty"`
 }
 
+// Credential 对应 apisix consumers/{consumer}/credentials/{id}，每个凭证仅包含一个认证插件
+type Credential struct {
+	BaseInfo
+	Desc    string                 `json:"desc,omitempty"`
+	Plugins map[string]interface{} `json:"plugins,omitempty"`
+}
+
 // ConsumerGroup ...
 type ConsumerGroup struct {
 	Desc       string                 `json:"desc,omitempty"`
@@ -273,6 +284,43 @@ type Proto struct {
 	Content string `json:"content"`
 }
 
+// VaultSecret 对应 apisix secrets/vault/{id}
+type VaultSecret struct {
+	BaseInfo
+	URI       string `json:"uri"`
+	Prefix    string `json:"prefix"`
+	Token     string `json:"token"`
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// AWSSecret 对应 apisix secrets/aws/{id}
+type AWSSecret struct {
+	BaseInfo
+	AccessKeyID     string `json:"access_key_id"`
+	SecretAccessKey string `json:"secret_access_key"`
+	SessionToken    string `json:"session_token,omitempty"`
+	Region          string `json:"region,omitempty"`
+	EndpointURL     string `json:"endpoint_url,omitempty"`
+}
+
+// GCPSecretAuthConfig ...
+type GCPSecretAuthConfig struct {
+	ClientEmail string   `json:"client_email"`
+	PrivateKey  string   `json:"private_key"`
+	ProjectID   string   `json:"project_id"`
+	TokenURI    string   `json:"token_uri,omitempty"`
+	EntriesURI  string   `json:"entries_uri,omitempty"`
+	Scope       []string `json:"scope,omitempty"`
+}
+
+// GCPSecret 对应 apisix secrets/gcp/{id}
+type GCPSecret struct {
+	BaseInfo
+	AuthConfig *GCPSecretAuthConfig `json:"auth_config,omitempty"`
+	AuthFile   string               `json:"auth_file,omitempty"`
+	SSLVerify  *bool                `json:"ssl_verify,omitempty"`
+}
+
 // StreamRouteProtocol ...
 type StreamRouteProtocol struct {
 	Name string                 `json:"name,omitempty"`
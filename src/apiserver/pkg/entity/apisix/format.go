@@ -20,28 +20,31 @@
 package entity
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
 	"net"
+	"net/netip"
+	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/apache/apisix-ingress-controller/pkg/log"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
 )
 
 func mapKV2Node(key string, val float64) (*Node, error) {
 	host, port, err := net.SplitHostPort(key)
-
-	// ipv6 address
-	if strings.Count(host, ":") >= 2 {
-		host = "[" + host + "]"
-	}
-
 	if err != nil {
-		if strings.Contains(err.Error(), "missing port in address") {
+		switch {
+		case strings.Contains(err.Error(), "missing port in address"):
 			//  according to APISIX upstream nodes policy, port is optional
-			host = key
-			port = "0"
-		} else {
+			host, port = key, "0"
+		case isIPv6Literal(key):
+			// 不带端口且未加方括号的 ipv6 地址
+			host, port = key, "0"
+		default:
 			return nil, errors.New("invalid upstream node")
 		}
 	}
@@ -53,7 +56,7 @@ func mapKV2Node(key string, val float64) (*Node, error) {
 	}
 
 	node := &Node{
-		Host:   host,
+		Host:   canonicalNodeHost(host),
 		Port:   portInt,
 		Weight: int(val),
 	}
@@ -61,6 +64,52 @@ func mapKV2Node(key string, val float64) (*Node, error) {
 	return node, nil
 }
 
+// NormalizeNodeHost 规范化上游节点 host：ipv6 地址去掉方括号并转换为 RFC 5952 规范形式(与 apisix 一致)，
+// 不支持带 zone 的 ipv6 地址；ipv4 及域名保持不变
+func NormalizeNodeHost(host string) (string, error) {
+	trimmed := host
+	bracketed := strings.HasPrefix(host, "[") && strings.HasSuffix(host, "]")
+	if bracketed {
+		trimmed = host[1 : len(host)-1]
+	}
+	if !bracketed && !strings.Contains(host, ":") {
+		return host, nil
+	}
+	addr, err := netip.ParseAddr(trimmed)
+	if err != nil || !addr.Is6() {
+		return "", fmt.Errorf("invalid upstream node host %q: not a valid ipv6 address", host)
+	}
+	if addr.Zone() != "" {
+		return "", fmt.Errorf("invalid upstream node host %q: ipv6 zone identifier is not supported", host)
+	}
+	return addr.String(), nil
+}
+
+// canonicalNodeHost 返回规范化后的 host，无法规范化时原样返回，由校验阶段报错
+func canonicalNodeHost(host string) string {
+	normalized, err := NormalizeNodeHost(host)
+	if err != nil {
+		return host
+	}
+	return normalized
+}
+
+// isIPv6Literal 判断是否为 ipv6 地址字面量(可带方括号)
+func isIPv6Literal(host string) bool {
+	addr, err := netip.ParseAddr(strings.TrimSuffix(strings.TrimPrefix(host, "["), "]"))
+	return err == nil && addr.Is6()
+}
+
+// UnmarshalJSON 反序列化时规范化 ipv6 host，保证等价的写法得到相同的节点
+func (n *Node) UnmarshalJSON(data []byte) error {
+	type node Node
+	if err := json.Unmarshal(data, (*node)(n)); err != nil {
+		return err
+	}
+	n.Host = canonicalNodeHost(n.Host)
+	return nil
+}
+
 // NodesFormat convert obj to []*Node
 func NodesFormat(obj interface{}) interface{} {
 	nodes := make([]*Node, 0)
@@ -96,7 +145,7 @@ func NodesFormat(obj interface{}) interface{} {
 		for _, v := range list {
 			val := v.(map[string]interface{})
 			node := &Node{
-				Host:   val["host"].(string),
+				Host:   canonicalNodeHost(val["host"].(string)),
 				Port:   int(val["port"].(float64)),
 				Weight: int(val["weight"].(float64)),
 			}
@@ -115,3 +164,98 @@ func NodesFormat(obj interface{}) interface{} {
 
 	return obj
 }
+
+// parseNodes 解析 nodes 的 json，支持数组及 host:port→weight 的 map 两种形式，统一转换为数组形式
+func parseNodes(raw []byte) ([]*Node, error) {
+	nodes := make([]*Node, 0)
+	if gjson.ParseBytes(raw).IsArray() {
+		if err := json.Unmarshal(raw, &nodes); err != nil {
+			return nil, err
+		}
+		return nodes, nil
+	}
+	var nodeMap map[string]float64
+	if err := json.Unmarshal(raw, &nodeMap); err != nil {
+		return nil, err
+	}
+	// map 无序，按 key 排序保证转换结果稳定
+	keys := make([]string, 0, len(nodeMap))
+	for key := range nodeMap {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		node, err := mapKV2Node(key, nodeMap[key])
+		if err != nil {
+			return nil, fmt.Errorf("invalid upstream node %q: %w", key, err)
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, nil
+}
+
+// UnmarshalJSON 反序列化时将 nodes 统一转换为 []*Node
+func (u *UpstreamDef) UnmarshalJSON(data []byte) error {
+	type upstreamDef UpstreamDef
+	aux := struct {
+		*upstreamDef
+		Nodes json.RawMessage `json:"nodes,omitempty"`
+	}{upstreamDef: (*upstreamDef)(u)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	u.Nodes = nil
+	if len(aux.Nodes) == 0 || gjson.ParseBytes(aux.Nodes).Type == gjson.Null {
+		return nil
+	}
+	nodes, err := parseNodes(aux.Nodes)
+	if err != nil {
+		return err
+	}
+	u.Nodes = nodes
+	return nil
+}
+
+// MarshalJSON 序列化时 nodes 统一输出数组形式
+func (u UpstreamDef) MarshalJSON() ([]byte, error) {
+	type upstreamDef UpstreamDef
+	aux := struct {
+		upstreamDef
+		Nodes interface{} `json:"nodes,omitempty"`
+	}{upstreamDef: upstreamDef(u), Nodes: NodesFormat(u.Nodes)}
+	return json.Marshal(aux)
+}
+
+// FormatNodesConfig 将配置中 path 处 map 形式的 nodes 转换为数组形式并规范化节点 host，其余字段保持不变
+func FormatNodesConfig(config []byte, path string) ([]byte, error) {
+	nodes := gjson.GetBytes(config, path)
+	if nodes.IsArray() {
+		var err error
+		for i, node := range nodes.Array() {
+			host := node.Get("host").String()
+			normalized, normalizeErr := NormalizeNodeHost(host)
+			if normalizeErr != nil {
+				return nil, normalizeErr
+			}
+			if normalized != host {
+				if config, err = sjson.SetBytes(config, fmt.Sprintf("%s.%d.host", path, i), normalized); err != nil {
+					return nil, err
+				}
+			}
+		}
+		return config, nil
+	}
+	if !nodes.IsObject() {
+		return config, nil
+	}
+	parsed, err := parseNodes([]byte(nodes.Raw))
+	if err != nil {
+		return nil, err
+	}
+	for _, node := range parsed {
+		if _, err := NormalizeNodeHost(node.Host); err != nil {
+			return nil, err
+		}
+	}
+	return sjson.SetBytes(config, path, parsed)
+}
@@ -19,6 +19,8 @@
 package entity
 
 import (
+	"encoding/json"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -37,7 +39,19 @@ var _ = Describe("Format", func() {
 			It("should correctly parse IPv6 address and port", func() {
 				node, err := mapKV2Node("[2001:db8::1]:8080", 20)
 				Expect(err).NotTo(HaveOccurred())
-				Expect(node).To(Equal(&Node{Host: "[2001:db8::1]", Port: 8080, Weight: 20}))
+				Expect(node).To(Equal(&Node{Host: "2001:db8::1", Port: 8080, Weight: 20}))
+			})
+		})
+
+		Context("Normal case - IPv6 address without port", func() {
+			It("should accept bracketed and bare IPv6 address", func() {
+				node, err := mapKV2Node("[2001:0db8:0:0::1]", 20)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(node).To(Equal(&Node{Host: "2001:db8::1", Port: 0, Weight: 20}))
+
+				node, err = mapKV2Node("::1", 20)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(node).To(Equal(&Node{Host: "::1", Port: 0, Weight: 20}))
 			})
 		})
 
@@ -152,4 +166,133 @@ var _ = Describe("Format", func() {
 			})
 		})
 	})
+
+	Describe("NormalizeNodeHost", func() {
+		It("should canonicalize IPv6 and keep other hosts", func() {
+			for host, expected := range map[string]string{
+				"[::1]":              "::1",
+				"0:0:0:0:0:0:0:1":    "::1",
+				"[2001:DB8::0:1]":    "2001:db8::1",
+				"127.0.0.1":          "127.0.0.1",
+				"example.com":        "example.com",
+				"[::ffff:127.0.0.1]": "::ffff:127.0.0.1",
+			} {
+				normalized, err := NormalizeNodeHost(host)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(normalized).To(Equal(expected), host)
+			}
+		})
+
+		It("should reject zone identifiers and invalid literals", func() {
+			_, err := NormalizeNodeHost("[fe80::1%eth0]")
+			Expect(err).To(MatchError(ContainSubstring("zone identifier")))
+			_, err = NormalizeNodeHost("[example.com]")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("UpstreamDef JSON", func() {
+		Context("map nodes with IPv6 and zero weight", func() {
+			It("should normalize to sorted node list", func() {
+				var upstream UpstreamDef
+				err := json.Unmarshal([]byte(
+					`{"type":"roundrobin","nodes":{"[::1]:8080":10,"127.0.0.1:80":0,"example.com":5}}`), &upstream)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(upstream.Type).To(Equal("roundrobin"))
+				Expect(upstream.Nodes).To(Equal([]*Node{
+					{Host: "127.0.0.1", Port: 80, Weight: 0},
+					{Host: "::1", Port: 8080, Weight: 10},
+					{Host: "example.com", Port: 0, Weight: 5},
+				}))
+			})
+		})
+
+		Context("array nodes", func() {
+			It("should keep the node list", func() {
+				var upstream Upstream
+				err := json.Unmarshal([]byte(
+					`{"nodes":[{"host":"[::1]","port":8080,"weight":0,"priority":1},`+
+						`{"host":"2001:DB8:0:0:0:0:0:1","port":80,"weight":1},`+
+						`{"host":"10.0.0.1","port":80,"weight":1}]}`),
+					&upstream)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(upstream.Nodes).To(Equal([]*Node{
+					{Host: "::1", Port: 8080, Weight: 0, Priority: 1},
+					{Host: "2001:db8::1", Port: 80, Weight: 1},
+					{Host: "10.0.0.1", Port: 80, Weight: 1},
+				}))
+			})
+		})
+
+		Context("IPv6 with zone identifier", func() {
+			It("should keep the host for validation to report", func() {
+				var upstream UpstreamDef
+				err := json.Unmarshal([]byte(`{"nodes":{"[fe80::1%eth0]:80":1}}`), &upstream)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(upstream.Nodes).To(Equal([]*Node{{Host: "fe80::1%eth0", Port: 80, Weight: 1}}))
+			})
+		})
+
+		Context("invalid map nodes", func() {
+			It("should return an error", func() {
+				var upstream UpstreamDef
+				err := json.Unmarshal([]byte(`{"nodes":{"host:port:extra":1}}`), &upstream)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		Context("marshal", func() {
+			It("should write canonical array nodes", func() {
+				upstream := UpstreamDef{
+					Type:  "roundrobin",
+					Nodes: map[string]interface{}{"[::1]:8080": float64(0)},
+				}
+				data, err := json.Marshal(upstream)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(data)).To(ContainSubstring(`"type":"roundrobin"`))
+				Expect(string(data)).To(ContainSubstring(`"nodes":[{"host":"::1","port":8080,"weight":0}]`))
+
+				route := Route{Upstream: &upstream}
+				data, err = json.Marshal(route)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(data)).To(ContainSubstring(`"nodes":[{"host":"::1","port":8080,"weight":0}]`))
+			})
+		})
+	})
+
+	Describe("FormatNodesConfig", func() {
+		It("should convert map nodes and keep other fields", func() {
+			config := []byte(`{"name":"r1","upstream":{"type":"chash","nodes":{"[::1]:8080":10,"127.0.0.1:80":0}}}`)
+			result, err := FormatNodesConfig(config, "upstream.nodes")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(result)).To(MatchJSON(`{"name":"r1","upstream":{"type":"chash","nodes":[` +
+				`{"host":"127.0.0.1","port":80,"weight":0},{"host":"::1","port":8080,"weight":10}]}}`))
+		})
+
+		It("should normalize IPv6 hosts in array nodes", func() {
+			config := []byte(`{"nodes":[{"host":"[2001:db8::0:1]","port":80,"weight":1,"metadata":{"a":1}},` +
+				`{"host":"127.0.0.1","port":80,"weight":1}]}`)
+			result, err := FormatNodesConfig(config, "nodes")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(result)).To(MatchJSON(`{"nodes":[{"host":"2001:db8::1","port":80,"weight":1,` +
+				`"metadata":{"a":1}},{"host":"127.0.0.1","port":80,"weight":1}]}`))
+		})
+
+		It("should reject IPv6 zone identifiers", func() {
+			_, err := FormatNodesConfig([]byte(`{"nodes":{"[fe80::1%eth0]:80":1}}`), "nodes")
+			Expect(err).To(MatchError(ContainSubstring("zone identifier")))
+		})
+
+		It("should keep array or missing nodes unchanged", func() {
+			config := []byte(`{"nodes":[{"host":"127.0.0.1","port":80,"weight":1}]}`)
+			result, err := FormatNodesConfig(config, "nodes")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(config))
+
+			config = []byte(`{"service_name":"svc","discovery_type":"dns"}`)
+			result, err = FormatNodesConfig(config, "nodes")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(config))
+		})
+	})
 })
@@ -0,0 +1,138 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package entity
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/tidwall/gjson"
+)
+
+// importFixturePath 集成测试的导入文件，包含真实的 upstream/service/route 配置
+const importFixturePath = "../../../tests/integration/openapi/data/test_import_file.json"
+
+// typedUpstreamFields UpstreamDef 中使用结构体描述的字段
+var typedUpstreamFields = []string{"checks", "keepalive_pool", "tls", "timeout"}
+
+// loadUpstreamFixtures 加载 testdata 中的 upstream 配置以及导入文件中 upstream 资源和 service/route 的内联 upstream
+func loadUpstreamFixtures() map[string]json.RawMessage {
+	fixtures := map[string]json.RawMessage{}
+	raw, err := os.ReadFile("testdata/upstreams.json")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(json.Unmarshal(raw, &fixtures)).To(Succeed())
+
+	imported, err := os.ReadFile(importFixturePath)
+	Expect(err).NotTo(HaveOccurred())
+	for _, resourceType := range []string{"upstream", "service", "route"} {
+		for i, item := range gjson.GetBytes(imported, resourceType).Array() {
+			config := item.Get("config")
+			if resourceType != "upstream" {
+				config = config.Get("upstream")
+			}
+			if !config.Exists() {
+				continue
+			}
+			fixtures[fmt.Sprintf("import_%s_%d", resourceType, i)] = json.RawMessage(config.Raw)
+		}
+	}
+	return fixtures
+}
+
+func roundTripUpstream(config []byte) []byte {
+	var upstream UpstreamDef
+	Expect(json.Unmarshal(config, &upstream)).To(Succeed())
+	output, err := json.Marshal(upstream)
+	Expect(err).NotTo(HaveOccurred())
+	return output
+}
+
+var _ = Describe("UpstreamDef", func() {
+	Describe("round trip", func() {
+		var (
+			fixtures map[string]json.RawMessage
+			golden   map[string]json.RawMessage
+		)
+
+		BeforeEach(func() {
+			fixtures = loadUpstreamFixtures()
+			raw, err := os.ReadFile("testdata/upstreams.golden.json")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(json.Unmarshal(raw, &golden)).To(Succeed())
+		})
+
+		It("should match the golden file byte by byte", func() {
+			Expect(golden).To(HaveLen(len(fixtures)))
+			for name, config := range fixtures {
+				expected, ok := golden[name]
+				Expect(ok).To(BeTrue(), name)
+				var compacted bytes.Buffer
+				Expect(json.Compact(&compacted, expected)).To(Succeed())
+				Expect(string(roundTripUpstream(config))).To(Equal(compacted.String()), name)
+			}
+		})
+
+		It("should keep typed fields identical to the original config", func() {
+			for name, config := range fixtures {
+				output := roundTripUpstream(config)
+				for _, field := range typedUpstreamFields {
+					original := gjson.GetBytes(config, field)
+					if !original.Exists() {
+						// 未使用的字段不会出现在序列化结果中
+						Expect(gjson.GetBytes(output, field).Exists()).To(BeFalse(), name+"."+field)
+						continue
+					}
+					Expect(gjson.GetBytes(output, field).Raw).To(MatchJSON(original.Raw), name+"."+field)
+				}
+			}
+		})
+
+		It("should be byte-identical when marshalled again", func() {
+			for name, config := range fixtures {
+				output := roundTripUpstream(config)
+				Expect(roundTripUpstream(output)).To(Equal(output), name)
+			}
+		})
+	})
+
+	Describe("typed fields", func() {
+		It("should distinguish explicit zero values from unset fields", func() {
+			var upstream UpstreamDef
+			Expect(json.Unmarshal([]byte(`{
+				"checks": {"active": {"https_verify_certificate": false},
+					"passive": {"healthy": {"successes": 0}}},
+				"keepalive_pool": {"idle_timeout": 0},
+				"tls": {"verify": false, "client_cert_id": 1}
+			}`), &upstream)).To(Succeed())
+
+			Expect(*upstream.Checks.Active.HTTPSVerifyCertificate).To(BeFalse())
+			Expect(upstream.Checks.Active.Healthy).To(BeNil())
+			Expect(*upstream.Checks.Passive.Healthy.Successes).To(Equal(0))
+			Expect(upstream.Checks.Passive.Healthy.HTTPStatuses).To(BeNil())
+			Expect(*upstream.KeepalivePool.IdleTimeout).To(Equal(TimeoutValue(0)))
+			Expect(upstream.KeepalivePool.Size).To(BeNil())
+			Expect(*upstream.TLS.Verify).To(BeFalse())
+			Expect(upstream.TLS.ClientCertID).To(Equal(float64(1)))
+		})
+	})
+})
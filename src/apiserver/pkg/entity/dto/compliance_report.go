@@ -0,0 +1,49 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package dto
+
+import (
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+// ComplianceFinding 合规检查发现的问题
+type ComplianceFinding struct {
+	Check    constant.ComplianceCheck    `json:"check"`    // 检查项
+	Severity constant.ComplianceSeverity `json:"severity"` // 严重程度
+	Message  string                      `json:"message"`  // 问题详情
+}
+
+// ComplianceResourceResult 单个资源的合规检查结果
+type ComplianceResourceResult struct {
+	ResourceType constant.APISIXResource `json:"resource_type"`
+	ResourceID   string                  `json:"resource_id"`
+	Name         string                  `json:"name"`
+	Status       constant.ResourceStatus `json:"status"` // 编辑区状态，仅存在于 etcd 中的资源为空
+	Passed       bool                    `json:"passed"`
+	Findings     []ComplianceFinding     `json:"findings"`
+}
+
+// ComplianceReportSummary 合规报告汇总
+type ComplianceReportSummary struct {
+	Total          int                                 `json:"total"`           // 检查资源总数
+	Passed         int                                 `json:"passed"`          // 通过数
+	Failed         int                                 `json:"failed"`          // 未通过数
+	FindingCounts  map[constant.ComplianceCheck]int    `json:"finding_counts"`  // 各检查项的问题数
+	SeverityCounts map[constant.ComplianceSeverity]int `json:"severity_counts"` // 各严重程度的问题数
+}
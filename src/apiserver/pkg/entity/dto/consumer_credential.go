@@ -0,0 +1,34 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package dto
+
+// ConsumerCredentialImportReport 导入 consumer 凭证的报告
+type ConsumerCredentialImportReport struct {
+	// 未携带凭证、需通过生成凭证接口生成的 consumer
+	PendingGeneration []ConsumerCredentialItem `json:"pending_generation"`
+	// 导入的凭证与网关中已有凭证不一致、需通过 credential_overwrite 明确是否覆盖的 consumer
+	Conflicts []ConsumerCredentialItem `json:"conflicts"`
+}
+
+// ConsumerCredentialItem 凭证导入报告中的单个 consumer
+type ConsumerCredentialItem struct {
+	ConsumerID string   `json:"consumer_id"`
+	Name       string   `json:"name"`
+	Fields     []string `json:"fields"` // 待生成或冲突的凭证字段，credential 以 credentials/{id} 表示
+}
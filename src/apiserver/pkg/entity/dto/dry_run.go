@@ -0,0 +1,87 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package dto
+
+import (
+	"encoding/json"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/schema"
+)
+
+// DiffResult 发布预览结果，按资源类型列出发布后将新增、更新、删除的资源
+type DiffResult struct {
+	// 读取并对比的资源类型，未包含的类型不在本次结果中
+	ScannedTypes []constant.APISIXResource `json:"scanned_types"`
+	Resources    []ResourceTypeDiff        `json:"resources"`
+	// 发布预演中出错的资源，与 PublishPlan.Errors 一致
+	Errors []PublishPlanError `json:"errors"`
+	// 发布后编辑区中关联资源不存在的 route/service
+	ReferenceErrors []schema.ReferenceError `json:"reference_errors"`
+	// 发布后匹配条件重叠且 priority 相同的路由，同时命中时匹配顺序不确定
+	RoutePriorityConflicts []schema.RouteOverlap `json:"route_priority_conflicts"`
+}
+
+// ResourceTypeDiff 单个资源类型的变更
+type ResourceTypeDiff struct {
+	ResourceType constant.APISIXResource `json:"resource_type"`
+	Creates      []ResourceDiffItem      `json:"creates"`
+	Updates      []ResourceDiffItem      `json:"updates"`
+	Deletes      []ResourceDiffItem      `json:"deletes"`
+}
+
+// ResourceDiffItem 单个资源的变更
+type ResourceDiffItem struct {
+	Key    string          `json:"key"`                                   // 资源标识: id/name/username
+	Config json.RawMessage `json:"config,omitempty" swaggertype:"object"` // 新增时为发布的配置，删除时为 etcd 中的配置
+	Deltas []FieldDelta    `json:"deltas,omitempty"`                      // 更新时的字段级变更
+}
+
+// FieldDelta 字段级变更，Before 为空表示新增字段，After 为空表示删除字段
+type FieldDelta struct {
+	Path   string          `json:"path"` // 字段路径，如 upstream.nodes
+	Before json.RawMessage `json:"before,omitempty" swaggertype:"object"`
+	After  json.RawMessage `json:"after,omitempty" swaggertype:"object"`
+}
+
+// PublishPlan 发布预演结果，按执行顺序列出发布将对 etcd 进行的写操作
+type PublishPlan struct {
+	Mutations []EtcdMutation `json:"mutations"`
+	// 未通过 etcd 模式校验或发布出错的资源，不影响其他资源的预演
+	Errors []PublishPlanError `json:"errors"`
+}
+
+// EtcdMutation 单个 etcd key 的写操作
+type EtcdMutation struct {
+	Op           constant.EtcdWriteOp    `json:"op"`  // put/delete
+	Key          string                  `json:"key"` // 含网关前缀的完整 key
+	ResourceType constant.APISIXResource `json:"resource_type"`
+	ResourceID   string                  `json:"resource_id"`
+	Before       json.RawMessage         `json:"before" swaggertype:"object"` // 写入前的值，key 不存在时为 null
+	After        json.RawMessage         `json:"after" swaggertype:"object"`  // 写入后的值，删除时为 null
+	TTL          int64                   `json:"ttl,omitempty"`               // 绑定的 lease 时长(秒)
+	Deltas       []FieldDelta            `json:"deltas,omitempty"`            // 更新时的字段级变更
+}
+
+// PublishPlanError 预演中出错的资源，ResourceID 为空表示该类型的发布整体出错
+type PublishPlanError struct {
+	ResourceType constant.APISIXResource `json:"resource_type"`
+	ResourceID   string                  `json:"resource_id,omitempty"`
+	Message      string                  `json:"message"`
+}
@@ -0,0 +1,35 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package dto
+
+// GatewayEtcdHealth 网关 etcd 的连通性
+type GatewayEtcdHealth struct {
+	GatewayID   int                  `json:"gateway_id"`
+	GatewayName string               `json:"gateway_name"`
+	Healthy     bool                 `json:"healthy"` // 多数节点可访问且鉴权通过
+	Endpoints   []EtcdEndpointHealth `json:"endpoints"`
+}
+
+// EtcdEndpointHealth 单个 etcd 节点的连通性
+type EtcdEndpointHealth struct {
+	Endpoint string  `json:"endpoint"`
+	Healthy  bool    `json:"healthy"`
+	RTTMs    float64 `json:"rtt_ms"` // 读请求的往返耗时(毫秒)，检查失败时为 0
+	Error    string  `json:"error,omitempty"`
+}
@@ -0,0 +1,42 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package dto
+
+import "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+
+// EtcdImportReport etcd 资源导入编辑区的结果
+type EtcdImportReport struct {
+	Strategy constant.EtcdImportStrategy `json:"strategy"`
+	// 读取并导入的资源类型，未包含的类型不在本次结果中
+	ScannedTypes []constant.APISIXResource         `json:"scanned_types"`
+	Aborted      bool                              `json:"aborted"` // fail 策略下存在冲突，未导入任何资源
+	Stats        map[constant.EtcdImportAction]int `json:"stats"`
+	Results      []EtcdImportResult                `json:"results"`
+}
+
+// EtcdImportResult 单个 etcd 资源的导入结果
+type EtcdImportResult struct {
+	ResourceType constant.APISIXResource   `json:"resource_type"`
+	EtcdKey      string                    `json:"etcd_key"` // 去掉网关前缀及资源类型目录后的 etcd key
+	ID           string                    `json:"id"`       // 编辑区中的资源 ID，未导入时为空
+	Action       constant.EtcdImportAction `json:"action"`
+	Message      string                    `json:"message,omitempty"`
+	// 冲突的字段，Before 为 etcd 中的配置，After 为编辑区发布后的配置
+	Deltas []FieldDelta `json:"deltas,omitempty"`
+}
@@ -0,0 +1,26 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package dto
+
+// EtcdWriteAuditDetail etcd 写操作审计详情
+type EtcdWriteAuditDetail struct {
+	PrevHash string `json:"prev_hash"` // 写入前的值的 sha256，key 不存在时为空
+	NewHash  string `json:"new_hash"`  // 写入的值的 sha256，删除时为空
+	Message  string `json:"message"`   // 失败原因
+}
@@ -0,0 +1,63 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package dto
+
+import (
+	"encoding/json"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+// OnboardingStepResult 接入引导步骤执行结果
+type OnboardingStepResult struct {
+	Step      constant.OnboardingStep       `json:"step"`
+	Mandatory bool                          `json:"mandatory"` // 是否为启用网关的必要步骤
+	Status    constant.OnboardingStepStatus `json:"status"`
+	Message   string                        `json:"message"`
+	// 步骤明细，如导入预览的各类资源数量、探测到的 apisix 版本
+	Detail      json.RawMessage `json:"detail,omitempty" swaggertype:"object"`
+	CheckedAt   int64           `json:"checked_at"`             // 最近一次执行时间，未执行为 0
+	WaivedBy    string          `json:"waived_by,omitempty"`    // 豁免人
+	WaiveReason string          `json:"waive_reason,omitempty"` // 豁免原因
+}
+
+// OnboardingSummary 网关接入就绪情况
+type OnboardingSummary struct {
+	GatewayID     int                    `json:"gateway_id"`
+	GatewayStatus constant.GatewayStatus `json:"gateway_status"`
+	// 所有必要步骤均已通过或被豁免
+	Ready bool `json:"ready"`
+	// 尚未通过的必要步骤
+	BlockingSteps []constant.OnboardingStep `json:"blocking_steps"`
+	Steps         []OnboardingStepResult    `json:"steps"`
+}
+
+// OnboardingVersionDetail apisix 版本探测明细
+type OnboardingVersionDetail struct {
+	DetectedVersion   string                 `json:"detected_version"`   // server_info 中的 apisix 版本
+	SuggestedVersion  constant.APISIXVersion `json:"suggested_version"`  // 建议使用的 apisix 版本
+	ConfiguredVersion constant.APISIXVersion `json:"configured_version"` // 网关当前配置的 apisix 版本
+	InstanceID        string                 `json:"instance_id"`
+}
+
+// OnboardingImportPreviewDetail etcd 资源导入预览明细
+type OnboardingImportPreviewDetail struct {
+	Total  int                             `json:"total"`
+	Counts map[constant.APISIXResource]int `json:"counts"` // 各类资源数量
+}
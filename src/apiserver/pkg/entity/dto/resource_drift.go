@@ -0,0 +1,49 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package dto
+
+import "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+
+// ResourceDrift 单个资源在编辑区与 etcd 中的配置对比
+type ResourceDrift struct {
+	ResourceType constant.APISIXResource `json:"resource_type"`
+	ID           string                  `json:"id"`
+	EtcdKey      string                  `json:"etcd_key"` // 去掉网关前缀及资源类型目录后的 etcd key
+	// 编辑区中的资源状态，资源仅存在于 etcd 时为空
+	ResourceStatus constant.ResourceStatus      `json:"resource_status"`
+	Status         constant.ResourceDriftStatus `json:"status"`
+	Deltas         []FieldDelta                 `json:"deltas"` // Before 为 etcd 中的配置，After 为编辑区发布后的配置
+}
+
+// ResourceDriftSummary 网关下编辑区与 etcd 的配置漂移统计
+type ResourceDriftSummary struct {
+	// 读取并对比的资源类型，未包含的类型不在本次结果中
+	ScannedTypes []constant.APISIXResource  `json:"scanned_types"`
+	Resources    []ResourceTypeDriftSummary `json:"resources"`
+}
+
+// ResourceTypeDriftSummary 单个资源类型的漂移统计，待发布的资源不参与统计
+type ResourceTypeDriftSummary struct {
+	ResourceType  constant.APISIXResource `json:"resource_type"`
+	InSync        int                     `json:"in_sync"`
+	Modified      int                     `json:"modified"`
+	MissingInEtcd int                     `json:"missing_in_etcd"`
+	MissingInDB   int                     `json:"missing_in_db"`
+	Drifted       int                     `json:"drifted"` // modified + missing_in_etcd + missing_in_db
+}
@@ -0,0 +1,40 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package dto
+
+import (
+	"encoding/json"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+// ResourceValidateItem 批量校验的单个资源
+type ResourceValidateItem struct {
+	Type   constant.APISIXResource `json:"type"`                        // 资源类型：route/upstream/...
+	Config json.RawMessage         `json:"config" swaggertype:"object"` // 资源配置
+}
+
+// ResourceValidateResult 单个资源的校验结果
+type ResourceValidateResult struct {
+	Index          int      `json:"index"` // 资源在请求中的下标
+	Valid          bool     `json:"valid"`
+	Errors         []string `json:"errors"`
+	Warnings       []string `json:"warnings,omitempty"`       // 不阻断的告警
+	Normalizations []string `json:"normalizations,omitempty"` // 导入时会做的规范化，校验针对规范化后的配置
+}
@@ -0,0 +1,31 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package dto
+
+// RouteServiceGroup 按服务分组的路由统计
+type RouteServiceGroup struct {
+	// 服务 ID，未绑定服务的分组为 constant.EmptyAssociationFilter，可直接作为路由列表的 service_id 过滤条件
+	ServiceID   string `json:"service_id"`
+	ServiceName string `json:"service_name"`
+	Unbound     bool   `json:"unbound"` // 未绑定服务的路由分组
+	RouteCount  int64  `json:"route_count"`
+	// 有待发布变更(create_draft/update_draft/delete_draft)的路由数量
+	UnpublishedCount int64 `json:"unpublished_count"`
+	DisabledCount    int64 `json:"disabled_count"` // 已禁用(status 为 0)的路由数量
+}
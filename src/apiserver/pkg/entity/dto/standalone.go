@@ -0,0 +1,38 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package dto
+
+import (
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+// StandaloneSkippedResource 导出 standalone 配置时因校验失败被跳过的资源
+type StandaloneSkippedResource struct {
+	ResourceType constant.APISIXResource `json:"resource_type"`
+	ID           string                  `json:"id"`
+	Name         string                  `json:"name"`
+	Reason       string                  `json:"reason"`
+}
+
+// StandaloneImportError 导入 apisix.yaml 时格式错误或校验失败的资源
+type StandaloneImportError struct {
+	ResourceType           constant.APISIXResource `json:"resource_type"`
+	ResourceIdentification string                  `json:"resource_identification"` // 资源标识，缺少 id 时为 {类型}[序号]
+	Reason                 string                  `json:"reason"`
+}
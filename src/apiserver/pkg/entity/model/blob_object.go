@@ -0,0 +1,34 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package model
+
+import "time"
+
+// BlobObject 数据库后端存储的大对象，key 由命名空间与内容 sha256 组成
+type BlobObject struct {
+	Key       string    `gorm:"column:key;type:varchar(255);primaryKey"`
+	Data      []byte    `gorm:"column:data;type:longblob"`
+	Size      int       `gorm:"column:size"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+}
+
+// TableName 设置表名
+func (BlobObject) TableName() string {
+	return "blob_object"
+}
@@ -0,0 +1,54 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package model
+
+import (
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+// ChangeSet 变更集：将一组相关的未发布变更(如 route、upstream、plugin_config)作为整体评审与发布
+type ChangeSet struct {
+	ID          int                      `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	GatewayID   int                      `gorm:"column:gateway_id;index" json:"gateway_id"`
+	Name        string                   `gorm:"column:name;type:varchar(255)" json:"name"`
+	Description string                   `gorm:"column:description;type:text" json:"description"`
+	Status      constant.ChangeSetStatus `gorm:"column:status;type:varchar(32);index" json:"status"`
+	BaseModel
+}
+
+// TableName 设置表名
+func (ChangeSet) TableName() string {
+	return "change_set"
+}
+
+// ChangeSetResource 变更集包含的资源，变更集关闭后删除以释放资源；
+// 唯一索引保证一个资源同时只属于一个未关闭的变更集
+type ChangeSetResource struct {
+	ID           int                     `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	ChangeSetID  int                     `gorm:"column:change_set_id;index" json:"change_set_id"`
+	GatewayID    int                     `gorm:"column:gateway_id;uniqueIndex:idx_cs_res" json:"gateway_id"`
+	ResourceType constant.APISIXResource `gorm:"column:resource_type;size:64;uniqueIndex:idx_cs_res" json:"resource_type"`
+	ResourceID   string                  `gorm:"column:resource_id;size:255;uniqueIndex:idx_cs_res" json:"resource_id"`
+	BaseModel
+}
+
+// TableName 设置表名
+func (ChangeSetResource) TableName() string {
+	return "change_set_resource"
+}
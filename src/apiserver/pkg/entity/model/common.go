@@ -45,6 +45,8 @@ type ResourceCommonModel struct {
 	Config    datatypes.JSON `gorm:"column:config;type:json"`                                            // config
 	// 发布状态: create-draft,update-draft,success,delete-draft
 	Status constant.ResourceStatus `gorm:"column:status;type:varchar(32)"`
+	// 兼容旧集群的自定义 etcd key(相对网关前缀)，为空时使用 {资源类型}/{id}
+	EtcdKeyOverride string `gorm:"column:etcd_key_override;type:varchar(512)"`
 }
 
 // GetResourceNameKey 获取资源名称key
@@ -55,6 +57,11 @@ func GetResourceNameKey(resourceType constant.APISIXResource) string {
 	return "name"
 }
 
+// GetID 获取资源 id
+func (r ResourceCommonModel) GetID() string {
+	return r.ID
+}
+
 // GetServiceID 获取service id
 func (r ResourceCommonModel) GetServiceID() string {
 	return gjson.GetBytes(r.Config, "service_id").String()
@@ -143,6 +150,18 @@ func (r ResourceCommonModel) ToResourceModel(resourceType constant.APISIXResourc
 			ResourceCommonModel: r,
 			Name:                r.GetName(resourceType),
 		}
+	case constant.Credential:
+		return Credential{
+			ResourceCommonModel: r,
+			Name:                r.GetName(resourceType),
+			ConsumerID:          ConsumerIDFromCredentialEtcdKey(r.EtcdKeyOverride),
+		}
+	case constant.Secret:
+		return Secret{
+			ResourceCommonModel: r,
+			Name:                r.GetName(resourceType),
+			Manager:             SecretManagerFromEtcdKey(r.EtcdKeyOverride),
+		}
 	case constant.StreamRoute:
 		return StreamRoute{
 			ResourceCommonModel: r,
@@ -0,0 +1,48 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package model
+
+import (
+	"gorm.io/datatypes"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+// ComplianceReport 合规审计报告
+type ComplianceReport struct {
+	ID        int                             `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	GatewayID int                             `gorm:"column:gateway_id;index" json:"gateway_id"`
+	Status    constant.ComplianceReportStatus `gorm:"column:status;type:varchar(32)" json:"status"`
+	Total     int                             `gorm:"column:total" json:"total"`         // 待检查资源总数
+	Processed int                             `gorm:"column:processed" json:"processed"` // 已检查资源数
+	Summary   datatypes.JSON                  `gorm:"column:summary;type:json" json:"summary"`
+	// 每个资源的检查结果及问题详情，新报告存放于大对象存储，仅历史报告内联在该字段
+	Result  datatypes.JSON `gorm:"column:result;type:json" json:"result"`
+	Message string         `gorm:"column:message;type:text" json:"message"` // 生成失败原因
+	// 检查结果在大对象存储中的 key
+	ResultKey string `gorm:"column:result_key;type:varchar(255);index" json:"-"`
+	// 检查的资源类型，逗号分隔，为空时检查全部类型
+	ResourceTypes string `gorm:"column:resource_types;type:varchar(255)" json:"resource_types"`
+	BaseModel
+}
+
+// TableName 设置表名
+func (ComplianceReport) TableName() string {
+	return "compliance_report"
+}
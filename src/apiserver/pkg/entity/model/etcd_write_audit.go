@@ -0,0 +1,43 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package model
+
+import (
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+// EtcdWriteAudit etcd 写操作审计，记录发布流程中每个 key 的写入/删除，Creator 为操作人
+type EtcdWriteAudit struct {
+	ID            int                     `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	GatewayID     int                     `gorm:"column:gateway_id;index" json:"gateway_id"`
+	Key           string                  `gorm:"column:key;type:varchar(512);index" json:"key"` // etcd 完整 key
+	ResourceType  constant.APISIXResource `gorm:"column:resource_type;type:varchar(64)" json:"resource_type"`
+	ResourceID    string                  `gorm:"column:resource_id;type:varchar(255);index" json:"resource_id"`
+	Op            constant.EtcdWriteOp    `gorm:"column:op;type:varchar(16)" json:"op"`
+	PublishTaskID int                     `gorm:"column:publish_task_id;index" json:"publish_task_id"`
+	Success       bool                    `gorm:"column:success" json:"success"`
+	// gzip 压缩的 dto.EtcdWriteAuditDetail，包含前后值的哈希及失败原因
+	Detail []byte `gorm:"column:detail;type:blob" json:"detail"`
+	BaseModel
+}
+
+// TableName 设置表名
+func (EtcdWriteAudit) TableName() string {
+	return "etcd_write_audit"
+}
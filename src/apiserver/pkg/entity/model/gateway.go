@@ -53,6 +53,14 @@ type Gateway struct {
 	ReadOnly      bool           `gorm:"column:read_only;type:tinyint"`                    // 是否只读
 	LastSyncedAt  time.Time      `json:"last_synced_at" gorm:"type:datetime;default:null"` // 上次同步时间
 	auditSnapshot datatypes.JSON `gorm:"-"`                                                // 用于审计日志传递网关信息，不持久化到数据库
+	// 是否允许路由 vars 使用内置变量表之外的自定义变量名
+	AllowCustomVars bool `gorm:"column:allow_custom_vars;type:tinyint"`
+	// chash 上游重试次数超过节点数时的校验模式：disabled、lenient、strict
+	RetriesCheck constant.RetriesCheckMode `gorm:"column:retries_check;type:varchar(32);default:disabled"`
+	// 网关状态，通过接入引导创建的网关在引导完成前为 onboarding
+	Status constant.GatewayStatus `gorm:"column:status;type:varchar(32);default:active"`
+	// etcd 连接状态，etcd 鉴权失效且重新认证失败时为 auth_failed
+	EtcdStatus constant.GatewayEtcdStatus `gorm:"column:etcd_status;type:varchar(32);default:normal"`
 	BaseModel
 }
 
@@ -141,18 +149,22 @@ func (g *Gateway) AfterFind(tx *gorm.DB) (err error) {
 // CopyAndMaskPassword 复制同时隐私密码
 func (g *Gateway) CopyAndMaskPassword() Gateway {
 	gateway := Gateway{
-		ID:            g.ID,
-		Name:          g.Name,
-		Mode:          g.Mode,
-		Maintainers:   g.Maintainers,
-		Desc:          g.Desc,
-		APISIXType:    g.APISIXType,
-		APISIXVersion: g.APISIXVersion,
-		EtcdConfig:    g.EtcdConfig,
-		Token:         g.Token,
-		ReadOnly:      g.ReadOnly,
-		LastSyncedAt:  g.LastSyncedAt,
-		BaseModel:     g.BaseModel,
+		ID:              g.ID,
+		Name:            g.Name,
+		Mode:            g.Mode,
+		Maintainers:     g.Maintainers,
+		Desc:            g.Desc,
+		APISIXType:      g.APISIXType,
+		APISIXVersion:   g.APISIXVersion,
+		EtcdConfig:      g.EtcdConfig,
+		Token:           g.Token,
+		ReadOnly:        g.ReadOnly,
+		AllowCustomVars: g.AllowCustomVars,
+		RetriesCheck:    g.RetriesCheck,
+		Status:          g.Status,
+		EtcdStatus:      g.EtcdStatus,
+		LastSyncedAt:    g.LastSyncedAt,
+		BaseModel:       g.BaseModel,
 	}
 	if gateway.EtcdConfig.GetSchemaType() == constant.HTTP {
 		pwd := gateway.EtcdConfig.Password
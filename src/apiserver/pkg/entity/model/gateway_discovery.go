@@ -0,0 +1,38 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package model
+
+import (
+	"gorm.io/datatypes"
+)
+
+// GatewayDiscovery 网关启用的服务发现类型
+type GatewayDiscovery struct {
+	ID        int    `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	GatewayID int    `gorm:"column:gateway_id;type:int;uniqueIndex:idx_type" json:"gateway_id"` // 网关ID
+	Type      string `gorm:"column:type;type:varchar(64);uniqueIndex:idx_type" json:"type"`     // 服务发现类型：nacos/consul等
+	// 服务发现连接配置，与 apisix config.yaml 中 discovery.<type> 一致
+	Config datatypes.JSON `gorm:"column:config;type:json" json:"config"`
+	BaseModel
+}
+
+// TableName 设置表名
+func (GatewayDiscovery) TableName() string {
+	return "gateway_discovery"
+}
@@ -0,0 +1,37 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package model
+
+import (
+	"gorm.io/datatypes"
+)
+
+// GatewayOnboarding 网关接入引导进度
+type GatewayOnboarding struct {
+	ID        int `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	GatewayID int `gorm:"column:gateway_id;uniqueIndex" json:"gateway_id"`
+	// 各步骤的执行结果，按步骤名索引，未执行的步骤不存在
+	Steps datatypes.JSON `gorm:"column:steps;type:json" json:"steps"`
+	BaseModel
+}
+
+// TableName 设置表名
+func (GatewayOnboarding) TableName() string {
+	return "gateway_onboarding"
+}
@@ -27,6 +27,7 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/redact"
 )
 
 // OperationAuditLog 操作审计表
@@ -56,7 +57,7 @@ func (OperationAuditLog) TableName() string {
 	return "operation_audit_log"
 }
 
-// 定义一个通用的回调
+// 定义一个通用的回调，写入审计前对配置中的敏感字段脱敏，同时记录资源的配置版本
 func auditCallback(db *gorm.DB, gatewayID int, resourceID string, operator string,
 	status constant.ResourceStatus, operationType constant.OperationType, resourceType constant.APISIXResource,
 	dataBefore datatypes.JSON, dataAfter datatypes.JSON,
@@ -68,7 +69,7 @@ func auditCallback(db *gorm.DB, gatewayID int, resourceID string, operator strin
 		dataBeforeList = append(dataBeforeList, BatchOperationData{
 			ID:     resourceID,
 			Status: status,
-			Config: json.RawMessage(dataBefore),
+			Config: redact.Config(resourceType, json.RawMessage(dataBefore)),
 		})
 	}
 
@@ -76,7 +77,7 @@ func auditCallback(db *gorm.DB, gatewayID int, resourceID string, operator strin
 		dataAfterList = append(dataAfterList, BatchOperationData{
 			ID:     resourceID,
 			Status: status,
-			Config: json.RawMessage(dataAfter),
+			Config: redact.Config(resourceType, json.RawMessage(dataAfter)),
 		})
 	}
 
@@ -103,5 +104,5 @@ func auditCallback(db *gorm.DB, gatewayID int, resourceID string, operator strin
 	if result := db.Create(&log); result.Error != nil {
 		return result.Error
 	}
-	return nil
+	return AddResourceRevision(db, gatewayID, resourceID, operator, operationType, resourceType, dataBefore, dataAfter)
 }
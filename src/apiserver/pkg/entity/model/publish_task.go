@@ -0,0 +1,42 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package model
+
+import (
+	"gorm.io/datatypes"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+// PublishTask 资源发布任务，用于服务退出时记录未完成的发布并在重启后恢复
+type PublishTask struct {
+	ID           int                        `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	GatewayID    int                        `gorm:"column:gateway_id;index" json:"gateway_id"`
+	ResourceType constant.APISIXResource    `gorm:"column:resource_type;type:varchar(64)" json:"resource_type"`
+	ResourceIDs  datatypes.JSON             `gorm:"column:resource_ids;type:json" json:"resource_ids"`
+	Status       constant.PublishTaskStatus `gorm:"column:status;type:varchar(32);index" json:"status"`
+	Instance     string                     `gorm:"column:instance;type:varchar(255)" json:"instance"` // 执行发布的实例
+	Message      string                     `gorm:"column:message;type:text" json:"message"`           // 失败原因
+	BaseModel
+}
+
+// TableName 设置表名
+func (PublishTask) TableName() string {
+	return "publish_task"
+}
@@ -0,0 +1,151 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package model
+
+import (
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/jsonx"
+)
+
+// DefaultResourceRevisionRetention 每个资源默认保留的版本数
+const DefaultResourceRevisionRetention = 100
+
+// resourceRevisionRetention 每个资源保留的版本数，小于等于 0 时不清理
+var resourceRevisionRetention atomic.Int64
+
+func init() {
+	resourceRevisionRetention.Store(DefaultResourceRevisionRetention)
+}
+
+// SetResourceRevisionRetention 设置每个资源保留的版本数，小于等于 0 时不清理历史版本
+func SetResourceRevisionRetention(retention int) {
+	resourceRevisionRetention.Store(int64(retention))
+}
+
+// ResourceRevision 资源配置版本，每次创建/修改/删除资源时追加一条，不可修改
+type ResourceRevision struct {
+	ID            int                     `gorm:"column:id;primaryKey;autoIncrement"`
+	GatewayID     int                     `gorm:"column:gateway_id;uniqueIndex:idx_revision"`
+	ResourceType  constant.APISIXResource `gorm:"column:resource_type;type:varchar(64);uniqueIndex:idx_revision"`
+	ResourceID    string                  `gorm:"column:resource_id;type:varchar(255);uniqueIndex:idx_revision"`
+	Revision      int                     `gorm:"column:revision;uniqueIndex:idx_revision"`
+	OperationType constant.OperationType  `gorm:"column:operation_type;type:varchar(64)"`
+	// 该版本的完整配置，删除时为删除前的配置
+	Config    datatypes.JSON `gorm:"column:config;type:json"`
+	Summary   string         `gorm:"column:summary;type:text"` // 变更说明
+	Operator  string         `gorm:"column:operator;type:varchar(50)"`
+	CreatedAt time.Time      `gorm:"column:created_at"`
+}
+
+// TableName 设置表名
+func (ResourceRevision) TableName() string {
+	return "resource_revision"
+}
+
+// AddResourceRevision 记录资源的新版本并清理超出保留数的旧版本
+// 配置未变化的修改（如仅修改状态）不产生新版本
+func AddResourceRevision(db *gorm.DB, gatewayID int, resourceID string, operator string,
+	operationType constant.OperationType, resourceType constant.APISIXResource,
+	dataBefore datatypes.JSON, dataAfter datatypes.JSON,
+) error {
+	if !slices.Contains(constant.ResourceTypeList, resourceType) || resourceID == "" {
+		return nil
+	}
+	summary, _ := db.Statement.Context.Value(constant.RevisionSummaryKey).(string)
+	config := dataAfter
+	switch operationType {
+	case constant.OperationTypeCreate:
+		if summary == "" {
+			summary = "创建"
+		}
+	case constant.OperationTypeDelete:
+		config = dataBefore
+		if summary == "" {
+			summary = "删除"
+		}
+	default:
+		fields := changedConfigFields(dataBefore, dataAfter)
+		if len(fields) == 0 && summary == "" {
+			return nil
+		}
+		if summary == "" {
+			summary = "修改 " + strings.Join(fields, ", ")
+		}
+	}
+
+	session := db.Session(&gorm.Session{NewDB: true})
+	scope := session.Model(&ResourceRevision{}).Where(
+		"gateway_id = ? AND resource_type = ? AND resource_id = ?", gatewayID, resourceType, resourceID)
+	var latest int
+	if err := scope.Session(&gorm.Session{}).Select("COALESCE(MAX(revision), 0)").Scan(&latest).Error; err != nil {
+		return errors.Wrap(err, "query latest revision failed")
+	}
+	revision := ResourceRevision{
+		GatewayID:     gatewayID,
+		ResourceType:  resourceType,
+		ResourceID:    resourceID,
+		Revision:      latest + 1,
+		OperationType: operationType,
+		Config:        config,
+		Summary:       summary,
+		Operator:      operator,
+		CreatedAt:     time.Now(),
+	}
+	if err := session.Create(&revision).Error; err != nil {
+		return errors.Wrap(err, "create revision failed")
+	}
+
+	retention := int(resourceRevisionRetention.Load())
+	if retention <= 0 || revision.Revision <= retention {
+		return nil
+	}
+	return scope.Session(&gorm.Session{}).Where("revision <= ?", revision.Revision-retention).
+		Delete(&ResourceRevision{}).Error
+}
+
+// changedConfigFields 返回前后配置中值不同的顶层字段，按字段名排序
+func changedConfigFields(before, after datatypes.JSON) []string {
+	var beforeMap, afterMap map[string]interface{}
+	_ = jsonx.UnmarshalUseNumber(before, &beforeMap)
+	_ = jsonx.UnmarshalUseNumber(after, &afterMap)
+	var fields []string
+	for key, value := range afterMap {
+		if origin, ok := beforeMap[key]; !ok || !reflect.DeepEqual(origin, value) {
+			fields = append(fields, key)
+		}
+	}
+	for key := range beforeMap {
+		if _, ok := afterMap[key]; !ok {
+			fields = append(fields, key)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
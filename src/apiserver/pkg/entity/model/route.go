@@ -37,6 +37,8 @@
 package model
 
 import (
+	"time"
+
 	"github.com/tidwall/sjson"
 	"gorm.io/datatypes"
 	"gorm.io/gorm"
@@ -53,7 +55,9 @@ type Route struct {
 	// 关联 upstream_id 唯一标识
 	UpstreamID string `gorm:"column:upstream_id;type:varchar(255)"`
 	// 关联 plugin_config_id 唯一标识
-	PluginConfigID      string                 `gorm:"column:plugin_config_id;type:varchar(255)"`
+	PluginConfigID string `gorm:"column:plugin_config_id;type:varchar(255)"`
+	// 临时路由过期时间，为空表示长期有效；发布时会为 etcd key 绑定对应 TTL 的 lease
+	ExpiresAt           *time.Time             `gorm:"column:expires_at;type:datetime;default:null"`
 	ResourceCommonModel                        // 资源通用 model: 创建时间、更新时间、创建人、更新人、config、status 等
 	OperationType       constant.OperationType `gorm:"-"` // 用于标识操作类型，不持久化到数据库
 }
@@ -63,6 +67,11 @@ func (Route) TableName() string {
 	return "route"
 }
 
+// IsExpired 临时路由是否已过期
+func (r Route) IsExpired(now time.Time) bool {
+	return r.ExpiresAt != nil && !r.ExpiresAt.After(now)
+}
+
 // BeforeCreate 创建前钩子
 func (r *Route) BeforeCreate(tx *gorm.DB) (err error) {
 	if err := r.HandleConfig(); err != nil {
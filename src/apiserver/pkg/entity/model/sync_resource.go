@@ -39,6 +39,8 @@ type GatewaySyncData struct {
 	ModRevision int                     `gorm:"column:mod_revision"`     // 更新版本
 	CreatedAt   time.Time               `json:"createdAt"`               // 创建时间
 	UpdatedAt   time.Time               `json:"updatedAt"`               // 更新时间
+	// 不符合 {资源类型}/{id} 约定的原始 etcd key(相对网关前缀)
+	EtcdKeyOverride string `gorm:"column:etcd_key_override;type:varchar(512)"`
 }
 
 // GetServiceID 获取service id
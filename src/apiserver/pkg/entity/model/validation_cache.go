@@ -0,0 +1,57 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package model
+
+import (
+	"time"
+
+	"gorm.io/datatypes"
+)
+
+// ValidationCache 资源配置校验结果缓存，批量校验时未变化的资源直接复用校验结果
+type ValidationCache struct {
+	// 缓存 key：网关、配置 hash、APISIX 版本、校验器类型、校验配置指纹及规则修订号共同计算的 sha256
+	CacheKey      string         `gorm:"column:cache_key;type:varchar(64);primaryKey"`
+	GatewayID     int            `gorm:"column:gateway_id;index"`
+	ConfigHash    string         `gorm:"column:config_hash;type:varchar(64)"`       // 资源配置的 sha256
+	APISIXVersion string         `gorm:"column:apisix_version;type:varchar(32)"`    // 网关的 APISIX 版本
+	Kind          string         `gorm:"column:kind;type:varchar(255)"`             // 校验器类型
+	Fingerprint   string         `gorm:"column:fingerprint;type:varchar(64);index"` // 校验配置指纹，不一致时记录已失效
+	Revision      int            `gorm:"column:revision"`                           // 网关的校验规则修订号
+	Message       string         `gorm:"column:message;type:text"`                  // 校验失败原因，为空表示校验通过
+	Warnings      datatypes.JSON `gorm:"column:warnings;type:json"`                 // 校验告警
+	CreatedAt     time.Time      `gorm:"column:created_at"`
+}
+
+// TableName 设置表名
+func (ValidationCache) TableName() string {
+	return "validation_cache"
+}
+
+// ValidationRuleset 网关的校验规则修订号，自定义插件 schema 变化时递增，使已缓存的校验结果失效
+type ValidationRuleset struct {
+	GatewayID int       `gorm:"column:gateway_id;primaryKey;autoIncrement:false"`
+	Revision  int       `gorm:"column:revision"`
+	UpdatedAt time.Time `gorm:"column:updated_at"`
+}
+
+// TableName 设置表名
+func (ValidationRuleset) TableName() string {
+	return "validation_ruleset"
+}
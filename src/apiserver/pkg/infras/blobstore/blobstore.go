@@ -0,0 +1,121 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package blobstore 提供大对象存储的抽象，对象以内容 sha256 寻址，支持数据库与 S3 兼容对象存储两种后端
+package blobstore
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"path"
+	"sync"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/config"
+)
+
+// 存储后端
+const (
+	BackendDB = "db"
+	BackendS3 = "s3"
+)
+
+var (
+	// ErrNotFound 对象不存在
+	ErrNotFound = errors.New("blob not found")
+	// ErrChecksumMismatch 对象内容与 key 中的 sha256 不一致
+	ErrChecksumMismatch = errors.New("blob checksum mismatch")
+)
+
+// Store 大对象存储
+type Store interface {
+	// Name 后端名称
+	Name() string
+	// Put 写入对象并返回其 key，相同内容写入多次得到相同的 key
+	Put(ctx context.Context, namespace string, data []byte) (string, error)
+	// Get 读取对象并校验内容 sha256，对象不存在时返回 ErrNotFound
+	Get(ctx context.Context, key string) ([]byte, error)
+	// Delete 删除对象，对象不存在时不报错
+	Delete(ctx context.Context, key string) error
+}
+
+var (
+	defaultStore Store = NewDBStore()
+	mu           sync.RWMutex
+)
+
+// Default 获取全局大对象存储，未初始化时使用数据库后端
+func Default() Store {
+	mu.RLock()
+	defer mu.RUnlock()
+	return defaultStore
+}
+
+// SetDefault 设置全局大对象存储
+func SetDefault(store Store) {
+	mu.Lock()
+	defer mu.Unlock()
+	defaultStore = store
+}
+
+// Init 根据配置初始化全局大对象存储，配置了回退后端时读取会先查主后端再查回退后端
+func Init(cfg config.BlobStorageConfig) error {
+	primary, err := New(cfg.Backend, cfg)
+	if err != nil {
+		return err
+	}
+	if cfg.FallbackBackend == "" || cfg.FallbackBackend == primary.Name() {
+		SetDefault(primary)
+		return nil
+	}
+	fallback, err := New(cfg.FallbackBackend, cfg)
+	if err != nil {
+		return err
+	}
+	SetDefault(NewFallbackStore(primary, fallback))
+	return nil
+}
+
+// New 创建指定后端的大对象存储，backend 为空时使用数据库后端
+func New(backend string, cfg config.BlobStorageConfig) (Store, error) {
+	switch backend {
+	case "", BackendDB:
+		return NewDBStore(), nil
+	case BackendS3:
+		return NewS3Store(cfg.S3)
+	default:
+		return nil, fmt.Errorf("unsupported blob storage backend: %s", backend)
+	}
+}
+
+// ContentKey 计算对象 key：{namespace}/{sha256}
+func ContentKey(namespace string, data []byte) string {
+	sum := sha256.Sum256(data)
+	return path.Join(namespace, hex.EncodeToString(sum[:]))
+}
+
+// Verify 校验对象内容与 key 中的 sha256 是否一致
+func Verify(key string, data []byte) error {
+	sum := sha256.Sum256(data)
+	if path.Base(key) != hex.EncodeToString(sum[:]) {
+		return fmt.Errorf("%w: %s", ErrChecksumMismatch, key)
+	}
+	return nil
+}
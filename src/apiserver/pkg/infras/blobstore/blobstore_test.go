@@ -0,0 +1,144 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package blobstore
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/config"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/util"
+)
+
+// memoryStore 内存后端，用于测试迁移与回退
+type memoryStore struct {
+	name    string
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func newMemoryStore(name string) *memoryStore {
+	return &memoryStore{name: name, objects: map[string][]byte{}}
+}
+
+func (s *memoryStore) Name() string {
+	return s.name
+}
+
+func (s *memoryStore) Put(_ context.Context, namespace string, data []byte) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := ContentKey(namespace, data)
+	s.objects[key] = data
+	return key, nil
+}
+
+func (s *memoryStore) Get(_ context.Context, key string) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, ok := s.objects[key]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return data, Verify(key, data)
+}
+
+func (s *memoryStore) Delete(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.objects, key)
+	return nil
+}
+
+func TestContentKey(t *testing.T) {
+	key := ContentKey("ns", []byte("hello"))
+	assert.Equal(t, "ns/2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", key)
+	assert.NoError(t, Verify(key, []byte("hello")))
+	assert.ErrorIs(t, Verify(key, []byte("hello!")), ErrChecksumMismatch)
+}
+
+func TestDBStore(t *testing.T) {
+	util.InitEmbedDb()
+	ctx := context.Background()
+	store := NewDBStore()
+
+	key, err := store.Put(ctx, "test", []byte("db blob"))
+	require.NoError(t, err)
+	// 相同内容重复写入
+	key2, err := store.Put(ctx, "test", []byte("db blob"))
+	require.NoError(t, err)
+	assert.Equal(t, key, key2)
+
+	data, err := store.Get(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, "db blob", string(data))
+
+	require.NoError(t, store.Delete(ctx, key))
+	_, err = store.Get(ctx, key)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestFallbackStore(t *testing.T) {
+	ctx := context.Background()
+	primary, fallback := newMemoryStore("s3"), newMemoryStore("db")
+	store := NewFallbackStore(primary, fallback)
+
+	oldKey, _ := fallback.Put(ctx, "ns", []byte("old"))
+	newKey, err := store.Put(ctx, "ns", []byte("new"))
+	require.NoError(t, err)
+	assert.Contains(t, primary.objects, newKey)
+	assert.NotContains(t, fallback.objects, newKey)
+
+	// 主后端不存在时回退读取
+	data, err := store.Get(ctx, oldKey)
+	require.NoError(t, err)
+	assert.Equal(t, "old", string(data))
+
+	require.NoError(t, store.Delete(ctx, oldKey))
+	_, err = store.Get(ctx, oldKey)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestCopy(t *testing.T) {
+	ctx := context.Background()
+	from, to := newMemoryStore("db"), newMemoryStore("s3")
+	key, _ := from.Put(ctx, "ns", []byte("payload"))
+
+	require.NoError(t, Copy(ctx, from, to, key))
+	assert.Equal(t, "payload", string(to.objects[key]))
+
+	// 源对象损坏时不写入目标
+	broken := ContentKey("ns", []byte("other"))
+	from.objects[broken] = []byte("tampered")
+	assert.ErrorIs(t, Copy(ctx, from, to, broken), ErrChecksumMismatch)
+	assert.NotContains(t, to.objects, broken)
+}
+
+func TestNew(t *testing.T) {
+	store, err := New("", config.BlobStorageConfig{})
+	require.NoError(t, err)
+	assert.Equal(t, BackendDB, store.Name())
+
+	_, err = New("oss", config.BlobStorageConfig{})
+	assert.Error(t, err)
+}
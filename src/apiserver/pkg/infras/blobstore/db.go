@@ -0,0 +1,83 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+)
+
+// DBStore 数据库后端，对象存放于 blob_object 表
+type DBStore struct{}
+
+// NewDBStore 创建数据库后端
+func NewDBStore() *DBStore {
+	return &DBStore{}
+}
+
+// Name ...
+func (s *DBStore) Name() string {
+	return BackendDB
+}
+
+// Put ...
+func (s *DBStore) Put(ctx context.Context, namespace string, data []byte) (string, error) {
+	key := ContentKey(namespace, data)
+	// 内容寻址，key 已存在时内容必然一致
+	err := repo.BlobObject.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&model.BlobObject{
+		Key:       key,
+		Data:      data,
+		Size:      len(data),
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		return "", err
+	}
+	return key, nil
+}
+
+// Get ...
+func (s *DBStore) Get(ctx context.Context, key string) ([]byte, error) {
+	u := repo.BlobObject
+	blob, err := u.WithContext(ctx).Where(u.Key.Eq(key)).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	if err := Verify(key, blob.Data); err != nil {
+		return nil, err
+	}
+	return blob.Data, nil
+}
+
+// Delete ...
+func (s *DBStore) Delete(ctx context.Context, key string) error {
+	u := repo.BlobObject
+	_, err := u.WithContext(ctx).Where(u.Key.Eq(key)).Delete()
+	return err
+}
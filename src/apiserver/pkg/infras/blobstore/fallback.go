@@ -0,0 +1,62 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package blobstore
+
+import (
+	"context"
+	"errors"
+)
+
+// FallbackStore 迁移期间使用：写入主后端，读取时主后端不存在则回退读取旧后端
+type FallbackStore struct {
+	primary  Store
+	fallback Store
+}
+
+// NewFallbackStore ...
+func NewFallbackStore(primary Store, fallback Store) *FallbackStore {
+	return &FallbackStore{primary: primary, fallback: fallback}
+}
+
+// Name ...
+func (s *FallbackStore) Name() string {
+	return s.primary.Name()
+}
+
+// Put ...
+func (s *FallbackStore) Put(ctx context.Context, namespace string, data []byte) (string, error) {
+	return s.primary.Put(ctx, namespace, data)
+}
+
+// Get ...
+func (s *FallbackStore) Get(ctx context.Context, key string) ([]byte, error) {
+	data, err := s.primary.Get(ctx, key)
+	if errors.Is(err, ErrNotFound) {
+		return s.fallback.Get(ctx, key)
+	}
+	return data, err
+}
+
+// Delete 同时删除两个后端中的对象，避免迁移未完成时旧后端残留
+func (s *FallbackStore) Delete(ctx context.Context, key string) error {
+	if err := s.primary.Delete(ctx, key); err != nil {
+		return err
+	}
+	return s.fallback.Delete(ctx, key)
+}
@@ -0,0 +1,62 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package blobstore
+
+import (
+	"context"
+	"fmt"
+	"path"
+)
+
+// Copy 将对象从 from 复制到 to，写入后从 to 读回并校验内容
+func Copy(ctx context.Context, from Store, to Store, key string) error {
+	data, err := from.Get(ctx, key)
+	if err != nil {
+		return fmt.Errorf("read %s from %s failed: %w", key, from.Name(), err)
+	}
+	return put(ctx, to, key, data)
+}
+
+// Import 将数据库中内联的内容写入 to，返回其 key，写入后读回校验
+func Import(ctx context.Context, to Store, namespace string, data []byte) (string, error) {
+	key := ContentKey(namespace, data)
+	if err := put(ctx, to, key, data); err != nil {
+		return "", err
+	}
+	return key, nil
+}
+
+// put 写入 to 并读回校验，Get 内部已校验 sha256
+func put(ctx context.Context, to Store, key string, data []byte) error {
+	newKey, err := to.Put(ctx, path.Dir(key), data)
+	if err != nil {
+		return fmt.Errorf("write %s to %s failed: %w", key, to.Name(), err)
+	}
+	if newKey != key {
+		return fmt.Errorf("%w: %s written as %s", ErrChecksumMismatch, key, newKey)
+	}
+	written, err := to.Get(ctx, key)
+	if err != nil {
+		return fmt.Errorf("verify %s in %s failed: %w", key, to.Name(), err)
+	}
+	if len(written) != len(data) {
+		return fmt.Errorf("%w: %s size %d, want %d", ErrChecksumMismatch, key, len(written), len(data))
+	}
+	return nil
+}
@@ -88,6 +88,18 @@ func InitDBClient(cfg *config.MysqlConfig, slogger *slog.Logger) {
 	})
 }
 
+// ReplicaDialectors 只读副本连接，未配置副本时返回空
+func ReplicaDialectors(cfg *config.MysqlConfig) []gorm.Dialector {
+	dialectors := make([]gorm.Dialector, 0, len(cfg.Replicas))
+	for _, replica := range cfg.Replicas {
+		dialectors = append(dialectors, mysql.New(mysql.Config{
+			DSN:               replica.DSN(),
+			DefaultStringSize: defaultStringSize,
+		}))
+	}
+	return dialectors
+}
+
 // 初始化 DB Client
 func newClient(cfg *config.MysqlConfig, slogger *slog.Logger) (*gorm.DB, error) {
 	sqlDB, err := sql.Open("mysql", cfg.DSN())
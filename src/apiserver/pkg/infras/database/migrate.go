@@ -52,6 +52,19 @@ func RunMigrate() error {
 		model.GatewayCustomPluginSchema{},
 		model.GatewayResourceSchemaAssociation{},
 		model.StreamRoute{},
+		model.ComplianceReport{},
+		model.PublishTask{},
+		model.GatewayDiscovery{},
+		model.EtcdWriteAudit{},
+		model.ResourceRevision{},
+		model.BlobObject{},
+		model.ChangeSet{},
+		model.ChangeSetResource{},
+		model.GatewayOnboarding{},
+		model.Secret{},
+		model.Credential{},
+		model.ValidationCache{},
+		model.ValidationRuleset{},
 	)
 }
 
@@ -81,6 +94,19 @@ func RunGenDao() {
 		model.GatewayCustomPluginSchema{},
 		model.GatewayResourceSchemaAssociation{},
 		model.StreamRoute{},
+		model.ComplianceReport{},
+		model.PublishTask{},
+		model.GatewayDiscovery{},
+		model.EtcdWriteAudit{},
+		model.ResourceRevision{},
+		model.BlobObject{},
+		model.ChangeSet{},
+		model.ChangeSetResource{},
+		model.GatewayOnboarding{},
+		model.Secret{},
+		model.Credential{},
+		model.ValidationCache{},
+		model.ValidationRuleset{},
 	)
 	g.Execute()
 }
@@ -73,3 +73,10 @@ func ReportToSentry(message string, extra map[string]interface{}) {
 		sentry.CaptureEvent(ev)
 	}
 }
+
+// Flush 等待缓冲中的事件上报完成，服务退出前调用
+func Flush(timeout time.Duration) {
+	if s.enabled {
+		sentry.Flush(timeout)
+	}
+}
@@ -0,0 +1,145 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package shutdown 服务优雅退出：拒绝新的写请求、等待进行中的任务并执行清理钩子
+package shutdown
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
+)
+
+// HookFunc 退出时执行的清理函数
+type HookFunc func(ctx context.Context) error
+
+type hook struct {
+	name string
+	fn   HookFunc
+}
+
+// Manager 优雅退出协调器
+type Manager struct {
+	clock clockwork.Clock
+
+	mu       sync.Mutex
+	draining bool
+	inflight int
+	idleCh   chan struct{}
+	hooks    []hook
+}
+
+// NewManager 创建退出协调器，clock 用于等待超时，测试时可传入 fake clock
+func NewManager(clock clockwork.Clock) *Manager {
+	return &Manager{clock: clock}
+}
+
+var defaultManager = NewManager(clockwork.NewRealClock())
+
+// Default 获取全局退出协调器
+func Default() *Manager {
+	return defaultManager
+}
+
+// Draining 是否已进入退出流程
+func (m *Manager) Draining() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.draining
+}
+
+// Track 登记一个进行中的任务，任务结束后需调用 done；已进入退出流程时返回 false
+func (m *Manager) Track() (done func(), ok bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.draining {
+		return func() {}, false
+	}
+	m.inflight++
+	var once sync.Once
+	return func() {
+		once.Do(m.release)
+	}, true
+}
+
+func (m *Manager) release() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.inflight--
+	if m.inflight == 0 && m.idleCh != nil {
+		close(m.idleCh)
+		m.idleCh = nil
+	}
+}
+
+// OnShutdown 注册退出钩子，钩子按注册的逆序执行
+func (m *Manager) OnShutdown(name string, fn HookFunc) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.hooks = append(m.hooks, hook{name: name, fn: fn})
+}
+
+// Drain 进入退出流程并等待进行中的任务结束，超时返回 false
+func (m *Manager) Drain(timeout time.Duration) bool {
+	m.mu.Lock()
+	m.draining = true
+	if m.inflight == 0 {
+		m.mu.Unlock()
+		return true
+	}
+	if m.idleCh == nil {
+		m.idleCh = make(chan struct{})
+	}
+	idleCh := m.idleCh
+	inflight := m.inflight
+	m.mu.Unlock()
+
+	logging.Infof("waiting for %d running task(s) to finish, timeout: %s", inflight, timeout)
+	select {
+	case <-idleCh:
+		return true
+	case <-m.clock.After(timeout):
+		return false
+	}
+}
+
+// Shutdown 等待进行中的任务(最多 drainTimeout)，然后依次执行退出钩子
+func (m *Manager) Shutdown(ctx context.Context, drainTimeout time.Duration) error {
+	if !m.Drain(drainTimeout) {
+		logging.Warnf("drain timeout after %s, running tasks will be interrupted", drainTimeout)
+	}
+	m.mu.Lock()
+	hooks := make([]hook, len(m.hooks))
+	copy(hooks, m.hooks)
+	m.mu.Unlock()
+
+	var errs []error
+	for i := len(hooks) - 1; i >= 0; i-- {
+		if err := hooks[i].fn(ctx); err != nil {
+			logging.Errorf("shutdown hook %s failed: %s", hooks[i].name, err)
+			errs = append(errs, fmt.Errorf("%s: %w", hooks[i].name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
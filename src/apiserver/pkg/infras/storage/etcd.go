@@ -41,10 +41,14 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
 	clientv3 "go.etcd.io/etcd/client/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/base"
 	log "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
@@ -57,6 +61,7 @@ var (
 	KeyNotFoundError      = errors.New("key not found")
 	ConnectionFailedError = errors.New("连接失败，请检查 etcd 地址是否正确")
 	AuthFailedError       = errors.New("用户名或密码错误，或者证书错误，请重新检查后再试")
+	AuthExpiredError      = errors.New("etcd 鉴权已失效且重新认证失败，请检查 etcd 用户名和密码")
 )
 
 // SkippedValueEtcdInitDir ...
@@ -75,17 +80,56 @@ const (
 	SkippedValueEtcdEmptyObject = "{}"
 	// MaxOperateNum ...
 	bulkOperateSize = 100
+	// listPageSize List 分页读取时每页的 key 数量
+	listPageSize = 500
 )
 
 // EtcdV3Storage ...
 type EtcdV3Storage struct {
+	mu     sync.RWMutex
 	client *clientv3.Client
 	prefix string
+	conf   base.EtcdConfig
+	// 重新认证后被替换下来的连接，可能仍被 GetClient 的调用方持有，关闭存储时统一关闭
+	retired []*clientv3.Client
+	// 鉴权状态回调
+	authStatusHandler AuthStatusHandler
+}
+
+// AuthStatusHandler etcd 鉴权状态回调：重新认证失败时传入失败原因，重新认证成功时传入 nil
+type AuthStatusHandler func(err error)
+
+// Option NewEtcdStorage 的可选配置
+type Option func(*EtcdV3Storage)
+
+// WithAuthStatusHandler 设置 etcd 鉴权状态回调
+func WithAuthStatusHandler(handler AuthStatusHandler) Option {
+	return func(e *EtcdV3Storage) {
+		e.authStatusHandler = handler
+	}
 }
 
 var _ StorageInterface = &EtcdV3Storage{}
 
+// openedStorages 当前进程中尚未关闭的 etcd 连接，服务退出时统一关闭
+var openedStorages sync.Map
+
 func initEtcdClient(etcdConf base.EtcdConfig) (*clientv3.Client, error) {
+	config, err := newEtcdClientConfig(etcdConf)
+	if err != nil {
+		return nil, err
+	}
+	cli, err := clientv3.New(config)
+	if err != nil {
+		err = translateInitError(err)
+		log.Errorf("init etcd failed: %s", err)
+		return nil, fmt.Errorf("etcd 初始化失败: %w", err)
+	}
+	return cli, nil
+}
+
+// newEtcdClientConfig 根据网关的 etcd 配置构造客户端配置
+func newEtcdClientConfig(etcdConf base.EtcdConfig) (clientv3.Config, error) {
 	config := clientv3.Config{
 		Endpoints:   etcdConf.Endpoint.Endpoints(),
 		DialTimeout: 5 * time.Second,
@@ -96,42 +140,126 @@ func initEtcdClient(etcdConf base.EtcdConfig) (*clientv3.Client, error) {
 		var err error
 		config.TLS, err = tls.NewClientTLSConfig(etcdConf.CACert, etcdConf.CertCert, etcdConf.CertKey)
 		if err != nil {
-			return nil, err
+			return config, err
 		}
 	}
-	cli, err := clientv3.New(config)
-	if err != nil {
-		if strings.Contains(err.Error(), "context deadline exceeded") {
-			err = ConnectionFailedError
-		}
-		if strings.Contains(err.Error(), "etcdserver: authentication failed, invalid user ID or password") {
-			err = AuthFailedError
-		}
-		log.Errorf("init etcd failed: %s", err)
-		return nil, fmt.Errorf("etcd 初始化失败: %s", err)
+	return config, nil
+}
+
+// translateInitError 将创建客户端时的连接超时及鉴权失败转换为可读的错误
+func translateInitError(err error) error {
+	if strings.Contains(err.Error(), "context deadline exceeded") {
+		return ConnectionFailedError
 	}
-	return cli, nil
+	if strings.Contains(err.Error(), "etcdserver: authentication failed, invalid user ID or password") {
+		return AuthFailedError
+	}
+	return err
 }
 
 // NewEtcdStorage ...
-func NewEtcdStorage(etcdConf base.EtcdConfig) (StorageInterface, error) {
+func NewEtcdStorage(etcdConf base.EtcdConfig, opts ...Option) (StorageInterface, error) {
 	cli, err := initEtcdClient(etcdConf)
 	if err != nil {
 		log.Errorf("init etcd failed: %s", err)
 		return nil, err
 	}
-	return &EtcdV3Storage{
+	s := &EtcdV3Storage{
 		client: cli,
 		prefix: etcdConf.Prefix,
-	}, nil
+		conf:   etcdConf,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	openedStorages.Store(s, struct{}{})
+	return s, nil
+}
+
+// IsAuthError 判断是否为 etcd 鉴权类错误，如 token 过期、鉴权信息变更等
+func IsAuthError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var etcdErr rpctypes.EtcdError
+	if !errors.As(err, &etcdErr) {
+		// 未经客户端转换的 grpc 错误
+		converted, ok := rpctypes.Error(err).(rpctypes.EtcdError)
+		if !ok {
+			return false
+		}
+		etcdErr = converted
+	}
+	switch error(etcdErr) {
+	case rpctypes.ErrInvalidAuthToken, rpctypes.ErrAuthOldRevision, rpctypes.ErrAuthFailed, rpctypes.ErrUserEmpty:
+		return true
+	}
+	return false
+}
+
+func (e *EtcdV3Storage) currentClient() *clientv3.Client {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.client
+}
+
+// reauth 使用原有配置重新建立连接并认证，替换掉鉴权失效的连接
+func (e *EtcdV3Storage) reauth(old *clientv3.Client) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	// 并发请求已经完成了重新认证
+	if e.client != old {
+		return nil
+	}
+	cli, err := initEtcdClient(e.conf)
+	if err != nil {
+		return err
+	}
+	e.retired = append(e.retired, old)
+	e.client = cli
+	return nil
+}
+
+func (e *EtcdV3Storage) notifyAuthStatus(err error) {
+	if e.authStatusHandler != nil {
+		e.authStatusHandler(err)
+	}
+}
+
+// withReauth 执行 etcd 操作，遇到鉴权失效时重新认证一次并重试；
+// 重新认证失败时返回 AuthExpiredError 并通过回调上报
+func (e *EtcdV3Storage) withReauth(op func(cli *clientv3.Client) error) error {
+	cli := e.currentClient()
+	err := op(cli)
+	if !IsAuthError(err) {
+		return err
+	}
+	log.Warnf("etcd auth expired, re-authenticating: %s", err)
+	if reauthErr := e.reauth(cli); reauthErr != nil {
+		log.Errorf("etcd re-authenticate failed: %s", reauthErr)
+		e.notifyAuthStatus(reauthErr)
+		return fmt.Errorf("%w: %s", AuthExpiredError, reauthErr)
+	}
+	err = op(e.currentClient())
+	if IsAuthError(err) {
+		log.Errorf("etcd auth still invalid after re-authenticate: %s", err)
+		e.notifyAuthStatus(err)
+		return fmt.Errorf("%w: %s", AuthExpiredError, err)
+	}
+	e.notifyAuthStatus(nil)
+	return err
 }
 
 // Get ...
 func (e *EtcdV3Storage) Get(ctx context.Context, key string) (string, error) {
-	resp, err := e.client.Get(ctx, fmt.Sprintf("%s/%s", e.prefix, key))
+	var resp *clientv3.GetResponse
+	err := e.withReauth(func(cli *clientv3.Client) (err error) {
+		resp, err = cli.Get(ctx, fmt.Sprintf("%s/%s", e.prefix, key))
+		return err
+	})
 	if err != nil {
 		log.Errorf("etcd get failed: %s", err)
-		return "", fmt.Errorf("etcd get failed: %s", err)
+		return "", fmt.Errorf("etcd get failed: %w", err)
 	}
 	if resp.Count == 0 {
 		log.Warnf("key: %s is not found", key)
@@ -144,8 +272,11 @@ func (e *EtcdV3Storage) Get(ctx context.Context, key string) (string, error) {
 func (e *EtcdV3Storage) txnOperate(ctx context.Context, ops []clientv3.Op) error {
 	timeoutCtx, cancelFunc := context.WithTimeout(ctx, time.Second*2)
 	defer cancelFunc()
-	txn := e.client.Txn(timeoutCtx)
-	txnRsp, err := txn.Then(ops...).Commit()
+	var txnRsp *clientv3.TxnResponse
+	err := e.withReauth(func(cli *clientv3.Client) (err error) {
+		txnRsp, err = cli.Txn(timeoutCtx).Then(ops...).Commit()
+		return err
+	})
 	if err != nil {
 		return err
 	}
@@ -176,58 +307,148 @@ func (e *EtcdV3Storage) txnMultiOperate(ctx context.Context, ops []clientv3.Op)
 			return err
 		}
 	}
-	_ = e.client.Close()
+	_ = e.currentClient().Close()
 	return nil
 }
 
-// List ...
+// List 分页读取 key 前缀下的所有资源，后续分页固定在首页的 revision 上以保证读到同一份快照；
+// 单页响应超过 grpc 消息大小限制时减半分页大小后重试
 func (e *EtcdV3Storage) List(ctx context.Context, key string) ([]KeyValuePair, error) {
-	resp, err := e.client.Get(ctx, key, clientv3.WithPrefix())
-	if err != nil {
-		log.Errorf("etcd get failed: %s", err)
-		return nil, fmt.Errorf("etcd get failed: %s", err)
-	}
 	var ret []KeyValuePair
-	for i := range resp.Kvs {
-		key := string(resp.Kvs[i].Key)
-		value := string(resp.Kvs[i].Value)
-
-		// Skip the data if its value is init_dir or {}
-		// during fetching-all phase.
-		//
-		// For more complex cases, an explicit function to determine if
-		// skippable would be better.
-		if value == SkippedValueEtcdInitDir || value == SkippedValueEtcdEmptyObject {
+	start, end := key, clientv3.GetPrefixRangeEnd(key)
+	limit := int64(listPageSize)
+	var revision int64
+	for {
+		opts := []clientv3.OpOption{clientv3.WithRange(end), clientv3.WithLimit(limit)}
+		if revision > 0 {
+			opts = append(opts, clientv3.WithRev(revision))
+		}
+		var resp *clientv3.GetResponse
+		err := e.withReauth(func(cli *clientv3.Client) (err error) {
+			resp, err = cli.Get(ctx, start, opts...)
+			return err
+		})
+		if status.Code(err) == codes.ResourceExhausted && limit > 1 {
+			log.Warnf("etcd range response too large, retry with limit %d: %s", limit/2, err)
+			limit /= 2
 			continue
 		}
+		if err != nil {
+			log.Errorf("etcd get failed: %s", err)
+			return nil, fmt.Errorf("etcd get failed: %w", err)
+		}
+		if revision == 0 {
+			revision = resp.Header.GetRevision()
+		}
+		for i := range resp.Kvs {
+			key := string(resp.Kvs[i].Key)
+			value := string(resp.Kvs[i].Value)
+
+			// Skip the data if its value is init_dir or {}
+			// during fetching-all phase.
+			//
+			// For more complex cases, an explicit function to determine if
+			// skippable would be better.
+			if value == SkippedValueEtcdInitDir || value == SkippedValueEtcdEmptyObject {
+				continue
+			}
 
-		data := KeyValuePair{
-			Key:         key,
-			Value:       value,
-			ModRevision: resp.Kvs[i].ModRevision,
+			data := KeyValuePair{
+				Key:         key,
+				Value:       value,
+				ModRevision: resp.Kvs[i].ModRevision,
+			}
+			ret = append(ret, data)
+		}
+		if !resp.More || len(resp.Kvs) == 0 {
+			return ret, nil
 		}
-		ret = append(ret, data)
+		// 下一页从本页最后一个 key 之后开始
+		start = string(resp.Kvs[len(resp.Kvs)-1].Key) + "\x00"
 	}
-
-	return ret, nil
 }
 
 // Create ...
 func (e *EtcdV3Storage) Create(ctx context.Context, key, val string) error {
-	_, err := e.client.Put(ctx, fmt.Sprintf("%s/%s", e.prefix, key), val)
+	err := e.withReauth(func(cli *clientv3.Client) error {
+		_, err := cli.Put(ctx, fmt.Sprintf("%s/%s", e.prefix, key), val)
+		return err
+	})
 	if err != nil {
 		log.Errorf("etcd put failed: %s", err)
-		return fmt.Errorf("etcd put failed: %s", err)
+		return fmt.Errorf("etcd put failed: %w", err)
 	}
 	return nil
 }
 
 // Update ...
 func (e *EtcdV3Storage) Update(ctx context.Context, key, val string) error {
-	_, err := e.client.Put(ctx, fmt.Sprintf("%s/%s", e.prefix, key), val)
+	err := e.withReauth(func(cli *clientv3.Client) error {
+		_, err := cli.Put(ctx, fmt.Sprintf("%s/%s", e.prefix, key), val)
+		return err
+	})
 	if err != nil {
 		log.Errorf("etcd put failed: %s", err)
-		return fmt.Errorf("etcd put failed: %s", err)
+		return fmt.Errorf("etcd put failed: %w", err)
+	}
+	return nil
+}
+
+// PutWithTTL 写入 key 并绑定指定 TTL(秒) 的 lease，lease 过期后 etcd 会自动删除该 key
+// 如果 key 已经绑定了 lease（续期场景），会在同一个事务中将 key 切换到新的 lease，之后再回收旧 lease，
+// 保证续期过程中 key 不会因为旧 lease 过期而被提前删除
+func (e *EtcdV3Storage) PutWithTTL(ctx context.Context, key, val string, ttl int64) error {
+	if ttl <= 0 {
+		return fmt.Errorf("invalid lease ttl: %d", ttl)
+	}
+	// 整个续期流程每一步都基于最新读取的数据，鉴权失效时可以整体重试
+	return e.withReauth(func(cli *clientv3.Client) error {
+		return e.putWithTTL(ctx, cli, key, val, ttl)
+	})
+}
+
+func (e *EtcdV3Storage) putWithTTL(ctx context.Context, cli *clientv3.Client, key, val string, ttl int64) error {
+	fullKey := fmt.Sprintf("%s/%s", e.prefix, key)
+	resp, err := cli.Get(ctx, fullKey)
+	if err != nil {
+		log.Errorf("etcd get failed: %s", err)
+		return fmt.Errorf("etcd get failed: %w", err)
+	}
+	var (
+		oldLease    = clientv3.NoLease
+		modRevision int64
+	)
+	if resp.Count > 0 {
+		oldLease = clientv3.LeaseID(resp.Kvs[0].Lease)
+		modRevision = resp.Kvs[0].ModRevision
+	}
+
+	lease, err := cli.Grant(ctx, ttl)
+	if err != nil {
+		log.Errorf("etcd grant lease failed: %s", err)
+		return fmt.Errorf("etcd grant lease failed: %w", err)
+	}
+	// key 在读取之后被修改过则放弃本次写入，避免覆盖并发写入的数据
+	txnRsp, err := cli.Txn(ctx).
+		If(clientv3.Compare(clientv3.ModRevision(fullKey), "=", modRevision)).
+		Then(clientv3.OpPut(fullKey, val, clientv3.WithLease(lease.ID))).
+		Commit()
+	if err != nil || !txnRsp.Succeeded {
+		if _, revokeErr := cli.Revoke(ctx, lease.ID); revokeErr != nil {
+			log.Warnf("etcd revoke lease %d failed: %s", lease.ID, revokeErr)
+		}
+		if err != nil {
+			log.Errorf("etcd put with lease failed: %s", err)
+			return fmt.Errorf("etcd put with lease failed: %w", err)
+		}
+		return fmt.Errorf("etcd put with lease failed: key %s was modified concurrently", key)
+	}
+
+	if oldLease != clientv3.NoLease && oldLease != lease.ID {
+		// 旧 lease 上已经没有绑定该 key，回收失败也只是等待其自然过期
+		if _, err := cli.Revoke(ctx, oldLease); err != nil {
+			log.Warnf("etcd revoke lease %d failed: %s", oldLease, err)
+		}
 	}
 	return nil
 }
@@ -253,7 +474,7 @@ func (e *EtcdV3Storage) BatchDelete(ctx context.Context, keys []string) error {
 // Watch ...
 func (e *EtcdV3Storage) Watch(ctx context.Context, key string) <-chan WatchResponse {
 	// NOTE: should use e.prefix here?
-	eventChan := e.client.Watch(ctx, key, clientv3.WithPrefix())
+	eventChan := e.currentClient().Watch(ctx, key, clientv3.WithPrefix())
 	ch := make(chan WatchResponse, 1)
 	go func() {
 		defer runtime.HandlePanic()
@@ -310,10 +531,29 @@ func (e *EtcdV3Storage) Watch(ctx context.Context, key string) <-chan WatchRespo
 
 // Close ...
 func (e *EtcdV3Storage) Close() error {
+	openedStorages.Delete(e)
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, cli := range e.retired {
+		_ = cli.Close()
+	}
+	e.retired = nil
 	return e.client.Close()
 }
 
+// CloseAll 关闭当前进程中所有未关闭的 etcd 连接，连接上的 watch 会随之结束
+func CloseAll() error {
+	var closeErr error
+	openedStorages.Range(func(key, _ any) bool {
+		if err := key.(*EtcdV3Storage).Close(); err != nil && closeErr == nil {
+			closeErr = err
+		}
+		return true
+	})
+	return closeErr
+}
+
 // GetClient ...
 func (e *EtcdV3Storage) GetClient() *clientv3.Client {
-	return e.client
+	return e.currentClient()
 }
@@ -0,0 +1,163 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	"github.com/stretchr/testify/assert"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/server/v3/embed"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/base"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/util"
+)
+
+const (
+	gatewayEtcdUser     = "gateway"
+	gatewayEtcdPassword = "gateway-password"
+	tokenTTLSeconds     = 1
+)
+
+// authStatusRecorder 记录鉴权状态回调
+type authStatusRecorder struct {
+	mu   sync.Mutex
+	errs []error
+}
+
+func (r *authStatusRecorder) handle(err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.errs = append(r.errs, err)
+}
+
+func (r *authStatusRecorder) calls() []error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]error(nil), r.errs...)
+}
+
+var _ = Describe("EtcdV3Storage auth", Ordered, func() {
+	var (
+		rootClient *clientv3.Client
+		etcd       *embed.Etcd
+		etcdConf   base.EtcdConfig
+		recorder   *authStatusRecorder
+		store      *EtcdV3Storage
+	)
+
+	BeforeAll(func() {
+		var err error
+		rootClient, etcd, err = util.StartEmbedEtcdWithAuth(context.Background(), tokenTTLSeconds)
+		assert.NoError(GinkgoT(), err)
+		_, err = rootClient.UserAdd(context.Background(), gatewayEtcdUser, gatewayEtcdPassword)
+		assert.NoError(GinkgoT(), err)
+		_, err = rootClient.UserGrantRole(context.Background(), gatewayEtcdUser, util.EmbedEtcdRootUser)
+		assert.NoError(GinkgoT(), err)
+		etcdConf = base.EtcdConfig{
+			Endpoint: base.Endpoint(etcd.Clients[0].Addr().String()),
+			Username: gatewayEtcdUser,
+			Password: gatewayEtcdPassword,
+			Prefix:   "/auth-test",
+		}
+	})
+
+	AfterAll(func() {
+		_ = rootClient.Close()
+		etcd.Close()
+	})
+
+	BeforeEach(func() {
+		_, err := rootClient.UserChangePassword(context.Background(), gatewayEtcdUser, gatewayEtcdPassword)
+		assert.NoError(GinkgoT(), err)
+		recorder = &authStatusRecorder{}
+		s, err := NewEtcdStorage(etcdConf, WithAuthStatusHandler(recorder.handle))
+		assert.NoError(GinkgoT(), err)
+		store = s.(*EtcdV3Storage)
+	})
+
+	AfterEach(func() {
+		_ = store.Close()
+	})
+
+	It("keeps working after the token expired", func() {
+		ctx := context.Background()
+		assert.NoError(GinkgoT(), store.Create(ctx, "routes/1", `{"id":"1"}`))
+
+		time.Sleep((tokenTTLSeconds + 1) * time.Second)
+
+		value, err := store.Get(ctx, "routes/1")
+		assert.NoError(GinkgoT(), err)
+		assert.Equal(GinkgoT(), `{"id":"1"}`, value)
+		assert.NoError(GinkgoT(), store.PutWithTTL(ctx, "routes/2", `{"id":"2"}`, 60))
+		assert.NoError(GinkgoT(), store.BatchCreate(ctx, map[string]string{"routes/3": `{"id":"3"}`}))
+		assert.Empty(GinkgoT(), recorder.calls())
+	})
+
+	It("re-authenticates with the latest config and retries once", func() {
+		ctx := context.Background()
+		oldClient := store.GetClient()
+		// etcd 密码被轮换，配置中的密码随之更新，但已建立的连接仍使用旧密码
+		_, err := rootClient.UserChangePassword(ctx, gatewayEtcdUser, "rotated-password")
+		assert.NoError(GinkgoT(), err)
+		store.conf.Password = "rotated-password"
+
+		assert.NoError(GinkgoT(), store.Create(ctx, "routes/4", `{"id":"4"}`))
+		assert.NotSame(GinkgoT(), oldClient, store.GetClient())
+		assert.Equal(GinkgoT(), []error{nil}, recorder.calls())
+
+		// 被替换下来的连接在存储关闭时一并关闭
+		assert.NoError(GinkgoT(), store.Close())
+		_, err = oldClient.Get(ctx, "routes/4")
+		assert.Error(GinkgoT(), err)
+	})
+
+	It("returns AuthExpiredError when re-authenticate failed", func() {
+		ctx := context.Background()
+		_, err := rootClient.UserChangePassword(ctx, gatewayEtcdUser, "changed-password")
+		assert.NoError(GinkgoT(), err)
+
+		_, err = store.Get(ctx, "routes/1")
+		assert.True(GinkgoT(), errors.Is(err, AuthExpiredError), err)
+		calls := recorder.calls()
+		if assert.Len(GinkgoT(), calls, 1) {
+			assert.True(GinkgoT(), errors.Is(calls[0], AuthFailedError), calls[0])
+		}
+
+		err = store.BatchDelete(ctx, []string{"routes/1"})
+		assert.True(GinkgoT(), errors.Is(err, AuthExpiredError), err)
+	})
+})
+
+var _ = Describe("IsAuthError", func() {
+	It("classifies auth errors", func() {
+		assert.False(GinkgoT(), IsAuthError(nil))
+		assert.False(GinkgoT(), IsAuthError(errors.New("etcd transaction failed")))
+		assert.True(GinkgoT(), IsAuthError(rpctypes.ErrInvalidAuthToken))
+		assert.True(GinkgoT(), IsAuthError(rpctypes.ErrGRPCAuthOldRevision))
+		assert.True(GinkgoT(), IsAuthError(fmt.Errorf("etcd get failed: %w", rpctypes.ErrAuthFailed)))
+		assert.False(GinkgoT(), IsAuthError(rpctypes.ErrKeyNotFound))
+	})
+})
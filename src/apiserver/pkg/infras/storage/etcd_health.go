@@ -0,0 +1,92 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package storage
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/base"
+)
+
+// EndpointHealth 单个 etcd 节点的连通性检查结果
+type EndpointHealth struct {
+	Endpoint string
+	Healthy  bool
+	RTT      time.Duration // 读请求的往返耗时，连接或鉴权失败时为 0
+	Err      error
+}
+
+// CheckEndpointsHealth 分别连接配置中的每个 etcd 节点，使用配置的账号读取一次 prefix：
+// 线性一致读需要 leader 确认多数派，成功即说明该节点可访问、鉴权通过且集群多数派可用；
+// timeout 为单个节点建立连接、鉴权及读取的总超时
+func CheckEndpointsHealth(ctx context.Context, etcdConf base.EtcdConfig, timeout time.Duration) []EndpointHealth {
+	endpoints := etcdConf.Endpoint.Endpoints()
+	results := make([]EndpointHealth, len(endpoints))
+	var wg sync.WaitGroup
+	for i, endpoint := range endpoints {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = checkEndpointHealth(ctx, etcdConf, endpoint, timeout)
+		}()
+	}
+	wg.Wait()
+	return results
+}
+
+// checkEndpointHealth 检查单个 etcd 节点，每次检查使用独立的连接，避免复用已失效的连接或 token
+func checkEndpointHealth(
+	ctx context.Context,
+	etcdConf base.EtcdConfig,
+	endpoint string,
+	timeout time.Duration,
+) EndpointHealth {
+	result := EndpointHealth{Endpoint: endpoint}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	etcdConf.Endpoint = base.Endpoint(endpoint)
+	config, err := newEtcdClientConfig(etcdConf)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	config.Context = ctx
+	config.DialTimeout = timeout
+	cli, err := clientv3.New(config)
+	if err != nil {
+		result.Err = translateInitError(err)
+		return result
+	}
+	defer cli.Close()
+	start := time.Now()
+	if _, err = cli.Get(ctx, etcdConf.Prefix); err != nil {
+		if ctx.Err() != nil {
+			err = ConnectionFailedError
+		}
+		result.Err = err
+		return result
+	}
+	result.RTT = time.Since(start)
+	result.Healthy = true
+	return result
+}
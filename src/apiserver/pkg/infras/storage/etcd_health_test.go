@@ -0,0 +1,83 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	"github.com/stretchr/testify/assert"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/server/v3/embed"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/base"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/util"
+)
+
+var _ = Describe("CheckEndpointsHealth", Ordered, func() {
+	var (
+		rootClient *clientv3.Client
+		etcd       *embed.Etcd
+		endpoint   string
+	)
+
+	BeforeAll(func() {
+		var err error
+		rootClient, etcd, err = util.StartEmbedEtcdWithAuth(context.Background(), 60)
+		assert.NoError(GinkgoT(), err)
+		endpoint = etcd.Clients[0].Addr().String()
+	})
+
+	AfterAll(func() {
+		_ = rootClient.Close()
+		etcd.Close()
+	})
+
+	It("reports round-trip time of reachable endpoints", func() {
+		// 第二个节点不可访问，多数派判断由调用方完成
+		results := CheckEndpointsHealth(context.Background(), base.EtcdConfig{
+			Endpoint: base.Endpoint(endpoint + ";127.0.0.1:1"),
+			Username: util.EmbedEtcdRootUser,
+			Password: util.EmbedEtcdRootPassword,
+			Prefix:   "/health-test",
+		}, time.Second)
+		assert.Len(GinkgoT(), results, 2)
+		assert.Equal(GinkgoT(), endpoint, results[0].Endpoint)
+		assert.True(GinkgoT(), results[0].Healthy, results[0].Err)
+		assert.Positive(GinkgoT(), results[0].RTT)
+		assert.Equal(GinkgoT(), "127.0.0.1:1", results[1].Endpoint)
+		assert.False(GinkgoT(), results[1].Healthy)
+		assert.Zero(GinkgoT(), results[1].RTT)
+		assert.True(GinkgoT(), errors.Is(results[1].Err, ConnectionFailedError), results[1].Err)
+	})
+
+	It("reports authentication failures", func() {
+		results := CheckEndpointsHealth(context.Background(), base.EtcdConfig{
+			Endpoint: base.Endpoint(endpoint),
+			Username: util.EmbedEtcdRootUser,
+			Password: "wrong-password",
+			Prefix:   "/health-test",
+		}, time.Second)
+		assert.Len(GinkgoT(), results, 1)
+		assert.False(GinkgoT(), results[0].Healthy)
+		assert.True(GinkgoT(), errors.Is(results[0].Err, AuthFailedError), results[0].Err)
+	})
+})
@@ -30,6 +30,8 @@ import (
 	"github.com/stretchr/testify/mock"
 	mvccpb "go.etcd.io/etcd/api/v3/mvccpb"
 	clientv3 "go.etcd.io/etcd/client/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/base"
 )
@@ -201,6 +203,48 @@ var _ = Describe("EtcdV3Storage", func() {
 				assert.Equal(GinkgoT(), "value2", result[1].Value)
 			})
 
+			It("List: paginated", func() {
+				kv := &MockKV{}
+				first := &clientv3.GetResponse{
+					Kvs: []*mvccpb.KeyValue{
+						{Key: []byte("/prefix/key1"), Value: []byte("value1")},
+						{Key: []byte("/prefix/key2"), Value: []byte("{}")},
+					},
+					More: true,
+				}
+				second := &clientv3.GetResponse{
+					Kvs: []*mvccpb.KeyValue{{Key: []byte("/prefix/key3"), Value: []byte("value3")}},
+				}
+				kv.On("Get", context.Background(), "/prefix", mock.Anything).Return(first, nil)
+				kv.On("Get", context.Background(), "/prefix/key2\x00", mock.Anything).Return(second, nil)
+
+				etcd := &EtcdV3Storage{client: &clientv3.Client{KV: kv}, prefix: "/prefix"}
+
+				result, err := etcd.List(context.Background(), "/prefix")
+				assert.NoError(GinkgoT(), err)
+				assert.Equal(GinkgoT(), []KeyValuePair{
+					{Key: "/prefix/key1", Value: "value1"},
+					{Key: "/prefix/key3", Value: "value3"},
+				}, result)
+			})
+
+			It("List: shrink page size when response too large", func() {
+				kv := &MockKV{}
+				tooLarge := status.Error(codes.ResourceExhausted, "received message larger than max")
+				kv.On("Get", context.Background(), "/prefix", mock.Anything).
+					Return(&clientv3.GetResponse{}, tooLarge).Once()
+				kv.On("Get", context.Background(), "/prefix", mock.Anything).Return(&clientv3.GetResponse{
+					Kvs: []*mvccpb.KeyValue{{Key: []byte("/prefix/key1"), Value: []byte("value1")}},
+				}, nil).Once()
+
+				etcd := &EtcdV3Storage{client: &clientv3.Client{KV: kv}, prefix: "/prefix"}
+
+				result, err := etcd.List(context.Background(), "/prefix")
+				assert.NoError(GinkgoT(), err)
+				assert.Len(GinkgoT(), result, 1)
+				kv.AssertNumberOfCalls(GinkgoT(), "Get", 2)
+			})
+
 			It("List: error", func() {
 				kv := &MockKV{}
 				kv.On("Get", context.Background(), "/prefix", mock.Anything).
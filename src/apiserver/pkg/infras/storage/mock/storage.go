@@ -136,6 +136,20 @@ func (mr *MockStorageInterfaceMockRecorder) List(ctx, key interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockStorageInterface)(nil).List), ctx, key)
 }
 
+// PutWithTTL mocks base method.
+func (m *MockStorageInterface) PutWithTTL(ctx context.Context, key, val string, ttl int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutWithTTL", ctx, key, val, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutWithTTL indicates an expected call of PutWithTTL.
+func (mr *MockStorageInterfaceMockRecorder) PutWithTTL(ctx, key, val, ttl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutWithTTL", reflect.TypeOf((*MockStorageInterface)(nil).PutWithTTL), ctx, key, val, ttl)
+}
+
 // Update mocks base method.
 func (m *MockStorageInterface) Update(ctx context.Context, key, val string) error {
 	m.ctrl.T.Helper()
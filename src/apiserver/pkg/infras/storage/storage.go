@@ -32,6 +32,7 @@ type StorageInterface interface {
 	List(ctx context.Context, key string) ([]KeyValuePair, error)
 	Create(ctx context.Context, key, val string) error
 	Update(ctx context.Context, key, val string) error
+	PutWithTTL(ctx context.Context, key, val string, ttl int64) error
 	BatchDelete(ctx context.Context, keys []string) error
 	BatchCreate(ctx context.Context, resource map[string]string) error
 	Watch(ctx context.Context, key string) <-chan WatchResponse
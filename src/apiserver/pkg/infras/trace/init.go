@@ -47,7 +47,8 @@ const (
 // Trace ...
 type Trace struct {
 	tc.Tracer
-	config config.Tracing
+	config   config.Tracing
+	provider *trace.TracerProvider
 }
 
 // Tracer global tracer
@@ -86,8 +87,9 @@ func InitTrace(config config.Tracing) error {
 		// set  global provider
 		otel.SetTracerProvider(tp)
 		globalTracer = &Trace{
-			Tracer: tp.Tracer(config.ServiceName),
-			config: config,
+			Tracer:   tp.Tracer(config.ServiceName),
+			config:   config,
+			provider: tp,
 		}
 	})
 	if err != nil {
@@ -96,6 +98,14 @@ func InitTrace(config config.Tracing) error {
 	return nil
 }
 
+// Shutdown 上报缓冲中的 span 并关闭 provider
+func Shutdown(ctx context.Context) error {
+	if globalTracer == nil {
+		return nil
+	}
+	return globalTracer.provider.Shutdown(ctx)
+}
+
 // getExporterClient Get exporter client
 func getExporterClient(protocolType string, endpoint string) (otlptrace.Client, error) {
 	switch protocolType {
@@ -24,6 +24,8 @@ import (
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
 )
 
 // CORS 用于管理跨域请求
@@ -37,7 +39,9 @@ func CORS(allowedOrigins []string) gin.HandlerFunc {
 			"Access-Control-Request-Headers",
 			"X-Requested-With", "X-CSRF-Token",
 		},
-		ExposeHeaders:    []string{"Content-Length", "Access-Control-Allow-Credentials"},
+		ExposeHeaders: []string{
+			"Content-Length", "Access-Control-Allow-Credentials", constant.ChangeTokenHeaderKey,
+		},
 		AllowCredentials: true,
 		MaxAge:           12 * time.Hour,
 	})
@@ -0,0 +1,73 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package middleware
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+)
+
+// DecompressGzipBody 透明解压 Content-Encoding 为 gzip 的请求体，后续的绑定与校验直接读取解压后的数据；
+// 解压后超过 maxSize 字节时返回 413，避免压缩炸弹耗尽内存，maxSize 不大于 0 时不限制
+func DecompressGzipBody(maxSize int64) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		encoding := strings.TrimSpace(c.GetHeader("Content-Encoding"))
+		if !strings.EqualFold(encoding, "gzip") || c.Request.Body == nil {
+			c.Next()
+			return
+		}
+		reader, err := gzip.NewReader(c.Request.Body)
+		if err != nil {
+			ginx.BaseErrorJSONResponse(c, ginx.BadRequestError, "请求体不是合法的 gzip 数据", http.StatusBadRequest)
+			c.Abort()
+			return
+		}
+		defer reader.Close()
+		var limited io.Reader = reader
+		if maxSize > 0 {
+			// 多读取一个字节以判断是否超过限制
+			limited = io.LimitReader(reader, maxSize+1)
+		}
+		body, err := io.ReadAll(limited)
+		if err != nil {
+			ginx.BaseErrorJSONResponse(c, ginx.BadRequestError, "请求体不是合法的 gzip 数据", http.StatusBadRequest)
+			c.Abort()
+			return
+		}
+		if maxSize > 0 && int64(len(body)) > maxSize {
+			ginx.BaseErrorJSONResponse(c, ginx.RequestEntityTooLarge,
+				fmt.Sprintf("请求体解压后超过 %d 字节", maxSize), http.StatusRequestEntityTooLarge)
+			c.Abort()
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+		c.Request.ContentLength = int64(len(body))
+		c.Request.Header.Del("Content-Encoding")
+		c.Request.Header.Del("Content-Length")
+		c.Next()
+	}
+}
@@ -0,0 +1,96 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package middleware
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+)
+
+func gzipBytes(t *testing.T, data []byte) []byte {
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	_, err := writer.Write(data)
+	assert.NoError(t, err)
+	assert.NoError(t, writer.Close())
+	return buf.Bytes()
+}
+
+func TestDecompressGzipBody(t *testing.T) {
+	r := gin.New()
+	r.Use(DecompressGzipBody(1024))
+	r.POST("/validate", func(c *gin.Context) {
+		var items []dto.ResourceValidateItem
+		if err := c.ShouldBindJSON(&items); err != nil {
+			ginx.BadRequestErrorJSONResponse(c, err)
+			return
+		}
+		ginx.SuccessJSONResponse(c, items)
+	})
+	request := func(body []byte, encoding string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/validate", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		if encoding != "" {
+			req.Header.Set("Content-Encoding", encoding)
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	bundle, err := json.Marshal([]dto.ResourceValidateItem{
+		{Type: constant.Route, Config: json.RawMessage(`{"uris":["/a"],"upstream_id":"u1"}`)},
+		{Type: constant.Upstream, Config: json.RawMessage(`{"nodes":{"127.0.0.1:80":1}}`)},
+	})
+	assert.NoError(t, err)
+
+	// gzip 压缩的合法资源包解压后正常绑定
+	w := request(gzipBytes(t, bundle), "GZIP")
+	assert.Equal(t, http.StatusOK, w.Code)
+	var resp struct {
+		Data []dto.ResourceValidateItem `json:"data"`
+	}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Len(t, resp.Data, 2)
+	assert.Equal(t, constant.Upstream, resp.Data[1].Type)
+
+	// 未压缩的请求体原样透传
+	assert.Equal(t, http.StatusOK, request(bundle, "").Code)
+
+	// 解压后超过上限的压缩炸弹被拒绝
+	w = request(gzipBytes(t, make([]byte, 1<<20)), "gzip")
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	var errResp ginx.ErrorResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &errResp))
+	assert.Equal(t, ginx.RequestEntityTooLarge, errResp.Error.Code)
+
+	// 非法的 gzip 数据
+	assert.Equal(t, http.StatusBadRequest, request(bundle, "gzip").Code)
+}
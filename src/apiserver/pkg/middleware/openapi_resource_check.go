@@ -144,11 +144,22 @@ func OpenAPIResourceCheck() gin.HandlerFunc {
 				c.Abort()
 				return
 			}
+			schema.SetAllowCustomVars(jsonConfigValidator, ginx.GetGatewayInfo(c).AllowCustomVars)
+			schema.SetRetriesCheck(jsonConfigValidator, ginx.GetGatewayInfo(c).RetriesCheck)
 			if err = jsonConfigValidator.Validate(json.RawMessage(configRaw)); err != nil { // 校验json schema
 				ginx.BadRequestErrorJSONResponse(c, fmt.Errorf("resource config:%s validate failed, err: %v",
 					configRaw, err))
 				c.Abort()
 			}
+			// 服务发现类型需在网关中启用
+			err = biz.ValidateUpstreamDiscoveryType(c.Request.Context(), ginx.GetGatewayInfo(c).ID,
+				resourceType, json.RawMessage(configRaw))
+			if err != nil {
+				ginx.BadRequestErrorJSONResponse(c, fmt.Errorf("resource config:%s validate failed, err: %v",
+					configRaw, err))
+				c.Abort()
+				return
+			}
 
 			// 校验关联数据是否存在
 			var resourceAssociateIDInfo serializer.ResourceAssociateID
@@ -0,0 +1,136 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package middleware
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jonboulle/clockwork"
+	"golang.org/x/time/rate"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+)
+
+// defaultRateLimitIdleTimeout 客户端令牌桶的默认空闲回收时间
+const defaultRateLimitIdleTimeout = 10 * time.Minute
+
+// RateLimitOptions RateLimit 的配置
+type RateLimitOptions struct {
+	// 每秒补充的令牌数
+	Rate float64
+	// 令牌桶容量，即允许的突发请求数，不大于 0 时取 Rate 向上取整(至少为 1)
+	Burst int
+	// 空闲超过该时长的客户端令牌桶被回收，不大于 0 时为 10 分钟
+	IdleTimeout time.Duration
+	// 限流维度，为空时已登录用户按用户限流，否则按客户端 IP 限流
+	KeyFunc func(c *gin.Context) string
+}
+
+// clientBucket 单个客户端的令牌桶
+type clientBucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// rateLimiter 按客户端维护令牌桶，空闲的令牌桶在后续请求时惰性回收，避免内存无限增长
+type rateLimiter struct {
+	options   RateLimitOptions
+	mu        sync.Mutex
+	buckets   map[string]*clientBucket
+	lastSweep time.Time
+	clock     clockwork.Clock
+}
+
+// RateLimit 按客户端限流(令牌桶)，超出限制时返回 429 并通过 Retry-After 提示客户端多久后重试
+func RateLimit(opts RateLimitOptions) gin.HandlerFunc {
+	return newRateLimiter(opts, clockwork.NewRealClock()).handle
+}
+
+func newRateLimiter(opts RateLimitOptions, clock clockwork.Clock) *rateLimiter {
+	if opts.Burst <= 0 {
+		opts.Burst = max(1, int(math.Ceil(opts.Rate)))
+	}
+	if opts.IdleTimeout <= 0 {
+		opts.IdleTimeout = defaultRateLimitIdleTimeout
+	}
+	if opts.KeyFunc == nil {
+		opts.KeyFunc = rateLimitKey
+	}
+	return &rateLimiter{
+		options:   opts,
+		buckets:   make(map[string]*clientBucket),
+		lastSweep: clock.Now(),
+		clock:     clock,
+	}
+}
+
+func (l *rateLimiter) handle(c *gin.Context) {
+	delay := l.reserve(l.options.KeyFunc(c))
+	if delay > 0 {
+		c.Header("Retry-After", strconv.Itoa(int(math.Ceil(delay.Seconds()))))
+		ginx.BaseErrorJSONResponse(c, ginx.TooManyRequests, "请求过于频繁，请稍后重试", http.StatusTooManyRequests)
+		c.Abort()
+		return
+	}
+	c.Next()
+}
+
+// reserve 从客户端的令牌桶中取一个令牌，令牌不足时不消耗令牌，返回需要等待的时间
+func (l *rateLimiter) reserve(key string) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.clock.Now()
+	if now.Sub(l.lastSweep) >= l.options.IdleTimeout {
+		for k, bucket := range l.buckets {
+			if now.Sub(bucket.lastSeen) >= l.options.IdleTimeout {
+				delete(l.buckets, k)
+			}
+		}
+		l.lastSweep = now
+	}
+	bucket, ok := l.buckets[key]
+	if !ok {
+		bucket = &clientBucket{limiter: rate.NewLimiter(rate.Limit(l.options.Rate), l.options.Burst)}
+		l.buckets[key] = bucket
+	}
+	bucket.lastSeen = now
+	reservation := bucket.limiter.ReserveN(now, 1)
+	if !reservation.OK() {
+		// 不补充令牌(Rate 为 0)时只能等待令牌桶空闲回收
+		return l.options.IdleTimeout
+	}
+	delay := reservation.DelayFrom(now)
+	if delay > 0 {
+		reservation.CancelAt(now)
+	}
+	return delay
+}
+
+// rateLimitKey 默认的限流维度：已登录用户按用户，否则按客户端 IP
+func rateLimitKey(c *gin.Context) string {
+	if userID := ginx.GetUserID(c); userID != "" {
+		return "user:" + userID
+	}
+	return "ip:" + c.ClientIP()
+}
@@ -0,0 +1,94 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+)
+
+func TestRateLimit(t *testing.T) {
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		if userID := c.GetHeader("X-User"); userID != "" {
+			ginx.SetUserID(c, userID)
+		}
+	})
+	r.Use(RateLimit(RateLimitOptions{Rate: 0.5, Burst: 2}))
+	r.GET("/ping", func(c *gin.Context) {
+		c.String(http.StatusOK, "pong")
+	})
+	request := func(ip, userID string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/ping", nil)
+		req.RemoteAddr = ip + ":1234"
+		if userID != "" {
+			req.Header.Set("X-User", userID)
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	assert.Equal(t, http.StatusOK, request("10.0.0.1", "").Code)
+	assert.Equal(t, http.StatusOK, request("10.0.0.1", "").Code)
+	w := request("10.0.0.1", "")
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "2", w.Header().Get("Retry-After"))
+	var resp ginx.ErrorResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, ginx.TooManyRequests, resp.Error.Code)
+
+	// 不同客户端 IP 互不影响
+	assert.Equal(t, http.StatusOK, request("10.0.0.2", "").Code)
+
+	// 登录用户按用户限流，与客户端 IP 无关
+	assert.Equal(t, http.StatusOK, request("10.0.0.3", "admin").Code)
+	assert.Equal(t, http.StatusOK, request("10.0.0.4", "admin").Code)
+	assert.Equal(t, http.StatusTooManyRequests, request("10.0.0.5", "admin").Code)
+}
+
+func TestRateLimiterReserve(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+	limiter := newRateLimiter(RateLimitOptions{Rate: 1, IdleTimeout: time.Minute}, clock)
+	assert.Equal(t, 1, limiter.options.Burst)
+
+	assert.Zero(t, limiter.reserve("a"))
+	assert.Equal(t, time.Second, limiter.reserve("a"))
+	// 被拒绝的请求不消耗令牌
+	clock.Advance(time.Second)
+	assert.Zero(t, limiter.reserve("a"))
+
+	// 空闲的令牌桶在后续请求时回收
+	clock.Advance(30 * time.Second)
+	assert.Zero(t, limiter.reserve("b"))
+	assert.Len(t, limiter.buckets, 2)
+	clock.Advance(40 * time.Second)
+	assert.Zero(t, limiter.reserve("b"))
+	assert.Len(t, limiter.buckets, 1)
+	assert.Contains(t, limiter.buckets, "b")
+}
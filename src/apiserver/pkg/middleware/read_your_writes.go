@@ -0,0 +1,70 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/spf13/cast"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+)
+
+// ReadYourWrites 读写一致性中间件：写请求在响应头中返回变更令牌，
+// 资源查询携带 min_token 时保证能读到该令牌对应的写入(副本延迟时回退主库)
+func ReadYourWrites() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		switch c.Request.Method {
+		case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+			// 令牌需在写入前生成，响应体写出后也无法再设置响应头
+			c.Header(constant.ChangeTokenHeaderKey, strconv.FormatInt(repo.NewChangeToken(), 10))
+			c.Next()
+			return
+		case http.MethodGet:
+		default:
+			c.Next()
+			return
+		}
+		minToken := cast.ToInt64(c.Query("min_token"))
+		if minToken <= 0 {
+			c.Next()
+			return
+		}
+		resourcePath, ok := strings.CutPrefix(c.FullPath(), "/api/v1/web/gateways/:gateway_id/")
+		if !ok {
+			c.Next()
+			return
+		}
+		resourceType, ok := constant.ResourcePrefixTypeMap[strings.Split(resourcePath, "/")[0]]
+		gatewayInfo := ginx.GetGatewayInfo(c)
+		if !ok || gatewayInfo == nil {
+			c.Next()
+			return
+		}
+		ctx := biz.ReadYourWrites(c.Request.Context(), resourceType, gatewayInfo.ID, minToken)
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
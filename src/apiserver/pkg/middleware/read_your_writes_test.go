@@ -0,0 +1,60 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/middleware"
+)
+
+func TestReadYourWrites(t *testing.T) {
+	t.Parallel()
+
+	r := gin.New()
+	r.Use(middleware.ReadYourWrites())
+	r.POST("/api/v1/web/gateways/:gateway_id/routes/", func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	})
+	r.GET("/api/v1/web/gateways/:gateway_id/routes/", func(c *gin.Context) {
+		c.String(http.StatusOK, "ok")
+	})
+
+	// 写请求返回变更令牌
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/v1/web/gateways/1/routes/", nil))
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	token, err := strconv.ParseInt(w.Header().Get(constant.ChangeTokenHeaderKey), 10, 64)
+	assert.NoError(t, err)
+	assert.Positive(t, token)
+
+	// 未配置只读副本时列表请求不受影响
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet,
+		"/api/v1/web/gateways/1/routes/?min_token="+strconv.FormatInt(token, 10), nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Header().Get(constant.ChangeTokenHeaderKey))
+}
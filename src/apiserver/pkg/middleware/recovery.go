@@ -20,27 +20,83 @@ package middleware
 
 import (
 	"fmt"
-	"log"
+	"log/slog"
 	"runtime"
 
 	"github.com/gin-gonic/gin"
 	"github.com/pkg/errors"
 
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/sentry"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
 )
 
-// Recovery 捕获 panic
-func Recovery() gin.HandlerFunc {
+// defaultStackBufferSize 记录 panic 堆栈的默认缓冲区大小
+const defaultStackBufferSize = 64 << 10
+
+// recoveryOptions Recovery 的可选配置
+type recoveryOptions struct {
+	// 记录堆栈的初始缓冲区大小
+	stackBufferSize int
+	// 堆栈超出缓冲区时扩容的上限，不大于 stackBufferSize 时不扩容
+	maxStackBufferSize int
+	// 是否记录所有 goroutine 的堆栈
+	allGoroutines bool
+}
+
+// RecoveryOption Recovery 的可选配置
+type RecoveryOption func(*recoveryOptions)
+
+// WithStackBufferSize 设置记录堆栈的初始缓冲区大小，默认 64KB
+func WithStackBufferSize(size int) RecoveryOption {
+	return func(o *recoveryOptions) {
+		if size > 0 {
+			o.stackBufferSize = size
+		}
+	}
+}
+
+// WithMaxStackBufferSize 设置堆栈缓冲区的扩容上限：堆栈填满缓冲区时成倍扩容后重新获取，直至获取完整堆栈或达到上限；
+// 默认不扩容，超出缓冲区的堆栈被截断
+func WithMaxStackBufferSize(size int) RecoveryOption {
+	return func(o *recoveryOptions) {
+		o.maxStackBufferSize = size
+	}
+}
+
+// WithAllGoroutines 记录所有 goroutine 的堆栈，用于排查与死锁相关的 panic
+func WithAllGoroutines() RecoveryOption {
+	return func(o *recoveryOptions) {
+		o.allGoroutines = true
+	}
+}
+
+// Recovery 捕获 panic，使用默认 logger 记录
+func Recovery(opts ...RecoveryOption) gin.HandlerFunc {
+	return RecoveryWithLogger(nil, opts...)
+}
+
+// RecoveryWithLogger 捕获 panic，以 error 级别的结构化日志记录堆栈及请求信息；logger 为空时使用默认 logger
+func RecoveryWithLogger(logger *slog.Logger, opts ...RecoveryOption) gin.HandlerFunc {
+	options := recoveryOptions{stackBufferSize: defaultStackBufferSize}
+	for _, opt := range opts {
+		opt(&options)
+	}
 	return func(c *gin.Context) {
 		defer func() {
 			if err := recover(); err != nil {
-				buf := make([]byte, 64<<10)
-				n := runtime.Stack(buf, false)
-				buf = buf[:n]
-				msg := fmt.Sprintf("panic err:%s", buf)
-				log.Println(msg)
-				sentry.ReportToSentry(msg, nil)
+				buf := captureStack(options)
+				extra := panicContext(c)
+				panicLogger := logger
+				if panicLogger == nil {
+					panicLogger = logging.GetLogger("default")
+				}
+				attrs := []any{slog.String("error", fmt.Sprint(err)), slog.String("stack", string(buf))}
+				for _, key := range panicContextKeys {
+					attrs = append(attrs, slog.Any(key, extra[key]))
+				}
+				panicLogger.ErrorContext(c.Request.Context(), "panic recovered", attrs...)
+				sentry.ReportToSentry(fmt.Sprintf("panic err:%s", buf), extra)
 				ginx.SystemErrorJSONResponse(c, errors.New("internal server error"))
 				c.Abort()
 			}
@@ -48,3 +104,33 @@ func Recovery() gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// captureStack 获取当前 goroutine(或所有 goroutine)的堆栈，
+// 堆栈填满缓冲区时成倍扩容后重试，直至获取完整堆栈或缓冲区达到上限
+func captureStack(options recoveryOptions) []byte {
+	size := options.stackBufferSize
+	for {
+		buf := make([]byte, size)
+		n := runtime.Stack(buf, options.allGoroutines)
+		if n < size || size >= options.maxStackBufferSize {
+			return buf[:n]
+		}
+		size = min(size*2, options.maxStackBufferSize)
+	}
+}
+
+// panicContextKeys panicContext 中的字段，按固定顺序写入日志
+var panicContextKeys = []string{"method", "path", "route", "client_ip", "request_id", "user_id"}
+
+// panicContext 上报 sentry 的请求上下文，仅包含定位问题所需的字段，
+// 不采集请求体、查询参数及认证相关的 header，避免泄露敏感信息
+func panicContext(c *gin.Context) map[string]interface{} {
+	return map[string]interface{}{
+		"method":     c.Request.Method,
+		"path":       c.Request.URL.Path,
+		"route":      c.FullPath(),
+		"client_ip":  c.ClientIP(),
+		"request_id": ginx.GetRequestID(c),
+		"user_id":    ginx.GetUserID(c),
+	}
+}
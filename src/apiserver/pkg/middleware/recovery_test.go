@@ -0,0 +1,119 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+func TestPanicContext(t *testing.T) {
+	var extra map[string]interface{}
+	r := gin.New()
+	r.Use(RequestID())
+	r.POST("/gateways/:gateway_id/routes", func(c *gin.Context) {
+		extra = panicContext(c)
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/gateways/1/routes?token=secret", nil)
+	req.Header.Set(constant.RequestIDHeaderKey, "rid")
+	req.Header.Set("Authorization", "Bearer secret")
+	req.RemoteAddr = "10.0.0.1:1234"
+	r.ServeHTTP(httptest.NewRecorder(), req)
+
+	assert.Equal(t, map[string]interface{}{
+		"method":     http.MethodPost,
+		"path":       "/gateways/1/routes",
+		"route":      "/gateways/:gateway_id/routes",
+		"client_ip":  "10.0.0.1",
+		"request_id": "rid",
+		"user_id":    "",
+	}, extra)
+}
+
+func TestRecoveryWithLogger(t *testing.T) {
+	var buf bytes.Buffer
+	r := gin.New()
+	r.Use(RecoveryWithLogger(slog.New(slog.NewJSONHandler(&buf, nil))))
+	r.Use(RequestID())
+	r.GET("/panic", func(c *gin.Context) {
+		panic("boom")
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/panic", nil)
+	req.Header.Set(constant.RequestIDHeaderKey, "rid")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+
+	var entry map[string]interface{}
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	assert.Equal(t, "ERROR", entry["level"])
+	assert.Equal(t, "boom", entry["error"])
+	assert.Equal(t, "/panic", entry["path"])
+	assert.Equal(t, "rid", entry["request_id"])
+	assert.Contains(t, entry["stack"], "TestRecoveryWithLogger")
+}
+
+// deepStack 递归 depth 层后执行 fn，用于构造较深的堆栈
+func deepStack(depth int, fn func()) {
+	if depth == 0 {
+		fn()
+		return
+	}
+	deepStack(depth-1, fn)
+}
+
+func TestCaptureStack(t *testing.T) {
+	var truncated, full []byte
+	deepStack(100, func() {
+		truncated = captureStack(recoveryOptions{stackBufferSize: 1 << 10})
+		full = captureStack(recoveryOptions{stackBufferSize: 1 << 10, maxStackBufferSize: 1 << 20})
+	})
+	// 默认不扩容，超出缓冲区的堆栈被截断，丢失最外层的调用
+	assert.Len(t, truncated, 1<<10)
+	assert.NotContains(t, string(truncated), "testing.tRunner")
+	assert.Greater(t, len(full), 1<<10)
+	assert.Contains(t, string(full), "testing.tRunner")
+
+	// 扩容不超过上限
+	capped := captureStack(recoveryOptions{stackBufferSize: 256, maxStackBufferSize: 300})
+	assert.Len(t, capped, 300)
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		<-done
+	}()
+	current := captureStack(recoveryOptions{stackBufferSize: defaultStackBufferSize})
+	all := captureStack(recoveryOptions{stackBufferSize: defaultStackBufferSize, allGoroutines: true})
+	// 不同 goroutine 的堆栈以空行分隔
+	assert.Zero(t, strings.Count(string(current), "\n\ngoroutine "))
+	assert.Positive(t, strings.Count(string(all), "\n\ngoroutine "))
+}
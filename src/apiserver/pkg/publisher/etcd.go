@@ -49,7 +49,7 @@ var _ PInterface = &EtcdPublisher{}
 
 // NewEtcdPublisher 创建 etcd publisher
 func NewEtcdPublisher(ctx context.Context, gatewayInfo *model.Gateway) (*EtcdPublisher, error) {
-	etcdStore, err := storage.NewEtcdStorage(gatewayInfo.EtcdConfig.EtcdConfig)
+	etcdStore, err := NewGatewayEtcdStorage(gatewayInfo)
 	if err != nil {
 		log.ErrorFWithContext(ctx, "init etcd failed: %s", err)
 		return nil, fmt.Errorf("init etcd failed: %s", err)
@@ -90,6 +90,8 @@ func (s *EtcdPublisher) Validate(resourceType constant.APISIXResource, config js
 	if err != nil {
 		return err
 	}
+	schema.SetAllowCustomVars(validator, s.gatewayInfo.AllowCustomVars)
+	schema.SetRetriesCheck(validator, s.gatewayInfo.RetriesCheck)
 	return validator.Validate(config)
 }
 
@@ -99,6 +101,9 @@ func (s *EtcdPublisher) Create(ctx context.Context, resource ResourceOperation)
 		return err
 	}
 
+	if resource.TTL > 0 {
+		return s.etcdStore.PutWithTTL(ctx, resource.GetKey(), string(resource.Config), resource.TTL)
+	}
 	if err := s.etcdStore.Create(ctx, resource.GetKey(), string(resource.Config)); err != nil {
 		return err
 	}
@@ -129,14 +134,27 @@ func (s *EtcdPublisher) Update(ctx context.Context, resource ResourceOperation,
 // BatchCreate 批量创建
 func (s *EtcdPublisher) BatchCreate(ctx context.Context, resources []ResourceOperation) error {
 	resourcesMap := make(map[string]string)
+	var leaseResources []ResourceOperation
 	for _, resource := range resources {
 		if err := s.Validate(resource.Type, resource.Config); err != nil {
 			return err
 		}
+		// 需要绑定 lease 的资源每个 key 单独授予 lease，不参与批量事务
+		if resource.TTL > 0 {
+			leaseResources = append(leaseResources, resource)
+			continue
+		}
 		resourcesMap[resource.GetKey()] = string(resource.Config)
 	}
-	if err := s.etcdStore.BatchCreate(ctx, resourcesMap); err != nil {
-		return err
+	if len(resourcesMap) > 0 {
+		if err := s.etcdStore.BatchCreate(ctx, resourcesMap); err != nil {
+			return err
+		}
+	}
+	for _, resource := range leaseResources {
+		if err := s.etcdStore.PutWithTTL(ctx, resource.GetKey(), string(resource.Config), resource.TTL); err != nil {
+			return err
+		}
 	}
 	return nil
 }
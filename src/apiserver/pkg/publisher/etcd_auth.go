@@ -0,0 +1,89 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package publisher
+
+import (
+	"context"
+	"errors"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	log "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/sentry"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/storage"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+)
+
+// NewGatewayEtcdStorage 创建网关的 etcd 存储
+// etcd 鉴权失效且重新认证失败时将网关的 etcd 状态标记为 auth_failed 并告警，重新认证成功后恢复为 normal
+func NewGatewayEtcdStorage(gatewayInfo *model.Gateway) (storage.StorageInterface, error) {
+	handler := gatewayEtcdAuthStatusHandler(gatewayInfo.ID, gatewayInfo.Name)
+	etcdStore, err := storage.NewEtcdStorage(gatewayInfo.EtcdConfig.EtcdConfig, storage.WithAuthStatusHandler(handler))
+	if err != nil {
+		if errors.Is(err, storage.AuthFailedError) {
+			handler(err)
+		}
+		return nil, err
+	}
+	// 建立连接时已经完成认证，说明 etcd 鉴权已恢复
+	if gatewayInfo.EtcdStatus == constant.GatewayEtcdStatusAuthFailed {
+		handler(nil)
+	}
+	return etcdStore, nil
+}
+
+func gatewayEtcdAuthStatusHandler(gatewayID int, gatewayName string) storage.AuthStatusHandler {
+	return func(authErr error) {
+		status := constant.GatewayEtcdStatusNormal
+		if authErr != nil {
+			status = constant.GatewayEtcdStatusAuthFailed
+		}
+		changed, err := updateGatewayEtcdStatus(context.Background(), gatewayID, status)
+		if err != nil {
+			log.Errorf("update gateway [%s] etcd status to %s failed: %s", gatewayName, status, err)
+			return
+		}
+		// 仅在状态发生变化时告警，避免每次请求失败都重复上报
+		if !changed {
+			return
+		}
+		if authErr == nil {
+			log.Infof("gateway [%s] etcd re-authenticated, etcd status recovered", gatewayName)
+			return
+		}
+		log.Errorf("gateway [%s] etcd auth failed: %s", gatewayName, authErr)
+		sentry.ReportToSentry("gateway etcd auth failed", map[string]interface{}{
+			"gateway_id":   gatewayID,
+			"gateway_name": gatewayName,
+			"error":        authErr.Error(),
+		})
+	}
+}
+
+// updateGatewayEtcdStatus 更新网关的 etcd 状态，返回状态是否发生了变化
+// etcd 状态由系统维护，不经过网关的更新钩子，也不记录审计
+func updateGatewayEtcdStatus(ctx context.Context, gatewayID int, status constant.GatewayEtcdStatus) (bool, error) {
+	u := repo.Gateway
+	info, err := u.WithContext(ctx).Where(u.ID.Eq(gatewayID), u.EtcdStatus.Neq(string(status))).
+		UpdateColumn(u.EtcdStatus, status)
+	if err != nil {
+		return false, err
+	}
+	return info.RowsAffected > 0, nil
+}
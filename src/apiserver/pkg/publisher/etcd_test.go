@@ -60,7 +60,7 @@ var _ = Describe("EtcdPublisher", func() {
 		It("Test NewEtcdPublisher: ok", func() {
 			patches := gomonkey.ApplyFunc(
 				storage.NewEtcdStorage,
-				func(base.EtcdConfig) (storage.StorageInterface, error) {
+				func(base.EtcdConfig, ...storage.Option) (storage.StorageInterface, error) {
 					return mockEtcdStore, nil
 				},
 			)
@@ -75,7 +75,7 @@ var _ = Describe("EtcdPublisher", func() {
 		It("Test NewEtcdPublisher: fail", func() {
 			patches := gomonkey.ApplyFunc(
 				storage.NewEtcdStorage,
-				func(base.EtcdConfig) (storage.StorageInterface, error) {
+				func(base.EtcdConfig, ...storage.Option) (storage.StorageInterface, error) {
 					return nil, errors.New("error")
 				},
 			)
@@ -418,6 +418,39 @@ var _ = Describe("EtcdPublisher", func() {
 				assert.Error(GinkgoT(), err)
 				assert.Equal(GinkgoT(), batchCreateError, err.Error())
 			})
+
+			It("Test BatchCreate: with lease", func() {
+				mockEtcdStore := mock.NewMockStorageInterface(ctrl)
+				mockEtcdStore.EXPECT().BatchCreate(gomock.Any(), map[string]string{"/key": "value"}).Return(nil)
+				mockEtcdStore.EXPECT().PutWithTTL(gomock.Any(), "/tmp", "value", int64(3600)).Return(nil)
+
+				p := &EtcdPublisher{
+					etcdStore: mockEtcdStore,
+				}
+
+				patches = gomonkey.ApplyMethod(
+					reflect.TypeOf(p),
+					"Validate",
+					func(_ *EtcdPublisher, resourceType constant.APISIXResource, config json.RawMessage) error {
+						return nil
+					},
+				)
+
+				resources := []ResourceOperation{
+					{
+						Key:    "key",
+						Config: json.RawMessage("value"),
+					},
+					{
+						Key:    "tmp",
+						Config: json.RawMessage("value"),
+						TTL:    3600,
+					},
+				}
+
+				err := p.BatchCreate(context.Background(), resources)
+				assert.NoError(GinkgoT(), err)
+			})
 		})
 
 		Describe("BatchUpdate", func() {
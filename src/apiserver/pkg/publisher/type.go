@@ -22,8 +22,10 @@ package publisher
 import (
 	"context"
 	"encoding/json"
+	"strings"
 
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
 )
 
 // ResourceOperation ...
@@ -31,13 +33,38 @@ type ResourceOperation struct {
 	Key    string
 	Config json.RawMessage
 	Type   constant.APISIXResource
+	// TTL etcd lease 时长(秒)，大于 0 时写入的 key 会绑定 lease，到期后由 etcd 自动删除
+	TTL int64
+	// KeyOverride 自定义 etcd key(相对网关前缀)，为空时使用 {资源类型}/{Key}
+	KeyOverride string
 }
 
 // GetKey 获取key
 func (r *ResourceOperation) GetKey() string {
+	if r.KeyOverride != "" {
+		return r.KeyOverride
+	}
 	return constant.ResourceTypePrefixMap[r.Type] + "/" + r.Key
 }
 
+// ParseResourceKey 解析 etcd key(相对网关前缀)对应的资源类型及去掉资源类型目录后的 key，与 GetKey 对应；
+// consumer 目录下 credentials 子目录中的 key 为凭证，不在资源类型目录下的 key 返回 false
+func ParseResourceKey(key string) (constant.APISIXResource, string, bool) {
+	typeDir, resourceKey, found := strings.Cut(key, "/")
+	if !found || resourceKey == "" {
+		return "", "", false
+	}
+	resourceType, ok := constant.ResourcePrefixTypeMap[typeDir]
+	if !ok {
+		return "", "", false
+	}
+	// 凭证位于所属 consumer 的子目录: consumers/{consumer}/credentials/{id}
+	if resourceType == constant.Consumer && model.ConsumerIDFromCredentialEtcdKey(key) != "" {
+		resourceType = constant.Credential
+	}
+	return resourceType, resourceKey, true
+}
+
 // PInterface ...
 type PInterface interface {
 	Get(ctx context.Context, key string) (any, error)
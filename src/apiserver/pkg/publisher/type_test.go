@@ -46,4 +46,32 @@ var _ = Describe("ResourceOperation", func() {
 			assert.Equal(GinkgoT(), expectedKey, resource.GetKey())
 		})
 	})
+
+	Context("ParseResourceKey", func() {
+		DescribeTable("should map the etcd key to the resource type and key",
+			func(key string, expectedType constant.APISIXResource, expectedKey string, expectedOK bool) {
+				resourceType, resourceKey, ok := ParseResourceKey(key)
+				assert.Equal(GinkgoT(), expectedOK, ok)
+				assert.Equal(GinkgoT(), expectedType, resourceType)
+				assert.Equal(GinkgoT(), expectedKey, resourceKey)
+			},
+			Entry("route", "routes/r1", constant.Route, "r1", true),
+			Entry("key override", "routes/team/r1", constant.Route, "team/r1", true),
+			Entry("secret", "secrets/vault/s1", constant.Secret, "vault/s1", true),
+			Entry("consumer with key override", "consumers/team/c1", constant.Consumer, "team/c1", true),
+			Entry("credential", "consumers/c1/credentials/cred1", constant.Credential, "c1/credentials/cred1", true),
+			Entry("credential of consumer with key override", "consumers/team/c1/credentials/cred1",
+				constant.Credential, "team/c1/credentials/cred1", true),
+			Entry("unknown resource type", "unknown/r1", constant.APISIXResource(""), "", false),
+			Entry("resource type dir", "routes/", constant.APISIXResource(""), "", false),
+		)
+
+		It("should be the inverse of GetKey", func() {
+			resource := ResourceOperation{Key: "c1", KeyOverride: "consumers/team/c1", Type: constant.Consumer}
+			resourceType, resourceKey, ok := ParseResourceKey(resource.GetKey())
+			assert.True(GinkgoT(), ok)
+			assert.Equal(GinkgoT(), constant.Consumer, resourceType)
+			assert.Equal(GinkgoT(), "team/c1", resourceKey)
+		})
+	})
 })
@@ -0,0 +1,122 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package repo
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/plugin/dbresolver"
+)
+
+const (
+	// 副本未追上变更令牌时的最长等待时间，超时后回退到主库读取
+	readYourWritesWait = 200 * time.Millisecond
+	// 等待期间检查副本同步进度的间隔
+	readYourWritesInterval = 50 * time.Millisecond
+)
+
+// ReadConsistency 只读副本同步进度检查
+type ReadConsistency interface {
+	// CaughtUp 副本上 table 中该网关的数据是否已同步到 token 对应的写入
+	CaughtUp(ctx context.Context, table string, gatewayID int, token int64) (bool, error)
+}
+
+// 未配置只读副本时为 nil，读写一致性检查直接跳过
+var readConsistency ReadConsistency
+
+type usePrimaryCtxKey struct{}
+
+// NewChangeToken 生成变更令牌(unix 毫秒)，在写入前生成，保证不大于本次写入的 updated_at
+func NewChangeToken() int64 {
+	return time.Now().UnixMilli()
+}
+
+// EnableReadReplicas 为 db 注册只读副本：查询默认走副本，写入走主库，并启用读写一致性检查
+func EnableReadReplicas(db *gorm.DB, replicas ...gorm.Dialector) error {
+	if len(replicas) == 0 {
+		return nil
+	}
+	if err := db.Use(dbresolver.Register(dbresolver.Config{Replicas: replicas})); err != nil {
+		return err
+	}
+	// ctx 标记了回退主库时，查询强制走主库
+	usePrimary := func(tx *gorm.DB) {
+		if UsePrimary(tx.Statement.Context) {
+			dbresolver.Write.ModifyStatement(tx.Statement)
+		}
+	}
+	if err := db.Callback().Query().Before("gorm:query").Register("bk:use_primary", usePrimary); err != nil {
+		return err
+	}
+	if err := db.Callback().Row().Before("gorm:row").Register("bk:use_primary", usePrimary); err != nil {
+		return err
+	}
+	readConsistency = &replicaWatermark{db: db}
+	return nil
+}
+
+// UsePrimary ctx 中的查询是否需要回退到主库
+func UsePrimary(ctx context.Context) bool {
+	usePrimary, _ := ctx.Value(usePrimaryCtxKey{}).(bool)
+	return usePrimary
+}
+
+// ReadYourWrites 保证 ctx 中的查询能读到 token 对应的写入：
+// 副本已同步时直接读副本，否则短暂等待，仍未同步则回退到主库
+func ReadYourWrites(ctx context.Context, table string, gatewayID int, token int64) context.Context {
+	if readConsistency == nil || token <= 0 {
+		return ctx
+	}
+	deadline := time.Now().Add(readYourWritesWait)
+	for {
+		caughtUp, err := readConsistency.CaughtUp(ctx, table, gatewayID, token)
+		if err == nil && caughtUp {
+			return ctx
+		}
+		if err != nil || time.Now().After(deadline) {
+			return context.WithValue(ctx, usePrimaryCtxKey{}, true)
+		}
+		select {
+		case <-ctx.Done():
+			return context.WithValue(ctx, usePrimaryCtxKey{}, true)
+		case <-time.After(readYourWritesInterval):
+		}
+	}
+}
+
+// replicaWatermark 以副本上资源表最新的 updated_at 作为同步水位
+type replicaWatermark struct {
+	db *gorm.DB
+}
+
+// CaughtUp ...
+func (w *replicaWatermark) CaughtUp(ctx context.Context, table string, gatewayID int, token int64) (bool, error) {
+	var updatedAts []time.Time
+	err := w.db.WithContext(ctx).Table(table).
+		Where("gateway_id = ?", gatewayID).
+		Order("updated_at DESC").
+		Limit(1).
+		Pluck("updated_at", &updatedAts).Error
+	if err != nil {
+		return false, err
+	}
+	return len(updatedAts) > 0 && updatedAts[0].UnixMilli() >= token, nil
+}
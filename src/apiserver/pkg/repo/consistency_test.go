@@ -0,0 +1,90 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package repo
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+)
+
+// 主库与副本为两个独立的 sqlite 连接，副本不会自动同步，用于模拟复制延迟
+func newPrimaryAndReplica(t *testing.T) (*gorm.DB, *gorm.DB) {
+	dir := t.TempDir()
+	primary, err := gorm.Open(sqlite.Open(filepath.Join(dir, "primary.db")), &gorm.Config{})
+	assert.NoError(t, err)
+	replica, err := gorm.Open(sqlite.Open(filepath.Join(dir, "replica.db")), &gorm.Config{})
+	assert.NoError(t, err)
+	assert.NoError(t, primary.AutoMigrate(&model.Route{}))
+	assert.NoError(t, replica.AutoMigrate(&model.Route{}))
+
+	assert.NoError(t, EnableReadReplicas(primary, sqlite.Open(filepath.Join(dir, "replica.db"))))
+	t.Cleanup(func() { readConsistency = nil })
+	return primary, replica
+}
+
+func countRoutes(ctx context.Context, db *gorm.DB, gatewayID int) int64 {
+	count, _ := Use(db).Route.WithContext(ctx).Where(Use(db).Route.GatewayID.Eq(gatewayID)).Count()
+	return count
+}
+
+func TestReadYourWrites(t *testing.T) {
+	primary, replica := newPrimaryAndReplica(t)
+	ctx := context.Background()
+	gatewayID := 1
+
+	token := NewChangeToken()
+	route := &model.Route{
+		Name: "route1",
+		ResourceCommonModel: model.ResourceCommonModel{
+			ID:        "route-1",
+			GatewayID: gatewayID,
+		},
+	}
+	assert.NoError(t, primary.Session(&gorm.Session{SkipHooks: true}).Create(route).Error)
+
+	// 副本尚未同步，默认读副本读不到
+	assert.Equal(t, int64(0), countRoutes(ctx, primary, gatewayID))
+
+	// 携带令牌时副本未追上，回退到主库
+	rywCtx := ReadYourWrites(ctx, route.TableName(), gatewayID, token)
+	assert.True(t, UsePrimary(rywCtx))
+	assert.Equal(t, int64(1), countRoutes(rywCtx, primary, gatewayID))
+
+	// 副本同步后直接读副本
+	assert.NoError(t, replica.Session(&gorm.Session{SkipHooks: true}).Create(route).Error)
+	rywCtx = ReadYourWrites(ctx, route.TableName(), gatewayID, token)
+	assert.False(t, UsePrimary(rywCtx))
+	assert.Equal(t, int64(1), countRoutes(rywCtx, primary, gatewayID))
+
+	// 不携带令牌时不做处理
+	assert.False(t, UsePrimary(ReadYourWrites(ctx, route.TableName(), gatewayID, 0)))
+}
+
+func TestReadYourWritesWithoutReplicas(t *testing.T) {
+	assert.NoError(t, EnableReadReplicas(nil))
+	ctx := ReadYourWrites(context.Background(), model.Route{}.TableName(), 1, NewChangeToken())
+	assert.False(t, UsePrimary(ctx))
+}
@@ -56,6 +56,7 @@ func newConsumer(db *gorm.DB, opts ...gen.DOOption) consumer {
 	_consumer.GatewayID = field.NewInt(tableName, "gateway_id")
 	_consumer.Config = field.NewField(tableName, "config")
 	_consumer.Status = field.NewString(tableName, "status")
+	_consumer.EtcdKeyOverride = field.NewString(tableName, "etcd_key_override")
 
 	_consumer.fillFieldMap()
 
@@ -65,18 +66,19 @@ func newConsumer(db *gorm.DB, opts ...gen.DOOption) consumer {
 type consumer struct {
 	consumerDo consumerDo
 
-	ALL       field.Asterisk
-	Username  field.String
-	GroupID   field.String
-	Creator   field.String
-	Updater   field.String
-	CreatedAt field.Time
-	UpdatedAt field.Time
-	AutoID    field.Int
-	ID        field.String
-	GatewayID field.Int
-	Config    field.Field
-	Status    field.String
+	ALL             field.Asterisk
+	Username        field.String
+	GroupID         field.String
+	Creator         field.String
+	Updater         field.String
+	CreatedAt       field.Time
+	UpdatedAt       field.Time
+	AutoID          field.Int
+	ID              field.String
+	GatewayID       field.Int
+	Config          field.Field
+	Status          field.String
+	EtcdKeyOverride field.String
 
 	fieldMap map[string]field.Expr
 }
@@ -106,6 +108,7 @@ func (c *consumer) updateTableName(table string) *consumer {
 	c.GatewayID = field.NewInt(table, "gateway_id")
 	c.Config = field.NewField(table, "config")
 	c.Status = field.NewString(table, "status")
+	c.EtcdKeyOverride = field.NewString(table, "etcd_key_override")
 
 	c.fillFieldMap()
 
@@ -135,7 +138,7 @@ func (c *consumer) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (c *consumer) fillFieldMap() {
-	c.fieldMap = make(map[string]field.Expr, 11)
+	c.fieldMap = make(map[string]field.Expr, 12)
 	c.fieldMap["username"] = c.Username
 	c.fieldMap["group_id"] = c.GroupID
 	c.fieldMap["creator"] = c.Creator
@@ -147,6 +150,7 @@ func (c *consumer) fillFieldMap() {
 	c.fieldMap["gateway_id"] = c.GatewayID
 	c.fieldMap["config"] = c.Config
 	c.fieldMap["status"] = c.Status
+	c.fieldMap["etcd_key_override"] = c.EtcdKeyOverride
 }
 
 func (c consumer) clone(db *gorm.DB) consumer {
@@ -55,6 +55,7 @@ func newConsumerGroup(db *gorm.DB, opts ...gen.DOOption) consumerGroup {
 	_consumerGroup.GatewayID = field.NewInt(tableName, "gateway_id")
 	_consumerGroup.Config = field.NewField(tableName, "config")
 	_consumerGroup.Status = field.NewString(tableName, "status")
+	_consumerGroup.EtcdKeyOverride = field.NewString(tableName, "etcd_key_override")
 
 	_consumerGroup.fillFieldMap()
 
@@ -64,17 +65,18 @@ func newConsumerGroup(db *gorm.DB, opts ...gen.DOOption) consumerGroup {
 type consumerGroup struct {
 	consumerGroupDo consumerGroupDo
 
-	ALL       field.Asterisk
-	Name      field.String
-	Creator   field.String
-	Updater   field.String
-	CreatedAt field.Time
-	UpdatedAt field.Time
-	AutoID    field.Int
-	ID        field.String
-	GatewayID field.Int
-	Config    field.Field
-	Status    field.String
+	ALL             field.Asterisk
+	Name            field.String
+	Creator         field.String
+	Updater         field.String
+	CreatedAt       field.Time
+	UpdatedAt       field.Time
+	AutoID          field.Int
+	ID              field.String
+	GatewayID       field.Int
+	Config          field.Field
+	Status          field.String
+	EtcdKeyOverride field.String
 
 	fieldMap map[string]field.Expr
 }
@@ -103,6 +105,7 @@ func (c *consumerGroup) updateTableName(table string) *consumerGroup {
 	c.GatewayID = field.NewInt(table, "gateway_id")
 	c.Config = field.NewField(table, "config")
 	c.Status = field.NewString(table, "status")
+	c.EtcdKeyOverride = field.NewString(table, "etcd_key_override")
 
 	c.fillFieldMap()
 
@@ -136,7 +139,7 @@ func (c *consumerGroup) GetFieldByName(fieldName string) (field.OrderExpr, bool)
 }
 
 func (c *consumerGroup) fillFieldMap() {
-	c.fieldMap = make(map[string]field.Expr, 10)
+	c.fieldMap = make(map[string]field.Expr, 11)
 	c.fieldMap["name"] = c.Name
 	c.fieldMap["creator"] = c.Creator
 	c.fieldMap["updater"] = c.Updater
@@ -147,6 +150,7 @@ func (c *consumerGroup) fillFieldMap() {
 	c.fieldMap["gateway_id"] = c.GatewayID
 	c.fieldMap["config"] = c.Config
 	c.fieldMap["status"] = c.Status
+	c.fieldMap["etcd_key_override"] = c.EtcdKeyOverride
 }
 
 func (c consumerGroup) clone(db *gorm.DB) consumerGroup {
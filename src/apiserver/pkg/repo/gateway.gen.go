@@ -56,6 +56,10 @@ func newGateway(db *gorm.DB, opts ...gen.DOOption) gateway {
 	_gateway.Token = field.NewString(tableName, "token")
 	_gateway.ReadOnly = field.NewBool(tableName, "read_only")
 	_gateway.LastSyncedAt = field.NewTime(tableName, "last_synced_at")
+	_gateway.AllowCustomVars = field.NewBool(tableName, "allow_custom_vars")
+	_gateway.RetriesCheck = field.NewString(tableName, "retries_check")
+	_gateway.Status = field.NewString(tableName, "status")
+	_gateway.EtcdStatus = field.NewString(tableName, "etcd_status")
 	_gateway.Creator = field.NewString(tableName, "creator")
 	_gateway.Updater = field.NewString(tableName, "updater")
 	_gateway.CreatedAt = field.NewTime(tableName, "created_at")
@@ -69,22 +73,26 @@ func newGateway(db *gorm.DB, opts ...gen.DOOption) gateway {
 type gateway struct {
 	gatewayDo gatewayDo
 
-	ALL           field.Asterisk
-	ID            field.Int
-	Name          field.String
-	Mode          field.Uint8
-	Maintainers   field.Field
-	Desc          field.String
-	APISIXType    field.String
-	APISIXVersion field.String
-	EtcdConfig    field.Field
-	Token         field.String
-	ReadOnly      field.Bool
-	LastSyncedAt  field.Time
-	Creator       field.String
-	Updater       field.String
-	CreatedAt     field.Time
-	UpdatedAt     field.Time
+	ALL             field.Asterisk
+	ID              field.Int
+	Name            field.String
+	Mode            field.Uint8
+	Maintainers     field.Field
+	Desc            field.String
+	APISIXType      field.String
+	APISIXVersion   field.String
+	EtcdConfig      field.Field
+	Token           field.String
+	ReadOnly        field.Bool
+	LastSyncedAt    field.Time
+	AllowCustomVars field.Bool
+	RetriesCheck    field.String
+	Status          field.String
+	EtcdStatus      field.String
+	Creator         field.String
+	Updater         field.String
+	CreatedAt       field.Time
+	UpdatedAt       field.Time
 
 	fieldMap map[string]field.Expr
 }
@@ -114,6 +122,10 @@ func (g *gateway) updateTableName(table string) *gateway {
 	g.Token = field.NewString(table, "token")
 	g.ReadOnly = field.NewBool(table, "read_only")
 	g.LastSyncedAt = field.NewTime(table, "last_synced_at")
+	g.AllowCustomVars = field.NewBool(table, "allow_custom_vars")
+	g.RetriesCheck = field.NewString(table, "retries_check")
+	g.Status = field.NewString(table, "status")
+	g.EtcdStatus = field.NewString(table, "etcd_status")
 	g.Creator = field.NewString(table, "creator")
 	g.Updater = field.NewString(table, "updater")
 	g.CreatedAt = field.NewTime(table, "created_at")
@@ -147,7 +159,7 @@ func (g *gateway) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (g *gateway) fillFieldMap() {
-	g.fieldMap = make(map[string]field.Expr, 15)
+	g.fieldMap = make(map[string]field.Expr, 19)
 	g.fieldMap["id"] = g.ID
 	g.fieldMap["name"] = g.Name
 	g.fieldMap["mode"] = g.Mode
@@ -159,6 +171,10 @@ func (g *gateway) fillFieldMap() {
 	g.fieldMap["token"] = g.Token
 	g.fieldMap["read_only"] = g.ReadOnly
 	g.fieldMap["last_synced_at"] = g.LastSyncedAt
+	g.fieldMap["allow_custom_vars"] = g.AllowCustomVars
+	g.fieldMap["retries_check"] = g.RetriesCheck
+	g.fieldMap["status"] = g.Status
+	g.fieldMap["etcd_status"] = g.EtcdStatus
 	g.fieldMap["creator"] = g.Creator
 	g.fieldMap["updater"] = g.Updater
 	g.fieldMap["created_at"] = g.CreatedAt
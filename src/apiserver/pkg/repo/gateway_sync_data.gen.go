@@ -53,6 +53,7 @@ func newGatewaySyncData(db *gorm.DB, opts ...gen.DOOption) gatewaySyncData {
 	_gatewaySyncData.ModRevision = field.NewInt(tableName, "mod_revision")
 	_gatewaySyncData.CreatedAt = field.NewTime(tableName, "created_at")
 	_gatewaySyncData.UpdatedAt = field.NewTime(tableName, "updated_at")
+	_gatewaySyncData.EtcdKeyOverride = field.NewString(tableName, "etcd_key_override")
 
 	_gatewaySyncData.fillFieldMap()
 
@@ -62,15 +63,16 @@ func newGatewaySyncData(db *gorm.DB, opts ...gen.DOOption) gatewaySyncData {
 type gatewaySyncData struct {
 	gatewaySyncDataDo gatewaySyncDataDo
 
-	ALL         field.Asterisk
-	AutoID      field.Int
-	ID          field.String
-	GatewayID   field.Int
-	Type        field.String
-	Config      field.Field
-	ModRevision field.Int
-	CreatedAt   field.Time
-	UpdatedAt   field.Time
+	ALL             field.Asterisk
+	AutoID          field.Int
+	ID              field.String
+	GatewayID       field.Int
+	Type            field.String
+	Config          field.Field
+	ModRevision     field.Int
+	CreatedAt       field.Time
+	UpdatedAt       field.Time
+	EtcdKeyOverride field.String
 
 	fieldMap map[string]field.Expr
 }
@@ -97,6 +99,7 @@ func (g *gatewaySyncData) updateTableName(table string) *gatewaySyncData {
 	g.ModRevision = field.NewInt(table, "mod_revision")
 	g.CreatedAt = field.NewTime(table, "created_at")
 	g.UpdatedAt = field.NewTime(table, "updated_at")
+	g.EtcdKeyOverride = field.NewString(table, "etcd_key_override")
 
 	g.fillFieldMap()
 
@@ -130,7 +133,7 @@ func (g *gatewaySyncData) GetFieldByName(fieldName string) (field.OrderExpr, boo
 }
 
 func (g *gatewaySyncData) fillFieldMap() {
-	g.fieldMap = make(map[string]field.Expr, 8)
+	g.fieldMap = make(map[string]field.Expr, 9)
 	g.fieldMap["auto_id"] = g.AutoID
 	g.fieldMap["id"] = g.ID
 	g.fieldMap["gateway_id"] = g.GatewayID
@@ -139,6 +142,7 @@ func (g *gatewaySyncData) fillFieldMap() {
 	g.fieldMap["mod_revision"] = g.ModRevision
 	g.fieldMap["created_at"] = g.CreatedAt
 	g.fieldMap["updated_at"] = g.UpdatedAt
+	g.fieldMap["etcd_key_override"] = g.EtcdKeyOverride
 }
 
 func (g gatewaySyncData) clone(db *gorm.DB) gatewaySyncData {
@@ -36,10 +36,18 @@ import (
 // Q ...
 var (
 	Q                                = new(Query)
+	BlobObject                       *blobObject
+	ChangeSet                        *changeSet
+	ChangeSetResource                *changeSetResource
+	ComplianceReport                 *complianceReport
 	Consumer                         *consumer
 	ConsumerGroup                    *consumerGroup
+	Credential                       *credential
+	EtcdWriteAudit                   *etcdWriteAudit
 	Gateway                          *gateway
 	GatewayCustomPluginSchema        *gatewayCustomPluginSchema
+	GatewayDiscovery                 *gatewayDiscovery
+	GatewayOnboarding                *gatewayOnboarding
 	GatewayReleaseVersion            *gatewayReleaseVersion
 	GatewayResourceSchemaAssociation *gatewayResourceSchemaAssociation
 	GatewaySyncData                  *gatewaySyncData
@@ -48,21 +56,34 @@ var (
 	PluginConfig                     *pluginConfig
 	PluginMetadata                   *pluginMetadata
 	Proto                            *proto
+	PublishTask                      *publishTask
+	ResourceRevision                 *resourceRevision
 	Route                            *route
 	SSL                              *sSL
+	Secret                           *secret
 	Service                          *service
 	StreamRoute                      *streamRoute
 	SystemConfig                     *systemConfig
 	Upstream                         *upstream
+	ValidationCache                  *validationCache
+	ValidationRuleset                *validationRuleset
 )
 
 // SetDefault ...
 func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 	*Q = *Use(db, opts...)
+	BlobObject = &Q.BlobObject
+	ChangeSet = &Q.ChangeSet
+	ChangeSetResource = &Q.ChangeSetResource
+	ComplianceReport = &Q.ComplianceReport
 	Consumer = &Q.Consumer
 	ConsumerGroup = &Q.ConsumerGroup
+	Credential = &Q.Credential
+	EtcdWriteAudit = &Q.EtcdWriteAudit
 	Gateway = &Q.Gateway
 	GatewayCustomPluginSchema = &Q.GatewayCustomPluginSchema
+	GatewayDiscovery = &Q.GatewayDiscovery
+	GatewayOnboarding = &Q.GatewayOnboarding
 	GatewayReleaseVersion = &Q.GatewayReleaseVersion
 	GatewayResourceSchemaAssociation = &Q.GatewayResourceSchemaAssociation
 	GatewaySyncData = &Q.GatewaySyncData
@@ -71,22 +92,35 @@ func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 	PluginConfig = &Q.PluginConfig
 	PluginMetadata = &Q.PluginMetadata
 	Proto = &Q.Proto
+	PublishTask = &Q.PublishTask
+	ResourceRevision = &Q.ResourceRevision
 	Route = &Q.Route
 	SSL = &Q.SSL
+	Secret = &Q.Secret
 	Service = &Q.Service
 	StreamRoute = &Q.StreamRoute
 	SystemConfig = &Q.SystemConfig
 	Upstream = &Q.Upstream
+	ValidationCache = &Q.ValidationCache
+	ValidationRuleset = &Q.ValidationRuleset
 }
 
 // Use ...
 func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 	return &Query{
 		db:                               db,
+		BlobObject:                       newBlobObject(db, opts...),
+		ChangeSet:                        newChangeSet(db, opts...),
+		ChangeSetResource:                newChangeSetResource(db, opts...),
+		ComplianceReport:                 newComplianceReport(db, opts...),
 		Consumer:                         newConsumer(db, opts...),
 		ConsumerGroup:                    newConsumerGroup(db, opts...),
+		Credential:                       newCredential(db, opts...),
+		EtcdWriteAudit:                   newEtcdWriteAudit(db, opts...),
 		Gateway:                          newGateway(db, opts...),
 		GatewayCustomPluginSchema:        newGatewayCustomPluginSchema(db, opts...),
+		GatewayDiscovery:                 newGatewayDiscovery(db, opts...),
+		GatewayOnboarding:                newGatewayOnboarding(db, opts...),
 		GatewayReleaseVersion:            newGatewayReleaseVersion(db, opts...),
 		GatewayResourceSchemaAssociation: newGatewayResourceSchemaAssociation(db, opts...),
 		GatewaySyncData:                  newGatewaySyncData(db, opts...),
@@ -95,12 +129,17 @@ func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 		PluginConfig:                     newPluginConfig(db, opts...),
 		PluginMetadata:                   newPluginMetadata(db, opts...),
 		Proto:                            newProto(db, opts...),
+		PublishTask:                      newPublishTask(db, opts...),
+		ResourceRevision:                 newResourceRevision(db, opts...),
 		Route:                            newRoute(db, opts...),
 		SSL:                              newSSL(db, opts...),
+		Secret:                           newSecret(db, opts...),
 		Service:                          newService(db, opts...),
 		StreamRoute:                      newStreamRoute(db, opts...),
 		SystemConfig:                     newSystemConfig(db, opts...),
 		Upstream:                         newUpstream(db, opts...),
+		ValidationCache:                  newValidationCache(db, opts...),
+		ValidationRuleset:                newValidationRuleset(db, opts...),
 	}
 }
 
@@ -108,10 +147,18 @@ func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 type Query struct {
 	db *gorm.DB
 
+	BlobObject                       blobObject
+	ChangeSet                        changeSet
+	ChangeSetResource                changeSetResource
+	ComplianceReport                 complianceReport
 	Consumer                         consumer
 	ConsumerGroup                    consumerGroup
+	Credential                       credential
+	EtcdWriteAudit                   etcdWriteAudit
 	Gateway                          gateway
 	GatewayCustomPluginSchema        gatewayCustomPluginSchema
+	GatewayDiscovery                 gatewayDiscovery
+	GatewayOnboarding                gatewayOnboarding
 	GatewayReleaseVersion            gatewayReleaseVersion
 	GatewayResourceSchemaAssociation gatewayResourceSchemaAssociation
 	GatewaySyncData                  gatewaySyncData
@@ -120,12 +167,17 @@ type Query struct {
 	PluginConfig                     pluginConfig
 	PluginMetadata                   pluginMetadata
 	Proto                            proto
+	PublishTask                      publishTask
+	ResourceRevision                 resourceRevision
 	Route                            route
 	SSL                              sSL
+	Secret                           secret
 	Service                          service
 	StreamRoute                      streamRoute
 	SystemConfig                     systemConfig
 	Upstream                         upstream
+	ValidationCache                  validationCache
+	ValidationRuleset                validationRuleset
 }
 
 // Available ...
@@ -134,10 +186,18 @@ func (q *Query) Available() bool { return q.db != nil }
 func (q *Query) clone(db *gorm.DB) *Query {
 	return &Query{
 		db:                               db,
+		BlobObject:                       q.BlobObject.clone(db),
+		ChangeSet:                        q.ChangeSet.clone(db),
+		ChangeSetResource:                q.ChangeSetResource.clone(db),
+		ComplianceReport:                 q.ComplianceReport.clone(db),
 		Consumer:                         q.Consumer.clone(db),
 		ConsumerGroup:                    q.ConsumerGroup.clone(db),
+		Credential:                       q.Credential.clone(db),
+		EtcdWriteAudit:                   q.EtcdWriteAudit.clone(db),
 		Gateway:                          q.Gateway.clone(db),
 		GatewayCustomPluginSchema:        q.GatewayCustomPluginSchema.clone(db),
+		GatewayDiscovery:                 q.GatewayDiscovery.clone(db),
+		GatewayOnboarding:                q.GatewayOnboarding.clone(db),
 		GatewayReleaseVersion:            q.GatewayReleaseVersion.clone(db),
 		GatewayResourceSchemaAssociation: q.GatewayResourceSchemaAssociation.clone(db),
 		GatewaySyncData:                  q.GatewaySyncData.clone(db),
@@ -146,12 +206,17 @@ func (q *Query) clone(db *gorm.DB) *Query {
 		PluginConfig:                     q.PluginConfig.clone(db),
 		PluginMetadata:                   q.PluginMetadata.clone(db),
 		Proto:                            q.Proto.clone(db),
+		PublishTask:                      q.PublishTask.clone(db),
+		ResourceRevision:                 q.ResourceRevision.clone(db),
 		Route:                            q.Route.clone(db),
 		SSL:                              q.SSL.clone(db),
+		Secret:                           q.Secret.clone(db),
 		Service:                          q.Service.clone(db),
 		StreamRoute:                      q.StreamRoute.clone(db),
 		SystemConfig:                     q.SystemConfig.clone(db),
 		Upstream:                         q.Upstream.clone(db),
+		ValidationCache:                  q.ValidationCache.clone(db),
+		ValidationRuleset:                q.ValidationRuleset.clone(db),
 	}
 }
 
@@ -169,10 +234,18 @@ func (q *Query) WriteDB() *Query {
 func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 	return &Query{
 		db:                               db,
+		BlobObject:                       q.BlobObject.replaceDB(db),
+		ChangeSet:                        q.ChangeSet.replaceDB(db),
+		ChangeSetResource:                q.ChangeSetResource.replaceDB(db),
+		ComplianceReport:                 q.ComplianceReport.replaceDB(db),
 		Consumer:                         q.Consumer.replaceDB(db),
 		ConsumerGroup:                    q.ConsumerGroup.replaceDB(db),
+		Credential:                       q.Credential.replaceDB(db),
+		EtcdWriteAudit:                   q.EtcdWriteAudit.replaceDB(db),
 		Gateway:                          q.Gateway.replaceDB(db),
 		GatewayCustomPluginSchema:        q.GatewayCustomPluginSchema.replaceDB(db),
+		GatewayDiscovery:                 q.GatewayDiscovery.replaceDB(db),
+		GatewayOnboarding:                q.GatewayOnboarding.replaceDB(db),
 		GatewayReleaseVersion:            q.GatewayReleaseVersion.replaceDB(db),
 		GatewayResourceSchemaAssociation: q.GatewayResourceSchemaAssociation.replaceDB(db),
 		GatewaySyncData:                  q.GatewaySyncData.replaceDB(db),
@@ -181,20 +254,33 @@ func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 		PluginConfig:                     q.PluginConfig.replaceDB(db),
 		PluginMetadata:                   q.PluginMetadata.replaceDB(db),
 		Proto:                            q.Proto.replaceDB(db),
+		PublishTask:                      q.PublishTask.replaceDB(db),
+		ResourceRevision:                 q.ResourceRevision.replaceDB(db),
 		Route:                            q.Route.replaceDB(db),
 		SSL:                              q.SSL.replaceDB(db),
+		Secret:                           q.Secret.replaceDB(db),
 		Service:                          q.Service.replaceDB(db),
 		StreamRoute:                      q.StreamRoute.replaceDB(db),
 		SystemConfig:                     q.SystemConfig.replaceDB(db),
 		Upstream:                         q.Upstream.replaceDB(db),
+		ValidationCache:                  q.ValidationCache.replaceDB(db),
+		ValidationRuleset:                q.ValidationRuleset.replaceDB(db),
 	}
 }
 
 type queryCtx struct {
+	BlobObject                       IBlobObjectDo
+	ChangeSet                        IChangeSetDo
+	ChangeSetResource                IChangeSetResourceDo
+	ComplianceReport                 IComplianceReportDo
 	Consumer                         IConsumerDo
 	ConsumerGroup                    IConsumerGroupDo
+	Credential                       ICredentialDo
+	EtcdWriteAudit                   IEtcdWriteAuditDo
 	Gateway                          IGatewayDo
 	GatewayCustomPluginSchema        IGatewayCustomPluginSchemaDo
+	GatewayDiscovery                 IGatewayDiscoveryDo
+	GatewayOnboarding                IGatewayOnboardingDo
 	GatewayReleaseVersion            IGatewayReleaseVersionDo
 	GatewayResourceSchemaAssociation IGatewayResourceSchemaAssociationDo
 	GatewaySyncData                  IGatewaySyncDataDo
@@ -203,21 +289,34 @@ type queryCtx struct {
 	PluginConfig                     IPluginConfigDo
 	PluginMetadata                   IPluginMetadataDo
 	Proto                            IProtoDo
+	PublishTask                      IPublishTaskDo
+	ResourceRevision                 IResourceRevisionDo
 	Route                            IRouteDo
 	SSL                              ISSLDo
+	Secret                           ISecretDo
 	Service                          IServiceDo
 	StreamRoute                      IStreamRouteDo
 	SystemConfig                     ISystemConfigDo
 	Upstream                         IUpstreamDo
+	ValidationCache                  IValidationCacheDo
+	ValidationRuleset                IValidationRulesetDo
 }
 
 // WithContext ...
 func (q *Query) WithContext(ctx context.Context) *queryCtx {
 	return &queryCtx{
+		BlobObject:                       q.BlobObject.WithContext(ctx),
+		ChangeSet:                        q.ChangeSet.WithContext(ctx),
+		ChangeSetResource:                q.ChangeSetResource.WithContext(ctx),
+		ComplianceReport:                 q.ComplianceReport.WithContext(ctx),
 		Consumer:                         q.Consumer.WithContext(ctx),
 		ConsumerGroup:                    q.ConsumerGroup.WithContext(ctx),
+		Credential:                       q.Credential.WithContext(ctx),
+		EtcdWriteAudit:                   q.EtcdWriteAudit.WithContext(ctx),
 		Gateway:                          q.Gateway.WithContext(ctx),
 		GatewayCustomPluginSchema:        q.GatewayCustomPluginSchema.WithContext(ctx),
+		GatewayDiscovery:                 q.GatewayDiscovery.WithContext(ctx),
+		GatewayOnboarding:                q.GatewayOnboarding.WithContext(ctx),
 		GatewayReleaseVersion:            q.GatewayReleaseVersion.WithContext(ctx),
 		GatewayResourceSchemaAssociation: q.GatewayResourceSchemaAssociation.WithContext(ctx),
 		GatewaySyncData:                  q.GatewaySyncData.WithContext(ctx),
@@ -226,12 +325,17 @@ func (q *Query) WithContext(ctx context.Context) *queryCtx {
 		PluginConfig:                     q.PluginConfig.WithContext(ctx),
 		PluginMetadata:                   q.PluginMetadata.WithContext(ctx),
 		Proto:                            q.Proto.WithContext(ctx),
+		PublishTask:                      q.PublishTask.WithContext(ctx),
+		ResourceRevision:                 q.ResourceRevision.WithContext(ctx),
 		Route:                            q.Route.WithContext(ctx),
 		SSL:                              q.SSL.WithContext(ctx),
+		Secret:                           q.Secret.WithContext(ctx),
 		Service:                          q.Service.WithContext(ctx),
 		StreamRoute:                      q.StreamRoute.WithContext(ctx),
 		SystemConfig:                     q.SystemConfig.WithContext(ctx),
 		Upstream:                         q.Upstream.WithContext(ctx),
+		ValidationCache:                  q.ValidationCache.WithContext(ctx),
+		ValidationRuleset:                q.ValidationRuleset.WithContext(ctx),
 	}
 }
 
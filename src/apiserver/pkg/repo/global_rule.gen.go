@@ -55,6 +55,7 @@ func newGlobalRule(db *gorm.DB, opts ...gen.DOOption) globalRule {
 	_globalRule.GatewayID = field.NewInt(tableName, "gateway_id")
 	_globalRule.Config = field.NewField(tableName, "config")
 	_globalRule.Status = field.NewString(tableName, "status")
+	_globalRule.EtcdKeyOverride = field.NewString(tableName, "etcd_key_override")
 
 	_globalRule.fillFieldMap()
 
@@ -64,17 +65,18 @@ func newGlobalRule(db *gorm.DB, opts ...gen.DOOption) globalRule {
 type globalRule struct {
 	globalRuleDo globalRuleDo
 
-	ALL       field.Asterisk
-	Name      field.String
-	Creator   field.String
-	Updater   field.String
-	CreatedAt field.Time
-	UpdatedAt field.Time
-	AutoID    field.Int
-	ID        field.String
-	GatewayID field.Int
-	Config    field.Field
-	Status    field.String
+	ALL             field.Asterisk
+	Name            field.String
+	Creator         field.String
+	Updater         field.String
+	CreatedAt       field.Time
+	UpdatedAt       field.Time
+	AutoID          field.Int
+	ID              field.String
+	GatewayID       field.Int
+	Config          field.Field
+	Status          field.String
+	EtcdKeyOverride field.String
 
 	fieldMap map[string]field.Expr
 }
@@ -103,6 +105,7 @@ func (g *globalRule) updateTableName(table string) *globalRule {
 	g.GatewayID = field.NewInt(table, "gateway_id")
 	g.Config = field.NewField(table, "config")
 	g.Status = field.NewString(table, "status")
+	g.EtcdKeyOverride = field.NewString(table, "etcd_key_override")
 
 	g.fillFieldMap()
 
@@ -134,7 +137,7 @@ func (g *globalRule) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (g *globalRule) fillFieldMap() {
-	g.fieldMap = make(map[string]field.Expr, 10)
+	g.fieldMap = make(map[string]field.Expr, 11)
 	g.fieldMap["name"] = g.Name
 	g.fieldMap["creator"] = g.Creator
 	g.fieldMap["updater"] = g.Updater
@@ -145,6 +148,7 @@ func (g *globalRule) fillFieldMap() {
 	g.fieldMap["gateway_id"] = g.GatewayID
 	g.fieldMap["config"] = g.Config
 	g.fieldMap["status"] = g.Status
+	g.fieldMap["etcd_key_override"] = g.EtcdKeyOverride
 }
 
 func (g globalRule) clone(db *gorm.DB) globalRule {
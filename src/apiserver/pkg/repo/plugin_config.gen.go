@@ -55,6 +55,7 @@ func newPluginConfig(db *gorm.DB, opts ...gen.DOOption) pluginConfig {
 	_pluginConfig.GatewayID = field.NewInt(tableName, "gateway_id")
 	_pluginConfig.Config = field.NewField(tableName, "config")
 	_pluginConfig.Status = field.NewString(tableName, "status")
+	_pluginConfig.EtcdKeyOverride = field.NewString(tableName, "etcd_key_override")
 
 	_pluginConfig.fillFieldMap()
 
@@ -64,17 +65,18 @@ func newPluginConfig(db *gorm.DB, opts ...gen.DOOption) pluginConfig {
 type pluginConfig struct {
 	pluginConfigDo pluginConfigDo
 
-	ALL       field.Asterisk
-	Name      field.String
-	Creator   field.String
-	Updater   field.String
-	CreatedAt field.Time
-	UpdatedAt field.Time
-	AutoID    field.Int
-	ID        field.String
-	GatewayID field.Int
-	Config    field.Field
-	Status    field.String
+	ALL             field.Asterisk
+	Name            field.String
+	Creator         field.String
+	Updater         field.String
+	CreatedAt       field.Time
+	UpdatedAt       field.Time
+	AutoID          field.Int
+	ID              field.String
+	GatewayID       field.Int
+	Config          field.Field
+	Status          field.String
+	EtcdKeyOverride field.String
 
 	fieldMap map[string]field.Expr
 }
@@ -103,6 +105,7 @@ func (p *pluginConfig) updateTableName(table string) *pluginConfig {
 	p.GatewayID = field.NewInt(table, "gateway_id")
 	p.Config = field.NewField(table, "config")
 	p.Status = field.NewString(table, "status")
+	p.EtcdKeyOverride = field.NewString(table, "etcd_key_override")
 
 	p.fillFieldMap()
 
@@ -136,7 +139,7 @@ func (p *pluginConfig) GetFieldByName(fieldName string) (field.OrderExpr, bool)
 }
 
 func (p *pluginConfig) fillFieldMap() {
-	p.fieldMap = make(map[string]field.Expr, 10)
+	p.fieldMap = make(map[string]field.Expr, 11)
 	p.fieldMap["name"] = p.Name
 	p.fieldMap["creator"] = p.Creator
 	p.fieldMap["updater"] = p.Updater
@@ -147,6 +150,7 @@ func (p *pluginConfig) fillFieldMap() {
 	p.fieldMap["gateway_id"] = p.GatewayID
 	p.fieldMap["config"] = p.Config
 	p.fieldMap["status"] = p.Status
+	p.fieldMap["etcd_key_override"] = p.EtcdKeyOverride
 }
 
 func (p pluginConfig) clone(db *gorm.DB) pluginConfig {
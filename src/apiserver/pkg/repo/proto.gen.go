@@ -55,6 +55,7 @@ func newProto(db *gorm.DB, opts ...gen.DOOption) proto {
 	_proto.GatewayID = field.NewInt(tableName, "gateway_id")
 	_proto.Config = field.NewField(tableName, "config")
 	_proto.Status = field.NewString(tableName, "status")
+	_proto.EtcdKeyOverride = field.NewString(tableName, "etcd_key_override")
 
 	_proto.fillFieldMap()
 
@@ -64,17 +65,18 @@ func newProto(db *gorm.DB, opts ...gen.DOOption) proto {
 type proto struct {
 	protoDo protoDo
 
-	ALL       field.Asterisk
-	Name      field.String
-	Creator   field.String
-	Updater   field.String
-	CreatedAt field.Time
-	UpdatedAt field.Time
-	AutoID    field.Int
-	ID        field.String
-	GatewayID field.Int
-	Config    field.Field
-	Status    field.String
+	ALL             field.Asterisk
+	Name            field.String
+	Creator         field.String
+	Updater         field.String
+	CreatedAt       field.Time
+	UpdatedAt       field.Time
+	AutoID          field.Int
+	ID              field.String
+	GatewayID       field.Int
+	Config          field.Field
+	Status          field.String
+	EtcdKeyOverride field.String
 
 	fieldMap map[string]field.Expr
 }
@@ -103,6 +105,7 @@ func (p *proto) updateTableName(table string) *proto {
 	p.GatewayID = field.NewInt(table, "gateway_id")
 	p.Config = field.NewField(table, "config")
 	p.Status = field.NewString(table, "status")
+	p.EtcdKeyOverride = field.NewString(table, "etcd_key_override")
 
 	p.fillFieldMap()
 
@@ -132,7 +135,7 @@ func (p *proto) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (p *proto) fillFieldMap() {
-	p.fieldMap = make(map[string]field.Expr, 10)
+	p.fieldMap = make(map[string]field.Expr, 11)
 	p.fieldMap["name"] = p.Name
 	p.fieldMap["creator"] = p.Creator
 	p.fieldMap["updater"] = p.Updater
@@ -143,6 +146,7 @@ func (p *proto) fillFieldMap() {
 	p.fieldMap["gateway_id"] = p.GatewayID
 	p.fieldMap["config"] = p.Config
 	p.fieldMap["status"] = p.Status
+	p.fieldMap["etcd_key_override"] = p.EtcdKeyOverride
 }
 
 func (p proto) clone(db *gorm.DB) proto {
@@ -49,6 +49,7 @@ func newRoute(db *gorm.DB, opts ...gen.DOOption) route {
 	_route.ServiceID = field.NewString(tableName, "service_id")
 	_route.UpstreamID = field.NewString(tableName, "upstream_id")
 	_route.PluginConfigID = field.NewString(tableName, "plugin_config_id")
+	_route.ExpiresAt = field.NewTime(tableName, "expires_at")
 	_route.Creator = field.NewString(tableName, "creator")
 	_route.Updater = field.NewString(tableName, "updater")
 	_route.CreatedAt = field.NewTime(tableName, "created_at")
@@ -58,6 +59,7 @@ func newRoute(db *gorm.DB, opts ...gen.DOOption) route {
 	_route.GatewayID = field.NewInt(tableName, "gateway_id")
 	_route.Config = field.NewField(tableName, "config")
 	_route.Status = field.NewString(tableName, "status")
+	_route.EtcdKeyOverride = field.NewString(tableName, "etcd_key_override")
 
 	_route.fillFieldMap()
 
@@ -67,20 +69,22 @@ func newRoute(db *gorm.DB, opts ...gen.DOOption) route {
 type route struct {
 	routeDo routeDo
 
-	ALL            field.Asterisk
-	Name           field.String
-	ServiceID      field.String
-	UpstreamID     field.String
-	PluginConfigID field.String
-	Creator        field.String
-	Updater        field.String
-	CreatedAt      field.Time
-	UpdatedAt      field.Time
-	AutoID         field.Int
-	ID             field.String
-	GatewayID      field.Int
-	Config         field.Field
-	Status         field.String
+	ALL             field.Asterisk
+	Name            field.String
+	ServiceID       field.String
+	UpstreamID      field.String
+	PluginConfigID  field.String
+	ExpiresAt       field.Time
+	Creator         field.String
+	Updater         field.String
+	CreatedAt       field.Time
+	UpdatedAt       field.Time
+	AutoID          field.Int
+	ID              field.String
+	GatewayID       field.Int
+	Config          field.Field
+	Status          field.String
+	EtcdKeyOverride field.String
 
 	fieldMap map[string]field.Expr
 }
@@ -103,6 +107,7 @@ func (r *route) updateTableName(table string) *route {
 	r.ServiceID = field.NewString(table, "service_id")
 	r.UpstreamID = field.NewString(table, "upstream_id")
 	r.PluginConfigID = field.NewString(table, "plugin_config_id")
+	r.ExpiresAt = field.NewTime(table, "expires_at")
 	r.Creator = field.NewString(table, "creator")
 	r.Updater = field.NewString(table, "updater")
 	r.CreatedAt = field.NewTime(table, "created_at")
@@ -112,6 +117,7 @@ func (r *route) updateTableName(table string) *route {
 	r.GatewayID = field.NewInt(table, "gateway_id")
 	r.Config = field.NewField(table, "config")
 	r.Status = field.NewString(table, "status")
+	r.EtcdKeyOverride = field.NewString(table, "etcd_key_override")
 
 	r.fillFieldMap()
 
@@ -141,11 +147,12 @@ func (r *route) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (r *route) fillFieldMap() {
-	r.fieldMap = make(map[string]field.Expr, 13)
+	r.fieldMap = make(map[string]field.Expr, 15)
 	r.fieldMap["name"] = r.Name
 	r.fieldMap["service_id"] = r.ServiceID
 	r.fieldMap["upstream_id"] = r.UpstreamID
 	r.fieldMap["plugin_config_id"] = r.PluginConfigID
+	r.fieldMap["expires_at"] = r.ExpiresAt
 	r.fieldMap["creator"] = r.Creator
 	r.fieldMap["updater"] = r.Updater
 	r.fieldMap["created_at"] = r.CreatedAt
@@ -155,6 +162,7 @@ func (r *route) fillFieldMap() {
 	r.fieldMap["gateway_id"] = r.GatewayID
 	r.fieldMap["config"] = r.Config
 	r.fieldMap["status"] = r.Status
+	r.fieldMap["etcd_key_override"] = r.EtcdKeyOverride
 }
 
 func (r route) clone(db *gorm.DB) route {
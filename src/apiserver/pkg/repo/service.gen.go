@@ -56,6 +56,7 @@ func newService(db *gorm.DB, opts ...gen.DOOption) service {
 	_service.GatewayID = field.NewInt(tableName, "gateway_id")
 	_service.Config = field.NewField(tableName, "config")
 	_service.Status = field.NewString(tableName, "status")
+	_service.EtcdKeyOverride = field.NewString(tableName, "etcd_key_override")
 
 	_service.fillFieldMap()
 
@@ -65,18 +66,19 @@ func newService(db *gorm.DB, opts ...gen.DOOption) service {
 type service struct {
 	serviceDo serviceDo
 
-	ALL        field.Asterisk
-	Name       field.String
-	UpstreamID field.String
-	Creator    field.String
-	Updater    field.String
-	CreatedAt  field.Time
-	UpdatedAt  field.Time
-	AutoID     field.Int
-	ID         field.String
-	GatewayID  field.Int
-	Config     field.Field
-	Status     field.String
+	ALL             field.Asterisk
+	Name            field.String
+	UpstreamID      field.String
+	Creator         field.String
+	Updater         field.String
+	CreatedAt       field.Time
+	UpdatedAt       field.Time
+	AutoID          field.Int
+	ID              field.String
+	GatewayID       field.Int
+	Config          field.Field
+	Status          field.String
+	EtcdKeyOverride field.String
 
 	fieldMap map[string]field.Expr
 }
@@ -106,6 +108,7 @@ func (s *service) updateTableName(table string) *service {
 	s.GatewayID = field.NewInt(table, "gateway_id")
 	s.Config = field.NewField(table, "config")
 	s.Status = field.NewString(table, "status")
+	s.EtcdKeyOverride = field.NewString(table, "etcd_key_override")
 
 	s.fillFieldMap()
 
@@ -135,7 +138,7 @@ func (s *service) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (s *service) fillFieldMap() {
-	s.fieldMap = make(map[string]field.Expr, 11)
+	s.fieldMap = make(map[string]field.Expr, 12)
 	s.fieldMap["name"] = s.Name
 	s.fieldMap["upstream_id"] = s.UpstreamID
 	s.fieldMap["creator"] = s.Creator
@@ -147,6 +150,7 @@ func (s *service) fillFieldMap() {
 	s.fieldMap["gateway_id"] = s.GatewayID
 	s.fieldMap["config"] = s.Config
 	s.fieldMap["status"] = s.Status
+	s.fieldMap["etcd_key_override"] = s.EtcdKeyOverride
 }
 
 func (s service) clone(db *gorm.DB) service {
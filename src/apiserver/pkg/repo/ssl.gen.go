@@ -55,6 +55,7 @@ func newSSL(db *gorm.DB, opts ...gen.DOOption) sSL {
 	_sSL.GatewayID = field.NewInt(tableName, "gateway_id")
 	_sSL.Config = field.NewField(tableName, "config")
 	_sSL.Status = field.NewString(tableName, "status")
+	_sSL.EtcdKeyOverride = field.NewString(tableName, "etcd_key_override")
 
 	_sSL.fillFieldMap()
 
@@ -64,17 +65,18 @@ func newSSL(db *gorm.DB, opts ...gen.DOOption) sSL {
 type sSL struct {
 	sSLDo sSLDo
 
-	ALL       field.Asterisk
-	Name      field.String
-	Creator   field.String
-	Updater   field.String
-	CreatedAt field.Time
-	UpdatedAt field.Time
-	AutoID    field.Int
-	ID        field.String
-	GatewayID field.Int
-	Config    field.Field
-	Status    field.String
+	ALL             field.Asterisk
+	Name            field.String
+	Creator         field.String
+	Updater         field.String
+	CreatedAt       field.Time
+	UpdatedAt       field.Time
+	AutoID          field.Int
+	ID              field.String
+	GatewayID       field.Int
+	Config          field.Field
+	Status          field.String
+	EtcdKeyOverride field.String
 
 	fieldMap map[string]field.Expr
 }
@@ -103,6 +105,7 @@ func (s *sSL) updateTableName(table string) *sSL {
 	s.GatewayID = field.NewInt(table, "gateway_id")
 	s.Config = field.NewField(table, "config")
 	s.Status = field.NewString(table, "status")
+	s.EtcdKeyOverride = field.NewString(table, "etcd_key_override")
 
 	s.fillFieldMap()
 
@@ -132,7 +135,7 @@ func (s *sSL) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (s *sSL) fillFieldMap() {
-	s.fieldMap = make(map[string]field.Expr, 10)
+	s.fieldMap = make(map[string]field.Expr, 11)
 	s.fieldMap["name"] = s.Name
 	s.fieldMap["creator"] = s.Creator
 	s.fieldMap["updater"] = s.Updater
@@ -143,6 +146,7 @@ func (s *sSL) fillFieldMap() {
 	s.fieldMap["gateway_id"] = s.GatewayID
 	s.fieldMap["config"] = s.Config
 	s.fieldMap["status"] = s.Status
+	s.fieldMap["etcd_key_override"] = s.EtcdKeyOverride
 }
 
 func (s sSL) clone(db *gorm.DB) sSL {
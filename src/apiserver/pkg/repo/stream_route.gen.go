@@ -57,6 +57,7 @@ func newStreamRoute(db *gorm.DB, opts ...gen.DOOption) streamRoute {
 	_streamRoute.GatewayID = field.NewInt(tableName, "gateway_id")
 	_streamRoute.Config = field.NewField(tableName, "config")
 	_streamRoute.Status = field.NewString(tableName, "status")
+	_streamRoute.EtcdKeyOverride = field.NewString(tableName, "etcd_key_override")
 
 	_streamRoute.fillFieldMap()
 
@@ -66,19 +67,20 @@ func newStreamRoute(db *gorm.DB, opts ...gen.DOOption) streamRoute {
 type streamRoute struct {
 	streamRouteDo streamRouteDo
 
-	ALL        field.Asterisk
-	Name       field.String
-	ServiceID  field.String
-	UpstreamID field.String
-	Creator    field.String
-	Updater    field.String
-	CreatedAt  field.Time
-	UpdatedAt  field.Time
-	AutoID     field.Int
-	ID         field.String
-	GatewayID  field.Int
-	Config     field.Field
-	Status     field.String
+	ALL             field.Asterisk
+	Name            field.String
+	ServiceID       field.String
+	UpstreamID      field.String
+	Creator         field.String
+	Updater         field.String
+	CreatedAt       field.Time
+	UpdatedAt       field.Time
+	AutoID          field.Int
+	ID              field.String
+	GatewayID       field.Int
+	Config          field.Field
+	Status          field.String
+	EtcdKeyOverride field.String
 
 	fieldMap map[string]field.Expr
 }
@@ -109,6 +111,7 @@ func (s *streamRoute) updateTableName(table string) *streamRoute {
 	s.GatewayID = field.NewInt(table, "gateway_id")
 	s.Config = field.NewField(table, "config")
 	s.Status = field.NewString(table, "status")
+	s.EtcdKeyOverride = field.NewString(table, "etcd_key_override")
 
 	s.fillFieldMap()
 
@@ -140,7 +143,7 @@ func (s *streamRoute) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (s *streamRoute) fillFieldMap() {
-	s.fieldMap = make(map[string]field.Expr, 12)
+	s.fieldMap = make(map[string]field.Expr, 13)
 	s.fieldMap["name"] = s.Name
 	s.fieldMap["service_id"] = s.ServiceID
 	s.fieldMap["upstream_id"] = s.UpstreamID
@@ -153,6 +156,7 @@ func (s *streamRoute) fillFieldMap() {
 	s.fieldMap["gateway_id"] = s.GatewayID
 	s.fieldMap["config"] = s.Config
 	s.fieldMap["status"] = s.Status
+	s.fieldMap["etcd_key_override"] = s.EtcdKeyOverride
 }
 
 func (s streamRoute) clone(db *gorm.DB) streamRoute {
@@ -56,6 +56,7 @@ func newUpstream(db *gorm.DB, opts ...gen.DOOption) upstream {
 	_upstream.GatewayID = field.NewInt(tableName, "gateway_id")
 	_upstream.Config = field.NewField(tableName, "config")
 	_upstream.Status = field.NewString(tableName, "status")
+	_upstream.EtcdKeyOverride = field.NewString(tableName, "etcd_key_override")
 
 	_upstream.fillFieldMap()
 
@@ -65,18 +66,19 @@ func newUpstream(db *gorm.DB, opts ...gen.DOOption) upstream {
 type upstream struct {
 	upstreamDo upstreamDo
 
-	ALL       field.Asterisk
-	Name      field.String
-	SSLID     field.String
-	Creator   field.String
-	Updater   field.String
-	CreatedAt field.Time
-	UpdatedAt field.Time
-	AutoID    field.Int
-	ID        field.String
-	GatewayID field.Int
-	Config    field.Field
-	Status    field.String
+	ALL             field.Asterisk
+	Name            field.String
+	SSLID           field.String
+	Creator         field.String
+	Updater         field.String
+	CreatedAt       field.Time
+	UpdatedAt       field.Time
+	AutoID          field.Int
+	ID              field.String
+	GatewayID       field.Int
+	Config          field.Field
+	Status          field.String
+	EtcdKeyOverride field.String
 
 	fieldMap map[string]field.Expr
 }
@@ -106,6 +108,7 @@ func (u *upstream) updateTableName(table string) *upstream {
 	u.GatewayID = field.NewInt(table, "gateway_id")
 	u.Config = field.NewField(table, "config")
 	u.Status = field.NewString(table, "status")
+	u.EtcdKeyOverride = field.NewString(table, "etcd_key_override")
 
 	u.fillFieldMap()
 
@@ -135,7 +138,7 @@ func (u *upstream) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (u *upstream) fillFieldMap() {
-	u.fieldMap = make(map[string]field.Expr, 11)
+	u.fieldMap = make(map[string]field.Expr, 12)
 	u.fieldMap["name"] = u.Name
 	u.fieldMap["ssl_id"] = u.SSLID
 	u.fieldMap["creator"] = u.Creator
@@ -147,6 +150,7 @@ func (u *upstream) fillFieldMap() {
 	u.fieldMap["gateway_id"] = u.GatewayID
 	u.fieldMap["config"] = u.Config
 	u.fieldMap["status"] = u.Status
+	u.fieldMap["etcd_key_override"] = u.EtcdKeyOverride
 }
 
 func (u upstream) clone(db *gorm.DB) upstream {
@@ -30,6 +30,7 @@ import (
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/open"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/web"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/config"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/shutdown"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/middleware"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/validation"
 )
@@ -48,9 +49,13 @@ func New(slogger *slog.Logger) *gin.Engine {
 
 	// middlewares: globally
 	// -- recovery sentry
-	router.Use(middleware.Recovery())
+	router.Use(middleware.RecoveryWithLogger(slogger))
 	router.Use(middleware.CORS(config.G.Service.AllowedOrigins))
 	router.Use(middleware.RequestID())
+	// -- 退出过程中拒绝写请求
+	router.Use(middleware.RejectWhenDraining(shutdown.Default(), config.G.Service.Server.GraceTimeout))
+	// -- 解压 gzip 请求体
+	router.Use(middleware.DecompressGzipBody(config.G.Service.Server.MaxDecompressedBodySize))
 	// -- trace
 	if config.G.Tracing.GinAPIEnabled() {
 		// set gin otel
@@ -81,6 +86,8 @@ func New(slogger *slog.Logger) *gin.Engine {
 		web.RegisterWebApi("/v1/web", apiRG)
 		// 注册openapi路由
 		open.RegisterOpenApi("/v1/open", apiRG)
+		// 注册内置 schema 查询路由
+		web.RegisterSchemaApi("/v1/schemas", apiRG)
 	}
 
 	return router
@@ -51,6 +51,14 @@ func GetFloat64(key string, fallback float64) float64 {
 	return fallback
 }
 
+// GetInt 读取int类型环境变量，支持默认值
+func GetInt(key string, fallback int) int {
+	if value, ok := os.LookupEnv(key); ok {
+		return cast.ToInt(value)
+	}
+	return fallback
+}
+
 // MustGet 读取环境变量，若不存在则 panic
 func MustGet(key string) string {
 	if value, ok := os.LookupEnv(key); ok {
@@ -92,6 +92,20 @@ func GetTx(ctx context.Context) *repo.Query {
 	return tx
 }
 
+// SetPublishTaskIDToContext ...
+func SetPublishTaskIDToContext(ctx context.Context, taskID int) context.Context {
+	return context.WithValue(ctx, constant.PublishTaskIDKey, taskID)
+}
+
+// GetPublishTaskIDFromContext ...
+func GetPublishTaskIDFromContext(ctx context.Context) int {
+	taskID, ok := ctx.Value(constant.PublishTaskIDKey).(int)
+	if !ok {
+		return 0
+	}
+	return taskID
+}
+
 // SetUserID ...
 func SetUserID(c *gin.Context, userID string) {
 	c.Set(string(constant.UserIDKey), userID)
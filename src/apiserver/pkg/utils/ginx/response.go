@@ -36,6 +36,10 @@ const (
 	NotFoundError     = "NotFound"
 	ConflictError     = "Conflict"
 	TooManyRequests   = "TooManyRequests"
+	// RequestEntityTooLarge 请求体超过大小限制
+	RequestEntityTooLarge = "RequestEntityTooLarge"
+	// ServiceUnavailable 服务正在退出，暂不可用
+	ServiceUnavailable = "ServiceUnavailable"
 
 	SystemError = "InternalServerError"
 )
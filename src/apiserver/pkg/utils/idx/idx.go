@@ -40,6 +40,7 @@ var resourceIDResourceTypePrefixMap = map[constant.APISIXResource]string{
 	constant.Upstream:       "u",
 	constant.Service:        "s",
 	constant.Consumer:       "c",
+	constant.Credential:     "cr",
 	constant.ConsumerGroup:  "cg",
 	constant.GlobalRule:     "gr",
 	constant.PluginConfig:   "pc",
@@ -47,6 +48,7 @@ var resourceIDResourceTypePrefixMap = map[constant.APISIXResource]string{
 	constant.Proto:          "pb",
 	constant.SSL:            "ss",
 	constant.StreamRoute:    "sr",
+	constant.Secret:         "sk",
 }
 
 var resourcePrefixResourceTypeMap = map[string]constant.APISIXResource{
@@ -54,6 +56,7 @@ var resourcePrefixResourceTypeMap = map[string]constant.APISIXResource{
 	"u":  constant.Upstream,
 	"s":  constant.Service,
 	"c":  constant.Consumer,
+	"cr": constant.Credential,
 	"cg": constant.ConsumerGroup,
 	"gr": constant.GlobalRule,
 	"pc": constant.PluginConfig,
@@ -61,6 +64,7 @@ var resourcePrefixResourceTypeMap = map[string]constant.APISIXResource{
 	"pb": constant.Proto,
 	"ss": constant.SSL,
 	"sr": constant.StreamRoute,
+	"sk": constant.Secret,
 }
 
 var _sf *sonyflake.Sonyflake
@@ -0,0 +1,133 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package jsonx
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// CanonicalizeConfig 将配置转换为稳定的字节表示，用于 hash 及相等性判断：
+// 对象 key 递归排序、去除无意义的空白、数字统一格式(1.0、1e0 -> 1，1.50 -> 1.5)；
+// 数组顺序在 apisix 中有语义(如 nodes、vars)，保持不变
+func CanonicalizeConfig(config json.RawMessage) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(config))
+	// 保留数字原始文本，避免大整数经 float64 转换丢失精度
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, fmt.Errorf("invalid json: %w", err)
+	}
+	if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
+		return nil, errors.New("invalid json: unexpected data after top-level value")
+	}
+	value, err := canonicalizeValue(value)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	// map 的 key 由 encoding/json 按字典序输出
+	if err := encoder.Encode(value); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// ConfigHash 返回配置规范化后的 sha256(十六进制)
+func ConfigHash(config json.RawMessage) (string, error) {
+	canonical, err := CanonicalizeConfig(config)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(canonical)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// canonicalizeValue 递归规范化数字格式
+func canonicalizeValue(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			normalized, err := canonicalizeValue(item)
+			if err != nil {
+				return nil, err
+			}
+			v[key] = normalized
+		}
+	case []interface{}:
+		for i, item := range v {
+			normalized, err := canonicalizeValue(item)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = normalized
+		}
+	case json.Number:
+		return canonicalizeNumber(v)
+	}
+	return value, nil
+}
+
+// maxSafeInteger float64 可精确表示的最大整数
+const maxSafeInteger = 1 << 53
+
+// canonicalizeNumber 规范化数字：不带小数点与指数的整数保持原样，
+// 其余数字中可精确表示的整数值输出为整数，否则输出为可往返的最短表示；
+// float64 无法精确表示的数字(如高精度小数)按十进制精确值输出，避免不同的数字得到相同的结果
+func canonicalizeNumber(number json.Number) (json.Number, error) {
+	text := number.String()
+	if !strings.ContainsAny(text, ".eE") {
+		if text == "-0" {
+			return "0", nil
+		}
+		return number, nil
+	}
+	f, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return "", fmt.Errorf("invalid number: %s", text)
+	}
+	canonical := strconv.FormatFloat(f, 'g', -1, 64)
+	if f == math.Trunc(f) && math.Abs(f) < maxSafeInteger {
+		canonical = strconv.FormatInt(int64(f), 10)
+	}
+	exact, ok := new(big.Rat).SetString(text)
+	if !ok {
+		return "", fmt.Errorf("invalid number: %s", text)
+	}
+	if value, _ := new(big.Rat).SetString(canonical); value.Cmp(exact) == 0 {
+		return json.Number(canonical), nil
+	}
+	// 十进制数的分母只含因子 2 和 5，乘以足够的 10 的幂后必为整数
+	digits := 0
+	for scaled := new(big.Rat).Set(exact); !scaled.IsInt(); digits++ {
+		scaled.Mul(scaled, big.NewRat(10, 1))
+	}
+	return json.Number(exact.FloatString(digits)), nil
+}
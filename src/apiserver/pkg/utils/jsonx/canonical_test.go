@@ -0,0 +1,107 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package jsonx
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanonicalizeConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   string
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "nested keys sorted and whitespace dropped",
+			config:   "{\n  \"b\": {\"y\": 1, \"x\": [3, 1]},\n  \"a\": \"<v>\"\n}",
+			expected: `{"a":"<v>","b":{"x":[3,1],"y":1}}`,
+		},
+		{
+			name: "array order preserved",
+			config: `{"nodes":[{"port":81,"host":"b"},{"port":80,"host":"a"}],` +
+				`"vars":[["arg_b","==","2"],["arg_a","==","1"]]}`,
+			expected: `{"nodes":[{"host":"b","port":81},{"host":"a","port":80}],` +
+				`"vars":[["arg_b","==","2"],["arg_a","==","1"]]}`,
+		},
+		{
+			name:     "number formatting",
+			config:   `[1.0, 1e0, 1E2, 1.50, -0, -0.0, 0.1, 2.5e-7, 9007199254740993, 1e300]`,
+			expected: `[1,1,100,1.5,0,0,0.1,2.5e-07,9007199254740993,1e+300]`,
+		},
+		{
+			name: "precision beyond float64 preserved",
+			config: `[0.12345678901234567890123, 1.0000000000000000001, 1.2345678901234567890123e22, ` +
+				`1690000000123456789]`,
+			expected: `[0.12345678901234567890123,1.0000000000000000001,12345678901234567890123,1690000000123456789]`,
+		},
+		{name: "scalar", config: ` "a" `, expected: `"a"`},
+		{name: "invalid json", config: `{"a":`, wantErr: true},
+		{name: "trailing data", config: `{"a":1} {"b":2}`, wantErr: true},
+		{name: "number out of range", config: `{"a":1e400}`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			canonical, err := CanonicalizeConfig(json.RawMessage(tt.config))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, string(canonical))
+			// 规范化是幂等的
+			again, err := CanonicalizeConfig(canonical)
+			assert.NoError(t, err)
+			assert.Equal(t, string(canonical), string(again))
+		})
+	}
+}
+
+func TestConfigHash(t *testing.T) {
+	hash, err := ConfigHash(json.RawMessage(`{"plugins":{"limit-count":{"count":10,"time_window":60}},"uri":"/a"}`))
+	assert.NoError(t, err)
+	assert.Len(t, hash, 64)
+
+	// key 顺序、空白、数字格式不同的相同配置 hash 一致
+	same, err := ConfigHash(json.RawMessage(
+		`{ "uri": "/a", "plugins": {"limit-count": {"time_window": 60.0, "count": 1e1}} }`))
+	assert.NoError(t, err)
+	assert.Equal(t, hash, same)
+
+	// 数组顺序不同视为不同的配置
+	first, err := ConfigHash(json.RawMessage(`{"uris":["/a","/b"]}`))
+	assert.NoError(t, err)
+	second, err := ConfigHash(json.RawMessage(`{"uris":["/b","/a"]}`))
+	assert.NoError(t, err)
+	assert.NotEqual(t, first, second)
+
+	// float64 无法区分的高精度数字视为不同的配置
+	first, err = ConfigHash(json.RawMessage(`{"id":1690000000123456789.0}`))
+	assert.NoError(t, err)
+	second, err = ConfigHash(json.RawMessage(`{"id":1690000000123456790.0}`))
+	assert.NoError(t, err)
+	assert.NotEqual(t, first, second)
+
+	_, err = ConfigHash(json.RawMessage(`{`))
+	assert.Error(t, err)
+}
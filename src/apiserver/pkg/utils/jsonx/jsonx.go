@@ -20,6 +20,7 @@
 package jsonx
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 
@@ -46,6 +47,14 @@ func IsJSONEmpty(raw json.RawMessage) bool {
 	}
 }
 
+// UnmarshalUseNumber 解析 json，数字保留为 json.Number，
+// 避免大整数(如雪花 id)及高精度小数经 float64 转换丢失精度，重新序列化时数字原样输出
+func UnmarshalUseNumber(data []byte, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	return decoder.Decode(v)
+}
+
 // MergeJson ...
 func MergeJson(doc, patch []byte) ([]byte, error) {
 	out, err := jsonpatch.MergePatch(doc, patch)
@@ -0,0 +1,123 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package redact 维护资源配置中的敏感字段，并在写入审计、导出等场景时脱敏
+package redact
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+// ResourceSensitivePaths 资源配置中的敏感字段路径，# 表示数组中的每个元素
+var ResourceSensitivePaths = map[constant.APISIXResource][]string{
+	constant.SSL:         {"key", "keys"},
+	constant.Upstream:    {"tls.client_key"},
+	constant.Route:       {"upstream.tls.client_key"},
+	constant.Service:     {"upstream.tls.client_key"},
+	constant.StreamRoute: {"upstream.tls.client_key"},
+	constant.Secret:      {"token", "secret_access_key", "session_token", "auth_config.private_key"},
+}
+
+// PluginSensitivePaths 插件配置中的敏感字段路径，相对于插件配置，# 表示数组中的每个元素
+var PluginSensitivePaths = map[string][]string{
+	"basic-auth":           {"password"},
+	"hmac-auth":            {"secret_key"},
+	"jwt-auth":             {"secret", "private_key"},
+	"key-auth":             {"key"},
+	"authz-casdoor":        {"client_secret"},
+	"authz-keycloak":       {"client_secret"},
+	"openid-connect":       {"client_secret", "client_rsa_private_key", "session.secret"},
+	"csrf":                 {"key"},
+	"clickhouse-logger":    {"password"},
+	"elasticsearch-logger": {"auth.password"},
+	"google-cloud-logging": {"auth_config.private_key"},
+	"http-logger":          {"auth_header"},
+	"kafka-logger":         {"brokers.#.sasl_config.password"},
+	"loggly":               {"customer_token"},
+	"rocketmq-logger":      {"secret_key"},
+	"sls-logger":           {"access_key_secret"},
+	"splunk-hec-logging":   {"endpoint.token"},
+	"tencent-cloud-cls":    {"secret_key"},
+}
+
+// Config 返回脱敏后的资源配置副本，敏感字段的值替换为 constant.SensitiveInfoFiledDisplay，原配置保持不变
+func Config(resourceType constant.APISIXResource, config json.RawMessage) json.RawMessage {
+	if len(config) == 0 || !gjson.ValidBytes(config) {
+		return config
+	}
+	redacted := append(json.RawMessage{}, config...)
+	for _, path := range ResourceSensitivePaths[resourceType] {
+		redacted = redactPath(redacted, path)
+	}
+	gjson.GetBytes(config, "plugins").ForEach(func(name, _ gjson.Result) bool {
+		for _, path := range PluginSensitivePaths[name.String()] {
+			redacted = redactPath(redacted, "plugins."+gjson.Escape(name.String())+"."+path)
+		}
+		return true
+	})
+	return redacted
+}
+
+// Restore 将配置中仍为脱敏占位符的资源敏感字段还原为 origin 中的值，用于编辑时回传了脱敏后的配置
+func Restore(resourceType constant.APISIXResource, config, origin json.RawMessage) json.RawMessage {
+	if len(config) == 0 || !gjson.ValidBytes(config) {
+		return config
+	}
+	for _, path := range ResourceSensitivePaths[resourceType] {
+		if strings.Contains(path, "#") || gjson.GetBytes(config, path).String() != constant.SensitiveInfoFiledDisplay {
+			continue
+		}
+		value := gjson.GetBytes(origin, path)
+		if !value.Exists() {
+			continue
+		}
+		restored, err := sjson.SetRawBytes(config, path, []byte(value.Raw))
+		if err == nil {
+			config = restored
+		}
+	}
+	return config
+}
+
+// redactPath 替换 path 对应的值，path 中的 # 会展开为数组的每个下标
+func redactPath(config json.RawMessage, path string) json.RawMessage {
+	prefix, rest, found := strings.Cut(path, ".#")
+	if found {
+		count := gjson.GetBytes(config, prefix+".#").Int()
+		for i := int64(0); i < count; i++ {
+			config = redactPath(config, prefix+"."+strconv.FormatInt(i, 10)+rest)
+		}
+		return config
+	}
+	value := gjson.GetBytes(config, path)
+	if !value.Exists() || value.Type == gjson.Null {
+		return config
+	}
+	redacted, err := sjson.SetBytes(config, path, constant.SensitiveInfoFiledDisplay)
+	if err != nil {
+		return config
+	}
+	return redacted
+}
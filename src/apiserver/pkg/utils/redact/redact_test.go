@@ -0,0 +1,122 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package redact
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+func TestConfig(t *testing.T) {
+	tests := []struct {
+		name         string
+		resourceType constant.APISIXResource
+		config       string
+		redacted     []string
+		kept         map[string]string
+	}{
+		{
+			name:         "route plugin secrets",
+			resourceType: constant.Route,
+			config: `{"uri": "/get", "plugins": {"openid-connect": {"client_id": "c",
+				"client_secret": "s", "session": {"secret": "ss"}}, "proxy-rewrite": {"uri": "/"}}}`,
+			redacted: []string{"plugins.openid-connect.client_secret", "plugins.openid-connect.session.secret"},
+			kept:     map[string]string{"plugins.openid-connect.client_id": "c", "plugins.proxy-rewrite.uri": "/"},
+		},
+		{
+			name:         "consumer auth secrets",
+			resourceType: constant.Consumer,
+			config:       `{"username": "u", "plugins": {"jwt-auth": {"key": "k", "secret": "s"}}}`,
+			redacted:     []string{"plugins.jwt-auth.secret"},
+			kept:         map[string]string{"plugins.jwt-auth.key": "k", "username": "u"},
+		},
+		{
+			name:         "array path",
+			resourceType: constant.GlobalRule,
+			config: `{"plugins": {"kafka-logger": {"brokers": [
+				{"host": "a", "sasl_config": {"password": "p1"}}, {"host": "b"},
+				{"host": "c", "sasl_config": {"password": "p3"}}]}}}`,
+			redacted: []string{
+				"plugins.kafka-logger.brokers.0.sasl_config.password",
+				"plugins.kafka-logger.brokers.2.sasl_config.password",
+			},
+			kept: map[string]string{"plugins.kafka-logger.brokers.1.host": "b"},
+		},
+		{
+			name:         "ssl key",
+			resourceType: constant.SSL,
+			config:       `{"cert": "c", "key": "k", "snis": ["a.com"]}`,
+			redacted:     []string{"key"},
+			kept:         map[string]string{"cert": "c"},
+		},
+		{
+			name:         "inline upstream client key",
+			resourceType: constant.Service,
+			config:       `{"upstream": {"tls": {"client_cert": "c", "client_key": "k"}}}`,
+			redacted:     []string{"upstream.tls.client_key"},
+			kept:         map[string]string{"upstream.tls.client_cert": "c"},
+		},
+		{
+			name:         "secret manager credentials",
+			resourceType: constant.Secret,
+			config:       `{"uri": "http://127.0.0.1:8200", "prefix": "kv/apisix", "token": "t"}`,
+			redacted:     []string{"token"},
+			kept:         map[string]string{"uri": "http://127.0.0.1:8200", "prefix": "kv/apisix"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := json.RawMessage(tt.config)
+			snapshot := string(original)
+			redacted := Config(tt.resourceType, original)
+			assert.Equal(t, snapshot, string(original))
+			for _, path := range tt.redacted {
+				assert.Equal(t, constant.SensitiveInfoFiledDisplay, gjson.GetBytes(redacted, path).String(), path)
+			}
+			for path, value := range tt.kept {
+				assert.Equal(t, value, gjson.GetBytes(redacted, path).String(), path)
+			}
+			assert.False(t, gjson.GetBytes(redacted, "plugins.kafka-logger.brokers.1.sasl_config").Exists())
+		})
+	}
+}
+
+func TestConfigInvalid(t *testing.T) {
+	assert.Equal(t, "{", string(Config(constant.Route, json.RawMessage(`{`))))
+	assert.Empty(t, Config(constant.Route, nil))
+}
+
+func TestRestore(t *testing.T) {
+	origin := json.RawMessage(`{"uri": "http://127.0.0.1:8200", "prefix": "kv/apisix", "token": "t"}`)
+
+	// 回传脱敏占位符时沿用原值
+	restored := Restore(constant.Secret, Config(constant.Secret, origin), origin)
+	assert.JSONEq(t, string(origin), string(restored))
+
+	// 修改了敏感字段时使用新值
+	changed := json.RawMessage(`{"uri": "http://a:8200", "prefix": "p", "token": "t2"}`)
+	restored = Restore(constant.Secret, changed, origin)
+	assert.Equal(t, "t2", gjson.GetBytes(restored, "token").String())
+	assert.Equal(t, "http://a:8200", gjson.GetBytes(restored, "uri").String())
+}
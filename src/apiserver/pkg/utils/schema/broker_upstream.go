@@ -0,0 +1,58 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"fmt"
+
+	entity "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/apisix"
+)
+
+// checkBrokerUpstream 校验消息队列类上游：kafka 上游的 nodes 即 broker 列表，每个 broker 需显式配置 port；
+// apisix 没有 amqp 类型的上游，schema 的枚举错误无法说明原因，因此单独给出错误信息
+func checkBrokerUpstream(upstream *entity.UpstreamDef) error {
+	switch upstream.Scheme {
+	case "amqp":
+		return fmt.Errorf("apisix 不支持 scheme 为 amqp 的上游, 消息队列类上游目前仅支持 kafka")
+	case "kafka":
+	default:
+		return nil
+	}
+	// 服务发现的 broker 由注册中心提供
+	if upstream.DiscoveryType != "" {
+		return nil
+	}
+	if upstream.Nodes == nil {
+		return fmt.Errorf("kafka 上游缺少 broker 列表: nodes")
+	}
+	nodes, ok := entity.NodesFormat(upstream.Nodes).([]*entity.Node)
+	if !ok {
+		return nil
+	}
+	if len(nodes) == 0 {
+		return fmt.Errorf("kafka 上游的 broker 列表 nodes 不能为空")
+	}
+	for _, node := range nodes {
+		// 上游未配置端口时按 scheme 推导默认端口, kafka 需显式指定 broker 端口
+		if node.Port == 0 {
+			return fmt.Errorf("kafka 上游的 broker %s 缺少 port", node.Host)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+func TestCheckBrokerUpstream(t *testing.T) {
+	tests := []struct {
+		name         string
+		resourceType constant.APISIXResource
+		config       string
+		wantErr      string
+	}{
+		{
+			name:         "valid kafka stream upstream",
+			resourceType: constant.StreamRoute,
+			config: `{"name":"sr1","server_port":9100,"upstream":{"type":"roundrobin","scheme":"kafka",` +
+				`"nodes":[{"host":"127.0.0.1","port":9092,"weight":1},{"host":"127.0.0.2","port":9092,"weight":1}]}}`,
+		},
+		{
+			name:         "valid kafka upstream with map nodes",
+			resourceType: constant.Upstream,
+			config:       `{"name":"u1","type":"roundrobin","scheme":"kafka","nodes":{"127.0.0.1:9092":1}}`,
+		},
+		{
+			name:         "kafka stream upstream missing brokers",
+			resourceType: constant.StreamRoute,
+			config:       `{"name":"sr1","server_port":9100,"upstream":{"type":"roundrobin","scheme":"kafka"}}`,
+			wantErr:      "kafka 上游缺少 broker 列表: nodes",
+		},
+		{
+			name:         "kafka upstream empty brokers",
+			resourceType: constant.Upstream,
+			config:       `{"name":"u1","type":"roundrobin","scheme":"kafka","nodes":[]}`,
+			wantErr:      "broker 列表 nodes 不能为空",
+		},
+		{
+			name:         "kafka broker missing port",
+			resourceType: constant.Upstream,
+			config: `{"name":"u1","type":"roundrobin","scheme":"kafka",` +
+				`"nodes":[{"host":"127.0.0.1","weight":1}]}`,
+			wantErr: "broker 127.0.0.1 缺少 port",
+		},
+		{
+			name:         "kafka map broker missing port",
+			resourceType: constant.Upstream,
+			config:       `{"name":"u1","type":"roundrobin","scheme":"kafka","nodes":{"127.0.0.1":1}}`,
+			wantErr:      "broker 127.0.0.1 缺少 port",
+		},
+		{
+			name:         "amqp upstream",
+			resourceType: constant.Upstream,
+			config: `{"name":"u1","type":"roundrobin","scheme":"amqp",` +
+				`"nodes":[{"host":"127.0.0.1","port":5672,"weight":1}]}`,
+			wantErr: "不支持 scheme 为 amqp 的上游",
+		},
+		{
+			name:         "kafka-proxy requires kafka upstream",
+			resourceType: constant.Route,
+			config: `{"name":"r1","uri":"/kafka","plugins":{"kafka-proxy":{}},"upstream":{"type":"roundrobin",` +
+				`"nodes":[{"host":"127.0.0.1","port":9092,"weight":1}]}}`,
+			wantErr: "插件 kafka-proxy 要求上游 scheme 为 kafka",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validator, err := NewAPISIXJsonSchemaValidator(constant.APISIXVersion313, tt.resourceType,
+				"main."+tt.resourceType.String(), nil, constant.DATABASE)
+			assert.NoError(t, err)
+			err = validator.Validate(json.RawMessage(tt.config))
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,117 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+// etcdExcludedFields DATABASE 形态中存在而 ETCD 形态中需要去除的字段，与发布时写入 etcd 前的处理保持一致：
+//   - plugin_config/global_rule/proto/secret/credential: name
+//   - consumer: id（consumer 以 username 作为标识）
+//   - consumer_group: id、name
+//   - ssl: name、validity_start、validity_end
+//   - stream_route: name、labels
+var etcdExcludedFields = map[constant.APISIXResource][]string{
+	constant.PluginConfig:  {"name"},
+	constant.GlobalRule:    {"name"},
+	constant.Proto:         {"name"},
+	constant.Consumer:      {"id"},
+	constant.ConsumerGroup: {"id", "name"},
+	constant.SSL:           {"name", "validity_start", "validity_end"},
+	constant.StreamRoute:   {"name", "labels"},
+	constant.Secret:        {"name"},
+	constant.Credential:    {"name"},
+}
+
+// databaseExcludedFields ETCD 形态中存在而 DATABASE 形态中需要去除的字段，避免影响资源的 diff
+var databaseExcludedFields = []string{"create_time", "update_time"}
+
+// ConvertResourceDataType 将资源配置从 from 形态转换为 to 形态，并使用目标形态的 schema 重新校验
+//
+// DATABASE -> ETCD：按 etcdExcludedFields 去除 etcd 中不允许的字段，其余字段原样保留
+// ETCD -> DATABASE：去除 create_time、update_time；
+// 若缺少 name 则注入，plugin_metadata 取 id，consumer 以 username 作为名称不做处理，其余资源取 {prefix}_{id}
+func ConvertResourceDataType(
+	version constant.APISIXVersion,
+	resourceType constant.APISIXResource,
+	from constant.DataType,
+	to constant.DataType,
+	config json.RawMessage,
+) (json.RawMessage, error) {
+	if !gjson.ValidBytes(config) {
+		return nil, fmt.Errorf("资源配置不是合法的 json")
+	}
+	converted := append(json.RawMessage{}, config...)
+	var err error
+	switch {
+	case from == to:
+	case from == constant.DATABASE && to == constant.ETCD:
+		for _, field := range etcdExcludedFields[resourceType] {
+			if converted, err = sjson.DeleteBytes(converted, field); err != nil {
+				return nil, fmt.Errorf("去除字段 %s 失败: %w", field, err)
+			}
+		}
+	case from == constant.ETCD && to == constant.DATABASE:
+		for _, field := range databaseExcludedFields {
+			if converted, err = sjson.DeleteBytes(converted, field); err != nil {
+				return nil, fmt.Errorf("去除字段 %s 失败: %w", field, err)
+			}
+		}
+		if converted, err = injectDatabaseName(resourceType, converted); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("不支持的数据类型转换: %s -> %s", from, to)
+	}
+	validator, err := NewAPISIXJsonSchemaValidator(version, resourceType, "main."+resourceType.String(), nil, to)
+	if err != nil {
+		return nil, err
+	}
+	if err := validator.Validate(converted); err != nil {
+		return nil, err
+	}
+	return converted, nil
+}
+
+// injectDatabaseName 为缺少 name 的 etcd 资源配置注入名称，与从 etcd 同步资源时的命名规则一致
+func injectDatabaseName(resourceType constant.APISIXResource, config json.RawMessage) (json.RawMessage, error) {
+	if resourceType == constant.Consumer || gjson.GetBytes(config, "name").String() != "" {
+		return config, nil
+	}
+	id := gjson.GetBytes(config, "id").String()
+	if id == "" {
+		return config, nil
+	}
+	name := fmt.Sprintf("%s_%s", constant.ResourceTypePrefixMap[resourceType], id)
+	if resourceType == constant.PluginMetadata {
+		name = id
+	}
+	converted, err := sjson.SetBytes(config, "name", name)
+	if err != nil {
+		return nil, fmt.Errorf("注入字段 name 失败: %w", err)
+	}
+	return converted, nil
+}
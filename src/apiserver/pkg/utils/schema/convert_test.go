@@ -0,0 +1,107 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+func TestConvertResourceDataType(t *testing.T) {
+	tests := []struct {
+		name         string
+		resourceType constant.APISIXResource
+		from         constant.DataType
+		to           constant.DataType
+		config       string
+		shouldFail   bool
+		check        func(t *testing.T, converted map[string]interface{})
+	}{
+		{
+			name:         "promote database route to etcd",
+			resourceType: constant.Route,
+			from:         constant.DATABASE,
+			to:           constant.ETCD,
+			config: `{"id": "r1", "name": "route1", "uri": "/get",
+				"upstream": {"type": "roundrobin", "nodes": {"httpbin.org:80": 1}}}`,
+			check: func(t *testing.T, converted map[string]interface{}) {
+				assert.Equal(t, "route1", converted["name"])
+				assert.Equal(t, "/get", converted["uri"])
+			},
+		},
+		{
+			name:         "database route with unknown field fails etcd validation",
+			resourceType: constant.Route,
+			from:         constant.DATABASE,
+			to:           constant.ETCD,
+			config:       `{"id": "r1", "uri": "/get", "unknown": 1, "upstream_id": "u1"}`,
+			shouldFail:   true,
+		},
+		{
+			name:         "database consumer group strips id and name",
+			resourceType: constant.ConsumerGroup,
+			from:         constant.DATABASE,
+			to:           constant.ETCD,
+			config:       `{"id": "cg1", "name": "group1", "plugins": {"prometheus": {}}}`,
+			check: func(t *testing.T, converted map[string]interface{}) {
+				assert.NotContains(t, converted, "id")
+				assert.NotContains(t, converted, "name")
+			},
+		},
+		{
+			name:         "etcd global rule injects name and strips timestamps",
+			resourceType: constant.GlobalRule,
+			from:         constant.ETCD,
+			to:           constant.DATABASE,
+			config:       `{"id": "g1", "plugins": {"prometheus": {}}, "create_time": 1, "update_time": 2}`,
+			check: func(t *testing.T, converted map[string]interface{}) {
+				assert.Equal(t, "global_rules_g1", converted["name"])
+				assert.NotContains(t, converted, "create_time")
+				assert.NotContains(t, converted, "update_time")
+			},
+		},
+		{
+			name:         "invalid json",
+			resourceType: constant.Route,
+			from:         constant.DATABASE,
+			to:           constant.ETCD,
+			config:       `{`,
+			shouldFail:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			converted, err := ConvertResourceDataType(constant.APISIXVersion311, tt.resourceType,
+				tt.from, tt.to, json.RawMessage(tt.config))
+			if tt.shouldFail {
+				assert.Error(t, err)
+				assert.Nil(t, converted)
+				return
+			}
+			assert.NoError(t, err)
+			var result map[string]interface{}
+			assert.NoError(t, json.Unmarshal(converted, &result))
+			tt.check(t, result)
+		})
+	}
+}
@@ -0,0 +1,125 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+// credentialVersions 支持 credential 资源的 apisix 版本，3.11 起 consumer 的凭证可独立存放于
+// consumers/{consumer}/credentials/{id}
+var credentialVersions = []constant.APISIXVersion{
+	constant.APISIXVersion311,
+	constant.APISIXVersion313,
+}
+
+// CredentialPlugins 可配置为 credential 的认证插件
+var CredentialPlugins = []string{"basic-auth", "hmac-auth", "jwt-auth", "key-auth"}
+
+// credentialKeyFields 认证插件中标识凭证的字段，同一插件下该字段的值不能重复，否则 apisix 无法确定请求所属的 consumer
+var credentialKeyFields = map[string]string{
+	"basic-auth": "username",
+	"hmac-auth":  "access_key",
+	"jwt-auth":   "key",
+	"key-auth":   "key",
+}
+
+// SupportsCredential apisix 版本是否支持 credential 资源
+func SupportsCredential(version constant.APISIXVersion) bool {
+	return slices.Contains(credentialVersions, version)
+}
+
+// withCredentialSchema 为支持 credential 的版本写入 main.credential，
+// 字段定义沿用同版本的 consumer，plugins 限定为一个认证插件，插件配置按 consumer_schema 校验
+func withCredentialSchema(raw []byte, version constant.APISIXVersion) []byte {
+	if !SupportsCredential(version) {
+		return raw
+	}
+	properties := map[string]json.RawMessage{
+		"id": json.RawMessage(gjson.GetBytes(raw, "main.proto.properties.id").Raw),
+	}
+	for _, field := range []string{"desc", "labels", "create_time", "update_time"} {
+		properties[field] = json.RawMessage(gjson.GetBytes(raw, "main.consumer.properties."+field).Raw)
+	}
+	plugins, _ := json.Marshal(map[string]interface{}{
+		"type":          "object",
+		"minProperties": 1,
+		"maxProperties": 1,
+		"propertyNames": map[string]interface{}{"enum": CredentialPlugins},
+	})
+	properties["plugins"] = plugins
+	credentialSchema, _ := json.Marshal(map[string]interface{}{
+		"type":       "object",
+		"properties": properties,
+		"required":   []string{"plugins"},
+	})
+	raw, err := sjson.SetRawBytes(raw, "main.credential", credentialSchema)
+	if err != nil {
+		panic(fmt.Sprintf("inject credential schema for apisix %s failed: %s", version, err))
+	}
+	return raw
+}
+
+// ValidateCredentialRotation 校验 consumer 轮换凭证时新增的凭证：每个凭证需通过 credential schema 校验
+// (按最新的支持 credential 的版本)，且凭证标识(如 key-auth 的 key)与 consumer 上的认证插件、
+// 其他新凭证均不冲突；轮换期间新旧凭证同时生效，冲突会导致请求被识别为错误的凭证。返回全部错误
+func ValidateCredentialRotation(consumer json.RawMessage, newCreds []json.RawMessage) []error {
+	version := credentialVersions[len(credentialVersions)-1]
+	validator, err := NewAPISIXJsonSchemaValidator(version, constant.Credential, "main.credential", nil,
+		constant.DATABASE)
+	if err != nil {
+		return []error{err}
+	}
+
+	// 插件:标识 -> 标识的来源
+	owners := make(map[string]string)
+	for plugin, field := range credentialKeyFields {
+		if key := gjson.GetBytes(consumer, "plugins."+plugin+"."+field).String(); key != "" {
+			owners[plugin+":"+key] = "consumer"
+		}
+	}
+	var errs []error
+	for i, cred := range newCreds {
+		source := fmt.Sprintf("第 %d 个凭证", i+1)
+		if err := validator.Validate(cred); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", source, err))
+			continue
+		}
+		for plugin, conf := range gjson.GetBytes(cred, "plugins").Map() {
+			field := credentialKeyFields[plugin]
+			key := conf.Get(field).String()
+			if key == "" {
+				continue
+			}
+			if owner, ok := owners[plugin+":"+key]; ok {
+				errs = append(errs, fmt.Errorf("%s: 插件 %s 的 %s 与 %s 冲突", source, plugin, field, owner))
+				continue
+			}
+			owners[plugin+":"+key] = source
+		}
+	}
+	return errs
+}
@@ -0,0 +1,151 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+func TestSupportsCredential(t *testing.T) {
+	assert.False(t, SupportsCredential(constant.APISIXVersion32))
+	assert.False(t, SupportsCredential(constant.APISIXVersion33))
+	assert.True(t, SupportsCredential(constant.APISIXVersion311))
+	assert.True(t, SupportsCredential(constant.APISIXVersion313))
+
+	// 不支持的版本中不存在 credential 的 schema
+	_, err := NewAPISIXSchemaValidator(constant.APISIXVersion33, "main.credential")
+	assert.Error(t, err)
+}
+
+func TestCredentialSchema(t *testing.T) {
+	tests := []struct {
+		name   string
+		config string
+		errMsg string
+	}{
+		{
+			name:   "key-auth",
+			config: `{"id":"c1","plugins":{"key-auth":{"key":"k"}}}`,
+		},
+		{
+			name:   "basic-auth",
+			config: `{"id":"c1","desc":"d","plugins":{"basic-auth":{"username":"u","password":"p"}}}`,
+		},
+		{
+			name:   "jwt-auth",
+			config: `{"id":"c1","plugins":{"jwt-auth":{"key":"k","secret":"s"}}}`,
+		},
+		{
+			name:   "hmac-auth",
+			config: `{"id":"c1","plugins":{"hmac-auth":{"access_key":"k","secret_key":"s"}}}`,
+		},
+		{
+			name:   "missing required plugin field",
+			config: `{"id":"c1","plugins":{"basic-auth":{"username":"u"}}}`,
+			errMsg: "password",
+		},
+		{
+			name:   "non-auth plugin",
+			config: `{"id":"c1","plugins":{"limit-count":{"count":1,"time_window":1}}}`,
+			errMsg: "schema 验证失败",
+		},
+		{
+			name:   "more than one plugin",
+			config: `{"id":"c1","plugins":{"key-auth":{"key":"k"},"jwt-auth":{"key":"k","secret":"s"}}}`,
+			errMsg: "schema 验证失败",
+		},
+		{
+			name:   "missing plugins",
+			config: `{"id":"c1","desc":"d"}`,
+			errMsg: "plugins",
+		},
+	}
+	validator, err := NewAPISIXJsonSchemaValidator(
+		constant.APISIXVersion313, constant.Credential, "main.credential", nil, constant.ETCD)
+	assert.NoError(t, err)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validator.Validate(json.RawMessage(tt.config))
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.errMsg)
+		})
+	}
+}
+
+func TestValidateCredentialRotation(t *testing.T) {
+	consumer := json.RawMessage(`{"username":"jack","plugins":{"key-auth":{"key":"old-key"}}}`)
+	tests := []struct {
+		name     string
+		newCreds []string
+		errs     []string
+	}{
+		{
+			name: "valid rotation",
+			newCreds: []string{
+				`{"id":"c1","plugins":{"key-auth":{"key":"new-key"}}}`,
+				// 不同插件的标识可以相同
+				`{"id":"c2","plugins":{"jwt-auth":{"key":"new-key","secret":"s"}}}`,
+			},
+		},
+		{
+			name: "collides with consumer",
+			newCreds: []string{
+				`{"id":"c1","plugins":{"key-auth":{"key":"old-key"}}}`,
+			},
+			errs: []string{"第 1 个凭证: 插件 key-auth 的 key 与 consumer 冲突"},
+		},
+		{
+			name: "collides between new credentials",
+			newCreds: []string{
+				`{"id":"c1","plugins":{"basic-auth":{"username":"u","password":"p1"}}}`,
+				`{"id":"c2","plugins":{"basic-auth":{"username":"u","password":"p2"}}}`,
+			},
+			errs: []string{"第 2 个凭证: 插件 basic-auth 的 username 与 第 1 个凭证 冲突"},
+		},
+		{
+			name: "invalid credential",
+			newCreds: []string{
+				`{"id":"c1","plugins":{"limit-count":{"count":1,"time_window":1}}}`,
+				`{"id":"c2","plugins":{"key-auth":{"key":"old-key"}}}`,
+			},
+			errs: []string{"第 1 个凭证: ", "第 2 个凭证: 插件 key-auth 的 key 与 consumer 冲突"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newCreds := make([]json.RawMessage, 0, len(tt.newCreds))
+			for _, cred := range tt.newCreds {
+				newCreds = append(newCreds, json.RawMessage(cred))
+			}
+			errs := ValidateCredentialRotation(consumer, newCreds)
+			assert.Len(t, errs, len(tt.errs))
+			for i, err := range errs {
+				assert.ErrorContains(t, err, tt.errs[i])
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"maps"
+	"sync"
+	"sync/atomic"
+)
+
+// CustomSchemaSnapshot 网关自定义插件 schema 的不可变快照：发布后不再修改，
+// 校验过程中持有的快照不受后续更新影响
+type CustomSchemaSnapshot struct {
+	// Revision 快照对应的网关校验规则修订号
+	Revision      int
+	pluginSchemas map[string]interface{}
+}
+
+// NewCustomSchemaSnapshot 创建快照，pluginSchemas 中的 schema 传入后调用方不能再修改
+func NewCustomSchemaSnapshot(revision int, pluginSchemas map[string]interface{}) *CustomSchemaSnapshot {
+	return &CustomSchemaSnapshot{Revision: revision, pluginSchemas: maps.Clone(pluginSchemas)}
+}
+
+// PluginSchemaMap 返回自定义插件 schema map，调用方只读；快照为 nil 时返回 nil
+func (s *CustomSchemaSnapshot) PluginSchemaMap() map[string]interface{} {
+	if s == nil {
+		return nil
+	}
+	return s.pluginSchemas
+}
+
+// customSchemaEntry 单个网关的快照
+type customSchemaEntry struct {
+	// mu 串行化同一网关的更新，避免并发更新基于同一快照相互覆盖；读取快照不加锁
+	mu       sync.Mutex
+	snapshot atomic.Pointer[CustomSchemaSnapshot]
+}
+
+// CustomSchemaRegistry 按网关保存自定义插件 schema 快照(copy-on-write)：
+// 更新时构建新快照并原子替换指针，进行中的校验继续使用开始时获取的快照
+type CustomSchemaRegistry struct {
+	entries sync.Map // gatewayID -> *customSchemaEntry
+}
+
+// NewCustomSchemaRegistry 创建 CustomSchemaRegistry
+func NewCustomSchemaRegistry() *CustomSchemaRegistry {
+	return &CustomSchemaRegistry{}
+}
+
+// Load 获取网关当前的快照，未加载时返回 nil
+func (r *CustomSchemaRegistry) Load(gatewayID int) *CustomSchemaSnapshot {
+	entry, ok := r.entries.Load(gatewayID)
+	if !ok {
+		return nil
+	}
+	return entry.(*customSchemaEntry).snapshot.Load()
+}
+
+// Update 基于当前快照构建新快照并原子替换，返回替换后生效的快照；
+// build 返回错误时保留当前快照，新快照的修订号低于当前快照时(加载到了更旧的数据)同样保留当前快照
+func (r *CustomSchemaRegistry) Update(
+	gatewayID int,
+	build func(current *CustomSchemaSnapshot) (*CustomSchemaSnapshot, error),
+) (*CustomSchemaSnapshot, error) {
+	value, _ := r.entries.LoadOrStore(gatewayID, &customSchemaEntry{})
+	entry := value.(*customSchemaEntry)
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+	current := entry.snapshot.Load()
+	next, err := build(current)
+	if err != nil {
+		return current, err
+	}
+	if next == nil || (current != nil && next.Revision < current.Revision) {
+		return current, nil
+	}
+	entry.snapshot.Store(next)
+	return next, nil
+}
@@ -0,0 +1,151 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+// generationPluginSchema 要求插件配置的 gen 等于 generation 的 schema
+func generationPluginSchema(generation int) map[string]interface{} {
+	return map[string]interface{}{
+		"type":       "object",
+		"properties": map[string]interface{}{"gen": map[string]interface{}{"enum": []interface{}{generation}}},
+		"required":   []interface{}{"gen"},
+	}
+}
+
+func TestCustomSchemaRegistryUpdate(t *testing.T) {
+	registry := NewCustomSchemaRegistry()
+	assert.Nil(t, registry.Load(1))
+	assert.Nil(t, registry.Load(1).PluginSchemaMap())
+
+	first := NewCustomSchemaSnapshot(2, map[string]interface{}{"custom-a": generationPluginSchema(2)})
+	snapshot, err := registry.Update(1, func(current *CustomSchemaSnapshot) (*CustomSchemaSnapshot, error) {
+		assert.Nil(t, current)
+		return first, nil
+	})
+	assert.NoError(t, err)
+	assert.Same(t, first, snapshot)
+	assert.Same(t, first, registry.Load(1))
+	assert.Nil(t, registry.Load(2))
+
+	// 构建失败或加载到更旧的修订号时保留当前快照
+	snapshot, err = registry.Update(1, func(*CustomSchemaSnapshot) (*CustomSchemaSnapshot, error) {
+		return nil, errors.New("load failed")
+	})
+	assert.EqualError(t, err, "load failed")
+	assert.Same(t, first, snapshot)
+	snapshot, err = registry.Update(1, func(*CustomSchemaSnapshot) (*CustomSchemaSnapshot, error) {
+		return NewCustomSchemaSnapshot(1, nil), nil
+	})
+	assert.NoError(t, err)
+	assert.Same(t, first, snapshot)
+
+	// 新快照不影响已获取的快照
+	_, err = registry.Update(1, func(current *CustomSchemaSnapshot) (*CustomSchemaSnapshot, error) {
+		pluginSchemas := maps.Clone(current.PluginSchemaMap())
+		pluginSchemas["custom-b"] = generationPluginSchema(3)
+		return NewCustomSchemaSnapshot(3, pluginSchemas), nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, registry.Load(1).Revision)
+	assert.Len(t, registry.Load(1).PluginSchemaMap(), 2)
+	assert.Len(t, first.PluginSchemaMap(), 1)
+}
+
+func TestCustomSchemaRegistryConcurrentValidate(t *testing.T) {
+	const (
+		gatewayID   = 1
+		validations = 100
+		generations = 20
+	)
+	// 每一代快照中两个插件的 schema 都要求 gen 等于快照修订号，部分更新的快照会导致校验失败
+	newSnapshot := func(generation int) *CustomSchemaSnapshot {
+		return NewCustomSchemaSnapshot(generation, map[string]interface{}{
+			"custom-a": generationPluginSchema(generation),
+			"custom-b": generationPluginSchema(generation),
+		})
+	}
+	registry := NewCustomSchemaRegistry()
+	_, err := registry.Update(gatewayID, func(*CustomSchemaSnapshot) (*CustomSchemaSnapshot, error) {
+		return newSnapshot(1), nil
+	})
+	assert.NoError(t, err)
+
+	var (
+		wg        sync.WaitGroup
+		validated atomic.Int64
+	)
+	done := make(chan struct{})
+	for i := 0; i < validations; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				snapshot := registry.Load(gatewayID)
+				validator, err := NewAPISIXJsonSchemaValidator(constant.APISIXVersion313, constant.Route, "main.route",
+					snapshot.PluginSchemaMap(), constant.DATABASE)
+				if !assert.NoError(t, err) {
+					return
+				}
+				config := fmt.Sprintf(`{"id":"r1","uri":"/a","upstream_id":"u1",`+
+					`"plugins":{"custom-a":{"gen":%[1]d},"custom-b":{"gen":%[1]d}}}`, snapshot.Revision)
+				if !assert.NoError(t, validator.Validate(json.RawMessage(config)), "revision %d", snapshot.Revision) {
+					return
+				}
+				validated.Add(1)
+			}
+		}()
+	}
+
+	for generation := 2; generation <= generations; generation++ {
+		// 每次更新前等待一轮校验完成，保证更新与校验交错进行
+		for start := validated.Load(); validated.Load()-start < validations && !t.Failed(); {
+			runtime.Gosched()
+		}
+		_, err := registry.Update(gatewayID, func(current *CustomSchemaSnapshot) (*CustomSchemaSnapshot, error) {
+			// copy-on-write：逐个插件更新副本，未发布前的中间状态对校验不可见
+			pluginSchemas := maps.Clone(current.PluginSchemaMap())
+			pluginSchemas["custom-a"] = generationPluginSchema(generation)
+			pluginSchemas["custom-b"] = generationPluginSchema(generation)
+			return NewCustomSchemaSnapshot(generation, pluginSchemas), nil
+		})
+		assert.NoError(t, err)
+	}
+	close(done)
+	wg.Wait()
+	assert.Equal(t, generations, registry.Load(gatewayID).Revision)
+}
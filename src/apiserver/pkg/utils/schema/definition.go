@@ -0,0 +1,94 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/tidwall/sjson"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+// SupportedSchemaVersions 内置 schema 支持的 APISIX 版本，按版本号升序
+func SupportedSchemaVersions() []string {
+	apisixSchemas := activeSchemas().apisix
+	versions := make([]string, 0, len(apisixSchemas))
+	for version := range apisixSchemas {
+		versions = append(versions, string(version))
+	}
+	slices.SortFunc(versions, compareVersion)
+	return versions
+}
+
+// SupportedSchemaResources 可查询 schema 的资源类型
+func SupportedSchemaResources() []string {
+	resources := make([]string, 0, len(constant.ResourceTypeList))
+	for _, resourceType := range constant.ResourceTypeList {
+		resources = append(resources, resourceType.String())
+	}
+	return resources
+}
+
+// ParseSchemaVersion 解析 schema 版本，兼容 3.13 与 3.13.X 两种写法
+func ParseSchemaVersion(version string) (constant.APISIXVersion, bool) {
+	if !strings.HasSuffix(version, ".X") {
+		version += ".X"
+	}
+	apisixVersion := constant.APISIXVersion(version)
+	_, ok := activeSchemas().apisix[apisixVersion]
+	return apisixVersion, ok
+}
+
+// ResourceSchemaDefinition 获取资源的 schema 定义，etcd 类型与校验时一致，不允许附加属性
+func ResourceSchemaDefinition(
+	version constant.APISIXVersion,
+	resourceType constant.APISIXResource,
+	dataType constant.DataType,
+) (json.RawMessage, error) {
+	compiled, err := getCompiledResourceSchema(version, resourceType, "main."+resourceType.String(), dataType)
+	if err != nil {
+		return nil, err
+	}
+	schemaDef := compiled.schemaDef
+	if dataType == constant.ETCD && resourceType != constant.PluginMetadata {
+		schemaDef, err = sjson.Set(schemaDef, "additionalProperties", false)
+		if err != nil {
+			return nil, fmt.Errorf("设置 additionalProperties 失败: %w", err)
+		}
+	}
+	return json.RawMessage(schemaDef), nil
+}
+
+// compareVersion 按数字比较 3.2.X 形式的版本号
+func compareVersion(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		if len(as[i]) != len(bs[i]) {
+			return len(as[i]) - len(bs[i])
+		}
+		if c := strings.Compare(as[i], bs[i]); c != 0 {
+			return c
+		}
+	}
+	return len(as) - len(bs)
+}
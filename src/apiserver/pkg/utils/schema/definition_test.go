@@ -0,0 +1,67 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+func TestSupportedSchemaVersions(t *testing.T) {
+	assert.Equal(t, []string{"3.2.X", "3.3.X", "3.11.X", "3.13.X"}, SupportedSchemaVersions())
+}
+
+func TestParseSchemaVersion(t *testing.T) {
+	version, ok := ParseSchemaVersion("3.13")
+	assert.True(t, ok)
+	assert.Equal(t, constant.APISIXVersion313, version)
+
+	version, ok = ParseSchemaVersion("3.11.X")
+	assert.True(t, ok)
+	assert.Equal(t, constant.APISIXVersion311, version)
+
+	_, ok = ParseSchemaVersion("2.15")
+	assert.False(t, ok)
+}
+
+func TestResourceSchemaDefinition(t *testing.T) {
+	def, err := ResourceSchemaDefinition(constant.APISIXVersion313, constant.Route, constant.DATABASE)
+	require.NoError(t, err)
+	assert.True(t, gjson.ValidBytes(def))
+	assert.True(t, gjson.GetBytes(def, "properties.uri").Exists())
+	assert.False(t, gjson.GetBytes(def, "additionalProperties").Exists())
+
+	def, err = ResourceSchemaDefinition(constant.APISIXVersion313, constant.Route, constant.ETCD)
+	require.NoError(t, err)
+	assert.False(t, gjson.GetBytes(def, "additionalProperties").Bool())
+	assert.True(t, gjson.GetBytes(def, "additionalProperties").Exists())
+
+	// plugin_metadata 在 etcd 下同样允许附加属性
+	def, err = ResourceSchemaDefinition(constant.APISIXVersion313, constant.PluginMetadata, constant.ETCD)
+	require.NoError(t, err)
+	assert.False(t, gjson.GetBytes(def, "additionalProperties").Exists())
+
+	_, err = ResourceSchemaDefinition(constant.APISIXVersion313, constant.APISIXResource("unknown"), constant.DATABASE)
+	assert.Error(t, err)
+}
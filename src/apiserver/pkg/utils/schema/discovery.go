@@ -0,0 +1,174 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/tidwall/gjson"
+	"github.com/xeipuuv/gojsonschema"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	entity "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/apisix"
+)
+
+// 服务发现类型定义：discovery_type -> {config_schema, args_schema, example}
+//
+//go:embed discovery.json
+var rawDiscovery []byte
+
+var discoveryManifest = gjson.ParseBytes(rawDiscovery)
+
+// GetDiscoveryTypes 获取支持的服务发现类型
+func GetDiscoveryTypes() []string {
+	var discoveryTypes []string
+	discoveryManifest.ForEach(func(key, _ gjson.Result) bool {
+		discoveryTypes = append(discoveryTypes, key.String())
+		return true
+	})
+	slices.Sort(discoveryTypes)
+	return discoveryTypes
+}
+
+// IsSupportedDiscoveryType 是否为支持的服务发现类型
+func IsSupportedDiscoveryType(discoveryType string) bool {
+	return discoveryType != "" && discoveryManifest.Get(gjson.Escape(discoveryType)).Exists()
+}
+
+// GetDiscoveryExample 获取基于服务发现的 upstream 示例
+func GetDiscoveryExample(discoveryType string) map[string]interface{} {
+	example, _ := discoveryManifest.Get(gjson.Escape(discoveryType) + ".example").Value().(map[string]interface{})
+	return example
+}
+
+// ValidateDiscoveryConfig 校验网关启用的服务发现连接配置
+func ValidateDiscoveryConfig(discoveryType string, config json.RawMessage) error {
+	if !IsSupportedDiscoveryType(discoveryType) {
+		return fmt.Errorf("不支持的服务发现类型: %s, 支持的类型: [%s]",
+			discoveryType, strings.Join(GetDiscoveryTypes(), ", "))
+	}
+	if len(config) == 0 {
+		config = json.RawMessage("{}")
+	}
+	return validateDiscoverySchema(discoveryType, "config_schema", gojsonschema.NewBytesLoader(config))
+}
+
+// CheckUpstreamDiscoveryType 校验资源中 upstream 的 discovery_type 是否已在网关中启用
+func CheckUpstreamDiscoveryType(
+	resourceType constant.APISIXResource,
+	config json.RawMessage,
+	enabledDiscoveryTypes []string,
+) error {
+	var path string
+	switch resourceType {
+	case constant.Upstream:
+		path = "discovery_type"
+	case constant.Route, constant.Service:
+		path = "upstream.discovery_type"
+	default:
+		return nil
+	}
+	discoveryType := gjson.GetBytes(config, path).String()
+	if discoveryType == "" || slices.Contains(enabledDiscoveryTypes, discoveryType) {
+		return nil
+	}
+	if len(enabledDiscoveryTypes) == 0 {
+		return fmt.Errorf("服务发现类型: %s 未启用, 当前网关未启用任何服务发现类型", discoveryType)
+	}
+	return fmt.Errorf("服务发现类型: %s 未启用, 已启用的类型: [%s]",
+		discoveryType, strings.Join(enabledDiscoveryTypes, ", "))
+}
+
+// checkUpstreamDiscovery 校验上游节点来源：配置 discovery_type 时必须配置 service_name 且不能配置 nodes，
+// 配置 service_name 时必须配置 discovery_type；两者都未配置时按 nodes 的规则校验
+func checkUpstreamDiscovery(upstream *entity.UpstreamDef) error {
+	if upstream.DiscoveryType == "" && upstream.ServiceName == "" {
+		return nil
+	}
+	if upstream.DiscoveryType == "" {
+		return fmt.Errorf("配置 `service_name` 时, `discovery_type` 不可为空")
+	}
+	if upstream.ServiceName == "" {
+		return fmt.Errorf("配置 `discovery_type` 时, `service_name` 不可为空")
+	}
+	if upstream.Nodes != nil {
+		return fmt.Errorf("使用服务发现(`discovery_type`: %s)时不能同时配置 `nodes`", upstream.DiscoveryType)
+	}
+	return nil
+}
+
+// upstreamDefFromConfig 获取资源配置中的上游定义：upstream 资源取整个配置，route/service/stream_route 取内联 upstream
+func upstreamDefFromConfig(resourceType constant.APISIXResource, config json.RawMessage) *entity.UpstreamDef {
+	raw := gjson.ParseBytes(config)
+	switch resourceType {
+	case constant.Upstream:
+	case constant.Route, constant.Service, constant.StreamRoute:
+		raw = raw.Get("upstream")
+	default:
+		return nil
+	}
+	if !raw.IsObject() {
+		return nil
+	}
+	var upstream entity.UpstreamDef
+	if err := json.Unmarshal([]byte(raw.Raw), &upstream); err != nil {
+		return nil
+	}
+	return &upstream
+}
+
+// checkDiscoveryArgs 根据服务发现类型校验 upstream 的 discovery_args
+func checkDiscoveryArgs(upstream *entity.UpstreamDef) error {
+	// 未知的服务发现类型(如自定义扩展)不做参数校验
+	if !IsSupportedDiscoveryType(upstream.DiscoveryType) {
+		return nil
+	}
+	args := upstream.DiscoveryArgs
+	if args == nil {
+		args = map[string]interface{}{}
+	}
+	if err := validateDiscoverySchema(upstream.DiscoveryType, "args_schema", gojsonschema.NewGoLoader(args)); err != nil {
+		return fmt.Errorf("discovery_args %w", err)
+	}
+	return nil
+}
+
+// validateDiscoverySchema 使用服务发现类型下指定的 schema 进行校验
+func validateDiscoverySchema(discoveryType string, schemaName string, loader gojsonschema.JSONLoader) error {
+	schemaDef := discoveryManifest.Get(gjson.Escape(discoveryType) + "." + schemaName).Raw
+	if schemaDef == "" {
+		return nil
+	}
+	s, err := gojsonschema.NewSchema(gojsonschema.NewStringLoader(schemaDef))
+	if err != nil {
+		return fmt.Errorf("schema 验证失败: %s", err)
+	}
+	ret, err := s.Validate(loader)
+	if err != nil {
+		return fmt.Errorf("schema 验证失败: %s", err)
+	}
+	if !ret.Valid() {
+		return fmt.Errorf("schema 验证失败: %s", GetSchemaValidateFailed(ret))
+	}
+	return nil
+}
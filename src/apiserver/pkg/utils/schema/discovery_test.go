@@ -0,0 +1,252 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+func TestValidateDiscoveryConfig(t *testing.T) {
+	tests := []struct {
+		name          string
+		discoveryType string
+		config        string
+		wantErr       bool
+	}{
+		{
+			name:          "valid nacos",
+			discoveryType: "nacos",
+			config:        `{"host":["http://127.0.0.1:8848"],"fetch_interval":30}`,
+		},
+		{
+			name:          "nacos missing host",
+			discoveryType: "nacos",
+			config:        `{"prefix":"/nacos/v1/"}`,
+			wantErr:       true,
+		},
+		{
+			name:          "valid kubernetes without config",
+			discoveryType: "kubernetes",
+		},
+		{
+			name:          "unsupported type",
+			discoveryType: "zookeeper",
+			config:        `{}`,
+			wantErr:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateDiscoveryConfig(tt.discoveryType, json.RawMessage(tt.config))
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCheckUpstreamDiscoveryType(t *testing.T) {
+	enabled := []string{"consul", "nacos"}
+	tests := []struct {
+		name         string
+		resourceType constant.APISIXResource
+		config       string
+		enabled      []string
+		wantErr      string
+	}{
+		{
+			name:         "upstream enabled",
+			resourceType: constant.Upstream,
+			config:       `{"discovery_type":"nacos","service_name":"svc"}`,
+			enabled:      enabled,
+		},
+		{
+			name:         "upstream without discovery",
+			resourceType: constant.Upstream,
+			config:       `{"nodes":[{"host":"127.0.0.1","port":80,"weight":1}]}`,
+		},
+		{
+			name:         "upstream not enabled",
+			resourceType: constant.Upstream,
+			config:       `{"discovery_type":"eureka","service_name":"svc"}`,
+			enabled:      enabled,
+			wantErr:      "已启用的类型: [consul, nacos]",
+		},
+		{
+			name:         "route inline upstream not enabled",
+			resourceType: constant.Route,
+			config:       `{"uri":"/a","upstream":{"discovery_type":"eureka","service_name":"svc"}}`,
+			wantErr:      "当前网关未启用任何服务发现类型",
+		},
+		{
+			name:         "other resource ignored",
+			resourceType: constant.Consumer,
+			config:       `{"discovery_type":"eureka"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckUpstreamDiscoveryType(tt.resourceType, json.RawMessage(tt.config), tt.enabled)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateUpstreamDiscoveryArgs(t *testing.T) {
+	validator, err := NewAPISIXJsonSchemaValidator(
+		constant.APISIXVersion311, constant.Upstream, "main.upstream", nil, constant.DATABASE)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		config  string
+		wantErr bool
+	}{
+		{
+			name: "valid nacos args",
+			config: `{"name":"u1","type":"roundrobin","discovery_type":"nacos","service_name":"svc",` +
+				`"discovery_args":{"namespace_id":"public","group_name":"DEFAULT_GROUP"}}`,
+		},
+		{
+			name: "typo nacos args",
+			config: `{"name":"u1","type":"roundrobin","discovery_type":"nacos","service_name":"svc",` +
+				`"discovery_args":{"group":"DEFAULT_GROUP"}}`,
+			wantErr: true,
+		},
+		{
+			name: "args not supported by consul",
+			config: `{"name":"u1","type":"roundrobin","discovery_type":"consul","service_name":"svc",` +
+				`"discovery_args":{"namespace_id":"public"}}`,
+			wantErr: true,
+		},
+		{
+			name: "unknown discovery type skips args check",
+			config: `{"name":"u1","type":"roundrobin","discovery_type":"custom","service_name":"svc",` +
+				`"discovery_args":{"anything":"ok"}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validator.Validate(json.RawMessage(tt.config))
+			if tt.wantErr {
+				assert.ErrorContains(t, err, "discovery_args")
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	// 每种服务发现类型的示例都应通过校验
+	for _, discoveryType := range GetDiscoveryTypes() {
+		example := GetDiscoveryExample(discoveryType)
+		assert.NotNil(t, example, discoveryType)
+		example["name"] = "example-" + discoveryType
+		raw, _ := json.Marshal(example)
+		assert.NoError(t, validator.Validate(raw), discoveryType)
+	}
+}
+
+func TestCheckUpstreamDiscovery(t *testing.T) {
+	validator, err := NewAPISIXJsonSchemaValidator(
+		constant.APISIXVersion311, constant.Upstream, "main.upstream", nil, constant.DATABASE)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		config  string
+		wantErr string
+	}{
+		{
+			name: "kubernetes",
+			config: `{"name":"u1","type":"roundrobin","discovery_type":"kubernetes",` +
+				`"service_name":"default/svc:http","pass_host":"node"}`,
+		},
+		{
+			name:   "nacos",
+			config: `{"name":"u1","type":"roundrobin","discovery_type":"nacos","service_name":"svc","pass_host":"node"}`,
+		},
+		{
+			name:   "consul",
+			config: `{"name":"u1","type":"roundrobin","discovery_type":"consul","service_name":"svc"}`,
+		},
+		{
+			name:   "static nodes",
+			config: `{"name":"u1","type":"roundrobin","nodes":[{"host":"127.0.0.1","port":80,"weight":1}]}`,
+		},
+		{
+			name: "both nodes and service_name",
+			config: `{"name":"u1","type":"roundrobin","discovery_type":"nacos","service_name":"svc",` +
+				`"nodes":[{"host":"127.0.0.1","port":80,"weight":1}]}`,
+			wantErr: "使用服务发现(`discovery_type`: nacos)时不能同时配置 `nodes`",
+		},
+		{
+			name:    "discovery_type without service_name",
+			config:  `{"name":"u1","type":"roundrobin","discovery_type":"consul","service_name":""}`,
+			wantErr: "`service_name` 不可为空",
+		},
+		{
+			name: "service_name without discovery_type",
+			config: `{"name":"u1","type":"roundrobin","service_name":"svc",` +
+				`"nodes":[{"host":"127.0.0.1","port":80,"weight":1}]}`,
+			wantErr: "`discovery_type` 不可为空",
+		},
+		{
+			name: "static nodes with pass_host node still single node",
+			config: `{"name":"u1","type":"roundrobin","pass_host":"node","nodes":[` +
+				`{"host":"127.0.0.1","port":80,"weight":1},{"host":"127.0.0.2","port":80,"weight":1}]}`,
+			wantErr: "目前仅支持 `node` 模式下的单节点",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validator.Validate(json.RawMessage(tt.config))
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestCheckRouteUpstreamDiscovery(t *testing.T) {
+	validator, err := NewAPISIXJsonSchemaValidator(
+		constant.APISIXVersion311, constant.Route, "main.route", nil, constant.DATABASE)
+	assert.NoError(t, err)
+
+	err = validator.Validate(json.RawMessage(`{"name":"r1","uri":"/get","upstream":{"type":"roundrobin",` +
+		`"discovery_type":"kubernetes","service_name":"default/svc:http",` +
+		`"nodes":[{"host":"127.0.0.1","port":80,"weight":1}]}}`))
+	assert.ErrorContains(t, err, "不能同时配置 `nodes`")
+
+	err = validator.Validate(json.RawMessage(`{"name":"r1","uri":"/get","upstream":{"type":"roundrobin",` +
+		`"discovery_type":"kubernetes","service_name":"default/svc:http"}}`))
+	assert.NoError(t, err)
+}
@@ -0,0 +1,154 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"fmt"
+
+	entity "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/apisix"
+)
+
+// healthCheckRange 健康检查数值字段的取值范围，max 为 0 表示无上限
+type healthCheckRange struct {
+	path  string
+	value *int
+	min   int
+	max   int
+}
+
+// healthCheckTypes 健康检查支持的类型
+var healthCheckTypes = map[string]bool{"http": true, "https": true, "tcp": true}
+
+// checkUpstreamHealthChecks 校验上游健康检查配置：被动检查必须搭配主动检查，
+// 检查类型为 http/https/tcp，http(s) 主动检查需要配置 http_path，阈值需在文档约定范围内
+func checkUpstreamHealthChecks(upstream *entity.UpstreamDef) error {
+	if upstream == nil || upstream.Checks == nil {
+		return nil
+	}
+	active, passive := upstream.Checks.Active, upstream.Checks.Passive
+	if passive != nil && active == nil {
+		return fmt.Errorf("健康检查配置了被动检查 checks.passive 时必须同时配置主动检查 checks.active")
+	}
+	if active != nil {
+		// 未配置 type 时 apisix 默认为 http
+		activeType := active.Type
+		if activeType == "" {
+			activeType = "http"
+		}
+		if !healthCheckTypes[activeType] {
+			return fmt.Errorf("健康检查 checks.active.type 仅支持 http/https/tcp, 当前值: %s", activeType)
+		}
+		if activeType != "tcp" && active.HTTPPath == "" {
+			return fmt.Errorf("健康检查 checks.active.type 为 %s 时, checks.active.http_path 不可为空", activeType)
+		}
+	}
+	// 阈值范围与 apisix health_checker 文档保持一致：
+	// 主动检查的次数阈值为 1-254，被动检查允许为 0 表示不依据该项判断
+	for _, item := range healthCheckRanges(upstream.Checks) {
+		if item.value == nil {
+			continue
+		}
+		if *item.value < item.min {
+			return fmt.Errorf("健康检查 checks.%s 不能小于 %d, 当前值: %d", item.path, item.min, *item.value)
+		}
+		if item.max > 0 && *item.value > item.max {
+			return fmt.Errorf("健康检查 checks.%s 不能大于 %d, 当前值: %d", item.path, item.max, *item.value)
+		}
+	}
+	for _, list := range healthCheckStatuses(upstream.Checks) {
+		for _, status := range list.statuses {
+			if status < 200 || status > 599 {
+				return fmt.Errorf("健康检查 checks.%s 中的状态码必须在 200-599 之间, 当前值: %d", list.path, status)
+			}
+		}
+	}
+	return nil
+}
+
+// healthCheckRanges 健康检查中需要校验取值范围的数值字段
+func healthCheckRanges(checks *entity.HealthChecker) []healthCheckRange {
+	var ranges []healthCheckRange
+	if active := checks.Active; active != nil {
+		if healthy := active.Healthy; healthy != nil {
+			ranges = append(ranges, []healthCheckRange{
+				{path: "active.healthy.interval", value: healthy.Interval, min: 1},
+				{path: "active.healthy.successes", value: healthy.Successes, min: 1, max: 254},
+			}...)
+		}
+		if unhealthy := active.UnHealthy; unhealthy != nil {
+			ranges = append(ranges, []healthCheckRange{
+				{path: "active.unhealthy.interval", value: unhealthy.Interval, min: 1},
+				{path: "active.unhealthy.http_failures", value: unhealthy.HTTPFailures, min: 1, max: 254},
+				{path: "active.unhealthy.tcp_failures", value: unhealthy.TCPFailures, min: 1, max: 254},
+				{path: "active.unhealthy.timeouts", value: unhealthy.Timeouts, min: 1, max: 254},
+			}...)
+		}
+	}
+	if passive := checks.Passive; passive != nil {
+		if healthy := passive.Healthy; healthy != nil {
+			ranges = append(ranges, []healthCheckRange{
+				{path: "passive.healthy.successes", value: healthy.Successes, min: 0, max: 254},
+			}...)
+		}
+		if unhealthy := passive.UnHealthy; unhealthy != nil {
+			ranges = append(ranges, []healthCheckRange{
+				{path: "passive.unhealthy.http_failures", value: unhealthy.HTTPFailures, min: 0, max: 254},
+				{path: "passive.unhealthy.tcp_failures", value: unhealthy.TCPFailures, min: 0, max: 254},
+				{path: "passive.unhealthy.timeouts", value: unhealthy.Timeouts, min: 0, max: 254},
+			}...)
+		}
+	}
+	return ranges
+}
+
+// healthCheckStatusList 健康检查中的 http 状态码列表字段
+type healthCheckStatusList struct {
+	path     string
+	statuses []int
+}
+
+// healthCheckStatuses 健康检查中配置的 http 状态码列表
+func healthCheckStatuses(checks *entity.HealthChecker) []healthCheckStatusList {
+	var lists []healthCheckStatusList
+	if active := checks.Active; active != nil {
+		if active.Healthy != nil {
+			lists = append(lists, healthCheckStatusList{
+				path: "active.healthy.http_statuses", statuses: active.Healthy.HTTPStatuses,
+			})
+		}
+		if active.UnHealthy != nil {
+			lists = append(lists, healthCheckStatusList{
+				path: "active.unhealthy.http_statuses", statuses: active.UnHealthy.HTTPStatuses,
+			})
+		}
+	}
+	if passive := checks.Passive; passive != nil {
+		if passive.Healthy != nil {
+			lists = append(lists, healthCheckStatusList{
+				path: "passive.healthy.http_statuses", statuses: passive.Healthy.HTTPStatuses,
+			})
+		}
+		if passive.UnHealthy != nil {
+			lists = append(lists, healthCheckStatusList{
+				path: "passive.unhealthy.http_statuses", statuses: passive.UnHealthy.HTTPStatuses,
+			})
+		}
+	}
+	return lists
+}
@@ -0,0 +1,116 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	entity "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/apisix"
+)
+
+func TestCheckUpstreamHealthChecks(t *testing.T) {
+	tests := []struct {
+		name    string
+		checks  string
+		wantErr string
+	}{
+		{
+			name:   "no checks",
+			checks: `null`,
+		},
+		{
+			name: "active http with boundary thresholds",
+			checks: `{"active": {"type": "http", "http_path": "/status",
+				"healthy": {"interval": 1, "successes": 1, "http_statuses": [200, 599]},
+				"unhealthy": {"interval": 1, "http_failures": 254, "tcp_failures": 254, "timeouts": 254}}}`,
+		},
+		{
+			name:   "active tcp without http_path",
+			checks: `{"active": {"type": "tcp"}}`,
+		},
+		{
+			name: "passive with active and zero thresholds",
+			checks: `{"active": {"type": "https", "http_path": "/"},
+				"passive": {"healthy": {"successes": 0}, "unhealthy": {"http_failures": 0, "timeouts": 0}}}`,
+		},
+		{
+			name:    "passive without active",
+			checks:  `{"passive": {"healthy": {"successes": 3}}}`,
+			wantErr: "必须同时配置主动检查 checks.active",
+		},
+		{
+			name:    "unknown active type",
+			checks:  `{"active": {"type": "udp"}}`,
+			wantErr: "checks.active.type 仅支持 http/https/tcp, 当前值: udp",
+		},
+		{
+			name:    "default http type without http_path",
+			checks:  `{"active": {"healthy": {"successes": 2}}}`,
+			wantErr: "checks.active.http_path 不可为空",
+		},
+		{
+			name:    "active successes zero",
+			checks:  `{"active": {"type": "http", "http_path": "/", "healthy": {"successes": 0}}}`,
+			wantErr: "checks.active.healthy.successes 不能小于 1, 当前值: 0",
+		},
+		{
+			name:    "active http_failures above max",
+			checks:  `{"active": {"type": "tcp", "unhealthy": {"http_failures": 255}}}`,
+			wantErr: "checks.active.unhealthy.http_failures 不能大于 254, 当前值: 255",
+		},
+		{
+			name:    "active interval zero",
+			checks:  `{"active": {"type": "tcp", "unhealthy": {"interval": 0}}}`,
+			wantErr: "checks.active.unhealthy.interval 不能小于 1",
+		},
+		{
+			name:    "passive timeouts above max",
+			checks:  `{"active": {"type": "tcp"}, "passive": {"unhealthy": {"timeouts": 255}}}`,
+			wantErr: "checks.passive.unhealthy.timeouts 不能大于 254",
+		},
+		{
+			// 阈值字段为整数类型，非整数在解析上游配置时即失败
+			name:    "non integer threshold",
+			checks:  `{"active": {"type": "tcp", "healthy": {"successes": 1.5}}}`,
+			wantErr: "cannot unmarshal number 1.5",
+		},
+		{
+			name:    "status code out of range",
+			checks:  `{"active": {"type": "tcp", "healthy": {"http_statuses": [199]}}}`,
+			wantErr: "checks.active.healthy.http_statuses 中的状态码必须在 200-599 之间, 当前值: 199",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var upstream entity.UpstreamDef
+			err := json.Unmarshal([]byte(`{"checks": `+tt.checks+`}`), &upstream)
+			if err == nil {
+				err = checkUpstreamHealthChecks(&upstream)
+			}
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
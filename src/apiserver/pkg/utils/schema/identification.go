@@ -0,0 +1,76 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/tidwall/gjson"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/jsonx"
+)
+
+// 资源标识的来源
+const (
+	IdentificationSourceID         = "id"
+	IdentificationSourceName       = "name"
+	IdentificationSourceUsername   = "username"
+	IdentificationSourceConfigHash = "config_hash"
+)
+
+// configHashLength 配置 hash 标识保留的十六进制字符数
+const configHashLength = 16
+
+// ResourceIdentification 资源标识及其来源字段
+type ResourceIdentification struct {
+	Value  string
+	Source string
+}
+
+// IsConfigHash 标识是否由配置 hash 生成，即配置中没有 id/name/username
+func (i ResourceIdentification) IsConfigHash() bool {
+	return i.Source == IdentificationSourceConfigHash
+}
+
+// GetResourceIdentifier 获取资源标识：依次取 id、name、username，
+// 都不存在时 (如只有插件配置的 global_rule、plugin_metadata) 取规范化配置的 hash
+func GetResourceIdentifier(config json.RawMessage) ResourceIdentification {
+	for _, source := range []string{IdentificationSourceID, IdentificationSourceName, IdentificationSourceUsername} {
+		if value := gjson.GetBytes(config, source).String(); value != "" {
+			return ResourceIdentification{Value: value, Source: source}
+		}
+	}
+	if len(config) == 0 {
+		return ResourceIdentification{}
+	}
+	hash, err := jsonx.ConfigHash(config)
+	if err != nil {
+		// 非法 json 直接对原始内容计算 hash
+		sum := sha256.Sum256(config)
+		hash = hex.EncodeToString(sum[:])
+	}
+	return ResourceIdentification{Value: hash[:configHashLength], Source: IdentificationSourceConfigHash}
+}
+
+// GetResourceIdentification 获取资源标识，即 GetResourceIdentifier 的 Value
+func GetResourceIdentification(config json.RawMessage) string {
+	return GetResourceIdentifier(config).Value
+}
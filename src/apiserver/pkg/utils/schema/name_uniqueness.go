@@ -0,0 +1,68 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/tidwall/gjson"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+// resourceNameField 获取资源名称所在的字段，consumer 以 username 作为名称
+func resourceNameField(resourceType constant.APISIXResource) string {
+	if resourceType == constant.Consumer {
+		return "username"
+	}
+	return "name"
+}
+
+// CheckNameUniqueness 检查同一类型资源的名称是否重复，每个重复的名称返回一个错误
+// apisix 不要求名称唯一，但平台将名称作为用户可见的标识，未配置名称的资源不参与检查
+func CheckNameUniqueness(resourceType constant.APISIXResource, resources []json.RawMessage) []error {
+	nameField := resourceNameField(resourceType)
+	var names []string
+	resourcesByName := make(map[string][]string)
+	for i, config := range resources {
+		name := gjson.GetBytes(config, nameField).String()
+		if name == "" {
+			continue
+		}
+		if _, ok := resourcesByName[name]; !ok {
+			names = append(names, name)
+		}
+		identification := gjson.GetBytes(config, "id").String()
+		if identification == "" {
+			identification = fmt.Sprintf("第 %d 项", i+1)
+		}
+		resourcesByName[name] = append(resourcesByName[name], identification)
+	}
+	var errs []error
+	for _, name := range names {
+		if len(resourcesByName[name]) < 2 {
+			continue
+		}
+		errs = append(errs, fmt.Errorf("%s 名称 %s 重复, 涉及资源: %s",
+			resourceType, name, strings.Join(resourcesByName[name], ", ")))
+	}
+	return errs
+}
@@ -0,0 +1,81 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+func TestCheckNameUniqueness(t *testing.T) {
+	tests := []struct {
+		name         string
+		resourceType constant.APISIXResource
+		resources    []json.RawMessage
+		wantErrs     []string
+	}{
+		{
+			name:         "unique names",
+			resourceType: constant.Route,
+			resources: []json.RawMessage{
+				json.RawMessage(`{"id":"r1","name":"route-a"}`),
+				json.RawMessage(`{"id":"r2","name":"route-b"}`),
+				json.RawMessage(`{"id":"r3"}`),
+				json.RawMessage(`{"id":"r4"}`),
+			},
+		},
+		{
+			name:         "duplicate names",
+			resourceType: constant.Upstream,
+			resources: []json.RawMessage{
+				json.RawMessage(`{"id":"u1","name":"dup"}`),
+				json.RawMessage(`{"id":"u2","name":"other"}`),
+				json.RawMessage(`{"name":"dup"}`),
+				json.RawMessage(`{"id":"u4","name":"other"}`),
+			},
+			wantErrs: []string{
+				"upstream 名称 dup 重复, 涉及资源: u1, 第 3 项",
+				"upstream 名称 other 重复, 涉及资源: u2, u4",
+			},
+		},
+		{
+			name:         "consumer username",
+			resourceType: constant.Consumer,
+			resources: []json.RawMessage{
+				json.RawMessage(`{"username":"jack","name":"x"}`),
+				json.RawMessage(`{"username":"rose","name":"x"}`),
+				json.RawMessage(`{"username":"jack"}`),
+			},
+			wantErrs: []string{"consumer 名称 jack 重复, 涉及资源: 第 1 项, 第 3 项"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := CheckNameUniqueness(tt.resourceType, tt.resources)
+			assert.Len(t, errs, len(tt.wantErrs))
+			for i, err := range errs {
+				assert.EqualError(t, err, tt.wantErrs[i])
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+// normalizeField 导入时需要规范化的字段
+type normalizeField struct {
+	path      string
+	transform func(string) string
+	// sorted 数组元素的顺序没有语义时排序，保证相同配置的 hash 稳定；
+	// nodes、vars、uris 等顺序敏感的数组不能出现在这里
+	sorted bool
+}
+
+// normalizeFields 各资源类型导入时规范化的字段：
+// apisix 对 methods 大小写不敏感、对 host/sni 按小写匹配，而 schema 只接受大写的 methods
+var normalizeFields = map[constant.APISIXResource][]normalizeField{
+	constant.Route: {
+		{path: "methods", transform: strings.ToUpper, sorted: true},
+		{path: "host", transform: strings.ToLower},
+		{path: "hosts", transform: strings.ToLower},
+	},
+	constant.Service: {
+		{path: "hosts", transform: strings.ToLower},
+	},
+	constant.SSL: {
+		{path: "sni", transform: strings.ToLower},
+		{path: "snis", transform: strings.ToLower, sorted: true},
+	},
+	constant.StreamRoute: {
+		{path: "sni", transform: strings.ToLower},
+	},
+}
+
+// NormalizeImportConfig 规范化导入的资源配置：methods 转为大写，host/hosts/sni/snis 转为小写，
+// 多值字段去重，methods、snis 排序；返回规范化后的配置及每一处规范化的说明，未变化的字段不做修改
+func NormalizeImportConfig(
+	resourceType constant.APISIXResource,
+	config json.RawMessage,
+) (json.RawMessage, []string, error) {
+	fields := normalizeFields[resourceType]
+	if len(fields) == 0 || !gjson.ValidBytes(config) {
+		return config, nil, nil
+	}
+	normalized := config
+	var normalizations []string
+	for _, field := range fields {
+		value := gjson.GetBytes(normalized, field.path)
+		after, ok := normalizeFieldValue(value, field)
+		if !ok {
+			continue
+		}
+		var err error
+		if normalized, err = sjson.SetBytes(normalized, field.path, after); err != nil {
+			return nil, nil, fmt.Errorf("规范化字段 %s 失败: %w", field.path, err)
+		}
+		afterRaw, _ := json.Marshal(after)
+		normalizations = append(normalizations, fmt.Sprintf("%s: %s 规范化为 %s", field.path, value.Raw, afterRaw))
+	}
+	return normalized, normalizations, nil
+}
+
+// normalizeFieldValue 规范化单个字段的值，字段不存在、类型不符(交由 schema 校验)或无需规范化时返回 false
+func normalizeFieldValue(value gjson.Result, field normalizeField) (interface{}, bool) {
+	if value.Type == gjson.String {
+		after := field.transform(value.Str)
+		return after, after != value.Str
+	}
+	if !value.IsArray() {
+		return nil, false
+	}
+	items := value.Array()
+	before := make([]string, 0, len(items))
+	after := make([]string, 0, len(items))
+	for _, item := range items {
+		if item.Type != gjson.String {
+			return nil, false
+		}
+		before = append(before, item.Str)
+		// 规范化后可能出现重复项，schema 要求 uniqueItems
+		if transformed := field.transform(item.Str); !slices.Contains(after, transformed) {
+			after = append(after, transformed)
+		}
+	}
+	if field.sorted {
+		slices.Sort(after)
+	}
+	return after, !slices.Equal(before, after)
+}
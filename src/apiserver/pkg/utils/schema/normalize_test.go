@@ -0,0 +1,136 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+func TestNormalizeImportConfig(t *testing.T) {
+	tests := []struct {
+		name           string
+		resourceType   constant.APISIXResource
+		config         string
+		expected       string
+		normalizations []string
+	}{
+		{
+			name:         "route methods uppercased, deduplicated and sorted",
+			resourceType: constant.Route,
+			config:       `{"uri":"/a","methods":["post","get","Post"]}`,
+			expected:     `{"uri":"/a","methods":["GET","POST"]}`,
+			normalizations: []string{
+				`methods: ["post","get","Post"] 规范化为 ["GET","POST"]`,
+			},
+		},
+		{
+			name:         "route hosts lowercased without reordering",
+			resourceType: constant.Route,
+			config:       `{"uri":"/a","hosts":["b.Example.com","A.example.com"]}`,
+			expected:     `{"uri":"/a","hosts":["b.example.com","a.example.com"]}`,
+			normalizations: []string{
+				`hosts: ["b.Example.com","A.example.com"] 规范化为 ["b.example.com","a.example.com"]`,
+			},
+		},
+		{
+			name:           "route host",
+			resourceType:   constant.Route,
+			config:         `{"uri":"/a","host":"*.Example.com"}`,
+			expected:       `{"uri":"/a","host":"*.example.com"}`,
+			normalizations: []string{`host: "*.Example.com" 规范化为 "*.example.com"`},
+		},
+		{
+			name:         "ssl snis lowercased and sorted",
+			resourceType: constant.SSL,
+			config:       `{"snis":["b.example.com","A.example.com"]}`,
+			expected:     `{"snis":["a.example.com","b.example.com"]}`,
+			normalizations: []string{
+				`snis: ["b.example.com","A.example.com"] 规范化为 ["a.example.com","b.example.com"]`,
+			},
+		},
+		{
+			name:         "already normalized",
+			resourceType: constant.Route,
+			config:       `{"uri":"/a","methods":["GET","POST"],"hosts":["b.example.com","a.example.com"]}`,
+			expected:     `{"uri":"/a","methods":["GET","POST"],"hosts":["b.example.com","a.example.com"]}`,
+		},
+		{
+			name:         "invalid type left to schema",
+			resourceType: constant.Route,
+			config:       `{"uri":"/a","methods":"get"}`,
+			expected:     `{"uri":"/a","methods":"GET"}`,
+			normalizations: []string{
+				`methods: "get" 规范化为 "GET"`,
+			},
+		},
+		{
+			name:         "non-string items left to schema",
+			resourceType: constant.Route,
+			config:       `{"uri":"/a","methods":["get",1]}`,
+			expected:     `{"uri":"/a","methods":["get",1]}`,
+		},
+		{
+			name:         "resource type without normalization",
+			resourceType: constant.Upstream,
+			config:       `{"nodes":[{"host":"B.example.com","port":80,"weight":1}]}`,
+			expected:     `{"nodes":[{"host":"B.example.com","port":80,"weight":1}]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			normalized, normalizations, err := NormalizeImportConfig(tt.resourceType, json.RawMessage(tt.config))
+			assert.NoError(t, err)
+			assert.JSONEq(t, tt.expected, string(normalized))
+			assert.Equal(t, tt.normalizations, normalizations)
+
+			// 规范化是幂等的
+			again, normalizations, err := NormalizeImportConfig(tt.resourceType, normalized)
+			assert.NoError(t, err)
+			assert.Equal(t, string(normalized), string(again))
+			assert.Empty(t, normalizations)
+		})
+	}
+}
+
+func TestNormalizeImportConfigKeepsOrderSensitiveArrays(t *testing.T) {
+	config := `{"uris":["/z","/a"],"methods":["put","get"],` +
+		`"vars":[["http_x_b","==","2"],["arg_a","==","1"]],` +
+		`"upstream":{"type":"roundrobin","nodes":[` +
+		`{"host":"2.2.2.2","port":80,"weight":1},{"host":"1.1.1.1","port":80,"weight":1}]}}`
+	normalized, _, err := NormalizeImportConfig(constant.Route, json.RawMessage(config))
+	assert.NoError(t, err)
+	// uris、vars、nodes 的顺序有语义，保持原样，只有 methods 被排序
+	assert.JSONEq(t, `{"uris":["/z","/a"],"methods":["GET","PUT"],`+
+		`"vars":[["http_x_b","==","2"],["arg_a","==","1"]],`+
+		`"upstream":{"type":"roundrobin","nodes":[`+
+		`{"host":"2.2.2.2","port":80,"weight":1},{"host":"1.1.1.1","port":80,"weight":1}]}}`, string(normalized))
+
+	// methods 顺序不同的相同配置规范化后得到相同的配置 hash
+	reordered, _, err := NormalizeImportConfig(constant.Route, json.RawMessage(
+		`{"uris":["/z","/a"],"methods":["GET","put"],"vars":[["http_x_b","==","2"],["arg_a","==","1"]],`+
+			`"upstream":{"type":"roundrobin","nodes":[`+
+			`{"host":"2.2.2.2","port":80,"weight":1},{"host":"1.1.1.1","port":80,"weight":1}]}}`))
+	assert.NoError(t, err)
+	assert.Equal(t, GetResourceIdentifier(normalized), GetResourceIdentifier(reordered))
+}
@@ -0,0 +1,77 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+// ValidatePatch 将 patch 应用到原始资源配置上并校验合并后的配置，校验通过时返回合并后的配置
+// patch 类型根据内容自动识别：数组为 JSON Patch(application/json-patch+json)，
+// 对象为 JSON Merge Patch(application/merge-patch+json)
+func ValidatePatch(
+	version constant.APISIXVersion,
+	resourceType constant.APISIXResource,
+	dataType constant.DataType,
+	original json.RawMessage,
+	patch json.RawMessage,
+) (json.RawMessage, error) {
+	merged, err := applyPatch(original, patch)
+	if err != nil {
+		return nil, err
+	}
+	validator, err := NewAPISIXJsonSchemaValidator(version, resourceType, "main."+resourceType.String(), nil, dataType)
+	if err != nil {
+		return nil, err
+	}
+	if err := validator.Validate(merged); err != nil {
+		return nil, err
+	}
+	return merged, nil
+}
+
+// applyPatch 应用 JSON Patch 或 JSON Merge Patch
+func applyPatch(original json.RawMessage, patch json.RawMessage) (json.RawMessage, error) {
+	trimmed := bytes.TrimSpace(patch)
+	if len(trimmed) == 0 {
+		return nil, fmt.Errorf("patch 不能为空")
+	}
+	if trimmed[0] == '[' {
+		operations, err := jsonpatch.DecodePatch(trimmed)
+		if err != nil {
+			return nil, fmt.Errorf("json patch 解析失败: %w", err)
+		}
+		merged, err := operations.Apply(original)
+		if err != nil {
+			return nil, fmt.Errorf("json patch 应用失败: %w", err)
+		}
+		return merged, nil
+	}
+	merged, err := jsonpatch.MergePatch(original, trimmed)
+	if err != nil {
+		return nil, fmt.Errorf("merge patch 应用失败: %w", err)
+	}
+	return merged, nil
+}
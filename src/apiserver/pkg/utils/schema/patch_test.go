@@ -0,0 +1,99 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+func TestValidatePatch(t *testing.T) {
+	original := json.RawMessage(`{
+		"id": "r1",
+		"name": "route1",
+		"uri": "/get",
+		"methods": ["GET"],
+		"upstream": {"type": "roundrobin", "nodes": {"httpbin.org:80": 1}}
+	}`)
+	tests := []struct {
+		name       string
+		patch      string
+		shouldFail bool
+		check      func(t *testing.T, merged json.RawMessage)
+	}{
+		{
+			name:  "valid merge patch",
+			patch: `{"methods": ["GET", "POST"], "name": null, "desc": "patched"}`,
+			check: func(t *testing.T, merged json.RawMessage) {
+				var route map[string]interface{}
+				assert.NoError(t, json.Unmarshal(merged, &route))
+				assert.Equal(t, []interface{}{"GET", "POST"}, route["methods"])
+				assert.Equal(t, "patched", route["desc"])
+				assert.NotContains(t, route, "name")
+			},
+		},
+		{
+			name:  "valid json patch",
+			patch: `[{"op": "replace", "path": "/uri", "value": "/post"}, {"op": "add", "path": "/priority", "value": 1}]`,
+			check: func(t *testing.T, merged json.RawMessage) {
+				var route map[string]interface{}
+				assert.NoError(t, json.Unmarshal(merged, &route))
+				assert.Equal(t, "/post", route["uri"])
+				assert.Equal(t, float64(1), route["priority"])
+			},
+		},
+		{
+			name:       "merge patch produces invalid resource",
+			patch:      `{"methods": ["UNKNOWN"]}`,
+			shouldFail: true,
+		},
+		{
+			name:       "json patch produces invalid resource",
+			patch:      `[{"op": "replace", "path": "/uri", "value": 1}]`,
+			shouldFail: true,
+		},
+		{
+			name:       "json patch on missing path",
+			patch:      `[{"op": "replace", "path": "/not_exist", "value": 1}]`,
+			shouldFail: true,
+		},
+		{
+			name:       "empty patch",
+			patch:      ` `,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merged, err := ValidatePatch(constant.APISIXVersion311, constant.Route, constant.DATABASE,
+				original, json.RawMessage(tt.patch))
+			if tt.shouldFail {
+				assert.Error(t, err)
+				assert.Nil(t, merged)
+				return
+			}
+			assert.NoError(t, err)
+			tt.check(t, merged)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+// pluginAvailabilityResources 校验时需要检查插件在目标版本中是否可用的资源类型
+var pluginAvailabilityResources = map[constant.APISIXResource]bool{
+	constant.Route:        true,
+	constant.Service:      true,
+	constant.Consumer:     true,
+	constant.PluginConfig: true,
+}
+
+// PluginUnavailableError 插件在目标版本中不可用
+type PluginUnavailableError struct {
+	Plugin  string
+	Version constant.APISIXVersion
+	// MinVersion 高于目标版本且内置该插件的最低版本，插件只存在于更低版本(已废弃或更名)或未知时为空
+	MinVersion constant.APISIXVersion
+}
+
+// Error ...
+func (e *PluginUnavailableError) Error() string {
+	if e.MinVersion != "" {
+		return fmt.Sprintf("插件 %s 在 %s 中不可用, 最低支持版本为 %s", e.Plugin, e.Version, e.MinVersion)
+	}
+	return fmt.Sprintf("插件 %s 在 %s 中不可用", e.Plugin, e.Version)
+}
+
+// IsBundledPlugin 插件是否为指定版本内置的插件
+func IsBundledPlugin(version constant.APISIXVersion, pluginName string) bool {
+	_, ok := activeSchemas().bundledPlugins[version][pluginName]
+	return ok
+}
+
+// AvailablePlugins 列出指定版本内置的插件名称，按名称排序
+func AvailablePlugins(version constant.APISIXVersion) ([]string, error) {
+	plugins, ok := activeSchemas().bundledPlugins[version]
+	if !ok {
+		return nil, fmt.Errorf("不支持的 apisix 版本: %s", version)
+	}
+	names := make([]string, 0, len(plugins))
+	for name := range plugins {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names, nil
+}
+
+// ValidatePluginAvailability 校验插件在指定版本中是否可用，不可用时返回 *PluginUnavailableError，
+// 若更高的版本内置了该插件则给出最低支持版本
+func ValidatePluginAvailability(version constant.APISIXVersion, pluginName string) error {
+	if IsBundledPlugin(version, pluginName) {
+		return nil
+	}
+	err := &PluginUnavailableError{Plugin: pluginName, Version: version}
+	for _, candidate := range SupportedSchemaVersions() {
+		if compareVersion(candidate, string(version)) > 0 &&
+			IsBundledPlugin(constant.APISIXVersion(candidate), pluginName) {
+			err.MinVersion = constant.APISIXVersion(candidate)
+			break
+		}
+	}
+	return err
+}
@@ -0,0 +1,101 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+func TestValidatePluginAvailability(t *testing.T) {
+	tests := []struct {
+		name    string
+		version constant.APISIXVersion
+		plugin  string
+		wantErr string
+	}{
+		{name: "bundled", version: constant.APISIXVersion32, plugin: "limit-count"},
+		{name: "bk-apisix plugin", version: constant.APISIXVersion313, plugin: "bk-jwt"},
+		{
+			name:    "only in newer version",
+			version: constant.APISIXVersion32,
+			plugin:  "ai-proxy",
+			wantErr: "插件 ai-proxy 在 3.2.X 中不可用, 最低支持版本为 3.13.X",
+		},
+		{
+			name:    "removed in newer version",
+			version: constant.APISIXVersion313,
+			plugin:  "server-info",
+			wantErr: "插件 server-info 在 3.13.X 中不可用",
+		},
+		{
+			name:    "unknown",
+			version: constant.APISIXVersion311,
+			plugin:  "not-exist-plugin",
+			wantErr: "插件 not-exist-plugin 在 3.11.X 中不可用",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePluginAvailability(tt.version, tt.plugin)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestAvailablePlugins(t *testing.T) {
+	plugins, err := AvailablePlugins(constant.APISIXVersion313)
+	assert.NoError(t, err)
+	assert.IsNonDecreasing(t, plugins)
+	assert.Contains(t, plugins, "ai-proxy")
+	assert.Contains(t, plugins, "bk-jwt")
+
+	plugins, err = AvailablePlugins(constant.APISIXVersion32)
+	assert.NoError(t, err)
+	assert.NotContains(t, plugins, "ai-proxy")
+
+	_, err = AvailablePlugins("9.9.X")
+	assert.Error(t, err)
+}
+
+func TestValidateNewerVersionPlugin(t *testing.T) {
+	config := json.RawMessage(`{"id":"r1","uri":"/a","upstream_id":"u1",` +
+		`"plugins":{"ai-proxy":{"provider":"openai","auth":{"header":{"Authorization":"Bearer x"}}}}}`)
+	for _, resourceType := range []constant.APISIXResource{constant.Route, constant.PluginConfig} {
+		validator, err := NewAPISIXJsonSchemaValidator(constant.APISIXVersion32, resourceType,
+			"main."+resourceType.String(), nil, constant.DATABASE)
+		assert.NoError(t, err)
+		err = validator.Validate(config)
+		assert.ErrorContains(t, err, "资源: r1 schema 验证失败: 插件 ai-proxy 在 3.2.X 中不可用, 最低支持版本为 3.13.X")
+	}
+
+	// 自定义插件 schema 中存在的插件不受版本限制
+	validator, err := NewAPISIXJsonSchemaValidator(constant.APISIXVersion32, constant.Route, "main.route",
+		map[string]interface{}{"ai-proxy": map[string]interface{}{"type": "object"}}, constant.DATABASE)
+	assert.NoError(t, err)
+	assert.NoError(t, validator.Validate(config))
+}
@@ -0,0 +1,110 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// NumericBound 数值字段的取值范围(闭区间)，不限制的一侧使用 math.Inf
+type NumericBound struct {
+	Min float64
+	Max float64
+}
+
+// PluginNumericBounds 插件数值字段的额外取值范围：
+// 部分版本或定制插件的 schema 未声明 minimum/maximum，apisix 运行时却会拒绝或表现异常，
+// key 为插件名，字段支持 a.b 形式的嵌套路径
+var PluginNumericBounds = map[string]map[string]NumericBound{
+	"limit-count": {
+		"count":         {Min: 1, Max: math.Inf(1)},
+		"time_window":   {Min: 1, Max: math.Inf(1)},
+		"rejected_code": {Min: 200, Max: 599},
+	},
+	"limit-req": {
+		"burst":         {Min: 0, Max: math.Inf(1)},
+		"rejected_code": {Min: 200, Max: 599},
+	},
+	"limit-conn": {
+		"conn":          {Min: 1, Max: math.Inf(1)},
+		"burst":         {Min: 0, Max: math.Inf(1)},
+		"rejected_code": {Min: 200, Max: 599},
+	},
+	"api-breaker": {
+		"break_response_code": {Min: 200, Max: 599},
+		"max_breaker_sec":     {Min: 3, Max: math.Inf(1)},
+	},
+	"client-control": {
+		"max_body_size": {Min: 0, Max: math.Inf(1)},
+	},
+}
+
+// CheckPluginNumericBounds 检查插件数值字段是否在 PluginNumericBounds 的范围内，在 schema 校验之后执行；
+// 非数值类型由 schema 校验负责，这里跳过
+func CheckPluginNumericBounds(plugins map[string]interface{}) error {
+	var errs []string
+	for name, pluginConf := range plugins {
+		conf, _ := pluginConf.(map[string]interface{})
+		for field, bound := range PluginNumericBounds[name] {
+			value, ok := numericField(conf, field)
+			if !ok {
+				continue
+			}
+			switch {
+			case value < bound.Min:
+				errs = append(errs, fmt.Sprintf("插件 %s 的字段 %s 值为 %v, 需 >= %v", name, field, value, bound.Min))
+			case value > bound.Max:
+				errs = append(errs, fmt.Sprintf("插件 %s 的字段 %s 值为 %v, 需 <= %v", name, field, value, bound.Max))
+			}
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	sort.Strings(errs)
+	return fmt.Errorf("%s", strings.Join(errs, "; "))
+}
+
+// numericField 按 a.b 形式的路径读取数值字段
+func numericField(conf map[string]interface{}, path string) (float64, bool) {
+	keys := strings.Split(path, ".")
+	for _, key := range keys[:len(keys)-1] {
+		next, ok := conf[key].(map[string]interface{})
+		if !ok {
+			return 0, false
+		}
+		conf = next
+	}
+	switch value := conf[keys[len(keys)-1]].(type) {
+	case float64:
+		return value, true
+	case int:
+		return float64(value), true
+	case int64:
+		return float64(value), true
+	case json.Number:
+		f, err := value.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
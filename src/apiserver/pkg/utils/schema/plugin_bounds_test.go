@@ -0,0 +1,93 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+func TestCheckPluginNumericBounds(t *testing.T) {
+	tests := []struct {
+		name    string
+		plugins map[string]interface{}
+		wantErr string
+	}{
+		{
+			name: "valid count",
+			plugins: map[string]interface{}{
+				"limit-count": map[string]interface{}{"count": float64(1), "time_window": float64(60)},
+			},
+		},
+		{
+			name: "count below minimum",
+			plugins: map[string]interface{}{
+				"limit-count": map[string]interface{}{"count": float64(0), "time_window": float64(60)},
+			},
+			wantErr: "插件 limit-count 的字段 count 值为 0, 需 >= 1",
+		},
+		{
+			name: "rejected_code above maximum",
+			plugins: map[string]interface{}{
+				"limit-req": map[string]interface{}{"rate": float64(1), "burst": 0, "rejected_code": 600},
+			},
+			wantErr: "插件 limit-req 的字段 rejected_code 值为 600, 需 <= 599",
+		},
+		{
+			name: "non-numeric value left to schema",
+			plugins: map[string]interface{}{
+				"limit-count": map[string]interface{}{"count": "0"},
+			},
+		},
+		{
+			name: "plugin without bounds",
+			plugins: map[string]interface{}{
+				"proxy-rewrite": map[string]interface{}{"uri": "/new"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckPluginNumericBounds(tt.plugins)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateLimitCountBounds(t *testing.T) {
+	validator, err := NewAPISIXJsonSchemaValidator(
+		constant.APISIXVersion313, constant.PluginConfig, "main.plugin_config", nil, constant.DATABASE)
+	assert.NoError(t, err)
+
+	err = validator.Validate(json.RawMessage(
+		`{"id":"pc1","plugins":{"limit-count":{"count":0,"time_window":60}}}`))
+	assert.ErrorContains(t, err, "count")
+
+	err = validator.Validate(json.RawMessage(
+		`{"id":"pc1","plugins":{"limit-count":{"count":10,"time_window":60}}}`))
+	assert.NoError(t, err)
+}
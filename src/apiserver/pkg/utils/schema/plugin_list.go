@@ -0,0 +1,94 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/tidwall/gjson"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+// PluginInfo 插件信息
+type PluginInfo struct {
+	Name string `json:"name"`
+	// Type 插件分类(authentication、traffic、observability 等)，插件描述中未声明时为空
+	Type   string          `json:"type"`
+	Schema json.RawMessage `json:"schema"`
+}
+
+// ListPlugins 列出指定版本内置 schema 中的全部插件(包括 bk-apisix、tapisix 插件)，按名称排序
+func ListPlugins(version constant.APISIXVersion) ([]PluginInfo, error) {
+	set := activeSchemas()
+	apisixSchema, ok := set.apisix[version]
+	if !ok {
+		return nil, fmt.Errorf("不支持的 apisix 版本: %s", version)
+	}
+	pluginTypes, err := pluginTypeMap(version)
+	if err != nil {
+		return nil, err
+	}
+
+	plugins := make(map[string]json.RawMessage)
+	// 与 GetPluginSchema 的查找顺序一致：apisix 插件优先，其次 bk-apisix、tapisix 插件
+	for _, source := range []gjson.Result{
+		set.tapisix[version],
+		set.bkAPISIX[version],
+		apisixSchema,
+	} {
+		source.Get("plugins").ForEach(func(name, value gjson.Result) bool {
+			plugins[name.String()] = json.RawMessage(value.Get("schema").Raw)
+			return true
+		})
+	}
+
+	infos := make([]PluginInfo, 0, len(plugins))
+	for name, schema := range plugins {
+		infos = append(infos, PluginInfo{Name: name, Type: pluginTypes[name], Schema: schema})
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].Name < infos[j].Name
+	})
+	return infos, nil
+}
+
+// pluginTypeMap 根据插件描述文件获取插件名称到插件分类的映射
+func pluginTypeMap(version constant.APISIXVersion) (map[string]string, error) {
+	types := make(map[string]string)
+	for _, raw := range [][]byte{
+		versionTAPISIXPluginMap[version],
+		versionBkAPISIXPluginMap[version],
+		versionPluginMap[version],
+	} {
+		if raw == nil {
+			continue
+		}
+		var plugins []*Plugin
+		if err := json.Unmarshal(raw, &plugins); err != nil {
+			return nil, err
+		}
+		for _, plugin := range plugins {
+			types[plugin.Name] = plugin.Type
+		}
+	}
+	return types, nil
+}
@@ -0,0 +1,59 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+func TestListPlugins(t *testing.T) {
+	for _, version := range APISIXVersionList {
+		t.Run(string(version), func(t *testing.T) {
+			plugins, err := ListPlugins(version)
+			assert.NoError(t, err)
+			assert.NotEmpty(t, plugins)
+			assert.True(t, sort.SliceIsSorted(plugins, func(i, j int) bool {
+				return plugins[i].Name < plugins[j].Name
+			}))
+			for _, plugin := range plugins {
+				assert.NotEmpty(t, plugin.Name)
+				assert.NotEmpty(t, plugin.Schema, plugin.Name)
+			}
+		})
+	}
+
+	plugins, err := ListPlugins(constant.APISIXVersion311)
+	assert.NoError(t, err)
+	types := make(map[string]string, len(plugins))
+	for _, plugin := range plugins {
+		types[plugin.Name] = plugin.Type
+	}
+	assert.Equal(t, "authentication", types["key-auth"])
+	assert.Equal(t, "traffic", types["limit-count"])
+	assert.Equal(t, "observability", types["prometheus"])
+	assert.Contains(t, types, "bk-traffic-label")
+
+	_, err = ListPlugins("invalid_version")
+	assert.Error(t, err)
+}
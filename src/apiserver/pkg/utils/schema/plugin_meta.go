@@ -0,0 +1,63 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// AllowedPluginMetaKeys 插件 _meta 支持的字段，其余字段会被 apisix 静默忽略
+var AllowedPluginMetaKeys = map[string]bool{
+	"disable":        true,
+	"error_response": true,
+	"priority":       true,
+	"filter":         true,
+}
+
+// CheckPluginMetaKeys 检查插件 _meta 中是否包含不支持的字段，避免拼写错误被静默忽略
+func CheckPluginMetaKeys(plugins map[string]interface{}) error {
+	var errs []string
+	for name, pluginConf := range plugins {
+		conf, _ := pluginConf.(map[string]interface{})
+		var unknownKeys []string
+		for key := range pluginMeta(conf) {
+			if !AllowedPluginMetaKeys[key] {
+				unknownKeys = append(unknownKeys, key)
+			}
+		}
+		if len(unknownKeys) == 0 {
+			continue
+		}
+		sort.Strings(unknownKeys)
+		errs = append(errs, fmt.Sprintf("插件 %s 的 _meta 包含不支持的字段: %s",
+			name, strings.Join(unknownKeys, ", ")))
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	sort.Strings(errs)
+	allowedKeys := make([]string, 0, len(AllowedPluginMetaKeys))
+	for key := range AllowedPluginMetaKeys {
+		allowedKeys = append(allowedKeys, key)
+	}
+	sort.Strings(allowedKeys)
+	return fmt.Errorf("%s, 支持的字段: %s", strings.Join(errs, "; "), strings.Join(allowedKeys, ", "))
+}
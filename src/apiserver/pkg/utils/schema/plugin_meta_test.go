@@ -0,0 +1,88 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckPluginMetaKeys(t *testing.T) {
+	tests := []struct {
+		name    string
+		plugins map[string]interface{}
+		wantErr string
+	}{
+		{
+			name: "valid _meta",
+			plugins: map[string]interface{}{
+				"proxy-rewrite": map[string]interface{}{
+					"uri": "/new",
+					"_meta": map[string]interface{}{
+						"disable":        false,
+						"priority":       100,
+						"filter":         []interface{}{[]interface{}{"arg_version", "==", "v2"}},
+						"error_response": map[string]interface{}{"message": "error"},
+					},
+				},
+			},
+		},
+		{
+			name: "no _meta",
+			plugins: map[string]interface{}{
+				"proxy-rewrite": map[string]interface{}{"uri": "/new"},
+			},
+		},
+		{
+			name: "typo key",
+			plugins: map[string]interface{}{
+				"proxy-rewrite": map[string]interface{}{
+					"uri":   "/new",
+					"_meta": map[string]interface{}{"priorty": 100},
+				},
+			},
+			wantErr: "插件 proxy-rewrite 的 _meta 包含不支持的字段: priorty, " +
+				"支持的字段: disable, error_response, filter, priority",
+		},
+		{
+			name: "multiple plugins",
+			plugins: map[string]interface{}{
+				"limit-count": map[string]interface{}{
+					"_meta": map[string]interface{}{"disabled": true},
+				},
+				"proxy-rewrite": map[string]interface{}{
+					"_meta": map[string]interface{}{"filters": []interface{}{}, "priority": 1},
+				},
+			},
+			wantErr: "插件 limit-count 的 _meta 包含不支持的字段: disabled; " +
+				"插件 proxy-rewrite 的 _meta 包含不支持的字段: filters",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckPluginMetaKeys(tt.plugins)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"fmt"
+
+	"github.com/spf13/cast"
+)
+
+// ambiguousOrderPlugins 同时启用时执行顺序会影响结果的插件组合
+// serverless 函数可能改写请求，与 proxy-rewrite 的改写结果依赖执行顺序
+var ambiguousOrderPlugins = [][2]string{
+	{"serverless-pre-function", "proxy-rewrite"},
+}
+
+// CheckPluginOrderWarnings 检查执行顺序存在歧义的插件组合，返回告警信息
+// 两个插件都通过 _meta.priority 显式指定了不同的优先级时视为顺序明确
+func CheckPluginOrderWarnings(plugins map[string]interface{}) []string {
+	var warnings []string
+	for _, pair := range ambiguousOrderPlugins {
+		first, ok := enabledPluginConf(plugins, pair[0])
+		if !ok {
+			continue
+		}
+		second, ok := enabledPluginConf(plugins, pair[1])
+		if !ok {
+			continue
+		}
+		firstPriority, firstOK := pluginMetaPriority(first)
+		secondPriority, secondOK := pluginMetaPriority(second)
+		if firstOK && secondOK && firstPriority != secondPriority {
+			continue
+		}
+		warnings = append(warnings, fmt.Sprintf(
+			"插件 %s 与 %s 同时启用但未通过 _meta.priority 指定不同的优先级, 请求改写结果依赖执行顺序",
+			pair[0], pair[1],
+		))
+	}
+	return warnings
+}
+
+// enabledPluginConf 获取启用状态的插件配置
+func enabledPluginConf(plugins map[string]interface{}, name string) (map[string]interface{}, bool) {
+	pluginConf, ok := plugins[name]
+	if !ok {
+		return nil, false
+	}
+	conf, _ := pluginConf.(map[string]interface{})
+	// 兼容旧版本插件配置中的 disable 字段
+	if disable, ok := conf["disable"].(bool); ok && disable {
+		return nil, false
+	}
+	if disable, ok := pluginMeta(conf)["disable"].(bool); ok && disable {
+		return nil, false
+	}
+	return conf, true
+}
+
+// pluginMeta 获取插件 _meta 配置
+func pluginMeta(conf map[string]interface{}) map[string]interface{} {
+	meta, _ := conf["_meta"].(map[string]interface{})
+	return meta
+}
+
+// pluginMetaPriority 获取插件 _meta.priority
+func pluginMetaPriority(conf map[string]interface{}) (int, bool) {
+	priority, ok := pluginMeta(conf)["priority"]
+	if !ok {
+		return 0, false
+	}
+	value, err := cast.ToIntE(priority)
+	return value, err == nil
+}
@@ -0,0 +1,99 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckPluginOrderWarnings(t *testing.T) {
+	serverless := func(meta map[string]interface{}) map[string]interface{} {
+		conf := map[string]interface{}{"phase": "rewrite", "functions": []interface{}{"return function() end"}}
+		if meta != nil {
+			conf["_meta"] = meta
+		}
+		return conf
+	}
+	proxyRewrite := func(meta map[string]interface{}) map[string]interface{} {
+		conf := map[string]interface{}{"uri": "/new"}
+		if meta != nil {
+			conf["_meta"] = meta
+		}
+		return conf
+	}
+	tests := []struct {
+		name     string
+		plugins  map[string]interface{}
+		warnings int
+	}{
+		{
+			name: "ambiguous without priority",
+			plugins: map[string]interface{}{
+				"serverless-pre-function": serverless(nil),
+				"proxy-rewrite":           proxyRewrite(nil),
+			},
+			warnings: 1,
+		},
+		{
+			name: "ambiguous with only one priority",
+			plugins: map[string]interface{}{
+				"serverless-pre-function": serverless(map[string]interface{}{"priority": 100}),
+				"proxy-rewrite":           proxyRewrite(nil),
+			},
+			warnings: 1,
+		},
+		{
+			name: "ambiguous with same priority",
+			plugins: map[string]interface{}{
+				"serverless-pre-function": serverless(map[string]interface{}{"priority": 100}),
+				"proxy-rewrite":           proxyRewrite(map[string]interface{}{"priority": 100}),
+			},
+			warnings: 1,
+		},
+		{
+			name: "explicitly ordered",
+			plugins: map[string]interface{}{
+				"serverless-pre-function": serverless(map[string]interface{}{"priority": 2000}),
+				"proxy-rewrite":           proxyRewrite(map[string]interface{}{"priority": float64(1000)}),
+			},
+		},
+		{
+			name: "one of them disabled",
+			plugins: map[string]interface{}{
+				"serverless-pre-function": serverless(map[string]interface{}{"disable": true}),
+				"proxy-rewrite":           proxyRewrite(nil),
+			},
+		},
+		{
+			name: "only proxy-rewrite",
+			plugins: map[string]interface{}{
+				"proxy-rewrite": proxyRewrite(nil),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			warnings := CheckPluginOrderWarnings(tt.plugins)
+			assert.Len(t, warnings, tt.warnings)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/xeipuuv/gojsonschema"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	log "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
+)
+
+// PluginSchemaValidator 单个插件配置的校验器，用于插件配置编辑时的即时校验
+type PluginSchemaValidator struct {
+	schema     *gojsonschema.Schema
+	version    constant.APISIXVersion
+	pluginName string
+}
+
+// NewPluginSchemaValidator 创建 PluginSchemaValidator，插件在该版本中不存在时返回错误
+func NewPluginSchemaValidator(version constant.APISIXVersion, pluginName string) (*PluginSchemaValidator, error) {
+	if _, ok := activeSchemas().apisix[version]; !ok {
+		return nil, fmt.Errorf("不支持的 apisix 版本: %s", version)
+	}
+	schemaValue := GetPluginSchema(version, pluginName, "schema")
+	if schemaValue == nil {
+		return nil, fmt.Errorf("插件 %s 在 apisix %s 中不存在", pluginName, version)
+	}
+	s, err := gojsonschema.NewSchema(gojsonschema.NewGoLoader(schemaValue))
+	if err != nil {
+		log.Warnf("new plugin schema failed: %s, %v", pluginName, err)
+		return nil, fmt.Errorf("插件:%s 实例化 schema 失败: %w", pluginName, err)
+	}
+	return &PluginSchemaValidator{
+		schema:     s,
+		version:    version,
+		pluginName: pluginName,
+	}, nil
+}
+
+// Validate 校验插件配置，与资源校验中的插件校验规则一致
+func (v *PluginSchemaValidator) Validate(config json.RawMessage) error {
+	var conf map[string]interface{}
+	if err := json.Unmarshal(config, &conf); err != nil || conf == nil {
+		return fmt.Errorf("插件:%s 配置必须为 json 对象", v.pluginName)
+	}
+	// disable 为 bool 时不参与 schema 校验
+	if disable, ok := conf["disable"].(bool); ok {
+		delete(conf, "disable")
+		defer func() { conf["disable"] = disable }()
+	}
+	ret, err := v.schema.Validate(gojsonschema.NewGoLoader(conf))
+	if err != nil {
+		return fmt.Errorf("插件:%s schema 验证失败: %w", v.pluginName, err)
+	}
+	if !ret.Valid() {
+		return fmt.Errorf("插件:%s schema 验证失败: %s", v.pluginName, GetSchemaValidateFailed(ret))
+	}
+	return CheckPluginNumericBounds(map[string]interface{}{v.pluginName: conf})
+}
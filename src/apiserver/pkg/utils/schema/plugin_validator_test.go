@@ -0,0 +1,102 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+func TestNewPluginSchemaValidator(t *testing.T) {
+	_, err := NewPluginSchemaValidator(constant.APISIXVersion311, "not-exist-plugin")
+	assert.ErrorContains(t, err, "插件 not-exist-plugin 在 apisix 3.11.X 中不存在")
+
+	_, err = NewPluginSchemaValidator("1.0.X", "limit-count")
+	assert.ErrorContains(t, err, "不支持的 apisix 版本: 1.0.X")
+
+	for _, version := range APISIXVersionList {
+		validator, err := NewPluginSchemaValidator(version, "limit-count")
+		assert.NoError(t, err, version)
+		assert.NotNil(t, validator, version)
+	}
+}
+
+func TestPluginSchemaValidatorValidate(t *testing.T) {
+	// schema 已覆盖默认的数值范围，临时收紧 count 的上限以验证 schema 之后的范围检查
+	countBound := PluginNumericBounds["limit-count"]["count"]
+	PluginNumericBounds["limit-count"]["count"] = NumericBound{Min: 1, Max: 100}
+	defer func() { PluginNumericBounds["limit-count"]["count"] = countBound }()
+
+	tests := []struct {
+		name       string
+		pluginName string
+		config     string
+		wantErr    string
+	}{
+		{
+			name:       "valid limit-count",
+			pluginName: "limit-count",
+			config:     `{"count": 10, "time_window": 60}`,
+		},
+		{
+			name:       "valid with bool disable",
+			pluginName: "limit-count",
+			config:     `{"count": 10, "time_window": 60, "disable": true}`,
+		},
+		{
+			name:       "missing required field",
+			pluginName: "limit-count",
+			config:     `{"count": 10}`,
+			wantErr:    "插件:limit-count schema 验证失败",
+		},
+		{
+			name:       "numeric bound after schema",
+			pluginName: "limit-count",
+			config:     `{"count": 101, "time_window": 60}`,
+			wantErr:    "插件 limit-count 的字段 count 值为 101, 需 <= 100",
+		},
+		{
+			name:       "valid key-auth",
+			pluginName: "key-auth",
+			config:     `{}`,
+		},
+		{
+			name:       "not an object",
+			pluginName: "key-auth",
+			config:     `[]`,
+			wantErr:    "插件:key-auth 配置必须为 json 对象",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validator, err := NewPluginSchemaValidator(constant.APISIXVersion311, tt.pluginName)
+			assert.NoError(t, err)
+			err = validator.Validate(json.RawMessage(tt.config))
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
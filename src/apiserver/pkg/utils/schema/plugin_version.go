@@ -0,0 +1,188 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/samber/lo"
+	"github.com/tidwall/gjson"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+// CheckPluginVersionChanges 检查配置中的插件从 from 版本迁移到 to 版本时的变化，返回迁移提示
+// 包括目标版本中已移除(或更名)的插件、schema 发生变更的插件
+func CheckPluginVersionChanges(config json.RawMessage, from, to constant.APISIXVersion) []string {
+	if from == to {
+		return nil
+	}
+	plugins := gjson.GetBytes(config, "plugins").Map()
+	names := lo.Keys(plugins)
+	sort.Strings(names)
+
+	var changes []string
+	for _, name := range names {
+		fromSchema := GetPluginSchema(from, name, "schema")
+		if fromSchema == nil {
+			// 源版本中不存在的插件由 schema 校验处理
+			continue
+		}
+		toSchema := GetPluginSchema(to, name, "schema")
+		if toSchema == nil {
+			changes = append(changes, fmt.Sprintf("插件 %s 在 %s 中已移除或更名", name, to))
+			continue
+		}
+		if reflect.DeepEqual(fromSchema, toSchema) {
+			continue
+		}
+		changes = append(changes, describePluginSchemaChange(name, plugins[name], fromSchema, toSchema, from, to))
+	}
+	return changes
+}
+
+// describePluginSchemaChange 描述插件 schema 的变更，优先给出配置中受影响的字段
+func describePluginSchemaChange(
+	name string,
+	pluginConf gjson.Result,
+	fromSchema, toSchema interface{},
+	from, to constant.APISIXVersion,
+) string {
+	fromProperties := schemaProperties(fromSchema)
+	toProperties := schemaProperties(toSchema)
+	var details []string
+
+	// 配置中使用了但目标版本已移除的字段
+	var removed []string
+	pluginConf.ForEach(func(key, _ gjson.Result) bool {
+		field := key.String()
+		if _, ok := fromProperties[field]; ok {
+			if _, ok = toProperties[field]; !ok {
+				removed = append(removed, field)
+			}
+		}
+		return true
+	})
+	if len(removed) > 0 {
+		sort.Strings(removed)
+		details = append(details, "已移除字段: "+strings.Join(removed, ", "))
+	}
+
+	// 目标版本新增的必填字段且配置中未设置
+	fromRequired := schemaRequired(fromSchema)
+	var missing []string
+	for _, field := range schemaRequired(toSchema) {
+		if !lo.Contains(fromRequired, field) && !pluginConf.Get(gjson.Escape(field)).Exists() {
+			missing = append(missing, field)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		details = append(details, "新增必填字段: "+strings.Join(missing, ", "))
+	}
+
+	if len(details) == 0 {
+		return fmt.Sprintf("插件 %s 的 schema 在 %s 与 %s 之间存在变更, 请确认配置是否仍然有效", name, from, to)
+	}
+	return fmt.Sprintf("插件 %s 的 schema 在 %s 与 %s 之间存在变更, %s", name, from, to, strings.Join(details, "; "))
+}
+
+// CheckUnsupportedPluginFields 检查配置中插件使用的字段是否在目标版本的插件 schema 中定义
+// 未定义的字段在 schema 未禁止额外字段时会被 apisix 静默忽略，返回这些字段的提示
+func CheckUnsupportedPluginFields(config json.RawMessage, version constant.APISIXVersion) []string {
+	plugins := gjson.GetBytes(config, "plugins").Map()
+	names := lo.Keys(plugins)
+	sort.Strings(names)
+
+	var unsupported []string
+	for _, name := range names {
+		pluginSchema := GetPluginSchema(version, name, "schema")
+		if pluginSchema == nil || allowsArbitraryFields(pluginSchema) {
+			// 目标版本中不存在的插件由 schema 校验处理
+			continue
+		}
+		fields := schemaFieldNames(pluginSchema)
+		var missing []string
+		plugins[name].ForEach(func(key, _ gjson.Result) bool {
+			if _, ok := fields[key.String()]; !ok {
+				missing = append(missing, key.String())
+			}
+			return true
+		})
+		if len(missing) == 0 {
+			continue
+		}
+		sort.Strings(missing)
+		unsupported = append(unsupported, fmt.Sprintf("插件 %s 的字段 %s 在 %s 中不受支持, 配置将不会生效",
+			name, strings.Join(missing, ", "), version))
+	}
+	return unsupported
+}
+
+// allowsArbitraryFields schema 显式允许任意字段时无法判断字段是否受支持
+func allowsArbitraryFields(schema interface{}) bool {
+	schemaMap, _ := schema.(map[string]interface{})
+	if additional, ok := schemaMap["additionalProperties"].(bool); ok && additional {
+		return true
+	}
+	_, ok := schemaMap["patternProperties"]
+	return ok
+}
+
+// schemaFieldNames 获取 schema 顶层可用字段，包括 oneOf/anyOf/allOf 分支中定义的字段
+func schemaFieldNames(schema interface{}) map[string]struct{} {
+	fields := make(map[string]struct{})
+	for field := range schemaProperties(schema) {
+		fields[field] = struct{}{}
+	}
+	schemaMap, _ := schema.(map[string]interface{})
+	for _, keyword := range []string{"oneOf", "anyOf", "allOf"} {
+		branches, _ := schemaMap[keyword].([]interface{})
+		for _, branch := range branches {
+			for field := range schemaFieldNames(branch) {
+				fields[field] = struct{}{}
+			}
+		}
+	}
+	return fields
+}
+
+// schemaProperties 获取 schema 顶层 properties
+func schemaProperties(schema interface{}) map[string]interface{} {
+	schemaMap, _ := schema.(map[string]interface{})
+	properties, _ := schemaMap["properties"].(map[string]interface{})
+	return properties
+}
+
+// schemaRequired 获取 schema 顶层 required
+func schemaRequired(schema interface{}) []string {
+	schemaMap, _ := schema.(map[string]interface{})
+	required, _ := schemaMap["required"].([]interface{})
+	fields := make([]string, 0, len(required))
+	for _, field := range required {
+		if s, ok := field.(string); ok {
+			fields = append(fields, s)
+		}
+	}
+	return fields
+}
@@ -0,0 +1,155 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+func TestCheckPluginVersionChanges(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  string
+		from    constant.APISIXVersion
+		to      constant.APISIXVersion
+		changes []string
+	}{
+		{
+			name:   "server-info removed in 3.13",
+			config: `{"plugins":{"server-info":{},"limit-count":{"count":1,"time_window":60}}}`,
+			from:   constant.APISIXVersion311,
+			to:     constant.APISIXVersion313,
+			changes: []string{
+				"插件 server-info 在 3.13.X 中已移除或更名",
+			},
+		},
+		{
+			name:   "loggly schema changed in 3.11",
+			config: `{"plugins":{"loggly":{"customer_token":"token"}}}`,
+			from:   constant.APISIXVersion33,
+			to:     constant.APISIXVersion311,
+			changes: []string{
+				"插件 loggly 的 schema 在 3.3.X 与 3.11.X 之间存在变更, 请确认配置是否仍然有效",
+			},
+		},
+		{
+			name:   "unchanged plugin",
+			config: `{"plugins":{"limit-count":{"count":1,"time_window":60}}}`,
+			from:   constant.APISIXVersion32,
+			to:     constant.APISIXVersion313,
+		},
+		{
+			name:   "same version",
+			config: `{"plugins":{"server-info":{}}}`,
+			from:   constant.APISIXVersion311,
+			to:     constant.APISIXVersion311,
+		},
+		{
+			name:   "no plugins",
+			config: `{"uri":"/test"}`,
+			from:   constant.APISIXVersion311,
+			to:     constant.APISIXVersion313,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.changes, CheckPluginVersionChanges([]byte(tt.config), tt.from, tt.to))
+		})
+	}
+}
+
+func TestDescribePluginSchemaChange(t *testing.T) {
+	fromSchema := map[string]interface{}{
+		"properties": map[string]interface{}{
+			"host":    map[string]interface{}{"type": "string"},
+			"timeout": map[string]interface{}{"type": "integer"},
+		},
+		"required": []interface{}{"host"},
+	}
+	toSchema := map[string]interface{}{
+		"properties": map[string]interface{}{
+			"host":  map[string]interface{}{"type": "string"},
+			"token": map[string]interface{}{"type": "string"},
+		},
+		"required": []interface{}{"host", "token"},
+	}
+
+	msg := describePluginSchemaChange("demo", gjson.Parse(`{"host":"a","timeout":3}`),
+		fromSchema, toSchema, constant.APISIXVersion311, constant.APISIXVersion313)
+	assert.Equal(t, "插件 demo 的 schema 在 3.11.X 与 3.13.X 之间存在变更, 已移除字段: timeout; 新增必填字段: token", msg)
+
+	// 配置已包含新增的必填字段
+	msg = describePluginSchemaChange("demo", gjson.Parse(`{"host":"a","token":"t"}`),
+		fromSchema, toSchema, constant.APISIXVersion311, constant.APISIXVersion313)
+	assert.Equal(t, "插件 demo 的 schema 在 3.11.X 与 3.13.X 之间存在变更, 请确认配置是否仍然有效", msg)
+}
+
+func TestCheckUnsupportedPluginFields(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      string
+		version     constant.APISIXVersion
+		unsupported []string
+	}{
+		{
+			name:    "newer field on older version",
+			config:  `{"plugins":{"limit-count":{"count":1,"time_window":60,"sync_interval":0.5}}}`,
+			version: constant.APISIXVersion32,
+			unsupported: []string{
+				"插件 limit-count 的字段 sync_interval 在 3.2.X 中不受支持, 配置将不会生效",
+			},
+		},
+		{
+			name: "multiple plugins and fields",
+			config: `{"plugins":{"proxy-rewrite":{"uri":"/a","use_real_request_uri_unsafe":true},` +
+				`"cors":{"allow_origins":"*","allow_private_network":true,"allow_origins_by_metadata":["a"]}}}`,
+			version: constant.APISIXVersion32,
+			unsupported: []string{
+				"插件 cors 的字段 allow_private_network 在 3.2.X 中不受支持, 配置将不会生效",
+			},
+		},
+		{
+			name:    "all fields supported",
+			config:  `{"plugins":{"limit-count":{"count":1,"time_window":60,"_meta":{"disable":false}}}}`,
+			version: constant.APISIXVersion313,
+		},
+		{
+			name:    "unknown plugin skipped",
+			config:  `{"plugins":{"not-exist-plugin":{"foo":"bar"}}}`,
+			version: constant.APISIXVersion313,
+		},
+		{
+			name:    "no plugins",
+			config:  `{"uri":"/test"}`,
+			version: constant.APISIXVersion313,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.unsupported, CheckUnsupportedPluginFields([]byte(tt.config), tt.version))
+		})
+	}
+}
@@ -0,0 +1,145 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/tidwall/gjson"
+
+	log "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
+)
+
+// parseSchemaDocument 解析内置 schema 文档，解析前先展开文档内部的 $ref
+func parseSchemaDocument(raw []byte) gjson.Result {
+	return gjson.ParseBytes(resolveSchemaRefs(raw))
+}
+
+// resolveSchemaRefs 将 schema 文档中指向文档内部的 $ref 替换为被引用的定义，
+// 这样按路径截取出的子 schema (如单个插件的 schema) 不再依赖截取范围之外的 $defs/definitions
+func resolveSchemaRefs(raw []byte) []byte {
+	if !bytes.Contains(raw, []byte(`"$ref"`)) {
+		return raw
+	}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	// 保留数字原始精度
+	decoder.UseNumber()
+	var doc any
+	if err := decoder.Decode(&doc); err != nil {
+		log.Warnf("resolve schema $ref failed: schema json decode failed: %v", err)
+		return raw
+	}
+	resolved, err := json.Marshal(resolveRefNode(doc, doc, nil))
+	if err != nil {
+		log.Warnf("resolve schema $ref failed: schema json encode failed: %v", err)
+		return raw
+	}
+	return resolved
+}
+
+// resolveRefNode 递归展开节点中的 $ref，resolving 为当前展开链路上的 $ref，用于识别循环引用
+func resolveRefNode(node, root any, resolving []string) any {
+	switch v := node.(type) {
+	case map[string]any:
+		if ref, ok := v["$ref"].(string); ok && strings.HasPrefix(ref, "#") {
+			return resolveRef(v, ref, root, resolving)
+		}
+		out := make(map[string]any, len(v))
+		for key, child := range v {
+			out[key] = resolveRefNode(child, root, resolving)
+		}
+		return out
+	case []any:
+		out := make([]any, len(v))
+		for i, child := range v {
+			out[i] = resolveRefNode(child, root, resolving)
+		}
+		return out
+	}
+	return node
+}
+
+func resolveRef(node map[string]any, ref string, root any, resolving []string) any {
+	// 循环引用无法展开，保留原始 $ref
+	if slices.Contains(resolving, ref) {
+		log.Warnf("resolve schema $ref skipped: recursive reference %s", ref)
+		return node
+	}
+	target, ok := lookupJSONPointer(root, ref)
+	if !ok {
+		log.Warnf("resolve schema $ref failed: reference %s not found", ref)
+		return node
+	}
+	resolved := resolveRefNode(target, root, append(slices.Clone(resolving), ref))
+	if len(node) == 1 {
+		return resolved
+	}
+	// $ref 同级还有其他关键字时，通过 allOf 与被引用的定义同时生效
+	out := make(map[string]any, len(node))
+	for key, child := range node {
+		if key != "$ref" {
+			out[key] = resolveRefNode(child, root, resolving)
+		}
+	}
+	out["allOf"] = append([]any{resolved}, toSlice(out["allOf"])...)
+	return out
+}
+
+func toSlice(value any) []any {
+	if s, ok := value.([]any); ok {
+		return s
+	}
+	return nil
+}
+
+// lookupJSONPointer 按 JSON Pointer (RFC 6901) 查找文档中的节点，如 #/$defs/timeout
+func lookupJSONPointer(root any, ref string) (any, bool) {
+	pointer := strings.TrimPrefix(ref, "#")
+	if pointer == "" {
+		return root, true
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, false
+	}
+	node := root
+	for _, token := range strings.Split(pointer[1:], "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		switch v := node.(type) {
+		case map[string]any:
+			child, ok := v[token]
+			if !ok {
+				return nil, false
+			}
+			node = child
+		case []any:
+			index, err := strconv.Atoi(token)
+			if err != nil || index < 0 || index >= len(v) {
+				return nil, false
+			}
+			node = v[index]
+		default:
+			return nil, false
+		}
+	}
+	return node, true
+}
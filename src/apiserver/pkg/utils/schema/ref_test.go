@@ -0,0 +1,115 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"encoding/json"
+	"maps"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+// refPluginSchemaDocument 插件 schema 通过 $ref 引用文档顶层共享的 $defs
+const refPluginSchemaDocument = `{
+	"plugins": {
+		"ref-demo": {
+			"schema": {
+				"type": "object",
+				"properties": {
+					"timeout": {"$ref": "#/$defs/timeout"},
+					"retry": {"$ref": "#/$defs/retry", "description": "重试配置"}
+				},
+				"required": ["timeout"]
+			}
+		}
+	},
+	"$defs": {
+		"timeout": {"type": "integer", "minimum": 1},
+		"retry": {
+			"type": "object",
+			"properties": {"count": {"$ref": "#/$defs/timeout"}},
+			"required": ["count"]
+		}
+	}
+}`
+
+func TestPluginSchemaRefResolution(t *testing.T) {
+	origin := activeSchemas()
+	bkAPISIX := maps.Clone(origin.bkAPISIX)
+	bkAPISIX[constant.APISIXVersion313] = parseSchemaDocument([]byte(refPluginSchemaDocument))
+	activeSchemaSet.Store(&schemaSet{
+		apisix:         origin.apisix,
+		bkAPISIX:       bkAPISIX,
+		tapisix:        origin.tapisix,
+		digests:        origin.digests,
+		bundledPlugins: origin.bundledPlugins,
+	})
+	defer activeSchemaSet.Store(origin)
+
+	validator, err := NewPluginSchemaValidator(constant.APISIXVersion313, "ref-demo")
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		config  string
+		wantErr bool
+	}{
+		{name: "valid", config: `{"timeout": 3, "retry": {"count": 2}}`},
+		{name: "timeout below minimum", config: `{"timeout": 0}`, wantErr: true},
+		{name: "timeout wrong type", config: `{"timeout": "3"}`, wantErr: true},
+		{name: "nested ref", config: `{"timeout": 3, "retry": {"count": 0}}`, wantErr: true},
+		{name: "ref with sibling keywords", config: `{"timeout": 3, "retry": {}}`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validator.Validate(json.RawMessage(tt.config))
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestResolveSchemaRefs(t *testing.T) {
+	resolved := gjson.ParseBytes(resolveSchemaRefs([]byte(refPluginSchemaDocument)))
+	assert.False(t, resolved.Get(`plugins.ref-demo.schema.properties.timeout.\$ref`).Exists())
+	assert.Equal(t, int64(1), resolved.Get("plugins.ref-demo.schema.properties.timeout.minimum").Int())
+	// $ref 同级的关键字保留，被引用的定义放入 allOf
+	retry := resolved.Get("plugins.ref-demo.schema.properties.retry")
+	assert.Equal(t, "重试配置", retry.Get("description").String())
+	assert.Equal(t, "count", retry.Get("allOf.0.required.0").String())
+
+	// 没有 $ref 的文档原样返回
+	raw := []byte(`{"type": "object"}`)
+	assert.Equal(t, raw, resolveSchemaRefs(raw))
+
+	// 循环引用及不存在的引用保留原始 $ref
+	recursive := gjson.ParseBytes(resolveSchemaRefs([]byte(
+		`{"$defs": {"node": {"type": "object", "properties": {"next": {"$ref": "#/$defs/node"}}}},` +
+			`"schema": {"$ref": "#/$defs/node"}, "missing": {"$ref": "#/$defs/missing"}}`,
+	)))
+	assert.Equal(t, "#/$defs/node", recursive.Get(`schema.properties.next.\$ref`).String())
+	assert.Equal(t, "#/$defs/missing", recursive.Get(`missing.\$ref`).String())
+}
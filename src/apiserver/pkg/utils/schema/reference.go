@@ -0,0 +1,171 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/tidwall/gjson"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+// ResourceSet 待发布的资源集合: 资源类型 -> 资源配置
+type ResourceSet map[constant.APISIXResource][]json.RawMessage
+
+// ReferenceError 关联的资源在资源集合中不存在
+type ReferenceError struct {
+	ResourceType constant.APISIXResource `json:"resource_type"`
+	ResourceID   string                  `json:"resource_id"`
+	Field        string                  `json:"field"`
+	TargetType   constant.APISIXResource `json:"target_type"`
+	TargetID     string                  `json:"target_id"`
+}
+
+// Error ...
+func (e ReferenceError) Error() string {
+	return fmt.Sprintf("%s [id:%s] 的 %s 关联的 %s [id:%s] 不存在",
+		e.ResourceType, e.ResourceID, e.Field, e.TargetType, e.TargetID)
+}
+
+// referenceField 资源中引用其他资源 id 的字段
+type referenceField struct {
+	field      string
+	targetType constant.APISIXResource
+}
+
+// resourceReferenceFields 需要检查关联的资源类型及其引用字段
+var resourceReferenceFields = map[constant.APISIXResource][]referenceField{
+	constant.Route: {
+		{field: "service_id", targetType: constant.Service},
+		{field: "upstream_id", targetType: constant.Upstream},
+		{field: "plugin_config_id", targetType: constant.PluginConfig},
+	},
+	constant.Service: {
+		{field: "upstream_id", targetType: constant.Upstream},
+	},
+}
+
+// CheckReferences 检查 route/service 引用的 service_id、upstream_id、plugin_config_id 是否都在资源集合中，
+// 返回悬空的关联，避免发布到 etcd 后资源无法生效
+func CheckReferences(resources ResourceSet) []ReferenceError {
+	ids := make(map[constant.APISIXResource]map[string]bool, len(resources))
+	for resourceType, configs := range resources {
+		ids[resourceType] = make(map[string]bool, len(configs))
+		for _, config := range configs {
+			ids[resourceType][gjson.GetBytes(config, "id").String()] = true
+		}
+	}
+	var errs []ReferenceError
+	for _, resourceType := range constant.ResourceTypeList {
+		fields, ok := resourceReferenceFields[resourceType]
+		if !ok {
+			continue
+		}
+		for _, config := range resources[resourceType] {
+			for _, ref := range fields {
+				targetID := gjson.GetBytes(config, ref.field).String()
+				if targetID == "" || ids[ref.targetType][targetID] {
+					continue
+				}
+				errs = append(errs, ReferenceError{
+					ResourceType: resourceType,
+					ResourceID:   gjson.GetBytes(config, "id").String(),
+					Field:        ref.field,
+					TargetType:   ref.targetType,
+					TargetID:     targetID,
+				})
+			}
+		}
+	}
+	return errs
+}
+
+// RefNode 引用关系图中的资源节点，ID 为 GetResourceIdentification 得到的资源标识
+type RefNode struct {
+	ResourceType constant.APISIXResource `json:"resource_type"`
+	ID           string                  `json:"id"`
+}
+
+// RefEdge 引用关系图中的边，From 通过 Field 字段引用 To
+type RefEdge struct {
+	From  RefNode `json:"from"`
+	To    RefNode `json:"to"`
+	Field string  `json:"field"`
+}
+
+// RefGraph 资源集合的引用关系图
+type RefGraph struct {
+	Nodes []RefNode `json:"nodes"`
+	Edges []RefEdge `json:"edges"`
+}
+
+// graphReferenceFields 引用关系图中的引用字段，在 resourceReferenceFields 的基础上包含 consumer 所属的 consumer_group
+func graphReferenceFields(resourceType constant.APISIXResource) []referenceField {
+	if resourceType == constant.Consumer {
+		return []referenceField{{field: "group_id", targetType: constant.ConsumerGroup}}
+	}
+	return resourceReferenceFields[resourceType]
+}
+
+// BuildReferenceGraph 构建资源集合的引用关系图(route→service/upstream/plugin_config、service→upstream、
+// consumer→consumer_group)，节点按资源类型及集合中的顺序排列；
+// 关联的资源不在集合中时不生成边，以 ReferenceError 返回
+func BuildReferenceGraph(resources map[constant.APISIXResource][]json.RawMessage) (*RefGraph, []error) {
+	graph := &RefGraph{Nodes: []RefNode{}, Edges: []RefEdge{}}
+	ids := make(map[constant.APISIXResource]map[string]bool, len(resources))
+	for _, resourceType := range constant.ResourceTypeList {
+		ids[resourceType] = make(map[string]bool, len(resources[resourceType]))
+		for _, config := range resources[resourceType] {
+			node := RefNode{ResourceType: resourceType, ID: GetResourceIdentification(config)}
+			graph.Nodes = append(graph.Nodes, node)
+			ids[resourceType][node.ID] = true
+		}
+	}
+	var errs []error
+	for _, resourceType := range constant.ResourceTypeList {
+		fields := graphReferenceFields(resourceType)
+		for _, config := range resources[resourceType] {
+			from := RefNode{ResourceType: resourceType, ID: GetResourceIdentification(config)}
+			for _, ref := range fields {
+				targetID := gjson.GetBytes(config, ref.field).String()
+				if targetID == "" {
+					continue
+				}
+				if !ids[ref.targetType][targetID] {
+					errs = append(errs, ReferenceError{
+						ResourceType: resourceType,
+						ResourceID:   from.ID,
+						Field:        ref.field,
+						TargetType:   ref.targetType,
+						TargetID:     targetID,
+					})
+					continue
+				}
+				graph.Edges = append(graph.Edges, RefEdge{
+					From:  from,
+					To:    RefNode{ResourceType: ref.targetType, ID: targetID},
+					Field: ref.field,
+				})
+			}
+		}
+	}
+	return graph, errs
+}
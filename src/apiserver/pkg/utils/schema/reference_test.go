@@ -0,0 +1,107 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+func TestCheckReferences(t *testing.T) {
+	resources := ResourceSet{
+		constant.Route: {
+			json.RawMessage(`{"id":"r1","service_id":"s1","plugin_config_id":"pc1"}`),
+			json.RawMessage(`{"id":"r2","upstream_id":"u1"}`),
+			json.RawMessage(`{"id":"r3","service_id":"s2","upstream_id":"u2","plugin_config_id":"pc2"}`),
+		},
+		constant.Service: {
+			json.RawMessage(`{"id":"s1","upstream_id":"u1"}`),
+			json.RawMessage(`{"id":"s3","upstream_id":"u3"}`),
+		},
+		constant.Upstream:     {json.RawMessage(`{"id":"u1"}`)},
+		constant.PluginConfig: {json.RawMessage(`{"id":"pc1"}`)},
+	}
+	refErr := func(
+		resourceType constant.APISIXResource, id, field string, targetType constant.APISIXResource, targetID string,
+	) ReferenceError {
+		return ReferenceError{
+			ResourceType: resourceType, ResourceID: id, Field: field, TargetType: targetType, TargetID: targetID,
+		}
+	}
+	errs := CheckReferences(resources)
+	assert.Equal(t, []ReferenceError{
+		refErr(constant.Route, "r3", "service_id", constant.Service, "s2"),
+		refErr(constant.Route, "r3", "upstream_id", constant.Upstream, "u2"),
+		refErr(constant.Route, "r3", "plugin_config_id", constant.PluginConfig, "pc2"),
+		refErr(constant.Service, "s3", "upstream_id", constant.Upstream, "u3"),
+	}, errs)
+	assert.Equal(t, "route [id:r3] 的 service_id 关联的 service [id:s2] 不存在", errs[0].Error())
+
+	// 关联资源齐全时无错误
+	delete(resources, constant.Service)
+	resources[constant.Route] = resources[constant.Route][:2]
+	resources[constant.Route][0] = json.RawMessage(`{"id":"r1","plugin_config_id":"pc1"}`)
+	assert.Empty(t, CheckReferences(resources))
+}
+
+func TestBuildReferenceGraph(t *testing.T) {
+	resources := map[constant.APISIXResource][]json.RawMessage{
+		constant.Route: {
+			json.RawMessage(`{"id":"r1","service_id":"s1","plugin_config_id":"pc1"}`),
+			json.RawMessage(`{"id":"r2","upstream_id":"u2"}`),
+		},
+		constant.Service:       {json.RawMessage(`{"id":"s1","upstream_id":"u1"}`)},
+		constant.Upstream:      {json.RawMessage(`{"id":"u1"}`)},
+		constant.PluginConfig:  {json.RawMessage(`{"id":"pc1"}`)},
+		constant.ConsumerGroup: {json.RawMessage(`{"id":"g1"}`)},
+		constant.Consumer: {
+			json.RawMessage(`{"username":"c1","group_id":"g1"}`),
+			json.RawMessage(`{"username":"c2"}`),
+		},
+	}
+	node := func(resourceType constant.APISIXResource, id string) RefNode {
+		return RefNode{ResourceType: resourceType, ID: id}
+	}
+	graph, errs := BuildReferenceGraph(resources)
+	assert.ElementsMatch(t, []RefNode{
+		node(constant.Route, "r1"), node(constant.Route, "r2"), node(constant.Service, "s1"),
+		node(constant.Upstream, "u1"), node(constant.PluginConfig, "pc1"),
+		node(constant.ConsumerGroup, "g1"), node(constant.Consumer, "c1"), node(constant.Consumer, "c2"),
+	}, graph.Nodes)
+	assert.ElementsMatch(t, []RefEdge{
+		{From: node(constant.Route, "r1"), To: node(constant.Service, "s1"), Field: "service_id"},
+		{From: node(constant.Route, "r1"), To: node(constant.PluginConfig, "pc1"), Field: "plugin_config_id"},
+		{From: node(constant.Service, "s1"), To: node(constant.Upstream, "u1"), Field: "upstream_id"},
+		{From: node(constant.Consumer, "c1"), To: node(constant.ConsumerGroup, "g1"), Field: "group_id"},
+	}, graph.Edges)
+	// 悬空的关联不生成边
+	assert.Equal(t, []error{ReferenceError{
+		ResourceType: constant.Route, ResourceID: "r2", Field: "upstream_id",
+		TargetType: constant.Upstream, TargetID: "u2",
+	}}, errs)
+
+	graph, errs = BuildReferenceGraph(nil)
+	assert.Empty(t, graph.Nodes)
+	assert.Empty(t, graph.Edges)
+	assert.Empty(t, errs)
+}
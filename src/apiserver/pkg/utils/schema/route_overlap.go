@@ -0,0 +1,211 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/tidwall/gjson"
+)
+
+// RouteOverlap 匹配条件重叠的一对路由，路由以 GetResourceIdentification 标识
+type RouteOverlap struct {
+	RouteID            string `json:"route_id"`
+	RoutePriority      int    `json:"route_priority"`
+	OtherRouteID       string `json:"other_route_id"`
+	OtherRoutePriority int    `json:"other_route_priority"`
+}
+
+// String ...
+func (o RouteOverlap) String() string {
+	return fmt.Sprintf("路由 %s 与 %s 的匹配条件重叠且 priority 相同(%d), 匹配顺序不确定",
+		o.RouteID, o.OtherRouteID, o.RoutePriority)
+}
+
+// routeMatch 路由的匹配条件
+type routeMatch struct {
+	id       string
+	priority int
+	uris     []string
+	hosts    []string
+	methods  []string
+	// vars、filter_func、remote_addr(s) 不展开对比，两条路由都配置且不同时视为不重叠
+	opaque map[string]string
+}
+
+// opaqueMatchFields 不展开对比的匹配条件
+var opaqueMatchFields = []string{"vars", "filter_func", "remote_addrs"}
+
+// FindOverlappingRoutes 检测匹配条件重叠的路由对：uri(支持末尾 * 前缀匹配及 :param 参数)、host(支持 *. 泛域名)、
+// methods 均可能同时命中，且 vars/filter_func/remote_addr(s) 未将两者区分开；禁用的路由不参与检测，
+// 不展开 service 中的 hosts
+func FindOverlappingRoutes(routes []json.RawMessage) []RouteOverlap {
+	matches := make([]routeMatch, 0, len(routes))
+	for _, config := range routes {
+		if status := gjson.GetBytes(config, "status"); status.Exists() && status.Int() == 0 {
+			continue
+		}
+		matches = append(matches, parseRouteMatch(config))
+	}
+	var overlaps []RouteOverlap
+	for i := range matches {
+		for j := i + 1; j < len(matches); j++ {
+			if !matches[i].overlaps(matches[j]) {
+				continue
+			}
+			overlaps = append(overlaps, RouteOverlap{
+				RouteID:            matches[i].id,
+				RoutePriority:      matches[i].priority,
+				OtherRouteID:       matches[j].id,
+				OtherRoutePriority: matches[j].priority,
+			})
+		}
+	}
+	return overlaps
+}
+
+// CheckRoutePriorityConflicts 检查匹配条件重叠且 priority 相同的路由对，这类路由同时命中时匹配顺序不确定
+func CheckRoutePriorityConflicts(routes []json.RawMessage) []RouteOverlap {
+	var conflicts []RouteOverlap
+	for _, overlap := range FindOverlappingRoutes(routes) {
+		if overlap.RoutePriority == overlap.OtherRoutePriority {
+			conflicts = append(conflicts, overlap)
+		}
+	}
+	return conflicts
+}
+
+// parseRouteMatch 解析路由的匹配条件，uri/uris、host/hosts、remote_addr/remote_addrs 合并处理
+func parseRouteMatch(config json.RawMessage) routeMatch {
+	match := routeMatch{
+		id:       GetResourceIdentification(config),
+		priority: int(gjson.GetBytes(config, "priority").Int()),
+		uris:     stringValues(config, "uri", "uris"),
+		hosts:    stringValues(config, "host", "hosts"),
+		methods:  stringValues(config, "methods"),
+		opaque:   make(map[string]string),
+	}
+	for i, host := range match.hosts {
+		match.hosts[i] = strings.ToLower(host)
+	}
+	for i, method := range match.methods {
+		match.methods[i] = strings.ToUpper(method)
+	}
+	remoteAddrs := stringValues(config, "remote_addr", "remote_addrs")
+	if len(remoteAddrs) > 0 {
+		match.opaque["remote_addrs"] = strings.Join(remoteAddrs, ",")
+	}
+	for _, field := range []string{"vars", "filter_func"} {
+		if value := gjson.GetBytes(config, field); value.Exists() {
+			match.opaque[field] = value.Raw
+		}
+	}
+	return match
+}
+
+// stringValues 获取字段中的字符串，字段可以是字符串或字符串数组
+func stringValues(config json.RawMessage, fields ...string) []string {
+	var values []string
+	for _, field := range fields {
+		value := gjson.GetBytes(config, field)
+		if value.IsArray() {
+			for _, item := range value.Array() {
+				values = append(values, item.String())
+			}
+			continue
+		}
+		if value.String() != "" {
+			values = append(values, value.String())
+		}
+	}
+	return values
+}
+
+// overlaps 两条路由是否可能同时命中同一请求
+func (m routeMatch) overlaps(other routeMatch) bool {
+	for _, field := range opaqueMatchFields {
+		value, ok := m.opaque[field]
+		otherValue, otherOK := other.opaque[field]
+		if ok && otherOK && value != otherValue {
+			return false
+		}
+	}
+	return anyPairMatches(m.uris, other.uris, urisOverlap) &&
+		anyPairMatches(m.hosts, other.hosts, hostsOverlap) &&
+		anyPairMatches(m.methods, other.methods, func(a, b string) bool { return a == b })
+}
+
+// anyPairMatches 任一侧为空(不限制)或存在一对值重叠时返回 true
+func anyPairMatches(values, others []string, overlap func(a, b string) bool) bool {
+	if len(values) == 0 || len(others) == 0 {
+		return true
+	}
+	for _, value := range values {
+		for _, other := range others {
+			if overlap(value, other) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// urisOverlap 两个 uri 是否可能匹配同一路径：末尾为 * 时为前缀匹配，:param 匹配任意一段
+func urisOverlap(a, b string) bool {
+	aPrefix, aWildcard := strings.CutSuffix(a, "*")
+	bPrefix, bWildcard := strings.CutSuffix(b, "*")
+	switch {
+	case aWildcard && bWildcard:
+		return strings.HasPrefix(aPrefix, bPrefix) || strings.HasPrefix(bPrefix, aPrefix)
+	case aWildcard:
+		return strings.HasPrefix(b, aPrefix)
+	case bWildcard:
+		return strings.HasPrefix(a, bPrefix)
+	}
+	aSegments := strings.Split(a, "/")
+	bSegments := strings.Split(b, "/")
+	if len(aSegments) != len(bSegments) {
+		return false
+	}
+	for i := range aSegments {
+		if aSegments[i] == bSegments[i] ||
+			strings.HasPrefix(aSegments[i], ":") || strings.HasPrefix(bSegments[i], ":") {
+			continue
+		}
+		return false
+	}
+	return true
+}
+
+// hostsOverlap 两个 host 是否可能匹配同一域名：*. 开头的泛域名匹配其任意子域名
+func hostsOverlap(a, b string) bool {
+	aSuffix, aWildcard := strings.CutPrefix(a, "*")
+	bSuffix, bWildcard := strings.CutPrefix(b, "*")
+	switch {
+	case aWildcard && bWildcard:
+		return strings.HasSuffix(aSuffix, bSuffix) || strings.HasSuffix(bSuffix, aSuffix)
+	case aWildcard:
+		return strings.HasSuffix(b, aSuffix)
+	case bWildcard:
+		return strings.HasSuffix(a, bSuffix)
+	}
+	return a == b
+}
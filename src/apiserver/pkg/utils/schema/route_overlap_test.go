@@ -0,0 +1,82 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/sjson"
+)
+
+func TestFindOverlappingRoutes(t *testing.T) {
+	tests := []struct {
+		name     string
+		route    string
+		other    string
+		overlaps bool
+	}{
+		{"same uri", `{"uri":"/a"}`, `{"uris":["/b","/a"]}`, true},
+		{"different uri", `{"uri":"/a"}`, `{"uri":"/b"}`, false},
+		{"prefix uri", `{"uri":"/api/*"}`, `{"uri":"/api/users"}`, true},
+		{"nested prefix uri", `{"uri":"/api/*"}`, `{"uri":"/api/v1/*"}`, true},
+		{"param uri", `{"uri":"/users/:id"}`, `{"uri":"/users/1"}`, true},
+		{"param uri with different depth", `{"uri":"/users/:id"}`, `{"uri":"/users/1/orders"}`, false},
+		{"disjoint methods", `{"uri":"/a","methods":["GET"]}`, `{"uri":"/a","methods":["POST"]}`, false},
+		{"any method", `{"uri":"/a","methods":["GET"]}`, `{"uri":"/a"}`, true},
+		{"wildcard host", `{"uri":"/a","host":"*.example.com"}`, `{"uri":"/a","hosts":["api.example.com"]}`, true},
+		{"different host", `{"uri":"/a","host":"a.example.com"}`, `{"uri":"/a","host":"b.example.com"}`, false},
+		{"different vars", `{"uri":"/a","vars":[["v","==","1"]]}`, `{"uri":"/a","vars":[["v","==","2"]]}`, false},
+		{"vars on one side", `{"uri":"/a","vars":[["arg_v","==","1"]]}`, `{"uri":"/a"}`, true},
+		{"disabled route", `{"uri":"/a","status":0}`, `{"uri":"/a"}`, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			route, _ := sjson.SetBytes([]byte(tt.route), "id", "r1")
+			other, _ := sjson.SetBytes([]byte(tt.other), "id", "r2")
+			overlaps := FindOverlappingRoutes([]json.RawMessage{route, other})
+			assert.Equal(t, tt.overlaps, len(overlaps) == 1, overlaps)
+		})
+	}
+}
+
+func TestCheckRoutePriorityConflicts(t *testing.T) {
+	routes := []json.RawMessage{
+		json.RawMessage(`{"id":"r1","uri":"/api/*"}`),
+		json.RawMessage(`{"id":"r2","uri":"/api/users","priority":10}`),
+		json.RawMessage(`{"id":"r3","uri":"/api/orders"}`),
+		json.RawMessage(`{"id":"r4","uri":"/other","priority":10}`),
+	}
+	// 重叠的路由对: r1-r2(priority 不同)、r1-r3(priority 相同)
+	assert.Equal(t, []RouteOverlap{
+		{RouteID: "r1", RoutePriority: 0, OtherRouteID: "r2", OtherRoutePriority: 10},
+		{RouteID: "r1", RoutePriority: 0, OtherRouteID: "r3", OtherRoutePriority: 0},
+	}, FindOverlappingRoutes(routes))
+
+	conflicts := CheckRoutePriorityConflicts(routes)
+	assert.Equal(t, []RouteOverlap{
+		{RouteID: "r1", RoutePriority: 0, OtherRouteID: "r3", OtherRoutePriority: 0},
+	}, conflicts)
+	assert.Equal(t, "路由 r1 与 r3 的匹配条件重叠且 priority 相同(0), 匹配顺序不确定", conflicts[0].String())
+
+	// 重叠的路由指定不同的 priority 后不再告警
+	routes[2] = json.RawMessage(`{"id":"r3","uri":"/api/orders","priority":5}`)
+	assert.Empty(t, CheckRoutePriorityConflicts(routes))
+}
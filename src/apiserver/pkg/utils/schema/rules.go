@@ -0,0 +1,408 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync/atomic"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+// RuleSeverity 校验规则的默认级别
+type RuleSeverity string
+
+const (
+	// RuleSeverityError 校验失败，资源不能保存/发布
+	RuleSeverityError RuleSeverity = "error"
+	// RuleSeverityWarning 仅给出告警，不影响保存/发布
+	RuleSeverityWarning RuleSeverity = "warning"
+)
+
+// 校验规则 ID
+const (
+	RuleExclusiveFields        = "exclusive_fields"
+	RuleUpstreamDiscovery      = "upstream_discovery"
+	RuleBrokerUpstream         = "broker_upstream"
+	RuleSecretManager          = "secret_manager"
+	RuleJSONSchema             = "json_schema"
+	RuleProtoSyntax            = "proto_syntax"
+	RuleRouteUpstreamSource    = "route_upstream_source"
+	RuleUpstream               = "upstream"
+	RuleUpstreamHost           = "upstream_host"
+	RuleUpstreamTLSVerify      = "upstream_tls_verify"
+	RuleUpstreamNodesCount     = "upstream_nodes_count"
+	RuleUpstreamRetries        = "upstream_retries"
+	RuleRemoteAddr             = "remote_addr"
+	RuleRouteVars              = "route_vars"
+	RulePluginUpstreamScheme   = "plugin_upstream_scheme"
+	RuleConsumerAuthPlugins    = "consumer_auth_plugins"
+	RuleConsumerAllowedPlugins = "consumer_allowed_plugins"
+	RuleSSL                    = "ssl"
+	RulePluginsRequired        = "plugins_required"
+	RulePluginAvailability     = "plugin_availability"
+	RuleUnknownPlugin          = "unknown_plugin"
+	RulePluginSchema           = "plugin_schema"
+	RulePluginNumericBounds    = "plugin_numeric_bounds"
+	RuleEmptyMatch             = "empty_match"
+	RulePluginOrder            = "plugin_order"
+	RulePluginMetaKeys         = "plugin_meta_keys"
+)
+
+// RuleInfo 校验规则说明
+type RuleInfo struct {
+	ID            string                    `json:"id"`
+	Description   string                    `json:"description"`
+	Severity      RuleSeverity              `json:"severity"`
+	ResourceTypes []constant.APISIXResource `json:"resource_types"`
+}
+
+var (
+	// upstreamResources 包含上游配置(upstream 或 upstream_id)的资源类型
+	upstreamResources = []constant.APISIXResource{
+		constant.Route, constant.Service, constant.Upstream, constant.StreamRoute,
+	}
+	// upstreamCheckResources 会对上游配置做语义校验的资源类型
+	upstreamCheckResources = []constant.APISIXResource{constant.Route, constant.Service, constant.Upstream}
+	// pluginResources 包含插件配置的资源类型
+	pluginResources = []constant.APISIXResource{
+		constant.Route, constant.Service, constant.Consumer, constant.ConsumerGroup, constant.Credential,
+		constant.PluginConfig, constant.GlobalRule, constant.StreamRoute, constant.PluginMetadata,
+	}
+)
+
+// builtinRules Validate 中内置的校验规则，新增或调整校验时需同步更新
+var builtinRules = []RuleInfo{
+	{
+		ID:            RuleExclusiveFields,
+		Description:   "uri/uris、host/hosts、remote_addr/remote_addrs 不能同时配置",
+		Severity:      RuleSeverityError,
+		ResourceTypes: []constant.APISIXResource{constant.Route, constant.StreamRoute},
+	},
+	{
+		ID:            RuleUpstreamDiscovery,
+		Description:   "配置 discovery_type 时必须配置 service_name 且不能配置 nodes",
+		Severity:      RuleSeverityError,
+		ResourceTypes: upstreamResources,
+	},
+	{
+		ID:            RuleBrokerUpstream,
+		Description:   "kafka 上游的每个 broker 需显式配置 port，不支持 amqp 类型的上游",
+		Severity:      RuleSeverityError,
+		ResourceTypes: upstreamResources,
+	},
+	{
+		ID:            RuleSecretManager,
+		Description:   "secret 按密钥管理器的 schema 校验",
+		Severity:      RuleSeverityError,
+		ResourceTypes: []constant.APISIXResource{constant.Secret},
+	},
+	{
+		ID:            RuleJSONSchema,
+		Description:   "配置符合对应 apisix 版本的 json schema",
+		Severity:      RuleSeverityError,
+		ResourceTypes: constant.ResourceTypeList,
+	},
+	{
+		ID:            RuleProtoSyntax,
+		Description:   "proto 内容语法正确",
+		Severity:      RuleSeverityError,
+		ResourceTypes: []constant.APISIXResource{constant.Proto},
+	},
+	{
+		ID:            RuleRouteUpstreamSource,
+		Description:   "upstream 与 upstream_id 不能同时配置",
+		Severity:      RuleSeverityError,
+		ResourceTypes: []constant.APISIXResource{constant.Route},
+	},
+	{
+		ID:            RuleUpstream,
+		Description:   "上游节点、pass_host、超时、健康检查、TLS 及 chash key 等配置合法",
+		Severity:      RuleSeverityError,
+		ResourceTypes: upstreamCheckResources,
+	},
+	{
+		ID:            RuleUpstreamHost,
+		Description:   "上游节点 host 与 pass_host 的组合可能导致非预期的 Host 头",
+		Severity:      RuleSeverityWarning,
+		ResourceTypes: upstreamCheckResources,
+	},
+	{
+		ID:            RuleUpstreamTLSVerify,
+		Description:   "开启 tls.verify 时上游缺少可校验的域名",
+		Severity:      RuleSeverityWarning,
+		ResourceTypes: upstreamCheckResources,
+	},
+	{
+		ID:            RuleUpstreamNodesCount,
+		Description:   "上游静态节点数超过告警阈值(服务发现的上游不检查)",
+		Severity:      RuleSeverityWarning,
+		ResourceTypes: upstreamCheckResources,
+	},
+	{
+		ID:            RuleUpstreamRetries,
+		Description:   "chash 上游重试次数超过节点数(网关 retries_check 为 lenient 时按此级别处理，为 strict 时总是报错)",
+		Severity:      RuleSeverityWarning,
+		ResourceTypes: upstreamCheckResources,
+	},
+	{
+		ID:            RuleRemoteAddr,
+		Description:   "remote_addr(s)、server_addr 为合法的 IP 或 CIDR",
+		Severity:      RuleSeverityError,
+		ResourceTypes: []constant.APISIXResource{constant.Route, constant.StreamRoute},
+	},
+	{
+		ID:            RuleRouteVars,
+		Description:   "vars 表达式的变量名、操作符及取值合法",
+		Severity:      RuleSeverityError,
+		ResourceTypes: []constant.APISIXResource{constant.Route},
+	},
+	{
+		ID:            RulePluginUpstreamScheme,
+		Description:   "内联上游的 scheme 与插件要求一致",
+		Severity:      RuleSeverityError,
+		ResourceTypes: []constant.APISIXResource{constant.Route, constant.Service},
+	},
+	{
+		ID:            RuleConsumerAuthPlugins,
+		Description:   "consumer 至少需要配置一个认证插件",
+		Severity:      RuleSeverityError,
+		ResourceTypes: []constant.APISIXResource{constant.Consumer},
+	},
+	{
+		ID:            RuleConsumerAllowedPlugins,
+		Description:   "consumer 上只允许配置认证、鉴权和限流插件",
+		Severity:      RuleSeverityError,
+		ResourceTypes: []constant.APISIXResource{constant.Consumer},
+	},
+	{
+		ID:            RuleSSL,
+		Description:   "证书与私钥可解析且匹配，有效期与证书一致，snis 均被证书覆盖",
+		Severity:      RuleSeverityError,
+		ResourceTypes: []constant.APISIXResource{constant.SSL},
+	},
+	{
+		ID:            RulePluginsRequired,
+		Description:   "资源必须配置插件",
+		Severity:      RuleSeverityError,
+		ResourceTypes: pluginsRequiredResources(),
+	},
+	{
+		ID:            RulePluginAvailability,
+		Description:   "插件只在更高的 apisix 版本中提供",
+		Severity:      RuleSeverityError,
+		ResourceTypes: sortedResourceTypes(pluginAvailabilityResources),
+	},
+	{
+		ID:            RuleUnknownPlugin,
+		Description:   "插件在当前版本中没有可用的 schema",
+		Severity:      RuleSeverityWarning,
+		ResourceTypes: pluginResources,
+	},
+	{
+		ID:            RulePluginSchema,
+		Description:   "插件配置符合插件 schema(包括自定义插件)",
+		Severity:      RuleSeverityError,
+		ResourceTypes: pluginResources,
+	},
+	{
+		ID:            RulePluginNumericBounds,
+		Description:   "插件数值字段在 PluginNumericBounds 的范围内",
+		Severity:      RuleSeverityError,
+		ResourceTypes: pluginResources,
+	},
+}
+
+// postValidator 在配置解析完成后执行的校验，按注册顺序执行；按规则生效的级别处理返回的问题：
+// 级别为 warning 时记为告警，为 error 时第一个问题即视为校验失败
+type postValidator struct {
+	rule  RuleInfo
+	check func(rawConfig json.RawMessage, plugins map[string]interface{}) []string
+}
+
+// postValidators 已注册的 post-validator
+var postValidators = []postValidator{
+	{
+		rule: RuleInfo{
+			ID:            RuleEmptyMatch,
+			Description:   "路由配置了空的 vars/filter_func，不会附加任何匹配条件",
+			Severity:      RuleSeverityWarning,
+			ResourceTypes: []constant.APISIXResource{constant.Route},
+		},
+		check: func(rawConfig json.RawMessage, _ map[string]interface{}) []string {
+			return CheckEmptyMatchWarnings(rawConfig)
+		},
+	},
+	{
+		rule: RuleInfo{
+			ID:            RulePluginOrder,
+			Description:   "插件配置的 priority 可能导致非预期的执行顺序",
+			Severity:      RuleSeverityWarning,
+			ResourceTypes: pluginResources,
+		},
+		check: func(_ json.RawMessage, plugins map[string]interface{}) []string {
+			return CheckPluginOrderWarnings(plugins)
+		},
+	},
+	{
+		rule: RuleInfo{
+			ID:            RulePluginMetaKeys,
+			Description:   "插件 _meta 只能包含支持的字段",
+			Severity:      RuleSeverityError,
+			ResourceTypes: pluginResources,
+		},
+		check: func(_ json.RawMessage, plugins map[string]interface{}) []string {
+			if err := CheckPluginMetaKeys(plugins); err != nil {
+				return []string{err.Error()}
+			}
+			return nil
+		},
+	},
+}
+
+// ListValidationRules 列出所有校验规则(内置规则及已注册的 post-validator)，按 ID 排序
+func ListValidationRules() []RuleInfo {
+	rules := make([]RuleInfo, 0, len(builtinRules)+len(postValidators))
+	rules = append(rules, builtinRules...)
+	for _, validator := range postValidators {
+		rules = append(rules, validator.rule)
+	}
+	for i := range rules {
+		// 避免调用方修改共享的资源类型列表
+		rules[i].ResourceTypes = slices.Clone(rules[i].ResourceTypes)
+	}
+	slices.SortFunc(rules, func(a, b RuleInfo) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+	return rules
+}
+
+// defaultRuleSeverities 各规则的默认级别：rule id -> severity
+var defaultRuleSeverities = func() map[string]RuleSeverity {
+	severities := make(map[string]RuleSeverity, len(builtinRules)+len(postValidators))
+	for _, rule := range builtinRules {
+		severities[rule.ID] = rule.Severity
+	}
+	for _, validator := range postValidators {
+		severities[validator.rule.ID] = validator.rule.Severity
+	}
+	return severities
+}()
+
+// ruleSeverityOverrides 配置中覆盖的规则级别：rule id -> severity
+var ruleSeverityOverrides atomic.Pointer[map[string]RuleSeverity]
+
+// SetRuleSeverityOverrides 覆盖规则的级别(rule id -> error/warning)，用于按需将规则提升为错误或降级为告警；
+// 存在未知的规则或级别时返回错误，且不影响当前生效的配置；传入空 map 时恢复为默认级别
+func SetRuleSeverityOverrides(overrides map[string]string) error {
+	severities := make(map[string]RuleSeverity, len(overrides))
+	for ruleID, severity := range overrides {
+		if _, ok := defaultRuleSeverities[ruleID]; !ok {
+			return fmt.Errorf("未知的校验规则: %s", ruleID)
+		}
+		switch RuleSeverity(severity) {
+		case RuleSeverityError, RuleSeverityWarning:
+			severities[ruleID] = RuleSeverity(severity)
+		default:
+			return fmt.Errorf("校验规则 %s 的级别 %s 不合法, 可选值为 error、warning", ruleID, severity)
+		}
+	}
+	ruleSeverityOverrides.Store(&severities)
+	return nil
+}
+
+// EffectiveRuleSeverity 获取规则生效的级别：配置了覆盖时使用覆盖的级别，否则为默认级别
+func EffectiveRuleSeverity(ruleID string) RuleSeverity {
+	if overrides := ruleSeverityOverrides.Load(); overrides != nil {
+		if severity, ok := (*overrides)[ruleID]; ok {
+			return severity
+		}
+	}
+	return defaultRuleSeverities[ruleID]
+}
+
+// RuleSeveritiesDigest 所有规则生效级别的摘要，按 rule id 排序计算，规则级别被覆盖后摘要随之变化
+func RuleSeveritiesDigest() string {
+	ruleIDs := make([]string, 0, len(defaultRuleSeverities))
+	for ruleID := range defaultRuleSeverities {
+		ruleIDs = append(ruleIDs, ruleID)
+	}
+	slices.Sort(ruleIDs)
+	h := sha256.New()
+	for _, ruleID := range ruleIDs {
+		fmt.Fprintf(h, "%s=%s;", ruleID, EffectiveRuleSeverity(ruleID))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// promotedWarningError 提升为 error 级别的告警，不受外层规则降级的影响
+type promotedWarningError struct {
+	message string
+}
+
+// Error ...
+func (e *promotedWarningError) Error() string {
+	return e.message
+}
+
+// ruleError 按规则生效的级别处理校验错误：级别为 warning 时记为告警并返回 nil，否则原样返回
+func (v *APISIXJsonSchemaValidator) ruleError(ruleID string, err error) error {
+	var promoted *promotedWarningError
+	if err == nil || EffectiveRuleSeverity(ruleID) == RuleSeverityError || errors.As(err, &promoted) {
+		return err
+	}
+	v.warn("%s", err.Error())
+	return nil
+}
+
+// ruleWarning 按规则生效的级别处理告警：级别为 error 时返回错误，否则记为告警
+func (v *APISIXJsonSchemaValidator) ruleWarning(ruleID string, warning Warning) error {
+	if EffectiveRuleSeverity(ruleID) == RuleSeverityError {
+		return &promotedWarningError{message: warning.Message}
+	}
+	v.addWarning(warning)
+	return nil
+}
+
+// appliesTo 规则是否适用于指定资源类型
+func (r RuleInfo) appliesTo(resourceType constant.APISIXResource) bool {
+	return slices.Contains(r.ResourceTypes, resourceType)
+}
+
+// pluginsRequiredResources 必须配置插件的资源类型，按 ResourceTypeList 的顺序
+func pluginsRequiredResources() []constant.APISIXResource {
+	return sortedResourceTypes(constant.PluginsMustResourceMap)
+}
+
+// sortedResourceTypes 将资源类型集合按 ResourceTypeList 的顺序转换为列表
+func sortedResourceTypes(resourceTypes map[constant.APISIXResource]bool) []constant.APISIXResource {
+	var result []constant.APISIXResource
+	for _, resourceType := range constant.ResourceTypeList {
+		if resourceTypes[resourceType] {
+			result = append(result, resourceType)
+		}
+	}
+	return result
+}
@@ -0,0 +1,162 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+func TestListValidationRules(t *testing.T) {
+	rules := ListValidationRules()
+	ruleMap := make(map[string]RuleInfo, len(rules))
+	for _, rule := range rules {
+		_, duplicated := ruleMap[rule.ID]
+		assert.False(t, duplicated, "duplicated rule: %s", rule.ID)
+		assert.NotEmpty(t, rule.Description, rule.ID)
+		assert.NotEmpty(t, rule.ResourceTypes, rule.ID)
+		ruleMap[rule.ID] = rule
+	}
+	assert.True(t, slices.IsSortedFunc(rules, func(a, b RuleInfo) int {
+		return strings.Compare(a.ID, b.ID)
+	}))
+
+	tests := []struct {
+		id           string
+		severity     RuleSeverity
+		resourceType constant.APISIXResource
+	}{
+		{id: "json_schema", severity: RuleSeverityError, resourceType: constant.Secret},
+		{id: "exclusive_fields", severity: RuleSeverityError, resourceType: constant.StreamRoute},
+		{id: "upstream_host", severity: RuleSeverityWarning, resourceType: constant.Upstream},
+		{id: "plugins_required", severity: RuleSeverityError, resourceType: constant.GlobalRule},
+		{id: "unknown_plugin", severity: RuleSeverityWarning, resourceType: constant.Route},
+		{id: "plugin_availability", severity: RuleSeverityError, resourceType: constant.PluginConfig},
+		// post-validator
+		{id: "empty_match", severity: RuleSeverityWarning, resourceType: constant.Route},
+		{id: "plugin_order", severity: RuleSeverityWarning, resourceType: constant.Service},
+		{id: "plugin_meta_keys", severity: RuleSeverityError, resourceType: constant.Consumer},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			rule, ok := ruleMap[tt.id]
+			if assert.True(t, ok) {
+				assert.Equal(t, tt.severity, rule.Severity)
+				assert.Contains(t, rule.ResourceTypes, tt.resourceType)
+			}
+		})
+	}
+
+	// 所有已注册的 post-validator 都需要出现在列表中
+	for _, validator := range postValidators {
+		assert.Contains(t, ruleMap, validator.rule.ID)
+	}
+
+	// 修改返回值不影响后续调用
+	rules[0].ResourceTypes[0] = "modified"
+	assert.NotEqual(t, constant.APISIXResource("modified"), ListValidationRules()[0].ResourceTypes[0])
+}
+
+func TestSetRuleSeverityOverrides(t *testing.T) {
+	t.Cleanup(func() { assert.NoError(t, SetRuleSeverityOverrides(nil)) })
+	assert.NoError(t, SetRuleSeverityOverrides(map[string]string{RuleEmptyMatch: "error"}))
+	assert.Equal(t, RuleSeverityError, EffectiveRuleSeverity(RuleEmptyMatch))
+	assert.Equal(t, RuleSeverityWarning, EffectiveRuleSeverity(RuleUnknownPlugin))
+
+	// 配置不合法时返回错误，保留当前生效的配置
+	assert.EqualError(t, SetRuleSeverityOverrides(map[string]string{"catch_all_route": "error"}),
+		"未知的校验规则: catch_all_route")
+	assert.EqualError(t, SetRuleSeverityOverrides(map[string]string{RuleRouteVars: "info"}),
+		"校验规则 route_vars 的级别 info 不合法, 可选值为 error、warning")
+	assert.Equal(t, RuleSeverityError, EffectiveRuleSeverity(RuleEmptyMatch))
+
+	assert.NoError(t, SetRuleSeverityOverrides(nil))
+	assert.Equal(t, RuleSeverityWarning, EffectiveRuleSeverity(RuleEmptyMatch))
+}
+
+func TestRuleSeverityOverridesValidate(t *testing.T) {
+	t.Cleanup(func() { assert.NoError(t, SetRuleSeverityOverrides(nil)) })
+	tests := []struct {
+		name        string
+		overrides   map[string]string
+		config      string
+		wantErr     string
+		wantWarning string
+	}{
+		{
+			name:        "empty match default warning",
+			config:      `{"id":"r1","uri":"/a","upstream_id":"u1","vars":[]}`,
+			wantWarning: "配置了空的 vars",
+		},
+		{
+			name:      "empty match promoted to error",
+			overrides: map[string]string{RuleEmptyMatch: "error"},
+			config:    `{"id":"r1","uri":"/a","upstream_id":"u1","vars":[]}`,
+			wantErr:   "资源: r1 schema 验证失败: 配置了空的 vars",
+		},
+		{
+			name:      "unknown plugin promoted to error",
+			overrides: map[string]string{RuleUnknownPlugin: "error"},
+			config:    `{"id":"r1","uri":"/a","upstream_id":"u1","plugins":{"no-such-plugin":{}}}`,
+			wantErr:   "插件 no-such-plugin 在 3.13.X 中不存在",
+		},
+		{
+			name:    "route vars default error",
+			config:  `{"id":"r1","uri":"/a","upstream_id":"u1","vars":[["foo_var","==","1"]]}`,
+			wantErr: "未知的变量 foo_var",
+		},
+		{
+			name:        "route vars demoted to warning",
+			overrides:   map[string]string{RuleRouteVars: "warning"},
+			config:      `{"id":"r1","uri":"/a","upstream_id":"u1","vars":[["foo_var","==","1"]]}`,
+			wantWarning: "未知的变量 foo_var",
+		},
+		{
+			name:        "plugin schema demoted to warning",
+			overrides:   map[string]string{RulePluginSchema: "warning"},
+			config:      `{"id":"r1","uri":"/a","upstream_id":"u1","plugins":{"limit-count":{"count":"1"}}}`,
+			wantWarning: "插件:limit-count schema 验证失败",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.NoError(t, SetRuleSeverityOverrides(tt.overrides))
+			validator, err := NewAPISIXJsonSchemaValidator(constant.APISIXVersion313, constant.Route, "main.route",
+				nil, constant.DATABASE)
+			assert.NoError(t, err)
+			err = validator.Validate(json.RawMessage(tt.config))
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			var warnings []string
+			for _, warning := range validator.(*APISIXJsonSchemaValidator).Warnings() {
+				warnings = append(warnings, warning.Message)
+			}
+			assert.Contains(t, strings.Join(warnings, "\n"), tt.wantWarning)
+		})
+	}
+}
@@ -19,7 +19,11 @@
 package schema
 
 import (
+	"crypto/sha256"
 	_ "embed"
+	"encoding/hex"
+	"sync"
+	"sync/atomic"
 
 	"github.com/tidwall/gjson"
 
@@ -57,38 +61,133 @@ var rawSchemaV33 []byte
 //go:embed 3.2/schema.json
 var rawSchemaV32 []byte
 
-var schemaVersionMap = map[constant.APISIXVersion]gjson.Result{
-	constant.APISIXVersion32:  gjson.ParseBytes(rawSchemaV32),
-	constant.APISIXVersion33:  gjson.ParseBytes(rawSchemaV33),
-	constant.APISIXVersion311: gjson.ParseBytes(rawSchemaV311),
-	constant.APISIXVersion313: gjson.ParseBytes(rawSchemaV313),
+// embeddedAPISIXSchemas 各版本内置的 apisix schema 文档
+var embeddedAPISIXSchemas = map[constant.APISIXVersion][]byte{
+	constant.APISIXVersion32:  withResourceSchema(rawSchemaV32, constant.APISIXVersion32),
+	constant.APISIXVersion33:  withResourceSchema(rawSchemaV33, constant.APISIXVersion33),
+	constant.APISIXVersion311: withResourceSchema(rawSchemaV311, constant.APISIXVersion311),
+	constant.APISIXVersion313: withResourceSchema(rawSchemaV313, constant.APISIXVersion313),
 }
 
-var bkAPISIXPluginSchemaVersionMap = map[constant.APISIXVersion]gjson.Result{
-	constant.APISIXVersion313: gjson.ParseBytes(rawBkAPISIXPluginSchemaV313),
-	constant.APISIXVersion311: gjson.ParseBytes(rawBkAPISIXPluginSchemaV311),
+// embeddedBkAPISIXPluginSchemas 各版本内置的 bk-apisix 插件 schema 文档
+var embeddedBkAPISIXPluginSchemas = map[constant.APISIXVersion][]byte{
+	constant.APISIXVersion313: rawBkAPISIXPluginSchemaV313,
+	constant.APISIXVersion311: rawBkAPISIXPluginSchemaV311,
 }
 
-var tapisixPluginSchemaVersionMap = map[constant.APISIXVersion]gjson.Result{
-	constant.APISIXVersion33:  gjson.ParseBytes(rawTAPISIXPluginSchemaV33),
-	constant.APISIXVersion311: gjson.ParseBytes(rawTAPISIXPluginSchemaV311),
-	constant.APISIXVersion313: gjson.ParseBytes(rawTAPISIXPluginSchemaV313),
+// embeddedTAPISIXPluginSchemas 各版本内置的 tapisix 插件 schema 文档
+var embeddedTAPISIXPluginSchemas = map[constant.APISIXVersion][]byte{
+	constant.APISIXVersion33:  rawTAPISIXPluginSchemaV33,
+	constant.APISIXVersion311: rawTAPISIXPluginSchemaV311,
+	constant.APISIXVersion313: rawTAPISIXPluginSchemaV313,
+}
+
+// embeddedSchemaDigests 各版本内置 schema (含 bk-apisix、tapisix 插件 schema) 的摘要
+var embeddedSchemaDigests = map[constant.APISIXVersion]string{
+	constant.APISIXVersion32:  digest(rawSchemaV32),
+	constant.APISIXVersion33:  digest(rawSchemaV33, rawTAPISIXPluginSchemaV33),
+	constant.APISIXVersion311: digest(rawSchemaV311, rawBkAPISIXPluginSchemaV311, rawTAPISIXPluginSchemaV311),
+	constant.APISIXVersion313: digest(rawSchemaV313, rawBkAPISIXPluginSchemaV313, rawTAPISIXPluginSchemaV313),
+}
+
+// embeddedSchemaSet 内置的 schema
+var embeddedSchemaSet = newSchemaSet(embeddedAPISIXSchemas, embeddedBkAPISIXPluginSchemas,
+	embeddedTAPISIXPluginSchemas, embeddedSchemaDigests)
+
+// activeSchemaSet 当前生效的 schema，配置外部 schema 目录后随目录变化整体替换
+var activeSchemaSet = func() *atomic.Pointer[schemaSet] {
+	p := &atomic.Pointer[schemaSet]{}
+	p.Store(embeddedSchemaSet)
+	return p
+}()
+
+// schemaSet 各版本的 schema 文档(含 bk-apisix、tapisix 插件 schema)，构建后不再修改
+type schemaSet struct {
+	apisix   map[constant.APISIXVersion]gjson.Result
+	bkAPISIX map[constant.APISIXVersion]gjson.Result
+	tapisix  map[constant.APISIXVersion]gjson.Result
+	// digests 各版本 schema 的摘要
+	digests map[constant.APISIXVersion]string
+	// bundledPlugins 各版本 schema 中的插件名称
+	bundledPlugins map[constant.APISIXVersion]map[string]struct{}
+	// compiled 缓存编译后的资源 schema，避免每次创建校验器都重新编译：schemaCacheKey -> *compiledSchema；
+	// 随 schema 一起替换，替换后不会再使用旧 schema 编译的结果
+	compiled sync.Map
+}
+
+// newSchemaSet 解析各版本的 schema 文档
+func newSchemaSet(
+	apisix, bkAPISIX, tapisix map[constant.APISIXVersion][]byte,
+	digests map[constant.APISIXVersion]string,
+) *schemaSet {
+	set := &schemaSet{
+		apisix:         make(map[constant.APISIXVersion]gjson.Result, len(apisix)),
+		bkAPISIX:       make(map[constant.APISIXVersion]gjson.Result, len(bkAPISIX)),
+		tapisix:        make(map[constant.APISIXVersion]gjson.Result, len(tapisix)),
+		digests:        digests,
+		bundledPlugins: make(map[constant.APISIXVersion]map[string]struct{}, len(apisix)),
+	}
+	for version, raw := range apisix {
+		set.apisix[version] = parseSchemaDocument(raw)
+	}
+	for version, raw := range bkAPISIX {
+		set.bkAPISIX[version] = parseSchemaDocument(raw)
+	}
+	for version, raw := range tapisix {
+		set.tapisix[version] = parseSchemaDocument(raw)
+	}
+	for version := range apisix {
+		names := make(map[string]struct{})
+		for _, source := range []gjson.Result{set.apisix[version], set.bkAPISIX[version], set.tapisix[version]} {
+			source.Get("plugins").ForEach(func(name, _ gjson.Result) bool {
+				names[name.String()] = struct{}{}
+				return true
+			})
+		}
+		set.bundledPlugins[version] = names
+	}
+	return set
+}
+
+// activeSchemas 获取当前生效的 schema，同一次查找中应只获取一次，避免前后使用不同的 schema
+func activeSchemas() *schemaSet {
+	return activeSchemaSet.Load()
+}
+
+// withResourceSchema 写入 schema.json 中未定义的资源 schema: secret、credential
+func withResourceSchema(raw []byte, version constant.APISIXVersion) []byte {
+	return withCredentialSchema(withSecretSchema(raw, version), version)
+}
+
+// digest 计算多个内容的 sha256 摘要
+func digest(raws ...[]byte) string {
+	h := sha256.New()
+	for _, raw := range raws {
+		h.Write(raw)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Digest 获取指定版本生效 schema 的摘要，内置 schema 更新或外部 schema 目录变化后摘要随之变化
+func Digest(version constant.APISIXVersion) string {
+	return activeSchemas().digests[version]
 }
 
 // GetResourceSchema 获取资源的schema
 func GetResourceSchema(version constant.APISIXVersion, name string) interface{} {
-	return schemaVersionMap[version].Get("main." + name).Value()
+	return activeSchemas().apisix[version].Get("main." + name).Value()
 }
 
 // GetMetadataPluginSchema 获取 metadata 插件类型的 schema
 func GetMetadataPluginSchema(version constant.APISIXVersion, path string) interface{} {
+	set := activeSchemas()
 	// 查找 apisix 插件
-	ret := schemaVersionMap[version].Get(path).Value()
+	ret := set.apisix[version].Get(path).Value()
 	if ret != nil {
 		return ret
 	}
 	// 查找 bk-apisix 插件
-	bkAPISIXPluginSchemaVersion, ok := bkAPISIXPluginSchemaVersionMap[version]
+	bkAPISIXPluginSchemaVersion, ok := set.bkAPISIX[version]
 	if ok {
 		ret = bkAPISIXPluginSchemaVersion.Get(path).Value()
 	}
@@ -96,7 +195,7 @@ func GetMetadataPluginSchema(version constant.APISIXVersion, path string) interf
 		return ret
 	}
 	// 查找 tapisix 插件
-	tapisixPluginSchemaVersion, ok := tapisixPluginSchemaVersionMap[version]
+	tapisixPluginSchemaVersion, ok := set.tapisix[version]
 	if ok {
 		ret = tapisixPluginSchemaVersion.Get(path).Value()
 	}
@@ -105,10 +204,11 @@ func GetMetadataPluginSchema(version constant.APISIXVersion, path string) interf
 
 // GetPluginSchema 获取插件的schema
 func GetPluginSchema(version constant.APISIXVersion, name string, schemaType string) interface{} {
+	set := activeSchemas()
 	var ret interface{}
 	if schemaType == "consumer" || schemaType == "consumer_schema" {
 		// 需匹配常规插件和 consumer 插件，当未查询到时，继续匹配后面常规插件
-		ret = schemaVersionMap[version].Get("plugins." + name + ".consumer_schema").Value()
+		ret = set.apisix[version].Get("plugins." + name + ".consumer_schema").Value()
 	}
 	if schemaType == "metadata" || schemaType == "metadata_schema" {
 		// 只需匹配 metadata 类型的插件，根据 "plugins."+name+".metadata_schema" 路径查询 schema，可直接返回结果，无需再匹配常规插件
@@ -116,17 +216,17 @@ func GetPluginSchema(version constant.APISIXVersion, name string, schemaType str
 	}
 	if schemaType == "stream" || schemaType == "stream_schema" {
 		// 只需要匹配 stream 类型的插件，由于该类型所有插件已在 schema.json 中存在，可直接返回结果，无需再匹配常规插件
-		return schemaVersionMap[version].Get("stream_plugins." + name + ".schema").Value()
+		return set.apisix[version].Get("stream_plugins." + name + ".schema").Value()
 	}
 	// 常规插件匹配
 	if ret == nil {
-		ret = schemaVersionMap[version].Get("plugins." + name + ".schema").Value()
+		ret = set.apisix[version].Get("plugins." + name + ".schema").Value()
 	}
 	if ret != nil {
 		return ret
 	}
 	// 如果apisix插件不存在，再去bk-apisix插件中查找
-	bkAPISIXPluginSchemaVersion, ok := bkAPISIXPluginSchemaVersionMap[version]
+	bkAPISIXPluginSchemaVersion, ok := set.bkAPISIX[version]
 	if ok {
 		ret = bkAPISIXPluginSchemaVersion.Get("plugins." + name + ".schema").Value()
 	}
@@ -134,7 +234,7 @@ func GetPluginSchema(version constant.APISIXVersion, name string, schemaType str
 		return ret
 	}
 	// 如果bk-apisix插件也不存在，再去tapisix插件中查找
-	tapisixPluginSchemaVersion, ok := tapisixPluginSchemaVersionMap[version]
+	tapisixPluginSchemaVersion, ok := set.tapisix[version]
 	if ok {
 		ret = tapisixPluginSchemaVersion.Get("plugins." + name + ".schema").Value()
 	}
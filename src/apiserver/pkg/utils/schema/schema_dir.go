@@ -0,0 +1,254 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+	"github.com/xeipuuv/gojsonschema"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	log "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/goroutinex"
+)
+
+// schemaDirParts 外部 schema 目录中版本目录下的子目录，文件 {part}/{name}.json 覆盖 schema 文档中的 {part}.{name}
+var schemaDirParts = []string{"main", "plugins"}
+
+// schemaReloadDelay 目录变化后延迟加载的时间，编辑器保存文件时会产生多个事件，合并后只加载一次
+var schemaReloadDelay = 200 * time.Millisecond
+
+// schemaOverlay 外部 schema 目录中的单个文件
+type schemaOverlay struct {
+	file    string
+	version constant.APISIXVersion
+	path    string
+}
+
+// LoadSchemaDir 加载外部 schema 目录并原子替换当前生效的 schema，dir 为空时恢复为内置 schema。
+// 目录结构为 {version}/{main|plugins}/{name}.json，version 与内置 schema 的目录一致(如 3.13)：
+// main/route.json 覆盖 main.route；plugins/limit-count.json 覆盖 plugins.limit-count，
+// 格式与 schema.json 中的插件定义一致，必须包含 schema。
+// 任一文件不合法时返回包含文件名的错误，保留当前生效的 schema
+func LoadSchemaDir(dir string) error {
+	if dir == "" {
+		activeSchemaSet.Store(embeddedSchemaSet)
+		return nil
+	}
+	set, err := loadSchemaDir(dir)
+	if err != nil {
+		return err
+	}
+	activeSchemaSet.Store(set)
+	return nil
+}
+
+// loadSchemaDir 在内置 schema 的基础上叠加外部 schema 目录中的文件
+func loadSchemaDir(dir string) (*schemaSet, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("读取 schema 目录 %s 失败: %w", dir, err)
+	}
+	apisix := maps.Clone(embeddedAPISIXSchemas)
+	digests := maps.Clone(embeddedSchemaDigests)
+	var overlays []schemaOverlay
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		version := constant.APISIXVersion(entry.Name() + ".X")
+		if _, ok := apisix[version]; !ok {
+			log.Warnf("schema dir: unsupported apisix version directory %s, skipped",
+				filepath.Join(dir, entry.Name()))
+			continue
+		}
+		raw, versionOverlays, overlayDigest, err := applySchemaOverlays(
+			apisix[version], version, filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		if len(versionOverlays) == 0 {
+			continue
+		}
+		apisix[version] = raw
+		// 外部文件变化后摘要随之变化，已缓存的校验结果失效
+		digests[version] = digest([]byte(digests[version]), overlayDigest)
+		overlays = append(overlays, versionOverlays...)
+	}
+	set := newSchemaSet(apisix, embeddedBkAPISIXPluginSchemas, embeddedTAPISIXPluginSchemas, digests)
+	// 展开 $ref 后再检查，文件中可以引用 schema 文档中的公共定义
+	for _, overlay := range overlays {
+		if err := checkSchemaOverlay(overlay.path, set.apisix[overlay.version].Get(overlay.path)); err != nil {
+			return nil, fmt.Errorf("schema 文件 %s 不合法: %w", overlay.file, err)
+		}
+	}
+	return set, nil
+}
+
+// applySchemaOverlays 将版本目录中的文件写入 schema 文档，返回写入后的文档、写入的文件及文件内容的摘要
+func applySchemaOverlays(
+	raw []byte,
+	version constant.APISIXVersion,
+	versionDir string,
+) ([]byte, []schemaOverlay, []byte, error) {
+	var (
+		overlays []schemaOverlay
+		contents [][]byte
+	)
+	for _, part := range schemaDirParts {
+		partDir := filepath.Join(versionDir, part)
+		// os.ReadDir 按文件名排序，摘要与目录遍历顺序无关
+		files, err := os.ReadDir(partDir)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("读取 schema 目录 %s 失败: %w", partDir, err)
+		}
+		for _, file := range files {
+			if file.IsDir() || filepath.Ext(file.Name()) != ".json" {
+				continue
+			}
+			overlay := schemaOverlay{
+				file:    filepath.Join(partDir, file.Name()),
+				version: version,
+				path:    part + "." + gjson.Escape(strings.TrimSuffix(file.Name(), ".json")),
+			}
+			content, err := os.ReadFile(overlay.file)
+			if err != nil {
+				return nil, nil, nil, fmt.Errorf("读取 schema 文件 %s 失败: %w", overlay.file, err)
+			}
+			if !gjson.ValidBytes(content) || !gjson.ParseBytes(content).IsObject() {
+				return nil, nil, nil, fmt.Errorf("schema 文件 %s 不合法: 不是合法的 json 对象", overlay.file)
+			}
+			if raw, err = sjson.SetRawBytes(raw, overlay.path, content); err != nil {
+				return nil, nil, nil, fmt.Errorf("schema 文件 %s 写入失败: %w", overlay.file, err)
+			}
+			overlays = append(overlays, overlay)
+			contents = append(contents, []byte(overlay.path), content)
+		}
+	}
+	return raw, overlays, []byte(digest(contents...)), nil
+}
+
+// checkSchemaOverlay 检查外部文件能否编译为 json schema，插件定义检查其中的各类 schema
+func checkSchemaOverlay(path string, value gjson.Result) error {
+	if !strings.HasPrefix(path, "plugins.") {
+		return compileSchemaOverlay(value)
+	}
+	if !value.Get("schema").IsObject() {
+		return errors.New("插件定义缺少 schema")
+	}
+	for _, key := range []string{"schema", "consumer_schema", "metadata_schema"} {
+		if schemaValue := value.Get(key); schemaValue.Exists() {
+			if err := compileSchemaOverlay(schemaValue); err != nil {
+				return fmt.Errorf("%s: %w", key, err)
+			}
+		}
+	}
+	return nil
+}
+
+// compileSchemaOverlay 编译 json schema
+func compileSchemaOverlay(value gjson.Result) error {
+	_, err := gojsonschema.NewSchema(gojsonschema.NewStringLoader(value.Raw))
+	return err
+}
+
+// WatchSchemaDir 加载外部 schema 目录，并在目录中的文件变化后重新加载，直到 ctx 结束；
+// 首次加载失败时返回错误，之后加载失败只记录日志并保留之前的 schema
+func WatchSchemaDir(ctx context.Context, dir string) error {
+	if err := LoadSchemaDir(dir); err != nil {
+		return err
+	}
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	if err := addSchemaDirWatches(watcher, dir); err != nil {
+		watcher.Close()
+		return err
+	}
+	goroutinex.GoroutineWithRecovery(ctx, func() {
+		defer watcher.Close()
+		var reload <-chan time.Time
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				// 新建的版本目录及子目录需要加入监听
+				if event.Has(fsnotify.Create) {
+					if err := addSchemaDirWatches(watcher, dir); err != nil {
+						log.Errorf("watch schema dir %s error: %v", dir, err)
+					}
+				}
+				reload = time.After(schemaReloadDelay)
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				log.Errorf("watch schema dir %s error: %v", dir, err)
+			case <-reload:
+				reload = nil
+				if err := LoadSchemaDir(dir); err != nil {
+					log.Errorf("reload schema dir %s failed, keep previous schema: %v", dir, err)
+					continue
+				}
+				log.Infof("schema dir %s reloaded", dir)
+			}
+		}
+	})
+	return nil
+}
+
+// addSchemaDirWatches 监听外部 schema 目录及其中的版本目录、子目录，fsnotify 不会递归监听子目录
+func addSchemaDirWatches(watcher *fsnotify.Watcher, dir string) error {
+	return filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		// 只需监听 {dir}、{dir}/{version}、{dir}/{version}/{part} 三层
+		if rel != "." && strings.Count(rel, string(filepath.Separator)) >= 2 {
+			return filepath.SkipDir
+		}
+		return watcher.Add(path)
+	})
+}
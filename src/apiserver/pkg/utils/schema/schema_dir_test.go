@@ -0,0 +1,126 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/sjson"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+// shortDescRouteSchema 将 3.13 的 route schema 中 desc 的最大长度改为 5
+func shortDescRouteSchema(t *testing.T) []byte {
+	raw, err := sjson.SetBytes([]byte(embeddedSchemaSet.apisix[constant.APISIXVersion313].Get("main.route").Raw),
+		"properties.desc.maxLength", 5)
+	assert.NoError(t, err)
+	return raw
+}
+
+// writeSchemaFile 写入外部 schema 目录中的文件
+func writeSchemaFile(t *testing.T, dir, name string, content []byte) {
+	path := filepath.Join(dir, name)
+	assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	assert.NoError(t, os.WriteFile(path, content, 0o644))
+}
+
+// validateLongDescRoute 校验 desc 长度为 8 的路由
+func validateLongDescRoute(version constant.APISIXVersion) error {
+	validator, err := NewAPISIXJsonSchemaValidator(version, constant.Route, "main.route", nil, constant.DATABASE)
+	if err != nil {
+		return err
+	}
+	return validator.Validate(json.RawMessage(`{"id":"r1","uri":"/a","upstream_id":"u1","desc":"12345678"}`))
+}
+
+func TestLoadSchemaDir(t *testing.T) {
+	t.Cleanup(func() { assert.NoError(t, LoadSchemaDir("")) })
+	dir := t.TempDir()
+	writeSchemaFile(t, dir, "3.13/main/route.json", shortDescRouteSchema(t))
+	writeSchemaFile(t, dir, "3.13/plugins/dir-plugin.json",
+		[]byte(`{"priority":1,"schema":{"type":"object","required":["foo"]}}`))
+	// 不支持的版本及非 json 文件被忽略
+	writeSchemaFile(t, dir, "9.9/main/route.json", []byte(`{`))
+	writeSchemaFile(t, dir, "3.13/main/README.md", []byte(`# schema`))
+
+	assert.NoError(t, validateLongDescRoute(constant.APISIXVersion313))
+	embeddedDigest := Digest(constant.APISIXVersion313)
+
+	assert.NoError(t, LoadSchemaDir(dir))
+	assert.ErrorContains(t, validateLongDescRoute(constant.APISIXVersion313), "desc")
+	assert.NoError(t, validateLongDescRoute(constant.APISIXVersion311))
+	assert.NotEqual(t, embeddedDigest, Digest(constant.APISIXVersion313))
+	assert.Equal(t, embeddedSchemaDigests[constant.APISIXVersion311], Digest(constant.APISIXVersion311))
+	assert.NotNil(t, GetPluginSchema(constant.APISIXVersion313, "dir-plugin", "schema"))
+	assert.True(t, IsBundledPlugin(constant.APISIXVersion313, "dir-plugin"))
+
+	// 不合法的文件导致整体加载失败，错误中包含文件名，保留之前的 schema
+	active := activeSchemas()
+	for name, content := range map[string]string{
+		"3.13/plugins/bad-json.json":       `{"schema":`,
+		"3.13/plugins/missing-schema.json": `{"priority":1}`,
+		"3.13/main/upstream.json":          `{"type":"not-a-type"}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			broken := t.TempDir()
+			writeSchemaFile(t, broken, name, []byte(content))
+			assert.ErrorContains(t, LoadSchemaDir(broken), filepath.Join(broken, name))
+			assert.Same(t, active, activeSchemas())
+		})
+	}
+
+	assert.NoError(t, LoadSchemaDir(""))
+	assert.NoError(t, validateLongDescRoute(constant.APISIXVersion313))
+	assert.Equal(t, embeddedDigest, Digest(constant.APISIXVersion313))
+}
+
+func TestWatchSchemaDir(t *testing.T) {
+	t.Cleanup(func() { assert.NoError(t, LoadSchemaDir("")) })
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	dir := t.TempDir()
+	assert.NoError(t, WatchSchemaDir(ctx, dir))
+	assert.NoError(t, validateLongDescRoute(constant.APISIXVersion313))
+
+	// 放入修改后的 route schema，新的校验使用新 schema
+	writeSchemaFile(t, dir, "3.13/main/route.json", shortDescRouteSchema(t))
+	assert.Eventually(t, func() bool {
+		return validateLongDescRoute(constant.APISIXVersion313) != nil
+	}, 5*time.Second, 50*time.Millisecond)
+
+	// 写入不合法的文件时保留之前的 schema
+	active := activeSchemas()
+	writeSchemaFile(t, dir, "3.13/main/route.json", []byte(`{`))
+	time.Sleep(4 * schemaReloadDelay)
+	assert.Same(t, active, activeSchemas())
+	assert.Error(t, validateLongDescRoute(constant.APISIXVersion313))
+
+	// 删除文件后恢复为内置 schema
+	assert.NoError(t, os.Remove(filepath.Join(dir, "3.13/main/route.json")))
+	assert.Eventually(t, func() bool {
+		return validateLongDescRoute(constant.APISIXVersion313) == nil
+	}, 5*time.Second, 50*time.Millisecond)
+}
@@ -63,7 +63,8 @@ func TestGetResourceSchema(t *testing.T) {
 		for _, resource := range constant.ResourceTypeList {
 			t.Run(tt.name, func(t *testing.T) {
 				result := GetResourceSchema(tt.version, resource.String())
-				if tt.shouldFail {
+				// credential 仅 3.11 及以上版本支持
+				if tt.shouldFail || (resource == constant.Credential && !SupportsCredential(tt.version)) {
 					assert.Nil(t, result)
 				} else {
 					assert.NotNil(t, result)
@@ -113,7 +114,7 @@ func TestGetPluginSchema(t *testing.T) {
 	}
 
 	// 查询所有版本的 schema
-	for version := range schemaVersionMap {
+	for version := range activeSchemas().apisix {
 		for _, tt := range tests {
 			t.Run(tt.name, func(t *testing.T) {
 				result := GetPluginSchema(version, tt.pluginName, tt.schemaType)
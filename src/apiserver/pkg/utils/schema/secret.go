@@ -0,0 +1,124 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+package schema
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+	"github.com/xeipuuv/gojsonschema"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+// secret 资源定义：manager -> {versions, schema}，versions 为支持该密钥管理器的 apisix 版本
+//
+//go:embed secret.json
+var rawSecret []byte
+
+var secretManifest = gjson.ParseBytes(rawSecret)
+
+// secretTimeFields apisix 写入的时间字段，与其他资源保持一致取 proto 的定义
+var secretTimeFields = []string{"create_time", "update_time"}
+
+// secretIDSchema secret 的 id，etcd 中为 {manager}/{id}
+var secretIDSchema = json.RawMessage(`{"type":"string","minLength":1,"maxLength":128,` +
+	`"pattern":"^([a-zA-Z0-9-_.]+/)?[a-zA-Z0-9-_.]+$"}`)
+
+// GetSecretManagers 获取 apisix 版本支持的密钥管理器
+func GetSecretManagers(version constant.APISIXVersion) []constant.SecretManager {
+	var managers []constant.SecretManager
+	secretManifest.ForEach(func(key, value gjson.Result) bool {
+		for _, v := range value.Get("versions").Array() {
+			if v.String() == string(version) {
+				managers = append(managers, constant.SecretManager(key.String()))
+				break
+			}
+		}
+		return true
+	})
+	slices.Sort(managers)
+	return managers
+}
+
+// ValidateSecretConfig 按密钥管理器的 schema 校验 secret 配置
+func ValidateSecretConfig(
+	version constant.APISIXVersion,
+	manager constant.SecretManager,
+	config json.RawMessage,
+) error {
+	if !slices.Contains(GetSecretManagers(version), manager) {
+		return fmt.Errorf("apisix %s 不支持密钥管理器: %s", version, manager)
+	}
+	s, err := gojsonschema.NewSchema(
+		gojsonschema.NewStringLoader(secretManifest.Get(string(manager) + ".schema").Raw))
+	if err != nil {
+		return fmt.Errorf("实例化 schema 失败: %w", err)
+	}
+	ret, err := s.Validate(gojsonschema.NewBytesLoader(config))
+	if err != nil {
+		return fmt.Errorf("密钥管理器: %s schema 验证失败: %w", manager, err)
+	}
+	if !ret.Valid() {
+		return fmt.Errorf("密钥管理器: %s schema 验证失败: %s", manager, GetSchemaValidateFailed(ret))
+	}
+	return nil
+}
+
+// withSecretSchema 将版本支持的密钥管理器 schema 合并为 main.secret 写入资源 schema
+// 各密钥管理器的必填字段互不相同，通过 oneOf 区分；顶层 properties 为所有字段的并集，
+// 以便 etcd 形态下按 additionalProperties=false 校验
+func withSecretSchema(raw []byte, version constant.APISIXVersion) []byte {
+	properties := map[string]json.RawMessage{"id": secretIDSchema}
+	for _, field := range secretTimeFields {
+		properties[field] = json.RawMessage(gjson.GetBytes(raw, "main.proto.properties."+field).Raw)
+	}
+	var branches []json.RawMessage
+	for _, manager := range GetSecretManagers(version) {
+		managerSchema := secretManifest.Get(string(manager) + ".schema")
+		managerSchema.Get("properties").ForEach(func(key, value gjson.Result) bool {
+			properties[key.String()] = json.RawMessage(value.Raw)
+			return true
+		})
+		branches = append(branches, json.RawMessage(managerSchema.Raw))
+	}
+	secretSchema, _ := json.Marshal(map[string]interface{}{
+		"type":       "object",
+		"properties": properties,
+		"oneOf":      branches,
+	})
+	raw, err := sjson.SetRawBytes(raw, "main.secret", secretSchema)
+	if err != nil {
+		panic(fmt.Sprintf("inject secret schema for apisix %s failed: %s", version, err))
+	}
+	return raw
+}
+
+// SecretManagerFromID 从 apisix 中的 secret id({manager}/{id}) 解析密钥管理器，编辑区的 id 不含密钥管理器时返回空
+func SecretManagerFromID(id string) constant.SecretManager {
+	manager, _, found := strings.Cut(id, "/")
+	if !found {
+		return ""
+	}
+	return constant.SecretManager(manager)
+}
@@ -0,0 +1,98 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+func TestGetSecretManagers(t *testing.T) {
+	assert.Equal(t, []constant.SecretManager{constant.SecretManagerVault},
+		GetSecretManagers(constant.APISIXVersion32))
+	assert.Equal(t, []constant.SecretManager{
+		constant.SecretManagerAWS,
+		constant.SecretManagerGCP,
+		constant.SecretManagerVault,
+	}, GetSecretManagers(constant.APISIXVersion313))
+}
+
+func TestValidateSecretConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		version constant.APISIXVersion
+		manager constant.SecretManager
+		config  string
+		errMsg  string
+	}{
+		{
+			name:    "vault",
+			version: constant.APISIXVersion32,
+			manager: constant.SecretManagerVault,
+			config:  `{"uri":"http://127.0.0.1:8200","prefix":"kv/apisix","token":"root"}`,
+		},
+		{
+			name:    "vault missing token",
+			version: constant.APISIXVersion32,
+			manager: constant.SecretManagerVault,
+			config:  `{"uri":"http://127.0.0.1:8200","prefix":"kv/apisix"}`,
+			errMsg:  "token",
+		},
+		{
+			name:    "aws unsupported in 3.2",
+			version: constant.APISIXVersion32,
+			manager: constant.SecretManagerAWS,
+			config:  `{"access_key_id":"ak","secret_access_key":"sk"}`,
+			errMsg:  "不支持密钥管理器: aws",
+		},
+		{
+			name:    "aws",
+			version: constant.APISIXVersion313,
+			manager: constant.SecretManagerAWS,
+			config:  `{"access_key_id":"ak","secret_access_key":"sk","region":"us-east-1"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSecretConfig(tt.version, tt.manager, json.RawMessage(tt.config))
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.errMsg)
+		})
+	}
+}
+
+func TestSecretEtcdSchema(t *testing.T) {
+	validator, err := NewAPISIXJsonSchemaValidator(
+		constant.APISIXVersion313, constant.Secret, "main.secret", nil, constant.ETCD)
+	assert.NoError(t, err)
+	assert.NoError(t, validator.Validate(json.RawMessage(
+		`{"id":"vault/1","uri":"http://127.0.0.1:8200","prefix":"kv/apisix","token":"root"}`)))
+	// etcd 中以 id 中的密钥管理器校验必填字段
+	assert.ErrorContains(t, validator.Validate(json.RawMessage(
+		`{"id":"aws/1","uri":"http://127.0.0.1:8200","prefix":"kv/apisix","token":"root"}`)), "access_key_id")
+	assert.Error(t, validator.Validate(json.RawMessage(
+		`{"id":"vault/1","uri":"http://127.0.0.1:8200","prefix":"kv/apisix","token":"root","unknown":1}`)))
+}
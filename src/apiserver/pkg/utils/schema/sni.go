@@ -0,0 +1,79 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"fmt"
+	"strings"
+
+	entity "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/apisix"
+)
+
+// sslTypeClient client 类型的 SSL 仅用于与上游的 mTLS，不能作为服务端证书
+const sslTypeClient = "client"
+
+// UncoveredSNI 未被 SSL 证书覆盖的 stream route sni
+type UncoveredSNI struct {
+	StreamRouteID string `json:"stream_route_id"`
+	SNI           string `json:"sni"`
+}
+
+// CheckStreamRouteSNI 检查 stream route 配置的 sni 是否都有匹配的 server 类型 SSL，返回未被覆盖的 sni
+func CheckStreamRouteSNI(streamRoutes []*entity.StreamRoute, ssls []*entity.SSL) []UncoveredSNI {
+	var serverSNIs []string
+	for _, ssl := range ssls {
+		if ssl == nil || ssl.Type == sslTypeClient {
+			continue
+		}
+		if ssl.Sni != "" {
+			serverSNIs = append(serverSNIs, ssl.Sni)
+		}
+		serverSNIs = append(serverSNIs, ssl.Snis...)
+	}
+	var uncovered []UncoveredSNI
+	for _, streamRoute := range streamRoutes {
+		if streamRoute == nil || streamRoute.SNI == "" {
+			continue
+		}
+		if sniCovered(streamRoute.SNI, serverSNIs) {
+			continue
+		}
+		uncovered = append(uncovered, UncoveredSNI{
+			StreamRouteID: fmt.Sprint(streamRoute.ID),
+			SNI:           streamRoute.SNI,
+		})
+	}
+	return uncovered
+}
+
+// sniCovered 判断 sni 是否被证书的 sni 列表覆盖，支持 *.example.com 形式的泛域名
+func sniCovered(sni string, certSNIs []string) bool {
+	sni = strings.ToLower(sni)
+	for _, certSNI := range certSNIs {
+		certSNI = strings.ToLower(certSNI)
+		if certSNI == sni {
+			return true
+		}
+		// 泛域名只匹配子域名，不匹配根域名本身
+		if strings.HasPrefix(certSNI, "*.") && strings.HasSuffix(sni, certSNI[1:]) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	entity "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/apisix"
+)
+
+func TestCheckStreamRouteSNI(t *testing.T) {
+	ssls := []*entity.SSL{
+		{Sni: "test.com"},
+		{Snis: []string{"*.example.com", "api.foo.com"}},
+		{Snis: []string{"client.com"}, Type: "client"},
+	}
+	tests := []struct {
+		name        string
+		streamRoute *entity.StreamRoute
+		uncovered   []UncoveredSNI
+	}{
+		{
+			name:        "no sni",
+			streamRoute: &entity.StreamRoute{BaseInfo: entity.BaseInfo{ID: "sr1"}},
+		},
+		{
+			name:        "covered by sni",
+			streamRoute: &entity.StreamRoute{BaseInfo: entity.BaseInfo{ID: "sr1"}, SNI: "test.com"},
+		},
+		{
+			name:        "covered by snis case insensitive",
+			streamRoute: &entity.StreamRoute{BaseInfo: entity.BaseInfo{ID: "sr1"}, SNI: "API.foo.com"},
+		},
+		{
+			name:        "covered by wildcard",
+			streamRoute: &entity.StreamRoute{BaseInfo: entity.BaseInfo{ID: "sr1"}, SNI: "www.example.com"},
+		},
+		{
+			name:        "wildcard does not cover root domain",
+			streamRoute: &entity.StreamRoute{BaseInfo: entity.BaseInfo{ID: "sr1"}, SNI: "example.com"},
+			uncovered:   []UncoveredSNI{{StreamRouteID: "sr1", SNI: "example.com"}},
+		},
+		{
+			name:        "client ssl does not cover",
+			streamRoute: &entity.StreamRoute{BaseInfo: entity.BaseInfo{ID: "sr1"}, SNI: "client.com"},
+			uncovered:   []UncoveredSNI{{StreamRouteID: "sr1", SNI: "client.com"}},
+		},
+		{
+			name:        "uncovered",
+			streamRoute: &entity.StreamRoute{BaseInfo: entity.BaseInfo{ID: "sr1"}, SNI: "unknown.com"},
+			uncovered:   []UncoveredSNI{{StreamRouteID: "sr1", SNI: "unknown.com"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uncovered := CheckStreamRouteSNI([]*entity.StreamRoute{tt.streamRoute}, ssls)
+			assert.Equal(t, tt.uncovered, uncovered)
+		})
+	}
+
+	// 没有任何 SSL 时所有 sni 都未覆盖
+	uncovered := CheckStreamRouteSNI([]*entity.StreamRoute{
+		{BaseInfo: entity.BaseInfo{ID: "sr1"}, SNI: "test.com"},
+		{BaseInfo: entity.BaseInfo{ID: "sr2"}, SNI: "www.example.com"},
+	}, nil)
+	assert.Len(t, uncovered, 2)
+}
@@ -0,0 +1,169 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	entity "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/apisix"
+)
+
+// sslValidityTolerance validity_start/validity_end 与证书实际有效期允许的误差
+const sslValidityTolerance = time.Minute
+
+// checkSSL 校验证书与私钥均可解析且匹配，有效期与证书一致，snis 均被证书覆盖，certs/keys 按下标一一匹配
+func checkSSL(ssl *entity.SSL) error {
+	certificate, err := checkCertKeyPair("cert", ssl.Cert, "key", ssl.Key)
+	if err != nil {
+		return err
+	}
+	if err = checkSSLValidity(ssl, certificate, time.Now()); err != nil {
+		return err
+	}
+	if len(ssl.Certs) != len(ssl.Keys) {
+		return fmt.Errorf("certs 与 keys 数量不一致: %d != %d", len(ssl.Certs), len(ssl.Keys))
+	}
+	for i := range ssl.Certs {
+		_, err = checkCertKeyPair(fmt.Sprintf("certs[%d]", i), ssl.Certs[i], fmt.Sprintf("keys[%d]", i), ssl.Keys[i])
+		if err != nil {
+			return err
+		}
+	}
+	// client 类型的证书用于与上游的 mTLS，不需要覆盖 sni
+	if ssl.Type == sslTypeClient {
+		return nil
+	}
+	names := certificateNames(certificate)
+	snis := ssl.Snis
+	if ssl.Sni != "" {
+		snis = append([]string{ssl.Sni}, snis...)
+	}
+	for _, sni := range snis {
+		if !certificateCoversSNI(names, sni) {
+			return fmt.Errorf("sni %s 未被证书覆盖, 证书域名: %s", sni, strings.Join(names, ", "))
+		}
+	}
+	return nil
+}
+
+// checkSSLValidity 校验声明的 validity_start/validity_end 与证书 notBefore/notAfter 一致且未过期，未声明时跳过
+func checkSSLValidity(ssl *entity.SSL, certificate *x509.Certificate, now time.Time) error {
+	if ssl.ValidityStart != 0 && ssl.ValidityEnd != 0 && ssl.ValidityStart >= ssl.ValidityEnd {
+		return fmt.Errorf("validity_start 必须早于 validity_end: validity_start=%s, validity_end=%s",
+			formatUnix(ssl.ValidityStart), formatUnix(ssl.ValidityEnd))
+	}
+	if ssl.ValidityStart != 0 && !withinTolerance(ssl.ValidityStart, certificate.NotBefore) {
+		return fmt.Errorf("validity_start 与证书 notBefore 不一致: 声明 %s, 实际 %s",
+			formatUnix(ssl.ValidityStart), formatUnix(certificate.NotBefore.Unix()))
+	}
+	if ssl.ValidityEnd != 0 && !withinTolerance(ssl.ValidityEnd, certificate.NotAfter) {
+		return fmt.Errorf("validity_end 与证书 notAfter 不一致: 声明 %s, 实际 %s",
+			formatUnix(ssl.ValidityEnd), formatUnix(certificate.NotAfter.Unix()))
+	}
+	if ssl.ValidityEnd != 0 && ssl.ValidityEnd < now.Unix() {
+		return fmt.Errorf("证书已过期: validity_end %s, 证书 notAfter %s",
+			formatUnix(ssl.ValidityEnd), formatUnix(certificate.NotAfter.Unix()))
+	}
+	return nil
+}
+
+// withinTolerance 声明的时间戳(unix 秒)与实际时间的误差是否在允许范围内
+func withinTolerance(declared int64, actual time.Time) bool {
+	diff := time.Unix(declared, 0).Sub(actual)
+	return diff <= sslValidityTolerance && diff >= -sslValidityTolerance
+}
+
+// formatUnix 格式化时间戳，同时保留原始值便于比对
+func formatUnix(ts int64) string {
+	return fmt.Sprintf("%d(%s)", ts, time.Unix(ts, 0).UTC().Format(time.RFC3339))
+}
+
+// checkCertKeyPair 分别解析证书与私钥，并校验二者是否匹配
+func checkCertKeyPair(certField, cert, keyField, key string) (*x509.Certificate, error) {
+	certBlock, _ := pem.Decode([]byte(cert))
+	if certBlock == nil {
+		return nil, fmt.Errorf("%s 解析失败: 不是合法的 PEM 证书", certField)
+	}
+	certificate, err := x509.ParseCertificate(certBlock.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%s 解析失败: %w", certField, err)
+	}
+	keyBlock, _ := pem.Decode([]byte(key))
+	if keyBlock == nil {
+		return nil, fmt.Errorf("%s 解析失败: 不是合法的 PEM 私钥", keyField)
+	}
+	if err = parsePrivateKey(keyBlock.Bytes); err != nil {
+		return nil, fmt.Errorf("%s 解析失败: %w", keyField, err)
+	}
+	if _, err = tls.X509KeyPair([]byte(cert), []byte(key)); err != nil {
+		return nil, fmt.Errorf("%s 与 %s 不匹配: %w", certField, keyField, err)
+	}
+	return certificate, nil
+}
+
+// parsePrivateKey 依次尝试 PKCS1/PKCS8/EC 格式解析私钥，PEM 头部与实际格式不一致时也能解析
+func parsePrivateKey(der []byte) error {
+	if _, err := x509.ParsePKCS1PrivateKey(der); err == nil {
+		return nil
+	}
+	if _, err := x509.ParsePKCS8PrivateKey(der); err == nil {
+		return nil
+	}
+	if _, err := x509.ParseECPrivateKey(der); err == nil {
+		return nil
+	}
+	return errors.New("不支持的私钥格式")
+}
+
+// certificateNames 获取证书覆盖的域名：SAN 中的 DNS/IP，以及 CN
+func certificateNames(certificate *x509.Certificate) []string {
+	names := append([]string{}, certificate.DNSNames...)
+	for _, ip := range certificate.IPAddresses {
+		names = append(names, ip.String())
+	}
+	if certificate.Subject.CommonName != "" {
+		names = append(names, certificate.Subject.CommonName)
+	}
+	return names
+}
+
+// certificateCoversSNI 判断 sni 是否被证书域名覆盖，泛域名只匹配一级子域名
+func certificateCoversSNI(names []string, sni string) bool {
+	sni = strings.ToLower(sni)
+	for _, name := range names {
+		name = strings.ToLower(name)
+		if name == sni {
+			return true
+		}
+		if !strings.HasPrefix(name, "*.") || strings.HasPrefix(sni, "*.") {
+			continue
+		}
+		label, parent, found := strings.Cut(sni, ".")
+		if found && label != "" && parent == name[2:] {
+			return true
+		}
+	}
+	return false
+}
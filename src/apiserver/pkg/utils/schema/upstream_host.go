@@ -0,0 +1,97 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/samber/lo"
+
+	entity "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/apisix"
+)
+
+// CheckUpstreamHostWarnings 检查域名节点与 pass_host 的组合，返回告警信息
+// pass_host 为 pass(默认值) 时 apisix 透传客户端 Host，与域名节点的后端 vhost 通常不一致
+func CheckUpstreamHostWarnings(upstream *entity.UpstreamDef) []string {
+	if upstream == nil || upstream.Nodes == nil {
+		return nil
+	}
+	if upstream.PassHost != "" && upstream.PassHost != "pass" {
+		return nil
+	}
+	nodes, ok := entity.NodesFormat(upstream.Nodes).([]*entity.Node)
+	if !ok {
+		return nil
+	}
+	var domains []string
+	for _, node := range nodes {
+		if isDomainHost(node.Host) {
+			domains = append(domains, node.Host)
+		}
+	}
+	if len(domains) == 0 {
+		return nil
+	}
+	return []string{fmt.Sprintf(
+		"上游节点 %s 为域名且 `pass_host` 为 `pass`, 将透传客户端 Host, 可能与后端 vhost 不一致, "+
+			"建议使用 `node` 或 `rewrite`",
+		strings.Join(domains, ", "),
+	)}
+}
+
+// isDomainHost 判断节点 host 是否为域名
+func isDomainHost(host string) bool {
+	host = strings.TrimSuffix(strings.TrimPrefix(host, "["), "]")
+	if host == "" {
+		return false
+	}
+	_, err := netip.ParseAddr(host)
+	return err != nil
+}
+
+// CheckUpstreamTLSVerifyWarnings 检查开启 `tls.verify` 时是否存在可校验的域名，返回告警信息
+// apisix 以上游请求的 Host 作为 SNI 并校验证书主机名，只有 rewrite 的 upstream_host 或 node 模式的域名节点可以提供该名称
+func CheckUpstreamTLSVerifyWarnings(upstream *entity.UpstreamDef) []string {
+	if upstream == nil || upstream.TLS == nil || upstream.TLS.Verify == nil || !*upstream.TLS.Verify {
+		return nil
+	}
+	switch upstream.PassHost {
+	case "rewrite":
+		if isDomainHost(upstream.UpstreamHost) {
+			return nil
+		}
+	case "node":
+		// 服务发现的节点由注册中心提供，无法在此判断
+		if upstream.DiscoveryType != "" {
+			return nil
+		}
+		nodes, ok := entity.NodesFormat(upstream.Nodes).([]*entity.Node)
+		if ok && len(nodes) > 0 && lo.EveryBy(nodes, func(node *entity.Node) bool {
+			return isDomainHost(node.Host)
+		}) {
+			return nil
+		}
+	}
+	return []string{
+		"上游开启了 `tls.verify`, 但未通过 `upstream_host`(`pass_host` 为 `rewrite`) 或域名节点(`pass_host` 为 `node`) " +
+			"提供可校验的 SNI, 证书主机名校验可能失败",
+	}
+}
@@ -0,0 +1,137 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+
+	entity "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/apisix"
+)
+
+func TestCheckUpstreamHostWarnings(t *testing.T) {
+	domainNodes := []*entity.Node{
+		{Host: "httpbin.org", Port: 80, Weight: 1},
+		{Host: "127.0.0.1", Port: 80, Weight: 1},
+	}
+	tests := []struct {
+		name     string
+		upstream *entity.UpstreamDef
+		warnings int
+	}{
+		{
+			name:     "domain node with pass",
+			upstream: &entity.UpstreamDef{PassHost: "pass", Nodes: domainNodes},
+			warnings: 1,
+		},
+		{
+			name:     "domain node with default pass_host",
+			upstream: &entity.UpstreamDef{Nodes: map[string]interface{}{"httpbin.org:80": float64(1)}},
+			warnings: 1,
+		},
+		{
+			name: "domain node with rewrite",
+			upstream: &entity.UpstreamDef{
+				PassHost:     "rewrite",
+				UpstreamHost: "httpbin.org",
+				Nodes:        domainNodes,
+			},
+		},
+		{
+			name: "ip nodes with pass",
+			upstream: &entity.UpstreamDef{PassHost: "pass", Nodes: []*entity.Node{
+				{Host: "127.0.0.1", Port: 80, Weight: 1},
+				{Host: "[::1]", Port: 8080, Weight: 1},
+			}},
+		},
+		{
+			name:     "discovery without nodes",
+			upstream: &entity.UpstreamDef{ServiceName: "svc", DiscoveryType: "dns"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			warnings := CheckUpstreamHostWarnings(tt.upstream)
+			assert.Len(t, warnings, tt.warnings)
+			if tt.warnings > 0 {
+				assert.Contains(t, warnings[0], "httpbin.org")
+			}
+		})
+	}
+}
+
+func TestCheckUpstreamTLSVerifyWarnings(t *testing.T) {
+	verify := &entity.UpstreamTLS{Verify: lo.ToPtr(true)}
+	tests := []struct {
+		name     string
+		upstream *entity.UpstreamDef
+		warnings int
+	}{
+		{
+			name: "verify without name",
+			upstream: &entity.UpstreamDef{Scheme: "https", TLS: verify, Nodes: []*entity.Node{
+				{Host: "127.0.0.1", Port: 443, Weight: 1},
+			}},
+			warnings: 1,
+		},
+		{
+			name: "verify with ip upstream_host",
+			upstream: &entity.UpstreamDef{
+				Scheme:       "https",
+				TLS:          verify,
+				PassHost:     "rewrite",
+				UpstreamHost: "10.0.0.1",
+				Nodes:        []*entity.Node{{Host: "10.0.0.1", Port: 443, Weight: 1}},
+			},
+			warnings: 1,
+		},
+		{
+			name: "verify with upstream_host",
+			upstream: &entity.UpstreamDef{
+				Scheme:       "https",
+				TLS:          verify,
+				PassHost:     "rewrite",
+				UpstreamHost: "httpbin.org",
+				Nodes:        []*entity.Node{{Host: "127.0.0.1", Port: 443, Weight: 1}},
+			},
+		},
+		{
+			name: "verify with domain node",
+			upstream: &entity.UpstreamDef{
+				Scheme:   "https",
+				TLS:      verify,
+				PassHost: "node",
+				Nodes:    map[string]interface{}{"httpbin.org:443": float64(1)},
+			},
+		},
+		{
+			name: "verify disabled",
+			upstream: &entity.UpstreamDef{Scheme: "https", TLS: &entity.UpstreamTLS{}, Nodes: []*entity.Node{
+				{Host: "127.0.0.1", Port: 443, Weight: 1},
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Len(t, CheckUpstreamTLSVerifyWarnings(tt.upstream), tt.warnings)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	entity "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/apisix"
+)
+
+// DefaultUpstreamNodesWarnThreshold 上游节点数告警阈值的默认值
+const DefaultUpstreamNodesWarnThreshold = 1000
+
+// upstreamNodesWarnThreshold 上游节点数告警阈值，小于等于 0 时不检查
+var upstreamNodesWarnThreshold atomic.Int64
+
+func init() {
+	upstreamNodesWarnThreshold.Store(DefaultUpstreamNodesWarnThreshold)
+}
+
+// SetUpstreamNodesWarnThreshold 设置上游节点数告警阈值，小于等于 0 时关闭该检查
+func SetUpstreamNodesWarnThreshold(threshold int) {
+	upstreamNodesWarnThreshold.Store(int64(threshold))
+}
+
+// UpstreamNodesWarnThreshold 当前生效的上游节点数告警阈值
+func UpstreamNodesWarnThreshold() int64 {
+	return upstreamNodesWarnThreshold.Load()
+}
+
+// CheckUpstreamNodesCountWarnings 检查上游静态节点数是否超过阈值，返回告警信息
+// 节点过多会影响 apisix 的负载均衡性能并增大 etcd 中的配置体积；服务发现的节点由注册中心提供，不检查
+func CheckUpstreamNodesCountWarnings(upstream *entity.UpstreamDef) []string {
+	threshold := UpstreamNodesWarnThreshold()
+	if upstream == nil || upstream.DiscoveryType != "" || threshold <= 0 {
+		return nil
+	}
+	nodes, ok := entity.NodesFormat(upstream.Nodes).([]*entity.Node)
+	if !ok || int64(len(nodes)) <= threshold {
+		return nil
+	}
+	return []string{fmt.Sprintf(
+		"上游节点数 %d 超过 %d, 节点过多会影响网关性能并增大 etcd 存储, 建议拆分上游或使用服务发现",
+		len(nodes), threshold,
+	)}
+}
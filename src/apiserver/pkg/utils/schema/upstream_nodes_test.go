@@ -0,0 +1,99 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	entity "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/apisix"
+)
+
+// genUpstreamNodes 生成指定数量的上游节点
+func genUpstreamNodes(count int) []*entity.Node {
+	nodes := make([]*entity.Node, 0, count)
+	for i := 0; i < count; i++ {
+		nodes = append(nodes, &entity.Node{Host: fmt.Sprintf("10.0.%d.%d", i/256, i%256), Port: 80, Weight: 1})
+	}
+	return nodes
+}
+
+func TestCheckUpstreamNodesCountWarnings(t *testing.T) {
+	defer SetUpstreamNodesWarnThreshold(DefaultUpstreamNodesWarnThreshold)
+	SetUpstreamNodesWarnThreshold(3)
+
+	tests := []struct {
+		name     string
+		upstream *entity.UpstreamDef
+		warnings int
+	}{
+		{
+			name:     "over threshold",
+			upstream: &entity.UpstreamDef{Nodes: genUpstreamNodes(4)},
+			warnings: 1,
+		},
+		{
+			name: "over threshold with map nodes",
+			upstream: &entity.UpstreamDef{Nodes: map[string]interface{}{
+				"10.0.0.1:80": float64(1), "10.0.0.2:80": float64(1),
+				"10.0.0.3:80": float64(1), "10.0.0.4:80": float64(1),
+			}},
+			warnings: 1,
+		},
+		{
+			name:     "normal",
+			upstream: &entity.UpstreamDef{Nodes: genUpstreamNodes(3)},
+		},
+		{
+			name:     "discovery",
+			upstream: &entity.UpstreamDef{ServiceName: "svc", DiscoveryType: "dns", Nodes: genUpstreamNodes(4)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			warnings := CheckUpstreamNodesCountWarnings(tt.upstream)
+			assert.Len(t, warnings, tt.warnings)
+			if tt.warnings > 0 {
+				assert.Contains(t, warnings[0], "上游节点数 4 超过 3")
+			}
+		})
+	}
+
+	// 阈值小于等于 0 时不检查
+	SetUpstreamNodesWarnThreshold(0)
+	assert.Empty(t, CheckUpstreamNodesCountWarnings(&entity.UpstreamDef{Nodes: genUpstreamNodes(4)}))
+}
+
+func TestValidateUpstreamNodesCount(t *testing.T) {
+	defer SetUpstreamNodesWarnThreshold(DefaultUpstreamNodesWarnThreshold)
+	SetUpstreamNodesWarnThreshold(3)
+
+	validator, err := NewAPISIXJsonSchemaValidator(constant.APISIXVersion313, constant.Upstream, "main.upstream",
+		nil, constant.DATABASE)
+	assert.NoError(t, err)
+	for count, warnings := range map[int]int{4: 1, 3: 0} {
+		config, _ := json.Marshal(map[string]interface{}{"type": "roundrobin", "nodes": genUpstreamNodes(count)})
+		assert.NoError(t, validator.Validate(config))
+		assert.Len(t, validator.(*APISIXJsonSchemaValidator).Warnings(), warnings, count)
+	}
+}
@@ -24,6 +24,12 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
+	"net/netip"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/spf13/cast"
 	"github.com/tidwall/gjson"
@@ -33,6 +39,7 @@ import (
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
 	entity "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/apisix"
 	log "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/proto"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/sslx"
 )
 
@@ -45,9 +52,16 @@ var allowedOps = map[string]bool{
 	"~~":  true, // 正则匹配
 	"~*":  true, // 不区分大小写的正则匹配
 	"IN":  true, // 在
+	"in":  true, // 在，取反使用四元组 [var, "!", "in", [...]]
 	"HAS": true, // 包含
 }
 
+// inVarOps 匹配值为列表的操作符
+var inVarOps = map[string]bool{
+	"IN": true,
+	"in": true,
+}
+
 // FuncGetCustomSchema ...
 type FuncGetCustomSchema func(ctx context.Context, name string) map[string]interface{}
 
@@ -68,6 +82,65 @@ type APISIXJsonSchemaValidator struct {
 	version                  constant.APISIXVersion
 	resourceType             constant.APISIXResource
 	customizePluginSchemaMap map[string]interface{}
+	// RetriesCheck chash 上游重试次数与节点数的校验模式，默认不校验
+	RetriesCheck constant.RetriesCheckMode
+	// AllowCustomVars 是否允许路由 vars 使用内置变量表之外的变量名，默认不允许
+	AllowCustomVars bool
+	// warnings 最近一次 Validate 产生的非阻断告警
+	warnings []Warning
+}
+
+// WarningType 非阻断告警类型
+type WarningType string
+
+const (
+	WarningTypeGeneral       WarningType = "general"        // 通用告警
+	WarningTypeUnknownPlugin WarningType = "unknown_plugin" // 插件在目标版本中不存在(未知或已废弃)，配置不会生效
+)
+
+// Warning 校验产生的非阻断告警
+type Warning struct {
+	Type    WarningType
+	Plugin  string // 告警关联的插件，仅插件相关告警有值
+	Message string
+}
+
+// String ...
+func (w Warning) String() string {
+	return w.Message
+}
+
+// Warnings 返回最近一次 Validate 产生的非阻断告警
+func (v *APISIXJsonSchemaValidator) Warnings() []Warning {
+	return v.warnings
+}
+
+// warn 记录通用非阻断告警
+func (v *APISIXJsonSchemaValidator) warn(format string, args ...interface{}) {
+	v.addWarning(Warning{Type: WarningTypeGeneral, Message: fmt.Sprintf(format, args...)})
+}
+
+// addWarning 记录非阻断告警
+func (v *APISIXJsonSchemaValidator) addWarning(warning Warning) {
+	log.Warnf("schema validate warning: %s", warning.Message)
+	v.warnings = append(v.warnings, warning)
+}
+
+// warnUnknownPlugin 记录目标版本中不存在的插件，其他版本中存在的插件视为已废弃
+func (v *APISIXJsonSchemaValidator) warnUnknownPlugin(resourceIdentification, pluginName, schemaType string) error {
+	reason := "未知插件"
+	for version := range activeSchemas().apisix {
+		if version != v.version && GetPluginSchema(version, pluginName, schemaType) != nil {
+			reason = "插件已废弃或更名"
+			break
+		}
+	}
+	return v.ruleWarning(RuleUnknownPlugin, Warning{
+		Type:   WarningTypeUnknownPlugin,
+		Plugin: pluginName,
+		Message: fmt.Sprintf("资源: %s 插件 %s 在 %s 中不存在(%s), 配置不会生效",
+			resourceIdentification, pluginName, v.version, reason),
+	})
 }
 
 // NewResourceSchema 获取资源 schema
@@ -77,7 +150,18 @@ func NewResourceSchema(
 	jsonPath string,
 	dataType constant.DataType,
 ) (string, *gojsonschema.Schema, error) {
-	schemaDef := schemaVersionMap[version].Get(jsonPath).String()
+	return newResourceSchema(activeSchemas(), version, resourceType, jsonPath, dataType)
+}
+
+// newResourceSchema 从指定的 schema 中获取资源 schema
+func newResourceSchema(
+	set *schemaSet,
+	version constant.APISIXVersion,
+	resourceType constant.APISIXResource,
+	jsonPath string,
+	dataType constant.DataType,
+) (string, *gojsonschema.Schema, error) {
+	schemaDef := set.apisix[version].Get(jsonPath).String()
 	if schemaDef == "" {
 		log.Warnf("schema validate failed: schema not found, path: %s", jsonPath)
 		return "", nil, fmt.Errorf("schema 验证失败: 未找到 schema, 路径: %s", jsonPath)
@@ -113,24 +197,78 @@ func NewResourceSchema(
 	return "", nil, fmt.Errorf("未知的数据类型: %s", dataType)
 }
 
+// schemaCacheKey 编译后 schema 的缓存 key
+type schemaCacheKey struct {
+	version      constant.APISIXVersion
+	resourceType constant.APISIXResource
+	jsonPath     string
+	dataType     constant.DataType
+}
+
+// compiledSchema 编译后的 schema 及其定义
+type compiledSchema struct {
+	schemaDef string
+	schema    *gojsonschema.Schema
+}
+
+// ResetSchemaCache 清空 schema 缓存，用于测试中强制重新编译
+func ResetSchemaCache() {
+	activeSchemas().compiled.Clear()
+}
+
+// getCompiledResourceSchema 获取编译后的资源 schema，优先从当前生效 schema 的缓存中获取
+func getCompiledResourceSchema(
+	version constant.APISIXVersion,
+	resourceType constant.APISIXResource,
+	jsonPath string,
+	dataType constant.DataType,
+) (*compiledSchema, error) {
+	set := activeSchemas()
+	key := schemaCacheKey{version: version, resourceType: resourceType, jsonPath: jsonPath, dataType: dataType}
+	if cached, ok := set.compiled.Load(key); ok {
+		return cached.(*compiledSchema), nil
+	}
+	schemaDef, schema, err := newResourceSchema(set, version, resourceType, jsonPath, dataType)
+	if err != nil {
+		return nil, err
+	}
+	// 并发编译时以先写入的结果为准
+	cached, _ := set.compiled.LoadOrStore(key, &compiledSchema{schemaDef: schemaDef, schema: schema})
+	return cached.(*compiledSchema), nil
+}
+
 // NewAPISIXJsonSchemaValidator 创建 APISIXJsonSchemaValidator
 func NewAPISIXJsonSchemaValidator(version constant.APISIXVersion,
 	resourceType constant.APISIXResource, jsonPath string, customizePluginSchemaMap map[string]interface{},
 	dataType constant.DataType,
 ) (Validator, error) {
-	schemaDef, schema, err := NewResourceSchema(version, resourceType, jsonPath, dataType)
+	compiled, err := getCompiledResourceSchema(version, resourceType, jsonPath, dataType)
 	if err != nil {
 		return nil, err
 	}
 	return &APISIXJsonSchemaValidator{
-		schema:                   schema,
-		schemaDef:                schemaDef,
+		schema:                   compiled.schema,
+		schemaDef:                compiled.schemaDef,
 		version:                  version,
 		resourceType:             resourceType,
 		customizePluginSchemaMap: customizePluginSchemaMap,
 	}, nil
 }
 
+// SetAllowCustomVars 设置校验器是否允许 vars 使用自定义变量名，对应网关的 allow_custom_vars 配置
+func SetAllowCustomVars(validator Validator, allow bool) {
+	if v, ok := validator.(*APISIXJsonSchemaValidator); ok {
+		v.AllowCustomVars = allow
+	}
+}
+
+// SetRetriesCheck 设置校验器 chash 上游重试次数的校验模式，对应网关的 retries_check 配置
+func SetRetriesCheck(validator Validator, mode constant.RetriesCheckMode) {
+	if v, ok := validator.(*APISIXJsonSchemaValidator); ok {
+		v.RetriesCheck = mode
+	}
+}
+
 func getPlugins(reqBody interface{}) (map[string]interface{}, string) {
 	switch bodyType := reqBody.(type) {
 	case *entity.Route:
@@ -145,6 +283,9 @@ func getPlugins(reqBody interface{}) (map[string]interface{}, string) {
 	case *entity.ConsumerGroup:
 		log.Infof("type of reqBody: %#v", bodyType)
 		return bodyType.Plugins, "consumer_schema"
+	case *entity.Credential:
+		log.Infof("type of reqBody: %#v", bodyType)
+		return bodyType.Plugins, "consumer_schema"
 	case *entity.PluginConfig:
 		log.Infof("type of reqBody: %#v", bodyType)
 		return bodyType.Plugins, "schema"
@@ -174,14 +315,14 @@ func (v *APISIXJsonSchemaValidator) cHashKeySchemaCheck(upstream *entity.Upstrea
 
 	var schemaDef string
 	if upstream.HashOn == "vars" {
-		schemaDef = schemaVersionMap[v.version].Get("main.upstream_hash_vars_schema").String()
+		schemaDef = activeSchemas().apisix[v.version].Get("main.upstream_hash_vars_schema").String()
 		if schemaDef == "" {
 			return fmt.Errorf("schema 验证失败: 未找到 schema, 路径: main.upstream_hash_vars_schema")
 		}
 	}
 
 	if upstream.HashOn == "header" || upstream.HashOn == "cookie" {
-		schemaDef = schemaVersionMap[v.version].Get("main.upstream_hash_header_schema").String()
+		schemaDef = activeSchemas().apisix[v.version].Get("main.upstream_hash_header_schema").String()
 		if schemaDef == "" {
 			return fmt.Errorf("schema 验证失败: 未找到 schema, 路径: main.upstream_hash_header_schema")
 		}
@@ -210,7 +351,8 @@ func (v *APISIXJsonSchemaValidator) checkUpstream(upstream *entity.UpstreamDef)
 		return nil
 	}
 
-	if upstream.PassHost == "node" && upstream.Nodes != nil {
+	// 服务发现的节点由注册中心提供，单节点的限制只针对静态 nodes
+	if upstream.DiscoveryType == "" && upstream.PassHost == "node" && upstream.Nodes != nil {
 		nodes, ok := entity.NodesFormat(upstream.Nodes).([]*entity.Node)
 		if !ok {
 			return fmt.Errorf("当 `pass_host` 为 `node` 时, upstreams 节点不支持值 %v", nodes)
@@ -219,10 +361,45 @@ func (v *APISIXJsonSchemaValidator) checkUpstream(upstream *entity.UpstreamDef)
 		}
 	}
 
+	if err := checkUpstreamNodes(upstream); err != nil {
+		return err
+	}
+
 	if upstream.PassHost == "rewrite" && upstream.UpstreamHost == "" {
 		return fmt.Errorf("`当 `pass_host` 为 `rewrite` 时, `upstream_host` 不可为空")
 	}
 
+	for _, warning := range CheckUpstreamHostWarnings(upstream) {
+		if err := v.ruleWarning(RuleUpstreamHost, Warning{Type: WarningTypeGeneral, Message: warning}); err != nil {
+			return err
+		}
+	}
+	for _, warning := range CheckUpstreamTLSVerifyWarnings(upstream) {
+		err := v.ruleWarning(RuleUpstreamTLSVerify, Warning{Type: WarningTypeGeneral, Message: warning})
+		if err != nil {
+			return err
+		}
+	}
+	for _, warning := range CheckUpstreamNodesCountWarnings(upstream) {
+		err := v.ruleWarning(RuleUpstreamNodesCount, Warning{Type: WarningTypeGeneral, Message: warning})
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := checkUpstreamTimeout(upstream.Timeout); err != nil {
+		return err
+	}
+
+	if err := checkUpstreamHealthChecks(upstream); err != nil {
+		return err
+	}
+
+	// check discovery args
+	if err := checkDiscoveryArgs(upstream); err != nil {
+		return err
+	}
+
 	// check upstream ssl
 	if upstream.TLS != nil && (upstream.TLS.ClientCert != "" || upstream.TLS.ClientKey != "") {
 		_, err := sslx.ParseCert(upstream.TLS.ClientCert, upstream.TLS.ClientKey)
@@ -252,29 +429,172 @@ func (v *APISIXJsonSchemaValidator) checkUpstream(upstream *entity.UpstreamDef)
 		return err
 	}
 
+	return v.checkRetries(upstream)
+}
+
+// checkUpstreamNodes 校验节点 host 能否规范化，并以规范化后的 host:port 检查节点是否重复，
+// 如 `::1`、`[::1]` 与 `[0:0::1]` 视为同一节点
+func checkUpstreamNodes(upstream *entity.UpstreamDef) error {
+	nodes, ok := entity.NodesFormat(upstream.Nodes).([]*entity.Node)
+	if !ok {
+		return nil
+	}
+	seen := make(map[string]bool, len(nodes))
+	for _, node := range nodes {
+		host, err := entity.NormalizeNodeHost(node.Host)
+		if err != nil {
+			return err
+		}
+		key := net.JoinHostPort(host, strconv.Itoa(node.Port))
+		if seen[key] {
+			return fmt.Errorf("上游节点 %s 重复", key)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// checkRouteUpstreamSource 检查路由的上游来源是否唯一
+// upstream 与 upstream_id 同时配置时 apisix 的生效顺序不直观，直接拒绝；
+// service_id 与内联 upstream 同时配置时内联 upstream 会覆盖 service 的上游，仅告警
+func (v *APISIXJsonSchemaValidator) checkRouteUpstreamSource(route *entity.Route) error {
+	if route.Upstream == nil {
+		return nil
+	}
+	if route.UpstreamID != nil {
+		if route.ServiceID != nil {
+			return fmt.Errorf("路由不能同时配置 `upstream`、`upstream_id` 与 `service_id`")
+		}
+		return fmt.Errorf("路由不能同时配置 `upstream` 与 `upstream_id`")
+	}
+	if route.ServiceID != nil {
+		v.warn("路由同时配置了 `service_id` 与 `upstream`, 内联 upstream 将覆盖 service 的上游配置")
+	}
 	return nil
 }
 
+// checkUpstreamTimeout 配置了 timeout 时，connect/send/read 均需为正数，否则 apisix 会使用默认值
+func checkUpstreamTimeout(timeout *entity.Timeout) error {
+	if timeout == nil {
+		return nil
+	}
+	for _, item := range []struct {
+		field string
+		value entity.TimeoutValue
+	}{
+		{"connect", timeout.Connect},
+		{"send", timeout.Send},
+		{"read", timeout.Read},
+	} {
+		if item.value <= 0 {
+			return fmt.Errorf("上游超时时间 timeout.%s 必须为正数, 当前值: %v", item.field, item.value)
+		}
+	}
+	return nil
+}
+
+// checkRetries chash 上游的重试次数超过节点数时，多出的重试会浪费在已尝试过的节点上
+func (v *APISIXJsonSchemaValidator) checkRetries(upstream *entity.UpstreamDef) error {
+	switch v.RetriesCheck {
+	case constant.RetriesCheckLenient, constant.RetriesCheckStrict:
+	default:
+		return nil
+	}
+	if upstream.Retries == nil || upstream.Nodes == nil {
+		return nil
+	}
+	nodes, ok := entity.NodesFormat(upstream.Nodes).([]*entity.Node)
+	if !ok || *upstream.Retries <= len(nodes) {
+		return nil
+	}
+	message := fmt.Sprintf("chash 上游重试次数 retries: %d 超过节点数: %d", *upstream.Retries, len(nodes))
+	if v.RetriesCheck == constant.RetriesCheckStrict {
+		return errors.New(message)
+	}
+	return v.ruleWarning(RuleUpstreamRetries, Warning{Type: WarningTypeGeneral, Message: message})
+}
+
+// exclusiveFields 不允许同时配置的单值/多值字段
+var exclusiveFields = [][2]string{
+	{"uri", "uris"},
+	{"host", "hosts"},
+	{"remote_addr", "remote_addrs"},
+}
+
+// checkExclusiveFields 检查 uri/uris、host/hosts、remote_addr/remote_addrs 是否同时配置
+func checkExclusiveFields(rawConfig json.RawMessage) error {
+	config := gjson.ParseBytes(rawConfig)
+	for _, fields := range exclusiveFields {
+		if config.Get(fields[0]).Exists() && config.Get(fields[1]).Exists() {
+			return fmt.Errorf("`%s` 与 `%s` 不能同时配置", fields[0], fields[1])
+		}
+	}
+	return nil
+}
+
+// checkRemoteAddr 校验 remote_addrs 中的每一项均为合法的 IP 或 CIDR
 func checkRemoteAddr(remoteAddrs []string) error {
-	for _, remoteAddr := range remoteAddrs {
-		if remoteAddr == "" {
-			return fmt.Errorf("schema 验证失败: 无效字段 remote_addrs")
+	if remoteAddrs != nil && len(remoteAddrs) == 0 {
+		return fmt.Errorf("schema 验证失败: remote_addrs 不能为空列表")
+	}
+	for i, remoteAddr := range remoteAddrs {
+		if err := checkIPOrCIDR(remoteAddr); err != nil {
+			return fmt.Errorf("schema 验证失败: remote_addrs[%d] %q %s", i, remoteAddr, err)
 		}
 	}
 	return nil
 }
 
-// validateVarItem 校验单个 var 条目
-func validateVarItem(item []interface{}) error {
+// checkAddrField 校验单值地址字段(如 remote_addr、server_addr)，未配置时跳过
+func checkAddrField(field string, addr string) error {
+	if addr == "" {
+		return nil
+	}
+	if err := checkIPOrCIDR(addr); err != nil {
+		return fmt.Errorf("schema 验证失败: %s %q %s", field, addr, err)
+	}
+	return nil
+}
+
+// checkIPOrCIDR 校验地址为 IPv4/IPv6 地址或 CIDR，不接受域名
+func checkIPOrCIDR(addr string) error {
+	if addr == "" {
+		return errors.New("不能为空")
+	}
+	if strings.Contains(addr, "/") {
+		if _, err := netip.ParsePrefix(addr); err != nil {
+			return errors.New("不是合法的 CIDR")
+		}
+		return nil
+	}
+	ip, err := netip.ParseAddr(addr)
+	if err != nil {
+		return errors.New("不是合法的 IP 地址或 CIDR")
+	}
+	// apisix 的 ip 匹配不支持 IPv6 zone
+	if ip.Zone() != "" {
+		return errors.New("不支持带 zone 的 IPv6 地址")
+	}
+	return nil
+}
+
+// validateVarItem 校验单个 var 条目，allowCustomVars 为 false 时变量名必须为已知变量
+func validateVarItem(item []interface{}, allowCustomVars bool) error {
 	length := len(item)
 	// 检查数组长度
 	if length != 3 && length != 4 {
 		return errors.New("var 项必须为三元组或四元组")
 	}
 	// 检查变量名是否为字符串
-	if _, ok := item[0].(string); !ok {
+	name, ok := item[0].(string)
+	if !ok {
 		return errors.New("变量名必须为字符串")
 	}
+	if !allowCustomVars {
+		if err := checkVarName(name); err != nil {
+			return err
+		}
+	}
 	// 处理四元组 [!]
 	if length == 4 {
 		// 第二个元素必须是 "!"
@@ -282,68 +602,172 @@ func validateVarItem(item []interface{}) error {
 			return errors.New("四元组第二位必须为 '!'")
 		}
 		// 检查第三位是否为合法操作符
-		if op, ok := item[2].(string); !ok || !allowedOps[op] {
+		op, ok := item[2].(string)
+		if !ok || !allowedOps[op] {
 			return errors.New("非法的操作符")
 		}
-		// 检查第四位是否存在(值校验可扩展)
-		if item[3] == nil {
-			return errors.New("匹配值不能为空")
-		}
-		return nil
+		return validateVarValue(op, item[3])
 	}
 	// 处理三元组
-	if op, ok := item[1].(string); !ok || !allowedOps[op] {
+	op, ok := item[1].(string)
+	if !ok || !allowedOps[op] {
 		return errors.New("非法的操作符")
 	}
-	// 检查值是否存在
-	if item[2] == nil {
+	return validateVarValue(op, item[2])
+}
+
+// validateVarValue 校验 var 条目的匹配值，in 操作符的匹配值必须为非空且类型一致的字符串或数字列表
+func validateVarValue(op string, value interface{}) error {
+	if value == nil {
 		return errors.New("匹配值不能为空")
 	}
+	if !inVarOps[op] {
+		return nil
+	}
+	values, ok := value.([]interface{})
+	if !ok {
+		return fmt.Errorf("操作符 %s 的匹配值必须为列表", op)
+	}
+	if len(values) == 0 {
+		return fmt.Errorf("操作符 %s 的匹配值不能为空列表", op)
+	}
+	var isString bool
+	for i, v := range values {
+		switch v.(type) {
+		case string:
+			if i > 0 && !isString {
+				return fmt.Errorf("操作符 %s 的匹配值类型必须一致", op)
+			}
+			isString = true
+		case float64, int, int64:
+			if i > 0 && isString {
+				return fmt.Errorf("操作符 %s 的匹配值类型必须一致", op)
+			}
+		default:
+			return fmt.Errorf("操作符 %s 的匹配值只能为字符串或数字", op)
+		}
+	}
 	return nil
 }
 
-// checkVars 校验 vars
-func checkVars(vars []interface{}) error {
+// logicalVarOps vars 嵌套分组支持的逻辑操作符
+var logicalVarOps = map[string]bool{
+	"AND":  true,
+	"OR":   true,
+	"!AND": true,
+	"!OR":  true,
+}
+
+// varExprMaxDepth vars 表达式最大嵌套层级
+const varExprMaxDepth = 16
+
+// checkVars 校验 vars，顶层首元素为逻辑操作符时整体作为一个逻辑表达式校验
+func checkVars(vars []interface{}, allowCustomVars bool) error {
 	if len(vars) == 0 {
 		return nil
 	}
+	if op, ok := vars[0].(string); ok && logicalVarOps[op] {
+		return checkVarExpr(vars, 1, allowCustomVars)
+	}
 	for i, item := range vars {
 		// 检查是否为数组
-		if _, ok := item.([]interface{}); !ok {
+		expr, ok := item.([]interface{})
+		if !ok {
+			// 漏掉了最外层的列表
+			if validateVarItem(vars, true) == nil {
+				return errors.New("vars 嵌套层级过浅: 期望条件列表, 实际为单个条件, 请在外层再包裹一层列表")
+			}
 			return errors.New(" vars数组的值对象必须也是列表")
 		}
-		if err := validateVarItem(item.([]interface{})); err != nil {
+		if err := checkVarExpr(expr, 1, allowCustomVars); err != nil {
 			return fmt.Errorf("第 %d 项错误: %v", i+1, err)
 		}
 	}
 	return nil
 }
 
+// checkVarExpr 校验 vars 表达式，支持嵌套分组：
+// 首元素为数组时为隐式 AND 分组，首元素为逻辑操作符(AND/OR/!AND/!OR)时其余元素为子表达式，否则为叶子条目
+func checkVarExpr(expr []interface{}, depth int, allowCustomVars bool) error {
+	if depth > varExprMaxDepth {
+		return fmt.Errorf("嵌套层级超过上限 %d", varExprMaxDepth)
+	}
+	if len(expr) == 0 {
+		return errors.New("var 项不能为空列表")
+	}
+	subExprs := expr
+	if _, ok := expr[0].([]interface{}); ok && len(expr) == 1 {
+		// 单个条件被多包裹了一层列表
+		return errors.New("嵌套层级过深: 期望单个条件, 实际为只包含一个元素的列表")
+	}
+	if _, ok := expr[0].([]interface{}); !ok {
+		op, _ := expr[0].(string)
+		if !logicalVarOps[op] {
+			return validateVarItem(expr, allowCustomVars)
+		}
+		if len(expr) < 2 {
+			return fmt.Errorf("逻辑操作符 %s 后至少需要一个子表达式", op)
+		}
+		subExprs = expr[1:]
+	}
+	for i, item := range subExprs {
+		sub, ok := item.([]interface{})
+		if !ok {
+			return fmt.Errorf("嵌套分组第 %d 项必须为列表", i+1)
+		}
+		if err := checkVarExpr(sub, depth+1, allowCustomVars); err != nil {
+			return fmt.Errorf("嵌套分组第 %d 项错误: %v", i+1, err)
+		}
+	}
+	return nil
+}
+
 func (v *APISIXJsonSchemaValidator) checkConf(reqBody interface{}) error {
 	switch bodyType := reqBody.(type) {
 	case *entity.Route:
 		route := reqBody.(*entity.Route)
 		log.Infof("type of reqBody: %#v", bodyType)
-		if err := v.checkUpstream(route.Upstream); err != nil {
+		if err := v.ruleError(RuleRouteUpstreamSource, v.checkRouteUpstreamSource(route)); err != nil {
+			return err
+		}
+		if err := v.ruleError(RuleUpstream, v.checkUpstream(route.Upstream)); err != nil {
+			return err
+		}
+		if err := v.ruleError(RuleRemoteAddr, checkAddrField("remote_addr", route.RemoteAddr)); err != nil {
 			return err
 		}
-		// todo: this is a temporary method, we'll drop it later
-		if err := checkRemoteAddr(route.RemoteAddrs); err != nil {
+		if err := v.ruleError(RuleRemoteAddr, checkRemoteAddr(route.RemoteAddrs)); err != nil {
 			return err
 		}
 		// check vars
-		if err := checkVars(route.Vars); err != nil {
+		if err := v.ruleError(RuleRouteVars, checkVars(route.Vars, v.AllowCustomVars)); err != nil {
+			return err
+		}
+		err := v.ruleError(RulePluginUpstreamScheme, checkPluginUpstreamScheme(route.Plugins, route.Upstream))
+		if err != nil {
+			return err
+		}
+
+	case *entity.StreamRoute:
+		if err := v.ruleError(RuleRemoteAddr, checkAddrField("remote_addr", bodyType.RemoteAddr)); err != nil {
+			return err
+		}
+		if err := v.ruleError(RuleRemoteAddr, checkAddrField("server_addr", bodyType.ServerAddr)); err != nil {
 			return err
 		}
 
 	case *entity.Service:
 		service := reqBody.(*entity.Service)
-		if err := v.checkUpstream(service.Upstream); err != nil {
+		if err := v.ruleError(RuleUpstream, v.checkUpstream(service.Upstream)); err != nil {
+			return err
+		}
+		err := v.ruleError(RulePluginUpstreamScheme, checkPluginUpstreamScheme(service.Plugins, service.Upstream))
+		if err != nil {
 			return err
 		}
 	case *entity.Upstream:
 		upstream := reqBody.(*entity.Upstream)
-		if err := v.checkUpstream(&upstream.UpstreamDef); err != nil {
+		if err := v.ruleError(RuleUpstream, v.checkUpstream(&upstream.UpstreamDef)); err != nil {
 			return err
 		}
 		if upstream.TLS != nil && (upstream.TLS.ClientCert != "" || upstream.TLS.ClientKey != "") {
@@ -356,27 +780,151 @@ func (v *APISIXJsonSchemaValidator) checkConf(reqBody interface{}) error {
 				return err
 			}
 		}
-	// case *entity.Consumer:
-	//	consumer := reqBody.(*entity.Consumer)
-	//	//if consumer.GroupID == "" && len(consumer.Plugins) == 0 {
-	//	//	return fmt.Errorf("schema 验证失败: 插件为空")
-	//	//}
-	case *entity.SSL:
-		_, err := sslx.ParseCert(bodyType.Cert, bodyType.Key)
-		if err != nil {
+	case *entity.Consumer:
+		if err := v.ruleError(RuleConsumerAuthPlugins, checkConsumerAuthPlugins(bodyType)); err != nil {
 			return err
 		}
-		_, err = sslx.X509CertValidity(bodyType.Cert)
-		if err != nil {
+		if err := v.ruleError(RuleConsumerAllowedPlugins, checkConsumerAllowedPlugins(bodyType)); err != nil {
+			return err
+		}
+	case *entity.SSL:
+		if err := v.ruleError(RuleSSL, checkSSL(bodyType)); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+// ConsumerAuthPlugins 可作为 consumer 凭证的认证插件，自定义认证插件可追加到此处
+var ConsumerAuthPlugins = map[string]bool{
+	"key-auth":   true,
+	"jwt-auth":   true,
+	"basic-auth": true,
+	"hmac-auth":  true,
+	"ldap-auth":  true,
+	"wolf-rbac":  true,
+}
+
+// checkConsumerAuthPlugins consumer 至少需要配置一个认证插件，否则无法被识别
+func checkConsumerAuthPlugins(consumer *entity.Consumer) error {
+	for name := range consumer.Plugins {
+		if ConsumerAuthPlugins[name] {
+			return nil
+		}
+	}
+	names := make([]string, 0, len(ConsumerAuthPlugins))
+	for name := range ConsumerAuthPlugins {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return fmt.Errorf("consumer 至少需要配置一个认证插件, 可选: %s", strings.Join(names, ", "))
+}
+
+// ConsumerAllowedPlugins 除认证插件外可配置在 consumer 上的插件，包括鉴权与限流插件
+var ConsumerAllowedPlugins = map[string]bool{
+	"authz-casbin":         true,
+	"authz-casdoor":        true,
+	"authz-keycloak":       true,
+	"consumer-restriction": true,
+	"limit-count":          true,
+	"limit-req":            true,
+	"limit-conn":           true,
+}
+
+// checkConsumerAllowedPlugins consumer 上只允许配置认证、鉴权和限流插件
+func checkConsumerAllowedPlugins(consumer *entity.Consumer) error {
+	names := make([]string, 0, len(consumer.Plugins))
+	for name := range consumer.Plugins {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if !ConsumerAuthPlugins[name] && !ConsumerAllowedPlugins[name] {
+			return fmt.Errorf("插件 %s 不允许配置在 consumer 上, 仅支持认证、鉴权和限流插件", name)
+		}
+	}
+	return nil
+}
+
+// PluginUpstreamSchemes 插件要求的上游 scheme，未配置 scheme 时 apisix 默认为 http
+var PluginUpstreamSchemes = map[string][]string{
+	"grpc-transcode": {"grpc", "grpcs"},
+	"grpc-web":       {"grpc", "grpcs"},
+	"kafka-proxy":    {"kafka"},
+	"proxy-cache":    {"http", "https"},
+}
+
+// checkPluginUpstreamScheme 校验内联上游的 scheme 与插件要求是否一致
+func checkPluginUpstreamScheme(plugins map[string]interface{}, upstream *entity.UpstreamDef) error {
+	if upstream == nil {
+		return nil
+	}
+	scheme := upstream.Scheme
+	if scheme == "" {
+		scheme = "http"
+	}
+	names := make([]string, 0, len(plugins))
+	for name := range plugins {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		schemes, ok := PluginUpstreamSchemes[name]
+		if ok && !slices.Contains(schemes, scheme) {
+			return fmt.Errorf("插件 %s 要求上游 scheme 为 %s, 当前为 %s", name, strings.Join(schemes, "/"), scheme)
+		}
+	}
+	return nil
+}
+
+// runPostValidators 按注册顺序执行适用于当前资源类型的 post-validator，按规则生效的级别记为告警或返回错误
+func (v *APISIXJsonSchemaValidator) runPostValidators(
+	resourceIdentification string,
+	rawConfig json.RawMessage,
+	plugins map[string]interface{},
+) error {
+	for _, validator := range postValidators {
+		if !validator.rule.appliesTo(v.resourceType) {
+			continue
+		}
+		for _, issue := range validator.check(rawConfig, plugins) {
+			if EffectiveRuleSeverity(validator.rule.ID) == RuleSeverityError {
+				return fmt.Errorf("资源: %s schema 验证失败: %s", resourceIdentification, issue)
+			}
+			v.warn("资源: %s %s", resourceIdentification, issue)
+		}
+	}
+	return nil
+}
+
 // Validate 验证
 func (v *APISIXJsonSchemaValidator) Validate(rawConfig json.RawMessage) error { //nolint:gocyclo
+	v.warnings = nil
 	resourceIdentification := GetResourceIdentification(rawConfig)
+	// 单值/多值字段语义相同，同时配置时 apisix 会静默忽略其中一个
+	// 部分版本 schema 的 oneOf 也会拦截，但错误信息无法定位字段，因此先于 schema 校验
+	if v.resourceType == constant.Route || v.resourceType == constant.StreamRoute {
+		if err := v.ruleError(RuleExclusiveFields, checkExclusiveFields(rawConfig)); err != nil {
+			return fmt.Errorf("资源: %s schema 验证失败: %w", resourceIdentification, err)
+		}
+	}
+	// 服务发现与 nodes 的组合、kafka 的 broker 列表同样由 schema 的 oneOf 拦截，先于 schema 校验以给出明确的错误信息
+	if upstream := upstreamDefFromConfig(v.resourceType, rawConfig); upstream != nil {
+		if err := v.ruleError(RuleUpstreamDiscovery, checkUpstreamDiscovery(upstream)); err != nil {
+			return fmt.Errorf("资源: %s schema 验证失败: %w", resourceIdentification, err)
+		}
+		if err := v.ruleError(RuleBrokerUpstream, checkBrokerUpstream(upstream)); err != nil {
+			return fmt.Errorf("资源: %s schema 验证失败: %w", resourceIdentification, err)
+		}
+	}
+	// etcd 中的 secret id 带有密钥管理器，按密钥管理器的 schema 校验以给出明确的必填字段错误
+	if v.resourceType == constant.Secret {
+		if manager := SecretManagerFromID(gjson.GetBytes(rawConfig, "id").String()); manager != "" {
+			if err := v.ruleError(RuleSecretManager, ValidateSecretConfig(v.version, manager, rawConfig)); err != nil {
+				return fmt.Errorf("资源: %s schema 验证失败: %w", resourceIdentification, err)
+			}
+		}
+	}
 	ret, err := v.schema.Validate(gojsonschema.NewBytesLoader(rawConfig))
 	if err != nil {
 		log.Errorf("schema validate failed: %s, s: %v, obj: %v", err, v.schema, rawConfig)
@@ -386,7 +934,17 @@ func (v *APISIXJsonSchemaValidator) Validate(rawConfig json.RawMessage) error {
 	if !ret.Valid() {
 		errString := GetSchemaValidateFailed(ret)
 		log.Errorf("schema validate failed:s: %v, obj: %#v", v.schemaDef, rawConfig)
-		return fmt.Errorf("资源: %s schema 验证失败: %s", resourceIdentification, errString)
+		if err := v.ruleError(RuleJSONSchema, errors.New(errString)); err != nil {
+			return fmt.Errorf("资源: %s schema 验证失败: %s", resourceIdentification, errString)
+		}
+	}
+
+	// schema 只约束 content 为字符串，从 etcd 同步或导入的 proto 未经过模型层解析，发布前需要补充语法检查
+	if v.resourceType == constant.Proto {
+		content := gjson.GetBytes(rawConfig, "content").String()
+		if err := v.ruleError(RuleProtoSyntax, proto.ParseContent(resourceIdentification, content)); err != nil {
+			return fmt.Errorf("资源: %s schema 验证失败: %w", resourceIdentification, err)
+		}
 	}
 
 	// custom check
@@ -410,6 +968,9 @@ func (v *APISIXJsonSchemaValidator) Validate(rawConfig json.RawMessage) error {
 	case constant.ConsumerGroup:
 		obj = &entity.ConsumerGroup{}
 		_ = json.Unmarshal(rawConfig, obj)
+	case constant.Credential:
+		obj = &entity.Credential{}
+		_ = json.Unmarshal(rawConfig, obj)
 	case constant.GlobalRule:
 		obj = &entity.GlobalRule{}
 		_ = json.Unmarshal(rawConfig, obj)
@@ -428,23 +989,39 @@ func (v *APISIXJsonSchemaValidator) Validate(rawConfig json.RawMessage) error {
 	}
 
 	plugins, schemaType := getPlugins(obj)
+	if err := v.runPostValidators(resourceIdentification, rawConfig, plugins); err != nil {
+		return err
+	}
 	// 判断插件是否为空
 	if constant.PluginsMustResourceMap[v.resourceType] && len(plugins) == 0 {
 		log.Error("schema validate failed: plugins is empty")
-		return fmt.Errorf("资源: %s schema 验证失败: 插件为空", resourceIdentification)
+		if err := v.ruleError(RulePluginsRequired, errors.New("插件为空")); err != nil {
+			return fmt.Errorf("资源: %s schema 验证失败: %w", resourceIdentification, err)
+		}
 	}
 
 	for pluginName, pluginConf := range plugins {
 		var schemaMap map[string]interface{}
-		schemaValue := GetPluginSchema(v.version, pluginName, schemaType)
-		// 查询自定义插件
-		if schemaValue == nil && v.customizePluginSchemaMap != nil {
-			schemaValue = v.customizePluginSchemaMap[pluginName]
+		// 网关注册的自定义插件 schema 优先于内置 schema
+		schemaValue := v.customizePluginSchemaMap[pluginName]
+		if schemaValue == nil {
+			schemaValue = GetPluginSchema(v.version, pluginName, schemaType)
 		}
 		if schemaValue == nil {
-			log.Errorf("schema validate failed: schema not found,  %s, %s", "plugins."+pluginName, schemaType)
-			return fmt.Errorf("资源:%s schema 验证失败: 未找到 schema, 路径: %s",
-				resourceIdentification, "plugins."+pluginName)
+			// 更高版本才内置的插件在数据面才会报错，提前拦截并给出最低支持版本
+			var unavailableErr *PluginUnavailableError
+			if err := ValidatePluginAvailability(v.version, pluginName); pluginAvailabilityResources[v.resourceType] &&
+				errors.As(err, &unavailableErr) && unavailableErr.MinVersion != "" {
+				if err := v.ruleError(RulePluginAvailability, err); err != nil {
+					return fmt.Errorf("资源: %s schema 验证失败: %w", resourceIdentification, err)
+				}
+				continue
+			}
+			// 已废弃或未知的插件会被 apisix 忽略，仅告警便于升级时清理配置
+			if err := v.warnUnknownPlugin(resourceIdentification, pluginName, schemaType); err != nil {
+				return err
+			}
+			continue
 		}
 		schemaMap = schemaValue.(map[string]interface{})
 		schemaByte, err := json.Marshal(schemaMap)
@@ -491,10 +1068,16 @@ func (v *APISIXJsonSchemaValidator) Validate(rawConfig json.RawMessage) error {
 		if !ret.Valid() {
 			errString := GetSchemaValidateFailed(ret)
 			log.Errorf("schema validate failed:s: %v, obj: %#v", v.schemaDef, rawConfig)
-			return fmt.Errorf("资源:%s 插件:%s schema 验证失败: %s", resourceIdentification, pluginName,
-				errString)
+			err := v.ruleError(RulePluginSchema, fmt.Errorf("插件:%s schema 验证失败: %s", pluginName, errString))
+			if err != nil {
+				return fmt.Errorf("资源:%s 插件:%s schema 验证失败: %s", resourceIdentification, pluginName,
+					errString)
+			}
 		}
 	}
+	if err := v.ruleError(RulePluginNumericBounds, CheckPluginNumericBounds(plugins)); err != nil {
+		return fmt.Errorf("资源: %s schema 验证失败: %w", resourceIdentification, err)
+	}
 
 	return nil
 }
@@ -507,7 +1090,7 @@ type APISIXSchemaValidator struct {
 
 // NewAPISIXSchemaValidator 创建 APISIXSchemaValidator
 func NewAPISIXSchemaValidator(version constant.APISIXVersion, jsonPath string) (Validator, error) {
-	schemaDef := schemaVersionMap[version].Get(jsonPath).String()
+	schemaDef := activeSchemas().apisix[version].Get(jsonPath).String()
 	if schemaDef == "" {
 		log.Warnf("schema validate failed: schema not found, path: %s", jsonPath)
 		return nil, fmt.Errorf("schema 验证失败: 未找到 schema, 路径: %s", jsonPath)
@@ -541,19 +1124,6 @@ func (v *APISIXSchemaValidator) Validate(obj json.RawMessage) error {
 	return nil
 }
 
-// GetResourceIdentification 获取资源标识
-func GetResourceIdentification(config json.RawMessage) string {
-	id := gjson.GetBytes(config, "id").String()
-	if id != "" {
-		return id
-	}
-	name := gjson.GetBytes(config, "name").String()
-	if name != "" {
-		return name
-	}
-	return gjson.GetBytes(config, "username").String()
-}
-
 // GetSchemaValidateFailed 获取 schema 验证失败的错误信息
 func GetSchemaValidateFailed(ret *gojsonschema.Result) string {
 	errString := buffer.Buffer{}
@@ -0,0 +1,94 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/samber/lo"
+	"github.com/tidwall/gjson"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+// ETCDOnlyField DATABASE 校验允许而 ETCD 校验不允许的顶层字段
+type ETCDOnlyField struct {
+	Field string
+	// Managed 为 true 表示该字段由平台维护，发布到 etcd 时会自动去除；否则为 apisix schema 中未定义的字段
+	Managed bool
+}
+
+// String 返回字段及不被允许的原因
+func (f ETCDOnlyField) String() string {
+	if f.Managed {
+		return fmt.Sprintf("%s: 平台维护字段, 发布时自动去除", f.Field)
+	}
+	return fmt.Sprintf("%s: apisix schema 中未定义的字段", f.Field)
+}
+
+// ValidateBoth 分别按 DATABASE 与 ETCD 校验资源配置，返回两者各自的校验错误
+func ValidateBoth(
+	version constant.APISIXVersion,
+	resourceType constant.APISIXResource,
+	config json.RawMessage,
+) (dbErr error, etcdErr error) {
+	validate := func(dataType constant.DataType) error {
+		validator, err := NewAPISIXJsonSchemaValidator(
+			version, resourceType, "main."+resourceType.String(), nil, dataType)
+		if err != nil {
+			return err
+		}
+		return validator.Validate(config)
+	}
+	return validate(constant.DATABASE), validate(constant.ETCD)
+}
+
+// ExplainETCDOnlyFields 找出导致资源配置仅在 ETCD 校验失败的顶层字段：
+// ETCD 校验不允许 schema properties 之外的字段，而 DATABASE 校验允许，按字段名排序返回
+func ExplainETCDOnlyFields(
+	version constant.APISIXVersion,
+	resourceType constant.APISIXResource,
+	config json.RawMessage,
+) ([]ETCDOnlyField, error) {
+	// PluginMetadata 的 schema 在 DATABASE 与 ETCD 下一致
+	if resourceType == constant.PluginMetadata {
+		return nil, nil
+	}
+	properties := activeSchemas().apisix[version].Get("main." + resourceType.String() + ".properties")
+	if !properties.Exists() {
+		return nil, fmt.Errorf("未找到 schema, 路径: main.%s", resourceType)
+	}
+	if !gjson.ValidBytes(config) {
+		return nil, fmt.Errorf("资源配置不是合法的 json")
+	}
+	var fields []ETCDOnlyField
+	gjson.ParseBytes(config).ForEach(func(key, _ gjson.Result) bool {
+		if !properties.Get(gjson.Escape(key.String())).Exists() {
+			fields = append(fields, ETCDOnlyField{
+				Field:   key.String(),
+				Managed: lo.Contains(etcdExcludedFields[resourceType], key.String()),
+			})
+		}
+		return true
+	})
+	sort.Slice(fields, func(i, j int) bool { return fields[i].Field < fields[j].Field })
+	return fields, nil
+}
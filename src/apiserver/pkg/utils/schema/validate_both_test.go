@@ -0,0 +1,64 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+func TestValidateBoth(t *testing.T) {
+	t.Run("valid in both", func(t *testing.T) {
+		config := json.RawMessage(`{"id": "r1", "name": "route1", "uri": "/get", "upstream_id": "u1"}`)
+		dbErr, etcdErr := ValidateBoth(constant.APISIXVersion311, constant.Route, config)
+		assert.NoError(t, dbErr)
+		assert.NoError(t, etcdErr)
+
+		fields, err := ExplainETCDOnlyFields(constant.APISIXVersion311, constant.Route, config)
+		assert.NoError(t, err)
+		assert.Empty(t, fields)
+	})
+
+	t.Run("valid in database only", func(t *testing.T) {
+		config := json.RawMessage(`{"id": "cg1", "name": "group1", "extra": 1,
+			"plugins": {"limit-count": {"count": 1, "time_window": 60}}}`)
+		dbErr, etcdErr := ValidateBoth(constant.APISIXVersion311, constant.ConsumerGroup, config)
+		assert.NoError(t, dbErr)
+		assert.Error(t, etcdErr)
+
+		fields, err := ExplainETCDOnlyFields(constant.APISIXVersion311, constant.ConsumerGroup, config)
+		assert.NoError(t, err)
+		assert.Equal(t, []ETCDOnlyField{
+			{Field: "extra", Managed: false},
+			{Field: "id", Managed: true},
+			{Field: "name", Managed: true},
+		}, fields)
+		assert.Equal(t, "extra: apisix schema 中未定义的字段", fields[0].String())
+		assert.Equal(t, "id: 平台维护字段, 发布时自动去除", fields[1].String())
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		_, err := ExplainETCDOnlyFields(constant.APISIXVersion311, constant.Route, json.RawMessage(`{`))
+		assert.Error(t, err)
+	})
+}
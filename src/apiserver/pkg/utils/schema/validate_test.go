@@ -21,6 +21,7 @@ package schema
 import (
 	"encoding/json"
 	"fmt"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -181,7 +182,7 @@ func TestNewAPISIXJsonSchemaValidator(t *testing.T) {
 	}
 
 	tests := []testMap{}
-	// 包含所有版本和资源类型
+	// 包含所有版本和资源类型, credential 仅 3.11 及以上版本支持
 	for _, version := range APISIXVersionList {
 		for _, resource := range constant.ResourceTypeList {
 			resourceTests := []testMap{
@@ -190,7 +191,7 @@ func TestNewAPISIXJsonSchemaValidator(t *testing.T) {
 					version:    version,
 					resource:   resource,
 					jsonPath:   fmt.Sprintf("main.%s", resource.String()),
-					shouldFail: false,
+					shouldFail: resource == constant.Credential && !SupportsCredential(version),
 				},
 				{
 					name:       "Invalid Version",
@@ -223,6 +224,56 @@ func TestNewAPISIXJsonSchemaValidator(t *testing.T) {
 	}
 }
 
+func TestNewAPISIXJsonSchemaValidatorCache(t *testing.T) {
+	ResetSchemaCache()
+	newValidator := func(dataType constant.DataType) *APISIXJsonSchemaValidator {
+		validator, err := NewAPISIXJsonSchemaValidator(
+			constant.APISIXVersion311, constant.Route, "main.route", nil, dataType)
+		assert.NoError(t, err)
+		return validator.(*APISIXJsonSchemaValidator)
+	}
+
+	// 相同 key 复用编译后的 schema
+	dbValidator := newValidator(constant.DATABASE)
+	assert.Same(t, dbValidator.schema, newValidator(constant.DATABASE).schema)
+	assert.Equal(t, dbValidator.schemaDef, newValidator(constant.DATABASE).schemaDef)
+
+	// 不同数据类型分别缓存
+	etcdValidator := newValidator(constant.ETCD)
+	assert.NotSame(t, dbValidator.schema, etcdValidator.schema)
+
+	// 并发创建时共享同一份缓存
+	var wg sync.WaitGroup
+	schemas := make([]*gojsonschema.Schema, 10)
+	for i := range schemas {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			schemas[i] = newValidator(constant.ETCD).schema
+		}(i)
+	}
+	wg.Wait()
+	for _, schema := range schemas {
+		assert.Same(t, etcdValidator.schema, schema)
+	}
+
+	// 清空缓存后重新编译
+	ResetSchemaCache()
+	assert.NotSame(t, dbValidator.schema, newValidator(constant.DATABASE).schema)
+
+	// 失败的结果不缓存
+	_, err := NewAPISIXJsonSchemaValidator(constant.APISIXVersion311, constant.Route, "invalid.path", nil,
+		constant.DATABASE)
+	assert.Error(t, err)
+	_, ok := activeSchemas().compiled.Load(schemaCacheKey{
+		version:      constant.APISIXVersion311,
+		resourceType: constant.Route,
+		jsonPath:     "invalid.path",
+		dataType:     constant.DATABASE,
+	})
+	assert.False(t, ok)
+}
+
 func TestAPISIXJsonSchemaValidatorValidate(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -335,7 +386,7 @@ func TestAPISIXJsonSchemaValidatorValidate(t *testing.T) {
                     "av"
                 ],
                 [
-                    "g",
+                    "cookie_g",
                     "!",
                     "HAS",
                     "gv"
@@ -483,6 +534,9 @@ func TestAPISIXJsonSchemaValidatorValidate(t *testing.T) {
 			  	"model": "path/to/model.conf",
 			  	"policy": "path/to/policy.csv",
 			  	"username": "admin"
+			    },
+			    "key-auth": {
+			  	"key": "consumer1-key"
 			    }
 			  },
 			  "username": "consumer1"
@@ -707,6 +761,9 @@ func TestAPISIXJsonSchemaValidatorValidate(t *testing.T) {
 			  	"model": "path/to/model.conf",
 			  	"policy": "path/to/policy.csv",
 			  	"username": "admin"
+			    },
+			    "key-auth": {
+			  	"key": "consumer1-key"
 			    }
 			  },
 			  "username": "consumer1"
@@ -1121,11 +1178,46 @@ func TestValidateVarItem(t *testing.T) {
 			},
 			shouldFail: true,
 		},
+		{
+			name:       "Valid In Strings",
+			item:       []interface{}{"arg_env", "in", []interface{}{"prod", "staging"}},
+			shouldFail: false,
+		},
+		{
+			name:       "Valid Not In Numbers",
+			item:       []interface{}{"arg_version", "!", "in", []interface{}{float64(1), float64(2)}},
+			shouldFail: false,
+		},
+		{
+			name:       "Valid Upper IN",
+			item:       []interface{}{"arg_env", "IN", []interface{}{"prod"}},
+			shouldFail: false,
+		},
+		{
+			name:       "In Scalar Value",
+			item:       []interface{}{"arg_env", "in", "prod"},
+			shouldFail: true,
+		},
+		{
+			name:       "In Empty List",
+			item:       []interface{}{"arg_env", "in", []interface{}{}},
+			shouldFail: true,
+		},
+		{
+			name:       "In Mixed Types",
+			item:       []interface{}{"arg_env", "!", "in", []interface{}{"prod", float64(1)}},
+			shouldFail: true,
+		},
+		{
+			name:       "In Nested List",
+			item:       []interface{}{"arg_env", "in", []interface{}{[]interface{}{"prod"}}},
+			shouldFail: true,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := validateVarItem(tt.item)
+			err := validateVarItem(tt.item, false)
 			if tt.shouldFail {
 				assert.Error(t, err)
 			} else {
@@ -1140,6 +1232,7 @@ func TestCheckVars(t *testing.T) {
 		name       string
 		vars       []interface{}
 		shouldFail bool
+		errMsg     string
 	}{
 		{
 			name: "Valid Vars",
@@ -1181,13 +1274,141 @@ func TestCheckVars(t *testing.T) {
 			},
 			shouldFail: true,
 		},
+		{
+			name: "Two Level Nested OR Group",
+			vars: []interface{}{
+				[]interface{}{
+					"OR",
+					[]interface{}{"arg_id", "==", "123"},
+					[]interface{}{
+						"AND",
+						[]interface{}{"http_x_env", "==", "prod"},
+						[]interface{}{"arg_debug", "!", "==", "1"},
+					},
+				},
+				[]interface{}{"uri", "~~", "^/api"},
+			},
+			shouldFail: false,
+		},
+		{
+			name: "Nested Group Without Operator",
+			vars: []interface{}{
+				[]interface{}{
+					[]interface{}{"arg_id", "==", "123"},
+					[]interface{}{"arg_name", "==", "foo"},
+				},
+			},
+			shouldFail: false,
+		},
+		{
+			name: "Invalid Leaf In Nested Group",
+			vars: []interface{}{
+				[]interface{}{
+					"OR",
+					[]interface{}{"arg_id", "==", "123"},
+					[]interface{}{"OR", []interface{}{"arg_id", "invalid_op", "1"}},
+				},
+			},
+			shouldFail: true,
+		},
+		{
+			name: "Bare String In Nested Group",
+			vars: []interface{}{
+				[]interface{}{"OR", []interface{}{"arg_id", "==", "123"}, "invalid_item"},
+			},
+			shouldFail: true,
+		},
+		{
+			name: "Logical Operator Without Sub Expression",
+			vars: []interface{}{
+				[]interface{}{"OR"},
+			},
+			shouldFail: true,
+		},
+		{
+			name: "Over Nested Single Condition",
+			vars: []interface{}{
+				[]interface{}{
+					[]interface{}{"arg_id", "==", "123"},
+				},
+			},
+			shouldFail: true,
+			errMsg:     "嵌套层级过深: 期望单个条件",
+		},
+		{
+			name: "Over Nested Inside Logical Group",
+			vars: []interface{}{
+				[]interface{}{
+					"OR",
+					[]interface{}{"arg_id", "==", "123"},
+					[]interface{}{[]interface{}{"arg_id", "==", "456"}},
+				},
+			},
+			shouldFail: true,
+			errMsg:     "嵌套层级过深: 期望单个条件",
+		},
+		{
+			name:       "Under Nested Single Condition",
+			vars:       []interface{}{"arg_id", "==", "123"},
+			shouldFail: true,
+			errMsg:     "vars 嵌套层级过浅: 期望条件列表",
+		},
+		{
+			name: "Top Level Logical Operator",
+			vars: []interface{}{
+				"!OR",
+				[]interface{}{"arg_a", "==", "1"},
+				[]interface{}{"arg_b", "==", "2"},
+			},
+			shouldFail: false,
+		},
+		{
+			name:       "Top Level Logical Operator Without Sub Expression",
+			vars:       []interface{}{"!AND"},
+			shouldFail: true,
+			errMsg:     "逻辑操作符 !AND 后至少需要一个子表达式",
+		},
+		{
+			name: "Top Level Unknown Operator",
+			vars: []interface{}{
+				"XOR",
+				[]interface{}{"arg_id", "==", "123"},
+			},
+			shouldFail: true,
+		},
+		{
+			name: "Mixed Logical And Comparison Forms",
+			vars: []interface{}{
+				[]interface{}{"arg_id", "==", "123"},
+				[]interface{}{
+					"!AND",
+					[]interface{}{"http_x_env", "in", []interface{}{"prod", "gray"}},
+					[]interface{}{"OR", []interface{}{"arg_a", "!", "==", "1"}, []interface{}{"arg_b", ">", 2}},
+				},
+			},
+			shouldFail: false,
+		},
+		{
+			name:       "Nested Up To Max Depth",
+			vars:       []interface{}{nestedVarExpr(varExprMaxDepth - 1)},
+			shouldFail: false,
+		},
+		{
+			name:       "Nested Over Max Depth",
+			vars:       []interface{}{nestedVarExpr(varExprMaxDepth)},
+			shouldFail: true,
+			errMsg:     "嵌套层级超过上限 16",
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := checkVars(tt.vars)
+			err := checkVars(tt.vars, false)
 			if tt.shouldFail {
 				assert.Error(t, err)
+				if tt.errMsg != "" {
+					assert.ErrorContains(t, err, tt.errMsg)
+				}
 			} else {
 				assert.NoError(t, err)
 			}
@@ -1195,6 +1416,15 @@ func TestCheckVars(t *testing.T) {
 	}
 }
 
+// nestedVarExpr 构造 depth 层 OR 分组包裹的单个条件
+func nestedVarExpr(depth int) []interface{} {
+	expr := []interface{}{"arg_id", "==", "123"}
+	for i := 0; i < depth; i++ {
+		expr = []interface{}{"OR", expr}
+	}
+	return expr
+}
+
 func TestAPISIXJsonSchemaValidatorCHashKeySchemaCheck(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -1364,6 +1594,408 @@ func TestAPISIXJsonSchemaValidatorCheckUpstream(t *testing.T) {
 	}
 }
 
+func TestCheckUpstreamTimeout(t *testing.T) {
+	tests := []struct {
+		name    string
+		timeout *entity.Timeout
+		wantErr string
+	}{
+		{
+			name: "not configured",
+		},
+		{
+			name:    "valid",
+			timeout: &entity.Timeout{Connect: 0.5, Send: 6, Read: 6},
+		},
+		{
+			name:    "zero connect",
+			timeout: &entity.Timeout{Connect: 0, Send: 6, Read: 6},
+			wantErr: "timeout.connect 必须为正数, 当前值: 0",
+		},
+		{
+			name:    "negative send",
+			timeout: &entity.Timeout{Connect: 6, Send: -1, Read: 6},
+			wantErr: "timeout.send 必须为正数, 当前值: -1",
+		},
+		{
+			name:    "negative read",
+			timeout: &entity.Timeout{Connect: 6, Send: 6, Read: -2.5},
+			wantErr: "timeout.read 必须为正数, 当前值: -2.5",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validator := &APISIXJsonSchemaValidator{}
+			err := validator.checkUpstream(&entity.UpstreamDef{Timeout: tt.timeout})
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAPISIXJsonSchemaValidatorCheckRetries(t *testing.T) {
+	chashUpstream := func(retries int, nodes interface{}) *entity.UpstreamDef {
+		return &entity.UpstreamDef{
+			Type:    "chash",
+			HashOn:  "consumer",
+			Retries: &retries,
+			Nodes:   nodes,
+		}
+	}
+	twoNodes := []*entity.Node{
+		{Host: "127.0.0.1", Port: 80, Weight: 1},
+		{Host: "127.0.0.2", Port: 80, Weight: 1},
+	}
+	tests := []struct {
+		name         string
+		mode         constant.RetriesCheckMode
+		overrides    map[string]string
+		upstream     *entity.UpstreamDef
+		wantErr      string
+		wantWarnings int
+	}{
+		{
+			name:     "disabled",
+			mode:     constant.RetriesCheckDisabled,
+			upstream: chashUpstream(5, twoNodes),
+		},
+		{
+			name:     "unset",
+			upstream: chashUpstream(5, twoNodes),
+		},
+		{
+			name:         "lenient warns",
+			mode:         constant.RetriesCheckLenient,
+			upstream:     chashUpstream(5, twoNodes),
+			wantWarnings: 1,
+		},
+		{
+			name:      "lenient promoted by rule override",
+			mode:      constant.RetriesCheckLenient,
+			overrides: map[string]string{RuleUpstreamRetries: "error"},
+			upstream:  chashUpstream(5, twoNodes),
+			wantErr:   "retries: 5 超过节点数: 2",
+		},
+		{
+			name:     "strict exceeds node count",
+			mode:     constant.RetriesCheckStrict,
+			upstream: chashUpstream(5, twoNodes),
+			wantErr:  "retries: 5 超过节点数: 2",
+		},
+		{
+			name:     "strict map nodes",
+			mode:     constant.RetriesCheckStrict,
+			upstream: chashUpstream(2, map[string]interface{}{"127.0.0.1:80": float64(1)}),
+			wantErr:  "retries: 2 超过节点数: 1",
+		},
+		{
+			name:     "strict equal to node count",
+			mode:     constant.RetriesCheckStrict,
+			upstream: chashUpstream(2, twoNodes),
+		},
+		{
+			name: "strict roundrobin ignored",
+			mode: constant.RetriesCheckStrict,
+			upstream: func() *entity.UpstreamDef {
+				u := chashUpstream(5, twoNodes)
+				u.Type = "roundrobin"
+				return u
+			}(),
+		},
+		{
+			name: "strict discovery without nodes",
+			mode: constant.RetriesCheckStrict,
+			upstream: func() *entity.UpstreamDef {
+				u := chashUpstream(5, nil)
+				u.ServiceName = "svc"
+				return u
+			}(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.NoError(t, SetRuleSeverityOverrides(tt.overrides))
+			t.Cleanup(func() { assert.NoError(t, SetRuleSeverityOverrides(nil)) })
+			validator := &APISIXJsonSchemaValidator{RetriesCheck: tt.mode}
+			err := validator.checkUpstream(tt.upstream)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Len(t, validator.Warnings(), tt.wantWarnings)
+		})
+	}
+}
+
+func TestValidateUpstreamMapNodes(t *testing.T) {
+	validator, err := NewAPISIXJsonSchemaValidator(
+		constant.APISIXVersion311, constant.Upstream, "main.upstream", nil, constant.DATABASE)
+	assert.NoError(t, err)
+	SetRetriesCheck(validator, constant.RetriesCheckStrict)
+
+	tests := []struct {
+		name    string
+		config  string
+		wantErr string
+	}{
+		{
+			name:   "ipv6 and zero weight",
+			config: `{"type":"roundrobin","nodes":{"[::1]:8080":10,"127.0.0.1:80":0}}`,
+		},
+		{
+			name:    "pass_host node with multiple map nodes",
+			config:  `{"type":"roundrobin","pass_host":"node","nodes":{"[::1]:8080":10,"127.0.0.1:80":0}}`,
+			wantErr: "仅支持 `node` 模式下的单节点",
+		},
+		{
+			name:   "pass_host node with single map node",
+			config: `{"type":"roundrobin","pass_host":"node","nodes":{"[::1]:8080":10}}`,
+		},
+		{
+			name:    "chash retries exceed map nodes",
+			config:  `{"type":"chash","hash_on":"consumer","retries":3,"nodes":{"[::1]:8080":1,"127.0.0.1:80":0}}`,
+			wantErr: "retries: 3 超过节点数: 2",
+		},
+		{
+			name:   "mixed ipv4 and ipv6 map nodes",
+			config: `{"type":"roundrobin","nodes":{"[2001:db8::1]:80":1,"2001:db8::2":1,"10.0.0.1:80":1}}`,
+		},
+		{
+			name:    "equivalent ipv6 map nodes",
+			config:  `{"type":"roundrobin","nodes":{"[::1]:8080":1,"[0:0::1]:8080":1}}`,
+			wantErr: "上游节点 [::1]:8080 重复",
+		},
+		{
+			name:    "ipv6 zone in map nodes",
+			config:  `{"type":"roundrobin","nodes":{"[fe80::1%eth0]:8080":1}}`,
+			wantErr: "ipv6 zone identifier is not supported",
+		},
+		{
+			name: "mixed ipv4 and ipv6 array nodes",
+			config: `{"type":"roundrobin","nodes":[{"host":"[::1]","port":80,"weight":1},` +
+				`{"host":"::1","port":81,"weight":1},{"host":"127.0.0.1","port":80,"weight":1}]}`,
+		},
+		{
+			name: "equivalent ipv6 array nodes",
+			config: `{"type":"roundrobin","nodes":[{"host":"[2001:db8::1]","port":80,"weight":1},` +
+				`{"host":"2001:DB8:0::1","port":80,"weight":1}]}`,
+			wantErr: "上游节点 [2001:db8::1]:80 重复",
+		},
+		{
+			name: "duplicate ipv4 array nodes",
+			config: `{"type":"roundrobin","nodes":[{"host":"127.0.0.1","port":80,"weight":1},` +
+				`{"host":"127.0.0.1","port":80,"weight":2}]}`,
+			wantErr: "上游节点 127.0.0.1:80 重复",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validator.Validate(json.RawMessage(tt.config))
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateRouteUpstreamSource(t *testing.T) {
+	validator, err := NewAPISIXJsonSchemaValidator(
+		constant.APISIXVersion311, constant.Route, "main.route", nil, constant.DATABASE)
+	assert.NoError(t, err)
+	inlineUpstream := `"upstream":{"type":"roundrobin","nodes":[{"host":"127.0.0.1","port":80,"weight":1}]}`
+
+	tests := []struct {
+		name     string
+		config   string
+		wantErr  string
+		warnings int
+	}{
+		{
+			name:    "upstream and upstream_id",
+			config:  `{"uri":"/a","upstream_id":"u1",` + inlineUpstream + `}`,
+			wantErr: "路由不能同时配置 `upstream` 与 `upstream_id`",
+		},
+		{
+			name:    "upstream, upstream_id and service_id",
+			config:  `{"uri":"/a","upstream_id":"u1","service_id":"s1",` + inlineUpstream + `}`,
+			wantErr: "路由不能同时配置 `upstream`、`upstream_id` 与 `service_id`",
+		},
+		{
+			name:     "service_id and upstream",
+			config:   `{"uri":"/a","service_id":"s1",` + inlineUpstream + `}`,
+			warnings: 1,
+		},
+		{
+			name:   "service_id and upstream_id",
+			config: `{"uri":"/a","service_id":"s1","upstream_id":"u1"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validator.Validate(json.RawMessage(tt.config))
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			warnings := validator.(*APISIXJsonSchemaValidator).Warnings()
+			assert.Len(t, warnings, tt.warnings)
+			if tt.warnings > 0 {
+				assert.Contains(t, warnings[0].Message, "`service_id` 与 `upstream`")
+			}
+		})
+	}
+}
+
+// TestValidateRouteTimeout 路由 timeout 的 connect/send/read 必须同时配置，部分配置由 schema 直接拒绝
+func TestValidateRouteTimeout(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  string
+		wantErr string
+	}{
+		{
+			name:   "full timeout",
+			config: `{"uri":"/a","upstream_id":"u1","timeout":{"connect":3,"send":3,"read":3}}`,
+		},
+		{
+			name:    "partial timeout",
+			config:  `{"uri":"/a","upstream_id":"u1","timeout":{"connect":3}}`,
+			wantErr: "timeout: read is required",
+		},
+	}
+	for _, version := range APISIXVersionList {
+		validator, err := NewAPISIXJsonSchemaValidator(version, constant.Route, "main.route", nil, constant.DATABASE)
+		assert.NoError(t, err)
+		for _, tt := range tests {
+			t.Run(string(version)+"/"+tt.name, func(t *testing.T) {
+				err := validator.Validate(json.RawMessage(tt.config))
+				if tt.wantErr != "" {
+					assert.ErrorContains(t, err, tt.wantErr)
+					return
+				}
+				assert.NoError(t, err)
+				assert.Empty(t, validator.(*APISIXJsonSchemaValidator).Warnings())
+			})
+		}
+	}
+}
+
+func TestValidatePluginUpstreamScheme(t *testing.T) {
+	grpcTranscode := `"plugins":{"grpc-transcode":{"proto_id":"1","service":"helloworld.Greeter","method":"SayHello"}}`
+	upstream := func(scheme string) string {
+		return fmt.Sprintf(`"upstream":{"type":"roundrobin","scheme":%q,`+
+			`"nodes":[{"host":"127.0.0.1","port":50051,"weight":1}]}`, scheme)
+	}
+	tests := []struct {
+		name     string
+		resource constant.APISIXResource
+		config   string
+		wantErr  string
+	}{
+		{
+			name:     "grpc-transcode on grpc upstream",
+			resource: constant.Route,
+			config:   `{"uri":"/a",` + grpcTranscode + `,` + upstream("grpc") + `}`,
+		},
+		{
+			name:     "grpc-transcode on http upstream",
+			resource: constant.Route,
+			config:   `{"uri":"/a",` + grpcTranscode + `,` + upstream("http") + `}`,
+			wantErr:  "插件 grpc-transcode 要求上游 scheme 为 grpc/grpcs, 当前为 http",
+		},
+		{
+			name:     "grpc-transcode on service http upstream",
+			resource: constant.Service,
+			config:   `{` + grpcTranscode + `,` + upstream("http") + `}`,
+			wantErr:  "插件 grpc-transcode 要求上游 scheme 为 grpc/grpcs, 当前为 http",
+		},
+		{
+			name:     "proxy-cache on grpc upstream",
+			resource: constant.Route,
+			config:   `{"uri":"/a","plugins":{"proxy-cache":{}},` + upstream("grpc") + `}`,
+			wantErr:  "插件 proxy-cache 要求上游 scheme 为 http/https, 当前为 grpc",
+		},
+		{
+			// 引用的上游无法在配置中确定 scheme，不校验
+			name:     "grpc-transcode with upstream_id",
+			resource: constant.Route,
+			config:   `{"uri":"/a",` + grpcTranscode + `,"upstream_id":"u1"}`,
+		},
+	}
+	for _, version := range APISIXVersionList {
+		for _, tt := range tests {
+			t.Run(string(version)+"/"+tt.name, func(t *testing.T) {
+				validator, err := NewAPISIXJsonSchemaValidator(
+					version, tt.resource, "main."+tt.resource.String(), nil, constant.DATABASE)
+				assert.NoError(t, err)
+				err = validator.Validate(json.RawMessage(tt.config))
+				if tt.wantErr != "" {
+					assert.ErrorContains(t, err, tt.wantErr)
+					return
+				}
+				assert.NoError(t, err)
+			})
+		}
+	}
+}
+
+func TestValidateConsumerAuthPlugins(t *testing.T) {
+	validator, err := NewAPISIXJsonSchemaValidator(
+		constant.APISIXVersion311, constant.Consumer, "main.consumer", nil, constant.DATABASE)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		config  string
+		wantErr string
+	}{
+		{
+			name:    "no plugins",
+			config:  `{"username":"c1"}`,
+			wantErr: "consumer 至少需要配置一个认证插件, 可选: basic-auth, hmac-auth, jwt-auth",
+		},
+		{
+			name:    "only non-auth plugin",
+			config:  `{"username":"c1","plugins":{"limit-count":{"count":1,"time_window":60}}}`,
+			wantErr: "consumer 至少需要配置一个认证插件",
+		},
+		{
+			name:   "key-auth",
+			config: `{"username":"c1","plugins":{"key-auth":{"key":"c1-key"}}}`,
+		},
+		{
+			name: "basic-auth with other plugin",
+			config: `{"username":"c1","plugins":{"basic-auth":{"username":"u","password":"p"},` +
+				`"limit-count":{"count":1,"time_window":60}}}`,
+		},
+		{
+			name: "auth plugin with proxy plugin",
+			config: `{"username":"c1","plugins":{"key-auth":{"key":"c1-key"},` +
+				`"proxy-rewrite":{"uri":"/test"}}}`,
+			wantErr: "插件 proxy-rewrite 不允许配置在 consumer 上",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validator.Validate(json.RawMessage(tt.config))
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
 func TestCheckRemoteAddr(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -1380,6 +2012,16 @@ func TestCheckRemoteAddr(t *testing.T) {
 			remoteAddrs: []string{""},
 			shouldFail:  true,
 		},
+		{
+			name:        "Not Configured",
+			remoteAddrs: nil,
+			shouldFail:  false,
+		},
+		{
+			name:        "Empty List",
+			remoteAddrs: []string{},
+			shouldFail:  true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -1394,6 +2036,223 @@ func TestCheckRemoteAddr(t *testing.T) {
 	}
 }
 
+func TestCheckRemoteAddrEntries(t *testing.T) {
+	tests := []struct {
+		name        string
+		remoteAddrs []string
+		wantErr     string
+	}{
+		{name: "IPv4", remoteAddrs: []string{"10.0.0.1"}},
+		{name: "IPv4 CIDR", remoteAddrs: []string{"10.0.0.0/8"}},
+		{name: "IPv4 /0", remoteAddrs: []string{"0.0.0.0/0"}},
+		{name: "IPv6", remoteAddrs: []string{"::1", "2001:db8::1"}},
+		{name: "IPv6 CIDR", remoteAddrs: []string{"2001:db8::/32"}},
+		{name: "IPv6 /0", remoteAddrs: []string{"::/0"}},
+		{
+			name:        "invalid IPv4",
+			remoteAddrs: []string{"127.0.0.1", "999.999.1.1"},
+			wantErr:     `remote_addrs[1] "999.999.1.1"`,
+		},
+		{
+			name:        "prefix too long",
+			remoteAddrs: []string{"10.0.0.0/99"},
+			wantErr:     `remote_addrs[0] "10.0.0.0/99" 不是合法的 CIDR`,
+		},
+		{
+			name:        "IPv6 prefix too long",
+			remoteAddrs: []string{"::/129"},
+			wantErr:     `remote_addrs[0] "::/129" 不是合法的 CIDR`,
+		},
+		{
+			name:        "hostname",
+			remoteAddrs: []string{"example.com"},
+			wantErr:     `remote_addrs[0] "example.com" 不是合法的 IP 地址或 CIDR`,
+		},
+		{
+			name:        "IPv6 with zone",
+			remoteAddrs: []string{"fe80::1%eth0"},
+			wantErr:     `remote_addrs[0] "fe80::1%eth0" 不支持带 zone 的 IPv6 地址`,
+		},
+		{
+			name:        "IPv6 CIDR with zone",
+			remoteAddrs: []string{"fe80::1%eth0/64"},
+			wantErr:     `remote_addrs[0] "fe80::1%eth0/64" 不是合法的 CIDR`,
+		},
+		{
+			name:        "missing prefix length",
+			remoteAddrs: []string{"10.0.0.0/"},
+			wantErr:     `remote_addrs[0] "10.0.0.0/" 不是合法的 CIDR`,
+		},
+		{
+			name:        "empty list",
+			remoteAddrs: []string{},
+			wantErr:     "remote_addrs 不能为空列表",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkRemoteAddr(tt.remoteAddrs)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCheckConfStreamRouteAddr(t *testing.T) {
+	tests := []struct {
+		name        string
+		streamRoute *entity.StreamRoute
+		wantErr     string
+	}{
+		{
+			name:        "valid",
+			streamRoute: &entity.StreamRoute{RemoteAddr: "192.168.0.0/16", ServerAddr: "127.0.0.1"},
+		},
+		{
+			name:        "not configured",
+			streamRoute: &entity.StreamRoute{},
+		},
+		{
+			name:        "invalid remote_addr",
+			streamRoute: &entity.StreamRoute{RemoteAddr: "10.0.0.0/33"},
+			wantErr:     `remote_addr "10.0.0.0/33" 不是合法的 CIDR`,
+		},
+		{
+			name:        "hostname server_addr",
+			streamRoute: &entity.StreamRoute{ServerAddr: "localhost"},
+			wantErr:     `server_addr "localhost" 不是合法的 IP 地址或 CIDR`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validator := &APISIXJsonSchemaValidator{}
+			err := validator.checkConf(tt.streamRoute)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAPISIXJsonSchemaValidatorExclusiveFields(t *testing.T) {
+	tests := []struct {
+		name         string
+		resourceType constant.APISIXResource
+		config       string
+		wantErr      string
+	}{
+		{
+			name:         "route uri only",
+			resourceType: constant.Route,
+			config:       `{"name":"r1","uri":"/a","host":"a.com","upstream_id":"u1"}`,
+		},
+		{
+			name:         "route uri and uris",
+			resourceType: constant.Route,
+			config:       `{"name":"r1","uri":"/a","uris":["/b"],"upstream_id":"u1"}`,
+			wantErr:      "`uri` 与 `uris` 不能同时配置",
+		},
+		{
+			name:         "route host and hosts",
+			resourceType: constant.Route,
+			config:       `{"name":"r1","uris":["/a"],"host":"a.com","hosts":["b.com"],"upstream_id":"u1"}`,
+			wantErr:      "`host` 与 `hosts` 不能同时配置",
+		},
+		{
+			name:         "route uris and hosts",
+			resourceType: constant.Route,
+			config:       `{"name":"r1","uris":["/a","/b"],"hosts":["a.com"],"upstream_id":"u1"}`,
+		},
+		{
+			name:         "route remote_addr and remote_addrs",
+			resourceType: constant.Route,
+			config:       `{"name":"r1","uri":"/a","remote_addr":"10.0.0.1","remote_addrs":["10.0.0.2"],"upstream_id":"u1"}`,
+			wantErr:      "`remote_addr` 与 `remote_addrs` 不能同时配置",
+		},
+		{
+			name:         "stream route remote_addr only",
+			resourceType: constant.StreamRoute,
+			config:       `{"name":"sr1","server_port":9100,"remote_addr":"10.0.0.1","upstream_id":"u1"}`,
+		},
+		{
+			name:         "stream route remote_addr and remote_addrs",
+			resourceType: constant.StreamRoute,
+			config: `{"name":"sr1","server_port":9100,"remote_addr":"10.0.0.1","remote_addrs":["10.0.0.2"],` +
+				`"upstream_id":"u1"}`,
+			wantErr: "`remote_addr` 与 `remote_addrs` 不能同时配置",
+		},
+		{
+			name:         "stream route host and hosts",
+			resourceType: constant.StreamRoute,
+			config:       `{"name":"sr1","server_port":9100,"host":"a.com","hosts":["b.com"],"upstream_id":"u1"}`,
+			wantErr:      "`host` 与 `hosts` 不能同时配置",
+		},
+		{
+			name:         "other resource is skipped",
+			resourceType: constant.Service,
+			config:       `{"name":"s1","hosts":["a.com"],"host":"b.com","upstream_id":"u1"}`,
+		},
+	}
+
+	for _, version := range APISIXVersionList {
+		for _, tt := range tests {
+			t.Run(fmt.Sprintf("%s/%s", version, tt.name), func(t *testing.T) {
+				validator, err := NewAPISIXJsonSchemaValidator(version, tt.resourceType,
+					"main."+tt.resourceType.String(), nil, constant.DATABASE)
+				assert.NoError(t, err)
+				err = validator.Validate(json.RawMessage(tt.config))
+				if tt.wantErr != "" {
+					assert.ErrorContains(t, err, tt.wantErr)
+				} else {
+					assert.NoError(t, err)
+				}
+			})
+		}
+	}
+}
+
+func TestAPISIXJsonSchemaValidatorProtoContent(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  string
+		wantErr string
+	}{
+		{
+			name: "valid proto",
+			config: `{"id":"p1","content":"syntax = \"proto3\";\npackage hello;\n` +
+				`message Req { string name = 1; }\nservice Greeter { rpc Say (Req) returns (Req) {} }"}`,
+		},
+		{
+			name:    "syntax error",
+			config:  `{"id":"p1","content":"syntax = \"proto3\";\nmessage Req { string name = }"}`,
+			wantErr: "无法解析 proto 内容",
+		},
+	}
+
+	for _, version := range APISIXVersionList {
+		for _, tt := range tests {
+			t.Run(fmt.Sprintf("%s/%s", version, tt.name), func(t *testing.T) {
+				validator, err := NewAPISIXJsonSchemaValidator(version, constant.Proto,
+					"main."+constant.Proto.String(), nil, constant.DATABASE)
+				assert.NoError(t, err)
+				err = validator.Validate(json.RawMessage(tt.config))
+				if tt.wantErr != "" {
+					assert.ErrorContains(t, err, tt.wantErr)
+				} else {
+					assert.NoError(t, err)
+				}
+			})
+		}
+	}
+}
+
 func TestNewAPISIXSchemaValidator(t *testing.T) {
 	type testMap struct {
 		name       string
@@ -1403,7 +2262,7 @@ func TestNewAPISIXSchemaValidator(t *testing.T) {
 	}
 
 	tests := []testMap{}
-	// 包含所有版本和资源类型
+	// 包含所有版本和资源类型, credential 仅 3.11 及以上版本支持
 	for _, version := range APISIXVersionList {
 		for _, resource := range constant.ResourceTypeList {
 			resourceTests := []testMap{
@@ -1411,7 +2270,7 @@ func TestNewAPISIXSchemaValidator(t *testing.T) {
 					name:       "Valid Schema",
 					version:    version,
 					jsonPath:   fmt.Sprintf("main.%s", resource.String()),
-					shouldFail: false,
+					shouldFail: resource == constant.Credential && !SupportsCredential(version),
 				},
 				{
 					name:       "Invalid Version",
@@ -1561,6 +2420,9 @@ func TestAPISIXSchemaValidatorValidate(t *testing.T) {
 			  	"model": "path/to/model.conf",
 			  	"policy": "path/to/policy.csv",
 			  	"username": "admin"
+			    },
+			    "key-auth": {
+			  	"key": "consumer1-key"
 			    }
 			  },
 			  "username": "consumer1"
@@ -1713,3 +2575,108 @@ func TestGetResourceIdentification(t *testing.T) {
 		})
 	}
 }
+
+func TestGetResourceIdentifier(t *testing.T) {
+	tests := []struct {
+		name   string
+		config string
+		source string
+		value  string
+	}{
+		{name: "id first", config: `{"id": "test-id", "name": "test-name"}`, source: "id", value: "test-id"},
+		{name: "name", config: `{"name": "test-name", "username": "u"}`, source: "name", value: "test-name"},
+		{name: "username", config: `{"username": "test-user"}`, source: "username", value: "test-user"},
+		{name: "empty config"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identifier := GetResourceIdentifier(json.RawMessage(tt.config))
+			assert.Equal(t, tt.source, identifier.Source)
+			assert.Equal(t, tt.value, identifier.Value)
+			assert.False(t, identifier.IsConfigHash())
+		})
+	}
+
+	// 只有插件配置的资源使用规范化配置的 hash 作为标识，与字段顺序、格式无关
+	globalRule := GetResourceIdentifier(json.RawMessage(
+		`{"plugins": {"limit-count": {"count": 10, "time_window": 60}, "cors": {}}}`))
+	assert.True(t, globalRule.IsConfigHash())
+	assert.Len(t, globalRule.Value, configHashLength)
+	reordered := GetResourceIdentifier(json.RawMessage(
+		`{ "plugins": {"cors": {}, "limit-count": {"time_window": 60, "count": 10}} }`))
+	assert.Equal(t, globalRule, reordered)
+	changed := GetResourceIdentifier(json.RawMessage(
+		`{"plugins": {"limit-count": {"count": 11, "time_window": 60}, "cors": {}}}`))
+	assert.NotEqual(t, globalRule.Value, changed.Value)
+	assert.Equal(t, globalRule.Value, GetResourceIdentification(json.RawMessage(
+		`{"plugins": {"cors": {}, "limit-count": {"count": 10, "time_window": 60}}}`)))
+}
+
+func TestAPISIXJsonSchemaValidatorUnknownPlugin(t *testing.T) {
+	tests := []struct {
+		name     string
+		version  constant.APISIXVersion
+		config   string
+		warnings []Warning
+	}{
+		{
+			name:    "deprecated plugin",
+			version: constant.APISIXVersion313,
+			config:  `{"id":"r1","uri":"/a","upstream_id":"u1","plugins":{"server-info":{}}}`,
+			warnings: []Warning{{
+				Type:    WarningTypeUnknownPlugin,
+				Plugin:  "server-info",
+				Message: "资源: r1 插件 server-info 在 3.13.X 中不存在(插件已废弃或更名), 配置不会生效",
+			}},
+		},
+		{
+			name:    "unknown plugin",
+			version: constant.APISIXVersion311,
+			config:  `{"id":"r1","uri":"/a","upstream_id":"u1","plugins":{"not-exist-plugin":{"foo":"bar"}}}`,
+			warnings: []Warning{{
+				Type:    WarningTypeUnknownPlugin,
+				Plugin:  "not-exist-plugin",
+				Message: "资源: r1 插件 not-exist-plugin 在 3.11.X 中不存在(未知插件), 配置不会生效",
+			}},
+		},
+		{
+			name:    "known plugin",
+			version: constant.APISIXVersion311,
+			config:  `{"id":"r1","uri":"/a","upstream_id":"u1","plugins":{"server-info":{}}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validator, err := NewAPISIXJsonSchemaValidator(tt.version, constant.Route, "main.route", nil,
+				constant.DATABASE)
+			assert.NoError(t, err)
+			assert.NoError(t, validator.Validate(json.RawMessage(tt.config)))
+			assert.Equal(t, tt.warnings, validator.(*APISIXJsonSchemaValidator).Warnings())
+		})
+	}
+}
+
+func TestAPISIXJsonSchemaValidatorCustomizePlugin(t *testing.T) {
+	customizePluginSchemaMap := map[string]interface{}{
+		"my-lua-plugin": map[string]interface{}{
+			"type":       "object",
+			"properties": map[string]interface{}{"count": map[string]interface{}{"type": "integer"}},
+			"required":   []interface{}{"count"},
+		},
+		// 自定义插件 schema 优先于内置 schema
+		"limit-count": map[string]interface{}{"type": "object", "required": []interface{}{"foo"}},
+	}
+	validator, err := NewAPISIXJsonSchemaValidator(constant.APISIXVersion313, constant.Route, "main.route",
+		customizePluginSchemaMap, constant.DATABASE)
+	assert.NoError(t, err)
+
+	assert.NoError(t, validator.Validate(json.RawMessage(
+		`{"id":"r1","uri":"/a","upstream_id":"u1","plugins":{"my-lua-plugin":{"count":1}}}`)))
+	assert.Empty(t, validator.(*APISIXJsonSchemaValidator).Warnings())
+	assert.ErrorContains(t, validator.Validate(json.RawMessage(
+		`{"id":"r1","uri":"/a","upstream_id":"u1","plugins":{"my-lua-plugin":{"count":"1"}}}`)),
+		"插件:my-lua-plugin schema 验证失败")
+	assert.ErrorContains(t, validator.Validate(json.RawMessage(
+		`{"id":"r1","uri":"/a","upstream_id":"u1","plugins":{"limit-count":{"count":1,"time_window":60}}}`)),
+		"foo is required")
+}
@@ -0,0 +1,161 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/tidwall/gjson"
+)
+
+// builtinVarNames vars 中可直接使用的 nginx 内置变量及 apisix 扩展变量
+var builtinVarNames = []string{
+	// nginx 内置变量
+	"args", "binary_remote_addr", "body_bytes_sent", "bytes_sent", "connection", "connection_requests",
+	"content_length", "content_type", "document_root", "document_uri", "host", "hostname", "http2",
+	"https", "is_args", "msec", "nginx_version", "pid", "proxy_protocol_addr", "proxy_protocol_port",
+	"proxy_protocol_server_addr", "proxy_protocol_server_port", "query_string", "realpath_root",
+	"remote_addr", "remote_port", "remote_user", "request", "request_body", "request_id", "request_length",
+	"request_method", "request_time", "request_uri", "scheme", "server_addr", "server_name", "server_port",
+	"server_protocol", "ssl_cipher", "ssl_client_fingerprint", "ssl_client_s_dn", "ssl_client_serial",
+	"ssl_client_verify", "ssl_protocol", "ssl_server_name", "status", "time_iso8601", "time_local", "uri",
+	"upstream_addr", "upstream_connect_time", "upstream_response_time", "upstream_status",
+	// apisix 扩展变量
+	"balancer_ip", "balancer_port", "consumer_group_id", "consumer_name", "route_id", "route_name",
+	"service_id", "service_name", "upstream_host", "upstream_scheme", "upstream_uri",
+}
+
+// VarNamePrefixes vars 中支持的动态变量前缀，如 arg_name、http_x_token
+var VarNamePrefixes = []string{"arg_", "http_", "cookie_", "post_arg_", "graphql_"}
+
+// varNameSuggestMaxDistance 编辑距离不超过该值且不超过变量名长度的一半时给出变量名建议
+const varNameSuggestMaxDistance = 3
+
+var builtinVarNameSet = func() map[string]bool {
+	set := make(map[string]bool, len(builtinVarNames))
+	for _, name := range builtinVarNames {
+		set[name] = true
+	}
+	return set
+}()
+
+// BuiltinVarNames 返回排序后的内置变量列表，供前端自动补全
+func BuiltinVarNames() []string {
+	names := append([]string{}, builtinVarNames...)
+	sort.Strings(names)
+	return names
+}
+
+// IsKnownVarName 是否为内置变量或带有支持前缀的动态变量
+func IsKnownVarName(name string) bool {
+	if builtinVarNameSet[name] {
+		return true
+	}
+	for _, prefix := range VarNamePrefixes {
+		if strings.HasPrefix(name, prefix) && len(name) > len(prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkVarName 校验变量名，未知变量时给出编辑距离最近的建议
+func checkVarName(name string) error {
+	if IsKnownVarName(name) {
+		return nil
+	}
+	if suggestion := suggestVarName(name); suggestion != "" {
+		return fmt.Errorf("未知的变量 %s, 是否为 %s?", name, suggestion)
+	}
+	return fmt.Errorf("未知的变量 %s", name)
+}
+
+// CheckEmptyMatchWarnings 检查路由中存在但为空的动态匹配配置(vars/filter_func)，返回告警信息
+// 空的动态匹配不附加任何条件，路由会匹配所有满足 uri/host 等条件的请求，往往并非预期；
+// 其中任一项配置了有效条件时视为匹配条件明确
+func CheckEmptyMatchWarnings(rawConfig json.RawMessage) []string {
+	config := gjson.ParseBytes(rawConfig)
+	var emptyFields []string
+	if vars := config.Get("vars"); vars.Exists() {
+		if len(vars.Array()) > 0 {
+			return nil
+		}
+		emptyFields = append(emptyFields, "vars")
+	}
+	if filterFunc := config.Get("filter_func"); filterFunc.Exists() {
+		if strings.TrimSpace(filterFunc.String()) != "" {
+			return nil
+		}
+		emptyFields = append(emptyFields, "filter_func")
+	}
+	if len(emptyFields) == 0 {
+		return nil
+	}
+	return []string{fmt.Sprintf(
+		"配置了空的 %s, 不会附加任何匹配条件, 路由将匹配所有满足 uri/host 等条件的请求, 请确认是否遗漏匹配条件",
+		strings.Join(emptyFields, "/"),
+	)}
+}
+
+// suggestVarName 在内置变量及前缀补全的候选中查找编辑距离最近的变量名
+func suggestVarName(name string) string {
+	candidates := append([]string{}, builtinVarNames...)
+	for _, prefix := range VarNamePrefixes {
+		// argid -> arg_id，httpfoo -> http_foo
+		trimmed := strings.TrimSuffix(prefix, "_")
+		if rest, ok := strings.CutPrefix(name, trimmed); ok && rest != "" {
+			candidates = append(candidates, prefix+strings.TrimLeft(rest, "_-"))
+		}
+	}
+	maxDistance := min(varNameSuggestMaxDistance, len(name)/2)
+	best, bestDistance := "", maxDistance+1
+	for _, candidate := range candidates {
+		if !IsKnownVarName(candidate) {
+			continue
+		}
+		if distance := levenshtein(name, candidate); distance < bestDistance {
+			best, bestDistance = candidate, distance
+		}
+	}
+	return best
+}
+
+// levenshtein 计算两个字符串的编辑距离
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,121 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+func TestCheckVarName(t *testing.T) {
+	tests := []struct {
+		name    string
+		varName string
+		wantErr string
+	}{
+		{name: "builtin", varName: "remote_addr"},
+		{name: "apisix builtin", varName: "route_id"},
+		{name: "arg prefix", varName: "arg_id"},
+		{name: "header prefix", varName: "http_x_token"},
+		{name: "post arg prefix", varName: "post_arg_name"},
+		{name: "graphql prefix", varName: "graphql_operation"},
+		{name: "bare prefix", varName: "arg_", wantErr: "未知的变量 arg_, 是否为 args?"},
+		{name: "missing underscore", varName: "argid", wantErr: "未知的变量 argid, 是否为 arg_id?"},
+		{name: "typo of builtin", varName: "remote_adr", wantErr: "未知的变量 remote_adr, 是否为 remote_addr?"},
+		{name: "header without underscore", varName: "httpx_token", wantErr: "未知的变量 httpx_token, 是否为 http_x_token?"},
+		{name: "no suggestion", varName: "g", wantErr: "未知的变量 g"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkVarName(tt.varName)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestValidateRouteVarNames(t *testing.T) {
+	config := json.RawMessage(`{"uris":["/test"],"upstream_id":"u1","vars":[["argid","==","1"]]}`)
+	validator, err := NewAPISIXJsonSchemaValidator(
+		constant.APISIXVersion311, constant.Route, "main.route", nil, constant.DATABASE)
+	assert.NoError(t, err)
+	assert.ErrorContains(t, validator.Validate(config), "第 1 项错误: 未知的变量 argid, 是否为 arg_id?")
+
+	// 网关开启自定义变量后不校验变量名
+	validator.(*APISIXJsonSchemaValidator).AllowCustomVars = true
+	assert.NoError(t, validator.Validate(config))
+}
+
+func TestCheckEmptyMatchWarnings(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   string
+		warnings []string
+	}{
+		{name: "no match constructs", config: `{"uri":"/a"}`},
+		{
+			name:   "empty vars",
+			config: `{"uri":"/a","vars":[]}`,
+			warnings: []string{
+				"配置了空的 vars, 不会附加任何匹配条件, 路由将匹配所有满足 uri/host 等条件的请求, 请确认是否遗漏匹配条件",
+			},
+		},
+		{
+			name:   "empty vars and filter_func",
+			config: `{"uri":"/a","vars":[],"filter_func":" "}`,
+			warnings: []string{
+				"配置了空的 vars/filter_func, 不会附加任何匹配条件, 路由将匹配所有满足 uri/host 等条件的请求, " +
+					"请确认是否遗漏匹配条件",
+			},
+		},
+		{name: "populated vars", config: `{"uri":"/a","vars":[["arg_id","==","1"]]}`},
+		{
+			name:   "empty vars with filter_func",
+			config: `{"uri":"/a","vars":[],"filter_func":"function(vars) return true end"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.warnings, CheckEmptyMatchWarnings(json.RawMessage(tt.config)))
+		})
+	}
+}
+
+func TestValidateRouteEmptyVars(t *testing.T) {
+	validator, err := NewAPISIXJsonSchemaValidator(
+		constant.APISIXVersion311, constant.Route, "main.route", nil, constant.DATABASE)
+	assert.NoError(t, err)
+
+	// 空 vars 不阻断校验，但给出告警
+	assert.NoError(t, validator.Validate(json.RawMessage(`{"id":"r1","uri":"/a","upstream_id":"u1","vars":[]}`)))
+	warnings := validator.(*APISIXJsonSchemaValidator).Warnings()
+	assert.Len(t, warnings, 1)
+	assert.Contains(t, warnings[0].Message, "资源: r1 配置了空的 vars")
+
+	config := json.RawMessage(`{"id":"r1","uri":"/a","upstream_id":"u1","vars":[["arg_id","==","1"]]}`)
+	assert.NoError(t, validator.Validate(config))
+	assert.Empty(t, validator.(*APISIXJsonSchemaValidator).Warnings())
+}
@@ -0,0 +1,152 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/tidwall/gjson"
+	yamlv2 "gopkg.in/yaml.v2"
+	yamlv3 "gopkg.in/yaml.v3"
+	"sigs.k8s.io/yaml"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+// YAMLToJSONDocuments 将 yaml 转换为 json，支持锚点及多文档(---分隔)
+// 返回结果与文档一一对应，空文档对应 nil，便于按文档序号定位问题
+func YAMLToJSONDocuments(data []byte) ([]json.RawMessage, error) {
+	decoder := yamlv2.NewDecoder(bytes.NewReader(data))
+	var docs []json.RawMessage
+	empty := true
+	for index := 0; ; index++ {
+		var doc interface{}
+		err := decoder.Decode(&doc)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("yaml 文档[%d] 解析失败: %w", index, err)
+		}
+		if doc == nil {
+			docs = append(docs, nil)
+			continue
+		}
+		// 解码时已展开锚点/别名，重新序列化后再转换为 json
+		resolved, err := yamlv2.Marshal(doc)
+		if err != nil {
+			return nil, fmt.Errorf("yaml 文档[%d] 解析失败: %w", index, err)
+		}
+		jsonDoc, err := yaml.YAMLToJSON(resolved)
+		if err != nil {
+			return nil, fmt.Errorf("yaml 文档[%d] 转换 json 失败: %w", index, err)
+		}
+		docs = append(docs, jsonDoc)
+		empty = false
+	}
+	if empty {
+		return nil, fmt.Errorf("yaml 内容为空")
+	}
+	return docs, nil
+}
+
+// ValidateYAML 校验 yaml 格式的资源配置，多文档时逐个校验，错误信息中带上文档序号
+func ValidateYAML(
+	version constant.APISIXVersion,
+	resourceType constant.APISIXResource,
+	jsonPath string,
+	dataType constant.DataType,
+	data []byte,
+) error {
+	docs, err := YAMLToJSONDocuments(data)
+	if err != nil {
+		return err
+	}
+	validator, err := NewAPISIXJsonSchemaValidator(version, resourceType, jsonPath, nil, dataType)
+	if err != nil {
+		return err
+	}
+	for index, doc := range docs {
+		if doc == nil {
+			continue
+		}
+		if err := validator.Validate(doc); err != nil {
+			return fmt.Errorf("yaml 文档[%d] 校验失败: %w", index, err)
+		}
+	}
+	return nil
+}
+
+// JSONToYAMLNode 将 json 转换为 yaml 节点，对象的 key 按字典序排列；
+// 数字按 json 中的原始文本输出，避免大整数及高精度小数经 float64 转换丢失精度
+func JSONToYAMLNode(data []byte) (*yamlv3.Node, error) {
+	if !gjson.ValidBytes(data) {
+		return nil, fmt.Errorf("json 格式错误")
+	}
+	return jsonValueToYAMLNode(gjson.ParseBytes(data)), nil
+}
+
+func jsonValueToYAMLNode(value gjson.Result) *yamlv3.Node {
+	switch {
+	case value.IsObject():
+		node := &yamlv3.Node{Kind: yamlv3.MappingNode, Tag: "!!map"}
+		var keys []string
+		fields := make(map[string]gjson.Result)
+		value.ForEach(func(key, field gjson.Result) bool {
+			if _, ok := fields[key.Str]; !ok {
+				keys = append(keys, key.Str)
+			}
+			fields[key.Str] = field
+			return true
+		})
+		sort.Strings(keys)
+		for _, key := range keys {
+			node.Content = append(node.Content,
+				&yamlv3.Node{Kind: yamlv3.ScalarNode, Tag: "!!str", Value: key},
+				jsonValueToYAMLNode(fields[key]))
+		}
+		return node
+	case value.IsArray():
+		node := &yamlv3.Node{Kind: yamlv3.SequenceNode, Tag: "!!seq"}
+		for _, item := range value.Array() {
+			node.Content = append(node.Content, jsonValueToYAMLNode(item))
+		}
+		return node
+	}
+	switch value.Type {
+	case gjson.String:
+		return &yamlv3.Node{Kind: yamlv3.ScalarNode, Tag: "!!str", Value: value.Str}
+	case gjson.Number:
+		tag := "!!int"
+		if strings.ContainsAny(value.Raw, ".eE") {
+			tag = "!!float"
+		}
+		return &yamlv3.Node{Kind: yamlv3.ScalarNode, Tag: tag, Value: value.Raw}
+	case gjson.True, gjson.False:
+		return &yamlv3.Node{Kind: yamlv3.ScalarNode, Tag: "!!bool", Value: value.Raw}
+	default:
+		return &yamlv3.Node{Kind: yamlv3.ScalarNode, Tag: "!!null", Value: "null"}
+	}
+}
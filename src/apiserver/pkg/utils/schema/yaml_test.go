@@ -0,0 +1,155 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	yamlv3 "gopkg.in/yaml.v3"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+func TestYAMLToJSONDocuments(t *testing.T) {
+	data := []byte(`
+base: &base
+  type: roundrobin
+  nodes:
+    - host: 127.0.0.1
+      port: 80
+      weight: 1
+upstream:
+  <<: *base
+  name: u1
+---
+---
+name: u2
+`)
+	docs, err := YAMLToJSONDocuments(data)
+	assert.NoError(t, err)
+	assert.Len(t, docs, 3)
+	assert.JSONEq(t, `{
+		"base": {"type":"roundrobin","nodes":[{"host":"127.0.0.1","port":80,"weight":1}]},
+		"upstream": {"type":"roundrobin","nodes":[{"host":"127.0.0.1","port":80,"weight":1}],"name":"u1"}
+	}`, string(docs[0]))
+	assert.Nil(t, docs[1])
+	assert.JSONEq(t, `{"name":"u2"}`, string(docs[2]))
+
+	_, err = YAMLToJSONDocuments([]byte(""))
+	assert.Error(t, err)
+
+	_, err = YAMLToJSONDocuments([]byte("name: u1\n---\nname: [u2\n"))
+	assert.ErrorContains(t, err, "yaml 文档[1]")
+}
+
+func TestValidateYAML(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{
+			name: "valid with anchor",
+			data: `
+name: u1
+type: roundrobin
+nodes: &nodes
+  - host: 127.0.0.1
+    port: 80
+    weight: 1
+`,
+		},
+		{
+			name: "valid multi document",
+			data: `
+name: u1
+type: roundrobin
+nodes:
+  - host: 127.0.0.1
+    port: 80
+    weight: 1
+---
+name: u2
+type: chash
+hash_on: header
+key: X-User
+nodes:
+  - host: 127.0.0.2
+    port: 80
+    weight: 1
+`,
+		},
+		{
+			name: "invalid second document",
+			data: `
+name: u1
+type: roundrobin
+nodes:
+  - host: 127.0.0.1
+    port: 80
+    weight: 1
+---
+name: u2
+type: roundrobin
+nodes:
+  - host: 127.0.0.2
+    port: -1
+    weight: 1
+`,
+			wantErr: "yaml 文档[1] 校验失败",
+		},
+		{
+			name:    "invalid yaml",
+			data:    "name: [u1",
+			wantErr: "yaml 文档[0] 解析失败",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateYAML(constant.APISIXVersion311, constant.Upstream, "main.upstream",
+				constant.DATABASE, []byte(tt.data))
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestJSONToYAMLNode(t *testing.T) {
+	node, err := JSONToYAMLNode([]byte(`{"name":"r1","id":1690000000123456789,` +
+		`"rate":0.12345678901234567890123,"enable":true,"desc":null,"tags":["1","a"]}`))
+	assert.NoError(t, err)
+	out, err := yamlv3.Marshal(node)
+	assert.NoError(t, err)
+	assert.Equal(t, `desc: null
+enable: true
+id: 1690000000123456789
+name: r1
+rate: 0.12345678901234567890123
+tags:
+    - "1"
+    - a
+`, string(out))
+
+	_, err = JSONToYAMLNode([]byte(`{"name":`))
+	assert.Error(t, err)
+}
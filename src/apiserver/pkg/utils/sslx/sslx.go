@@ -67,6 +67,15 @@ func ParseCert(crt, key string) ([]string, error) {
 	if err != nil {
 		return nil, fmt.Errorf("密钥和证书不匹配: %w", err)
 	}
+	return ParseCertSNIs(crt)
+}
+
+// ParseCertSNIs 从证书中提取 sni 列表，不校验私钥
+func ParseCertSNIs(crt string) ([]string, error) {
+	certDERBlock, _ := pem.Decode([]byte(crt))
+	if certDERBlock == nil {
+		return nil, errors.New("证书解析失败")
+	}
 
 	x509Cert, err := x509.ParseCertificate(certDERBlock.Bytes)
 	if err != nil {
@@ -21,6 +21,7 @@ package version
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
@@ -42,3 +43,53 @@ func ToXVersion(version string) (constant.APISIXVersion, error) {
 
 	return constant.APISIXVersion(strings.Join(parts, ".")), nil
 }
+
+// SuggestSupportedVersion 根据 apisix 实际版本号推荐支持的 x 版本号：
+// 版本受支持时直接返回，否则返回不高于该版本的最新受支持版本，如 3.12.0 -> 3.11.X
+func SuggestSupportedVersion(version string) (constant.APISIXVersion, error) {
+	xVersion, err := ToXVersion(version)
+	if err != nil {
+		return "", err
+	}
+	if _, ok := constant.SupportAPISIXVersionMap[string(xVersion)]; ok {
+		return xVersion, nil
+	}
+	major, minor, err := majorMinor(string(xVersion))
+	if err != nil {
+		return "", err
+	}
+	var suggested constant.APISIXVersion
+	var suggestedMajor, suggestedMinor int
+	for supported := range constant.SupportAPISIXVersionMap {
+		supportedMajor, supportedMinor, err := majorMinor(supported)
+		if err != nil {
+			continue
+		}
+		if supportedMajor > major || (supportedMajor == major && supportedMinor > minor) {
+			continue
+		}
+		if suggested == "" || supportedMajor > suggestedMajor ||
+			(supportedMajor == suggestedMajor && supportedMinor > suggestedMinor) {
+			suggested = constant.APISIXVersion(supported)
+			suggestedMajor, suggestedMinor = supportedMajor, supportedMinor
+		}
+	}
+	if suggested == "" {
+		return "", fmt.Errorf("no supported version for: %s", version)
+	}
+	return suggested, nil
+}
+
+// majorMinor 解析 x 版本号的主版本号与次版本号
+func majorMinor(xVersion string) (int, int, error) {
+	parts := strings.Split(xVersion, ".")
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid version: %s", xVersion)
+	}
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid version: %s", xVersion)
+	}
+	return major, minor, nil
+}
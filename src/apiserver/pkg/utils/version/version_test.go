@@ -28,3 +28,29 @@ func TestToXVersion(t *testing.T) {
 		}
 	}
 }
+
+func TestSuggestSupportedVersion(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected constant.APISIXVersion
+		hasError bool
+	}{
+		{"3.11.0", constant.APISIXVersion311, false},
+		{"3.13.1", constant.APISIXVersion313, false},
+		{"3.12.0", constant.APISIXVersion311, false},
+		{"3.5.0", constant.APISIXVersion33, false},
+		{"4.0.0", constant.APISIXVersion313, false},
+		{"2.15.0", "", true},
+		{"3", "", true},
+	}
+
+	for _, test := range tests {
+		result, err := SuggestSupportedVersion(test.input)
+		if (err != nil) != test.hasError {
+			t.Errorf("SuggestSupportedVersion(%s) error = %v, expected error = %v", test.input, err, test.hasError)
+		}
+		if result != test.expected {
+			t.Errorf("SuggestSupportedVersion(%s) = %v, expected %v", test.input, result, test.expected)
+		}
+	}
+}
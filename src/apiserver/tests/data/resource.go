@@ -263,6 +263,46 @@ func Proto1(gateway *model.Gateway, status constant.ResourceStatus) *model.Proto
 	}
 }
 
+// Secret1 ...
+func Secret1(gateway *model.Gateway, status constant.ResourceStatus) *model.Secret {
+	return &model.Secret{
+		Name:    "vault-secret",
+		Manager: constant.SecretManagerVault,
+		ResourceCommonModel: model.ResourceCommonModel{
+			GatewayID: gateway.ID,
+			ID:        idx.GenResourceID(constant.Secret),
+			Config: datatypes.JSON(`{
+				"uri": "http://127.0.0.1:8200",
+				"prefix": "kv/apisix",
+				"token": "root"
+			}`),
+			Status: status,
+		},
+	}
+}
+
+// Credential1 ...
+func Credential1(gateway *model.Gateway, consumer *model.Consumer, status constant.ResourceStatus) *model.Credential {
+	id := idx.GenResourceID(constant.Credential)
+	return &model.Credential{
+		Name:       "key-auth-credential",
+		ConsumerID: consumer.ID,
+		ResourceCommonModel: model.ResourceCommonModel{
+			GatewayID:       gateway.ID,
+			ID:              id,
+			EtcdKeyOverride: model.CredentialEtcdKey(consumer, id),
+			Config: datatypes.JSON(`{
+				"plugins": {
+					"key-auth": {
+						"key": "credential-key"
+					}
+				}
+			}`),
+			Status: status,
+		},
+	}
+}
+
 // PluginMetadata1 ...
 func PluginMetadata1(gateway *model.Gateway, status constant.ResourceStatus) *model.PluginMetadata {
 	return &model.PluginMetadata{
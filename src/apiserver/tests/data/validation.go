@@ -0,0 +1,59 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package data
+
+import (
+	"encoding/json"
+
+	"github.com/tidwall/sjson"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+)
+
+// ValidationFixtures 各资源类型具有代表性的配置，由上面的测试数据生成，用于校验性能基准测试
+func ValidationFixtures() map[constant.APISIXResource]json.RawMessage {
+	gateway := Gateway1WithBkAPISIX()
+	status := constant.ResourceStatusCreateDraft
+	consumer := Consumer1WithNoRelation(gateway, status)
+	fixtures := map[constant.APISIXResource]model.ResourceCommonModel{
+		constant.Route:         Route1WithNoRelationResource(gateway, status).ResourceCommonModel,
+		constant.Service:       Service1WithNoRelation(gateway, status).ResourceCommonModel,
+		constant.Upstream:      Upstream1WithNoRelation(gateway, status).ResourceCommonModel,
+		constant.Consumer:      consumer.ResourceCommonModel,
+		constant.PluginConfig:  PluginConfig1WithNoRelation(gateway, status).ResourceCommonModel,
+		constant.GlobalRule:    GlobalRule1(gateway, status).ResourceCommonModel,
+		constant.Proto:         Proto1(gateway, status).ResourceCommonModel,
+		constant.ConsumerGroup: ConsumerGroup1WithNoRelation(gateway, status).ResourceCommonModel,
+		constant.SSL:           SSL1(gateway, status).ResourceCommonModel,
+		constant.StreamRoute:   StreamRoute1WithNoRelationResource(gateway, status).ResourceCommonModel,
+		constant.Secret:        Secret1(gateway, status).ResourceCommonModel,
+		constant.Credential:    Credential1(gateway, consumer, status).ResourceCommonModel,
+	}
+	configs := make(map[constant.APISIXResource]json.RawMessage, len(fixtures))
+	for resourceType, resource := range fixtures {
+		config := json.RawMessage(resource.Config)
+		config, _ = sjson.SetBytes(config, "id", resource.ID)
+		if resourceType == constant.Consumer {
+			config, _ = sjson.SetBytes(config, "username", consumer.Username)
+		}
+		configs[resourceType] = config
+	}
+	return configs
+}
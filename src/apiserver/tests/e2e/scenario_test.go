@@ -0,0 +1,657 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package e2e
+
+import (
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/internal/testsupport"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/config"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+var h *testsupport.Harness
+
+func TestMain(m *testing.M) {
+	var err error
+	h, err = testsupport.New()
+	if err != nil {
+		panic(err)
+	}
+	code := m.Run()
+	h.Close()
+	os.Exit(code)
+}
+
+func upstreamBody(name string) map[string]any {
+	return map[string]any{
+		"name": name,
+		"config": map[string]any{
+			"type":  "roundrobin",
+			"nodes": []map[string]any{{"host": "127.0.0.1", "port": 80, "weight": 1}},
+		},
+	}
+}
+
+func routeBody(name string, uri string, upstreamID string) map[string]any {
+	return map[string]any{
+		"name":        name,
+		"upstream_id": upstreamID,
+		"config":      map[string]any{"uris": []string{uri}, "upstream_id": upstreamID},
+	}
+}
+
+// createPublishedRoute 创建上游与引用它的路由并全部发布
+func createPublishedRoute(t *testing.T, gateway *testsupport.Gateway, name string) (string, string) {
+	upstreamID := h.CreateResource(t, gateway, constant.Upstream, upstreamBody(name+"-upstream"))
+	h.MustPublish(t, gateway, constant.Upstream, upstreamID)
+	routeID := h.CreateResource(t, gateway, constant.Route, routeBody(name, "/"+name, upstreamID))
+	h.MustPublish(t, gateway, constant.Route, routeID)
+	return upstreamID, routeID
+}
+
+func TestCreateAndPublishRoute(t *testing.T) {
+	gateway := h.CreateGateway(t)
+	upstreamID, routeID := createPublishedRoute(t, gateway, "publish")
+
+	value, ok := h.EtcdGet(t, testsupport.EtcdKey(gateway, constant.Route, routeID))
+	require.True(t, ok)
+	assert.Equal(t, "/publish", gjson.Get(value, "uris.0").String())
+	assert.Equal(t, upstreamID, gjson.Get(value, "upstream_id").String())
+	_, ok = h.EtcdGet(t, testsupport.EtcdKey(gateway, constant.Upstream, upstreamID))
+	assert.True(t, ok)
+	assert.Equal(t, constant.ResourceStatusSuccess, h.ResourceStatus(t, gateway, constant.Route, routeID))
+	assert.Empty(t, h.RunCompliance(t, gateway).Drift(constant.Route, routeID))
+}
+
+func TestDriftDetectAndHeal(t *testing.T) {
+	gateway := h.CreateGateway(t)
+	upstreamID, routeID := createPublishedRoute(t, gateway, "drift")
+	routeKey := testsupport.EtcdKey(gateway, constant.Route, routeID)
+
+	// etcd 中的数据被外部删除
+	h.EtcdDelete(t, routeKey)
+	assert.Contains(t, h.RunCompliance(t, gateway).Drift(constant.Route, routeID), "资源已发布，但 etcd 中不存在")
+
+	// 修改后重新发布即可修复
+	resp := h.Do(http.MethodPut, h.ResourcePath(gateway, constant.Route, routeID),
+		routeBody("drift", "/drift-healed", upstreamID))
+	require.Equal(t, http.StatusOK, resp.Code, resp.String())
+	assert.Equal(t, constant.ResourceStatusUpdateDraft, h.ResourceStatus(t, gateway, constant.Route, routeID))
+	h.MustPublish(t, gateway, constant.Route, routeID)
+
+	value, ok := h.EtcdGet(t, routeKey)
+	require.True(t, ok)
+	assert.Equal(t, "/drift-healed", gjson.Get(value, "uris.0").String())
+	assert.Empty(t, h.RunCompliance(t, gateway).Drift(constant.Route, routeID))
+}
+
+func TestDriftOnUnmanagedEtcdResource(t *testing.T) {
+	gateway := h.CreateGateway(t)
+	h.EtcdPut(t, testsupport.EtcdKey(gateway, constant.Upstream, "unmanaged"),
+		`{"id":"unmanaged","type":"roundrobin","nodes":[{"host":"127.0.0.1","port":80,"weight":1}]}`)
+	assert.Contains(t, h.RunCompliance(t, gateway).Drift(constant.Upstream, "unmanaged"), "etcd 中存在未纳管的资源")
+}
+
+func TestDriftOnMissingDataPlaneDependency(t *testing.T) {
+	gateway := h.CreateGateway(t)
+	upstreamID, routeID := createPublishedRoute(t, gateway, "dependency")
+
+	// 已发布的上游被外部从 etcd 中删除，编辑区中的关联关系仍然完整
+	h.EtcdDelete(t, testsupport.EtcdKey(gateway, constant.Upstream, upstreamID))
+	results := h.RunCompliance(t, gateway)
+	assert.Contains(t, results.Drift(constant.Upstream, upstreamID), "资源已发布，但 etcd 中不存在")
+	assert.Empty(t, results.Drift(constant.Route, routeID))
+	assert.Empty(t, results.Findings(constant.Route, routeID, constant.ComplianceCheckReference))
+
+	findings := results.Findings(constant.Route, routeID, constant.ComplianceCheckDataPlaneReference)
+	require.Len(t, findings, 1)
+	assert.Equal(t, constant.ComplianceSeverityCritical, findings[0].Severity)
+	assert.Contains(t, findings[0].Message, upstreamID)
+}
+
+func TestReferenceProtectedDelete(t *testing.T) {
+	gateway := h.CreateGateway(t)
+	upstreamID := h.CreateResource(t, gateway, constant.Upstream, upstreamBody("referenced-upstream"))
+	routeID := h.CreateResource(t, gateway, constant.Route, routeBody("referencing", "/ref", upstreamID))
+
+	// 被路由引用的上游不允许删除
+	resp := h.Do(http.MethodDelete, h.ResourcePath(gateway, constant.Upstream, upstreamID), nil)
+	assert.Equal(t, http.StatusBadRequest, resp.Code, resp.String())
+	_, ok := h.GetResource(t, gateway, constant.Upstream, upstreamID)
+	assert.True(t, ok)
+
+	// 删除引用方后可以删除
+	resp = h.Do(http.MethodDelete, h.ResourcePath(gateway, constant.Route, routeID), nil)
+	require.Equal(t, http.StatusNoContent, resp.Code, resp.String())
+	resp = h.Do(http.MethodDelete, h.ResourcePath(gateway, constant.Upstream, upstreamID), nil)
+	require.Equal(t, http.StatusNoContent, resp.Code, resp.String())
+	_, ok = h.GetResource(t, gateway, constant.Upstream, upstreamID)
+	assert.False(t, ok)
+}
+
+func TestDeletePublishedRoute(t *testing.T) {
+	gateway := h.CreateGateway(t)
+	_, routeID := createPublishedRoute(t, gateway, "deleted")
+	routeKey := testsupport.EtcdKey(gateway, constant.Route, routeID)
+
+	// 已发布的资源删除后进入待发布状态，etcd 中仍然生效
+	resp := h.Do(http.MethodDelete, h.ResourcePath(gateway, constant.Route, routeID), nil)
+	require.Equal(t, http.StatusNoContent, resp.Code, resp.String())
+	assert.Equal(t, constant.ResourceStatusDeleteDraft, h.ResourceStatus(t, gateway, constant.Route, routeID))
+	_, ok := h.EtcdGet(t, routeKey)
+	assert.True(t, ok)
+
+	h.MustPublish(t, gateway, constant.Route, routeID)
+	_, ok = h.EtcdGet(t, routeKey)
+	assert.False(t, ok)
+	_, ok = h.GetResource(t, gateway, constant.Route, routeID)
+	assert.False(t, ok)
+}
+
+func TestInvalidConfigRejected(t *testing.T) {
+	gateway := h.CreateGateway(t)
+	resp := h.Do(http.MethodPost, h.ResourcePath(gateway, constant.Route, ""), map[string]any{
+		"name":   "invalid",
+		"config": map[string]any{"uris": []string{"/invalid"}, "methods": []string{"NOT-A-METHOD"}},
+	})
+	assert.Equal(t, http.StatusBadRequest, resp.Code, resp.String())
+	assert.Empty(t, h.FindResourceID(t, gateway, constant.Route, "invalid"))
+}
+
+func TestConsumerRequiresAuthPlugin(t *testing.T) {
+	gateway := h.CreateGateway(t)
+	resp := h.Do(http.MethodPost, h.ResourcePath(gateway, constant.Consumer, ""), map[string]any{
+		"name":   "anonymous",
+		"config": map[string]any{"username": "anonymous", "plugins": map[string]any{}},
+	})
+	assert.Equal(t, http.StatusBadRequest, resp.Code, resp.String())
+	assert.Contains(t, resp.String(), "consumer 至少需要配置一个认证插件")
+
+	consumerID := h.CreateResource(t, gateway, constant.Consumer, map[string]any{
+		"name": "jack",
+		"config": map[string]any{
+			"username": "jack",
+			"plugins":  map[string]any{"key-auth": map[string]any{"key": "jack-key"}},
+		},
+	})
+	h.MustPublish(t, gateway, constant.Consumer, consumerID)
+	value, ok := h.EtcdGet(t, testsupport.EtcdKey(gateway, constant.Consumer, consumerID))
+	require.True(t, ok)
+	assert.Equal(t, "jack-key", gjson.Get(value, "plugins.key-auth.key").String())
+}
+
+func TestImportThenPublish(t *testing.T) {
+	gateway := h.CreateGateway(t)
+	resp := h.Do(http.MethodPost, gateway.Path("/unify_op/resources/import/"), map[string]any{
+		"add": map[string]any{
+			"upstream": []map[string]any{{
+				"resource_type": constant.Upstream,
+				"resource_id":   "imported-upstream",
+				"name":          "imported-upstream",
+				"config": map[string]any{
+					"id":    "imported-upstream",
+					"name":  "imported-upstream",
+					"type":  "roundrobin",
+					"nodes": []map[string]any{{"host": "127.0.0.1", "port": 80, "weight": 1}},
+				},
+			}},
+			"route": []map[string]any{{
+				"resource_type": constant.Route,
+				"resource_id":   "imported-route",
+				"name":          "imported-route",
+				"config": map[string]any{
+					"id":          "imported-route",
+					"name":        "imported-route",
+					"uris":        []string{"/imported"},
+					"upstream_id": "imported-upstream",
+				},
+			}},
+		},
+	})
+	require.Equal(t, http.StatusNoContent, resp.Code, resp.String())
+
+	h.MustPublish(t, gateway, constant.Upstream, "imported-upstream")
+	h.MustPublish(t, gateway, constant.Route, "imported-route")
+	value, ok := h.EtcdGet(t, testsupport.EtcdKey(gateway, constant.Route, "imported-route"))
+	require.True(t, ok)
+	assert.Equal(t, "imported-upstream", gjson.Get(value, "upstream_id").String())
+	assert.Empty(t, h.RunCompliance(t, gateway).Drift(constant.Route, "imported-route"))
+}
+
+func TestSyncFromEtcdThenManage(t *testing.T) {
+	gateway := h.CreateGateway(t)
+	h.EtcdPut(t, testsupport.EtcdKey(gateway, constant.Upstream, "synced-upstream"),
+		`{"id":"synced-upstream","type":"roundrobin","nodes":[{"host":"127.0.0.1","port":80,"weight":1}]}`)
+
+	resp := h.Do(http.MethodPost, gateway.Path("/sync/"), map[string]any{})
+	require.Equal(t, http.StatusOK, resp.Code, resp.String())
+	assert.EqualValues(t, 1, resp.Data().Get(string(constant.Upstream)).Int())
+
+	resp = h.Do(http.MethodPost, gateway.Path("/unify_op/resources/-/managed/"),
+		map[string]any{"resource_id_list": []string{"synced-upstream"}})
+	require.Equal(t, http.StatusOK, resp.Code, resp.String())
+	assert.Equal(t, constant.ResourceStatusSuccess,
+		h.ResourceStatus(t, gateway, constant.Upstream, "synced-upstream"))
+	assert.Empty(t, h.RunCompliance(t, gateway).Drift(constant.Upstream, "synced-upstream"))
+}
+
+func TestEtcdKeyOverridePublish(t *testing.T) {
+	gateway := h.CreateGateway(t)
+	upstreamID := h.CreateResource(t, gateway, constant.Upstream, upstreamBody("override-upstream"))
+	h.MustPublish(t, gateway, constant.Upstream, upstreamID)
+	routeID := h.CreateResource(t, gateway, constant.Route, routeBody("override", "/override", upstreamID))
+
+	overridePath := gateway.Path("/unify_op/resources/%s/etcd_key_override/%s/", constant.Route, routeID)
+	resp := h.Do(http.MethodPut, overridePath, map[string]any{"etcd_key_override": "routes/legacy/1"})
+	require.Equal(t, http.StatusNoContent, resp.Code, resp.String())
+	h.MustPublish(t, gateway, constant.Route, routeID)
+
+	_, ok := h.EtcdGet(t, gateway.Prefix+"/routes/legacy/1")
+	assert.True(t, ok)
+	_, ok = h.EtcdGet(t, testsupport.EtcdKey(gateway, constant.Route, routeID))
+	assert.False(t, ok)
+	assert.Empty(t, h.RunCompliance(t, gateway).Drift(constant.Route, routeID))
+}
+
+func TestMutationReturnsChangeToken(t *testing.T) {
+	gateway := h.CreateGateway(t)
+	resp := h.Do(http.MethodPost, h.ResourcePath(gateway, constant.Upstream, ""), upstreamBody("token-upstream"))
+	require.Equal(t, http.StatusCreated, resp.Code, resp.String())
+	assert.NotEmpty(t, resp.Header.Get(constant.ChangeTokenHeaderKey))
+}
+
+func TestPublishWritesEtcdAudit(t *testing.T) {
+	gateway := h.CreateGateway(t)
+	upstreamID := h.CreateResource(t, gateway, constant.Upstream, upstreamBody("audited-upstream"))
+	h.MustPublish(t, gateway, constant.Upstream, upstreamID)
+
+	resp := h.Do(http.MethodGet, gateway.Path("/etcd_write_audits/?resource_id=%s", upstreamID), nil)
+	require.Equal(t, http.StatusOK, resp.Code, resp.String())
+	audits := resp.Data().Get("results").Array()
+	require.NotEmpty(t, audits)
+	assert.Equal(t, testsupport.EtcdKey(gateway, constant.Upstream, upstreamID), audits[0].Get("key").String())
+}
+
+func TestUnknownRouteVarRejected(t *testing.T) {
+	gateway := h.CreateGateway(t)
+	upstreamID := h.CreateResource(t, gateway, constant.Upstream, upstreamBody("vars-upstream"))
+	body := routeBody("vars", "/vars", upstreamID)
+	body["config"].(map[string]any)["vars"] = [][]any{{"argid", "==", "1"}}
+	resp := h.Do(http.MethodPost, h.ResourcePath(gateway, constant.Route, ""), body)
+	assert.Equal(t, http.StatusBadRequest, resp.Code, resp.String())
+	assert.Contains(t, resp.String(), "是否为 arg_id")
+
+	resp = h.Do(http.MethodGet, gateway.Path("/routes/-/vars/"), nil)
+	require.Equal(t, http.StatusOK, resp.Code, resp.String())
+	assert.Contains(t, resp.Data().Get("prefixes").String(), "arg_")
+	assert.False(t, resp.Data().Get("allow_custom_vars").Bool())
+}
+
+func TestGatewayRetriesCheck(t *testing.T) {
+	gateway := h.CreateGateway(t)
+	body := upstreamBody("chash-upstream")
+	config := body["config"].(map[string]any)
+	config["type"] = "chash"
+	config["key"] = "remote_addr"
+	config["retries"] = 3
+	resp := h.Do(http.MethodPost, h.ResourcePath(gateway, constant.Upstream, ""), body)
+	require.Equal(t, http.StatusCreated, resp.Code, resp.String())
+
+	gatewayBody := h.GatewayBody(gateway)
+	gatewayBody["retries_check"] = constant.RetriesCheckStrict
+	resp = h.Do(http.MethodPut, gateway.Path("/"), gatewayBody)
+	require.Equal(t, http.StatusOK, resp.Code, resp.String())
+	resp = h.Do(http.MethodGet, gateway.Path("/"), nil)
+	require.Equal(t, http.StatusOK, resp.Code, resp.String())
+	assert.Equal(t, string(constant.RetriesCheckStrict), resp.Data().Get("retries_check").String())
+
+	body["name"] = "chash-upstream-strict"
+	resp = h.Do(http.MethodPost, h.ResourcePath(gateway, constant.Upstream, ""), body)
+	assert.Equal(t, http.StatusBadRequest, resp.Code, resp.String())
+	assert.Contains(t, resp.String(), "retries: 3 超过节点数: 1")
+}
+
+func TestBatchValidateResources(t *testing.T) {
+	gateway := h.CreateGateway(t)
+	items := []map[string]any{
+		{"type": "upstream", "config": upstreamBody("batch-upstream")["config"]},
+		{"type": "route", "config": map[string]any{"name": "batch", "methods": []string{"NOT-A-METHOD"}}},
+	}
+	resp := h.Do(http.MethodPost, gateway.Path("/resources/-/validate/"), items)
+	require.Equal(t, http.StatusOK, resp.Code, resp.String())
+	assert.True(t, resp.Data().Get("0.valid").Bool(), resp.String())
+	assert.False(t, resp.Data().Get("1.valid").Bool())
+	assert.Equal(t, int64(1), resp.Data().Get("1.index").Int())
+	// 校验不落库
+	assert.Empty(t, h.FindResourceID(t, gateway, constant.Upstream, "batch-upstream"))
+
+	tooMany := make([]map[string]any, biz.MaxBatchValidateSize+1)
+	for i := range tooMany {
+		tooMany[i] = items[0]
+	}
+	resp = h.Do(http.MethodPost, gateway.Path("/resources/-/validate/"), tooMany)
+	assert.Equal(t, http.StatusBadRequest, resp.Code, resp.String())
+	assert.Contains(t, resp.String(), "单次最多校验 1000 个资源")
+}
+
+func TestPluginSecretsRedacted(t *testing.T) {
+	gateway := h.CreateGateway(t)
+	upstreamID := h.CreateResource(t, gateway, constant.Upstream, upstreamBody("secret-upstream"))
+	h.MustPublish(t, gateway, constant.Upstream, upstreamID)
+	clientSecret := "oidc-client-secret-value"
+	body := routeBody("secret", "/secret", upstreamID)
+	body["config"].(map[string]any)["plugins"] = map[string]any{
+		"openid-connect": map[string]any{
+			"client_id":     "client",
+			"client_secret": clientSecret,
+			"discovery":     "https://idp.example.com/.well-known/openid-configuration",
+		},
+	}
+	routeID := h.CreateResource(t, gateway, constant.Route, body)
+	h.MustPublish(t, gateway, constant.Route, routeID)
+
+	// 生效的配置保持原值
+	value, ok := h.EtcdGet(t, testsupport.EtcdKey(gateway, constant.Route, routeID))
+	require.True(t, ok)
+	assert.Equal(t, clientSecret, gjson.Get(value, "plugins.openid-connect.client_secret").String())
+
+	resp := h.Do(http.MethodGet, gateway.Path("/audits/logs/?limit=100"), nil)
+	require.Equal(t, http.StatusOK, resp.Code, resp.String())
+	assert.NotEmpty(t, resp.Data().Get("results").Array())
+	assert.NotContains(t, string(resp.Body), clientSecret)
+
+	for _, path := range []string{"/unify_op/etcd/export/", "/unify_op/standalone/export/"} {
+		resp = h.Do(http.MethodGet, gateway.Path("%s", path), nil)
+		require.Equal(t, http.StatusOK, resp.Code, resp.String())
+		assert.NotContains(t, string(resp.Body), clientSecret, path)
+		assert.True(t, strings.Contains(string(resp.Body), constant.SensitiveInfoFiledDisplay), path)
+
+		resp = h.Do(http.MethodGet, gateway.Path("%s?include_secrets=true", path), nil)
+		require.Equal(t, http.StatusOK, resp.Code, resp.String())
+		assert.Contains(t, string(resp.Body), clientSecret, path)
+	}
+}
+
+func TestSecretResource(t *testing.T) {
+	gateway := h.CreateGateway(t)
+	token := "vault-root-token"
+	body := map[string]any{
+		"name":    "vault-secret",
+		"manager": constant.SecretManagerVault,
+		"config": map[string]any{
+			"uri":    "http://127.0.0.1:8200",
+			"prefix": "kv/apisix",
+			"token":  token,
+		},
+	}
+	secretID := h.CreateResource(t, gateway, constant.Secret, body)
+	h.MustPublish(t, gateway, constant.Secret, secretID)
+
+	// etcd 中的 key 带有密钥管理器目录，id 为 {manager}/{id}
+	value, ok := h.EtcdGet(t, gateway.Prefix+"/secrets/vault/"+secretID)
+	require.True(t, ok)
+	assert.Equal(t, "vault/"+secretID, gjson.Get(value, "id").String())
+	assert.Equal(t, token, gjson.Get(value, "token").String())
+	assert.False(t, gjson.Get(value, "name").Exists())
+	assert.Empty(t, h.RunCompliance(t, gateway).Drift(constant.Secret, secretID))
+
+	// 列表与详情中的 token 脱敏，按脱敏后的配置更新不会覆盖原值
+	resp := h.Do(http.MethodGet, h.ResourcePath(gateway, constant.Secret, secretID), nil)
+	require.Equal(t, http.StatusOK, resp.Code, resp.String())
+	assert.Equal(t, constant.SensitiveInfoFiledDisplay, resp.Data().Get("config.token").String())
+	resp = h.Do(http.MethodGet, h.ResourcePath(gateway, constant.Secret, ""), nil)
+	require.Equal(t, http.StatusOK, resp.Code, resp.String())
+	assert.NotContains(t, resp.String(), token)
+
+	body["config"] = map[string]any{
+		"uri":    "http://127.0.0.1:8200",
+		"prefix": "kv/gateway",
+		"token":  constant.SensitiveInfoFiledDisplay,
+	}
+	resp = h.Do(http.MethodPut, h.ResourcePath(gateway, constant.Secret, secretID), body)
+	require.Equal(t, http.StatusOK, resp.Code, resp.String())
+	h.MustPublish(t, gateway, constant.Secret, secretID)
+	value, ok = h.EtcdGet(t, gateway.Prefix+"/secrets/vault/"+secretID)
+	require.True(t, ok)
+	assert.Equal(t, "kv/gateway", gjson.Get(value, "prefix").String())
+	assert.Equal(t, token, gjson.Get(value, "token").String())
+
+	// 密钥管理器创建后不允许修改，且需满足所选密钥管理器的 schema
+	body["manager"] = constant.SecretManagerAWS
+	resp = h.Do(http.MethodPut, h.ResourcePath(gateway, constant.Secret, secretID), body)
+	assert.Equal(t, http.StatusBadRequest, resp.Code, resp.String())
+	resp = h.Do(http.MethodPost, h.ResourcePath(gateway, constant.Secret, ""), map[string]any{
+		"name":    "vault-secret-without-token",
+		"manager": constant.SecretManagerVault,
+		"config":  map[string]any{"uri": "http://127.0.0.1:8200", "prefix": "kv/apisix"},
+	})
+	assert.Equal(t, http.StatusBadRequest, resp.Code, resp.String())
+	assert.Contains(t, resp.String(), "token")
+}
+
+func TestConsumerCredential(t *testing.T) {
+	gateway := h.CreateGateway(t)
+	consumerID := h.CreateResource(t, gateway, constant.Consumer, map[string]any{
+		"name": "rose",
+		"config": map[string]any{
+			"username": "rose",
+			"plugins":  map[string]any{"key-auth": map[string]any{"key": "rose-key"}},
+		},
+	})
+	credentialPath := h.ResourcePath(gateway, constant.Consumer, consumerID) + "credentials/"
+	resp := h.Do(http.MethodPost, credentialPath, map[string]any{
+		"name":   "rose-credential",
+		"config": map[string]any{"plugins": map[string]any{"key-auth": map[string]any{"key": "rose-credential-key"}}},
+	})
+	require.Equal(t, http.StatusCreated, resp.Code, resp.String())
+	resp = h.Do(http.MethodGet, credentialPath, nil)
+	require.Equal(t, http.StatusOK, resp.Code, resp.String())
+	credentialID := resp.Data().Get("results.0.id").String()
+	require.NotEmpty(t, credentialID)
+
+	// 发布凭证时会一并发布尚未发布的所属 consumer，凭证位于 consumer 的子目录
+	h.MustPublish(t, gateway, constant.Credential, credentialID)
+	assert.Equal(t, constant.ResourceStatusSuccess, h.ResourceStatus(t, gateway, constant.Consumer, consumerID))
+	value, ok := h.EtcdGet(t, gateway.Prefix+"/consumers/"+consumerID+"/credentials/"+credentialID)
+	require.True(t, ok)
+	assert.Equal(t, "rose-credential-key", gjson.Get(value, "plugins.key-auth.key").String())
+	assert.False(t, gjson.Get(value, "name").Exists())
+	assert.Empty(t, h.RunCompliance(t, gateway).Drift(constant.Credential, credentialID))
+
+	// 存在凭证的 consumer 不允许删除
+	resp = h.Do(http.MethodDelete, h.ResourcePath(gateway, constant.Consumer, consumerID), nil)
+	assert.Equal(t, http.StatusBadRequest, resp.Code, resp.String())
+
+	// 凭证仅能配置认证插件
+	resp = h.Do(http.MethodPost, credentialPath, map[string]any{
+		"name": "rose-limit",
+		"config": map[string]any{
+			"plugins": map[string]any{"limit-count": map[string]any{"count": 1, "time_window": 1}},
+		},
+	})
+	assert.Equal(t, http.StatusBadRequest, resp.Code, resp.String())
+}
+
+func TestConsumerCredentialPromotion(t *testing.T) {
+	source := h.CreateGateway(t)
+	consumerID := h.CreateResource(t, source, constant.Consumer, map[string]any{
+		"name": "promo",
+		"config": map[string]any{
+			"username": "promo",
+			"plugins":  map[string]any{"key-auth": map[string]any{"key": "promo-key"}},
+		},
+	})
+	credentialPath := h.ResourcePath(source, constant.Consumer, consumerID) + "credentials/"
+	resp := h.Do(http.MethodPost, credentialPath, map[string]any{
+		"name":   "promo-credential",
+		"config": map[string]any{"plugins": map[string]any{"key-auth": map[string]any{"key": "promo-credential-key"}}},
+	})
+	require.Equal(t, http.StatusCreated, resp.Code, resp.String())
+	resp = h.Do(http.MethodGet, credentialPath, nil)
+	require.Equal(t, http.StatusOK, resp.Code, resp.String())
+	credentialID := resp.Data().Get("results.0.id").String()
+	h.MustPublish(t, source, constant.Credential, credentialID)
+
+	// 资源 id 全局唯一，导入目标网关前替换 id 以模拟另一套环境
+	replacer := strings.NewReplacer(consumerID, "promo-consumer", credentialID, "promo-credential")
+	consumerID, credentialID = "promo-consumer", "promo-credential"
+	export := func(query string) gjson.Result {
+		resp := h.Do(http.MethodGet, source.Path("/unify_op/etcd/export/?%s", query), nil)
+		require.Equal(t, http.StatusOK, resp.Code, resp.String())
+		return gjson.Parse(replacer.Replace(string(resp.Body)))
+	}
+
+	// 默认只导出 consumer 身份，凭证字段替换为占位值
+	bundle := export("include_secrets=true")
+	assert.NotContains(t, bundle.Raw, "promo-key")
+	assert.NotContains(t, bundle.Raw, "promo-credential-key")
+	assert.Equal(t, constant.CredentialPlaceholder, bundle.Get("consumer.0.config.plugins.key-auth.key").String())
+	consumerInfo := bundle.Get("consumer.0").Value()
+
+	bundle = export("include_credentials=true")
+	assert.False(t, bundle.Get("credential").Exists())
+	credentials := bundle.Get("consumer_credentials").Value()
+	assert.Equal(t, "promo-key",
+		bundle.Get(`consumer_credentials.#(resource_type=="consumer").config.plugins.key-auth.key`).String())
+	assert.Equal(t, consumerID,
+		bundle.Get(`consumer_credentials.#(resource_type=="credential").consumer_id`).String())
+
+	// 未携带凭证导入后需生成凭证才能发布
+	target := h.CreateGateway(t)
+	resp = h.Do(http.MethodPost, target.Path("/unify_op/resources/import/"), map[string]any{
+		"add": map[string]any{"consumer": []any{consumerInfo}},
+	})
+	require.Equal(t, http.StatusNoContent, resp.Code, resp.String())
+	resp = h.Publish(target, constant.Consumer, consumerID)
+	assert.NotEqual(t, http.StatusCreated, resp.Code, resp.String())
+	assert.Contains(t, resp.String(), "plugins.key-auth.key")
+	resp = h.Do(http.MethodPost, h.ResourcePath(target, constant.Consumer, consumerID)+"generate_credentials/", nil)
+	require.Equal(t, http.StatusOK, resp.Code, resp.String())
+	generatedKey := resp.Data().Get(`generated.plugins\.key-auth\.key`).String()
+	assert.Len(t, generatedKey, constant.GeneratedCredentialLength)
+	h.MustPublish(t, target, constant.Consumer, consumerID)
+	value, ok := h.EtcdGet(t, testsupport.EtcdKey(target, constant.Consumer, consumerID))
+	require.True(t, ok)
+	assert.Equal(t, generatedKey, gjson.Get(value, "plugins.key-auth.key").String())
+
+	// 凭证与目标网关中已有的不一致时需逐个 consumer 明确是否覆盖
+	importBody := map[string]any{
+		"update":      map[string]any{"consumer": []any{consumerInfo}},
+		"credentials": credentials,
+	}
+	resp = h.Do(http.MethodPost, target.Path("/unify_op/resources/import/"), importBody)
+	require.Equal(t, http.StatusBadRequest, resp.Code, resp.String())
+	assert.Contains(t, resp.String(), consumerID)
+
+	importBody["credential_overwrite"] = map[string]bool{consumerID: false}
+	resp = h.Do(http.MethodPost, target.Path("/unify_op/resources/import/"), importBody)
+	require.Equal(t, http.StatusNoContent, resp.Code, resp.String())
+	data, ok := h.GetResource(t, target, constant.Consumer, consumerID)
+	require.True(t, ok)
+	assert.Equal(t, generatedKey, data.Get("config.plugins.key-auth.key").String())
+
+	importBody["credential_overwrite"] = map[string]bool{consumerID: true}
+	resp = h.Do(http.MethodPost, target.Path("/unify_op/resources/import/"), importBody)
+	require.Equal(t, http.StatusNoContent, resp.Code, resp.String())
+	data, ok = h.GetResource(t, target, constant.Consumer, consumerID)
+	require.True(t, ok)
+	assert.Equal(t, "promo-key", data.Get("config.plugins.key-auth.key").String())
+	h.MustPublish(t, target, constant.Credential, credentialID)
+	value, ok = h.EtcdGet(t, target.Prefix+"/consumers/"+consumerID+"/credentials/"+credentialID)
+	require.True(t, ok)
+	assert.Equal(t, "promo-credential-key", gjson.Get(value, "plugins.key-auth.key").String())
+}
+
+func TestGatewayOnboarding(t *testing.T) {
+	gateway := h.OnboardGateway(t)
+	runStep := func(step constant.OnboardingStep) *testsupport.Response {
+		return h.Do(http.MethodPost, gateway.Path("/onboarding/steps/%s/run/", step), nil)
+	}
+	stepStatus := func(step constant.OnboardingStep) string {
+		resp := runStep(step)
+		require.Equal(t, http.StatusOK, resp.Code, resp.String())
+		return resp.Data().Get("status").String()
+	}
+
+	// 引导完成前不允许发布
+	upstreamID := h.CreateResource(t, gateway, constant.Upstream, upstreamBody("onboarding-upstream"))
+	resp := h.Publish(gateway, constant.Upstream, upstreamID)
+	assert.NotEqual(t, http.StatusCreated, resp.Code, resp.String())
+	assert.Contains(t, resp.String(), "接入引导")
+
+	// 前序必要步骤未完成时不允许执行后续步骤
+	resp = runStep(constant.OnboardingStepForeignKeyScan)
+	assert.Equal(t, http.StatusBadRequest, resp.Code, resp.String())
+	assert.Equal(t, "passed", stepStatus(constant.OnboardingStepEtcdConnectivity))
+	assert.Equal(t, "passed", stepStatus(constant.OnboardingStepPrefixCollision))
+
+	// etcd 中已有资源引用了不存在的上游
+	brokenKey := testsupport.EtcdKey(gateway, constant.Route, "broken")
+	h.EtcdPut(t, brokenKey, `{"id":"broken","uri":"/broken","upstream_id":"missing"}`)
+	resp = runStep(constant.OnboardingStepForeignKeyScan)
+	require.Equal(t, http.StatusOK, resp.Code, resp.String())
+	assert.Equal(t, "failed", resp.Data().Get("status").String())
+	assert.Contains(t, resp.Data().Get("detail.0").String(), "missing")
+
+	resp = h.Do(http.MethodPost, gateway.Path("/onboarding/activate/"), nil)
+	assert.Equal(t, http.StatusBadRequest, resp.Code, resp.String())
+
+	h.EtcdDelete(t, brokenKey)
+	assert.Equal(t, "passed", stepStatus(constant.OnboardingStepForeignKeyScan))
+	assert.Equal(t, "passed", stepStatus(constant.OnboardingStepImportPreview))
+
+	// 探测到的版本与网关配置不一致时给出建议版本
+	h.EtcdPut(t, gateway.Prefix+"/data_plane/server_info/onboarding",
+		`{"id":"onboarding-instance","version":"3.13.1"}`)
+	resp = runStep(constant.OnboardingStepVersionDetection)
+	require.Equal(t, http.StatusOK, resp.Code, resp.String())
+	assert.Equal(t, "failed", resp.Data().Get("status").String())
+	assert.Equal(t, string(constant.APISIXVersion313), resp.Data().Get("detail.suggested_version").String())
+
+	// 仅管理员可以豁免
+	waiveBody := map[string]any{"reason": "upgrade scheduled"}
+	resp = h.Do(http.MethodPost, gateway.Path("/onboarding/steps/%s/waive/", constant.OnboardingStepVersionDetection),
+		waiveBody)
+	assert.Equal(t, http.StatusForbidden, resp.Code, resp.String())
+	config.G.Service.AdminUsers = []string{h.User}
+	defer func() { config.G.Service.AdminUsers = nil }()
+	resp = h.Do(http.MethodPost, gateway.Path("/onboarding/steps/%s/waive/", constant.OnboardingStepVersionDetection),
+		waiveBody)
+	require.Equal(t, http.StatusOK, resp.Code, resp.String())
+	assert.Equal(t, h.User, resp.Data().Get("waived_by").String())
+
+	resp = h.Do(http.MethodPost, gateway.Path("/onboarding/activate/"), nil)
+	require.Equal(t, http.StatusOK, resp.Code, resp.String())
+	assert.True(t, resp.Data().Get("ready").Bool())
+
+	resp = h.Do(http.MethodGet, gateway.Path("/"), nil)
+	require.Equal(t, http.StatusOK, resp.Code, resp.String())
+	assert.Equal(t, string(constant.GatewayStatusActive), resp.Data().Get("status").String())
+	assert.Equal(t, "onboarding-instance", resp.Data().Get("etcd.instance_id").String())
+	h.MustPublish(t, gateway, constant.Upstream, upstreamID)
+}
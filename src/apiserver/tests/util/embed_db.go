@@ -87,6 +87,19 @@ func InitEmbedDb() {
 			model.GatewayCustomPluginSchema{},
 			model.GatewayResourceSchemaAssociation{},
 			model.StreamRoute{},
+			model.ComplianceReport{},
+			model.PublishTask{},
+			model.GatewayDiscovery{},
+			model.EtcdWriteAudit{},
+			model.ResourceRevision{},
+			model.BlobObject{},
+			model.ChangeSet{},
+			model.ChangeSetResource{},
+			model.GatewayOnboarding{},
+			model.Secret{},
+			model.Credential{},
+			model.ValidationCache{},
+			model.ValidationRuleset{},
 		}
 		for _, m := range models {
 			// 执行迁移
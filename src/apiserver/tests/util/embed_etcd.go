@@ -22,6 +22,7 @@ package util
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/url"
 	"os"
 	"time"
@@ -40,6 +41,98 @@ func StartEmbedEtcdClient(ctx context.Context) (*clientv3.Client, *embed.Etcd, e
 	cfg.Dir, _ = os.MkdirTemp("", "etcd")
 	cfg.LogLevel = "error"
 
+	return startEmbedEtcd(cfg)
+}
+
+// StartEmbedEtcdClientOnFreePort 在随机空闲端口上启动 embedded etcd，避免与其他测试包的固定端口冲突
+func StartEmbedEtcdClientOnFreePort(ctx context.Context) (*clientv3.Client, *embed.Etcd, error) {
+	clientURL, err := freePortURL()
+	if err != nil {
+		return nil, nil, err
+	}
+	peerURL, err := freePortURL()
+	if err != nil {
+		return nil, nil, err
+	}
+	cfg := embed.NewConfig()
+	cfg.ListenClientUrls = []url.URL{clientURL}
+	cfg.AdvertiseClientUrls = []url.URL{clientURL}
+	cfg.ListenPeerUrls = []url.URL{peerURL}
+	cfg.AdvertisePeerUrls = []url.URL{peerURL}
+	cfg.InitialCluster = cfg.InitialClusterFromName(cfg.Name)
+	cfg.Dir, _ = os.MkdirTemp("", "etcd")
+	cfg.LogLevel = "error"
+	return startEmbedEtcd(cfg)
+}
+
+// embedEtcdTimeout embedded etcd 启动及建立连接的超时时间，-race 等慢速环境下 1s 不足以完成连接及认证
+const embedEtcdTimeout = 30 * time.Second
+
+// EmbedEtcdRootUser 开启鉴权的 embedded etcd 的 root 用户名和密码
+const (
+	EmbedEtcdRootUser     = "root"
+	EmbedEtcdRootPassword = "root-password"
+)
+
+// StartEmbedEtcdWithAuth 在随机空闲端口上启动开启鉴权的 embedded etcd，使用 simple token，token 有效期为 tokenTTL 秒
+// 返回的 client 使用 root 用户认证；出错时 client 为 nil
+func StartEmbedEtcdWithAuth(ctx context.Context, tokenTTL uint) (*clientv3.Client, *embed.Etcd, error) {
+	clientURL, err := freePortURL()
+	if err != nil {
+		return nil, nil, err
+	}
+	peerURL, err := freePortURL()
+	if err != nil {
+		return nil, nil, err
+	}
+	cfg := embed.NewConfig()
+	cfg.ListenClientUrls = []url.URL{clientURL}
+	cfg.AdvertiseClientUrls = []url.URL{clientURL}
+	cfg.ListenPeerUrls = []url.URL{peerURL}
+	cfg.AdvertisePeerUrls = []url.URL{peerURL}
+	cfg.InitialCluster = cfg.InitialClusterFromName(cfg.Name)
+	cfg.Dir, _ = os.MkdirTemp("", "etcd")
+	cfg.LogLevel = "error"
+	cfg.AuthToken = "simple"
+	cfg.AuthTokenTTL = tokenTTL
+	client, etcd, err := startEmbedEtcd(cfg)
+	if err != nil {
+		return nil, etcd, err
+	}
+	defer client.Close()
+
+	if _, err = client.RoleAdd(ctx, EmbedEtcdRootUser); err != nil {
+		return nil, etcd, err
+	}
+	if _, err = client.UserAdd(ctx, EmbedEtcdRootUser, EmbedEtcdRootPassword); err != nil {
+		return nil, etcd, err
+	}
+	if _, err = client.UserGrantRole(ctx, EmbedEtcdRootUser, EmbedEtcdRootUser); err != nil {
+		return nil, etcd, err
+	}
+	if _, err = client.AuthEnable(ctx); err != nil {
+		return nil, etcd, err
+	}
+	rootClient, err := clientv3.New(clientv3.Config{
+		Endpoints:   []string{etcd.Clients[0].Addr().String()},
+		DialTimeout: embedEtcdTimeout,
+		Username:    EmbedEtcdRootUser,
+		Password:    EmbedEtcdRootPassword,
+	})
+	return rootClient, etcd, err
+}
+
+// freePortURL 获取本机一个空闲端口的地址
+func freePortURL() (url.URL, error) {
+	listener, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		return url.URL{}, err
+	}
+	defer listener.Close()
+	return url.URL{Scheme: "http", Host: listener.Addr().String()}, nil
+}
+
+func startEmbedEtcd(cfg *embed.Config) (*clientv3.Client, *embed.Etcd, error) {
 	etcd, err := embed.StartEtcd(cfg)
 	if err != nil {
 		return nil, nil, err
@@ -49,10 +142,10 @@ func StartEmbedEtcdClient(ctx context.Context) (*clientv3.Client, *embed.Etcd, e
 	case <-etcd.Server.ReadyNotify():
 		client, err := clientv3.New(clientv3.Config{
 			Endpoints:   []string{etcd.Clients[0].Addr().String()},
-			DialTimeout: time.Second,
+			DialTimeout: embedEtcdTimeout,
 		})
 		return client, etcd, err
-	case <-time.After(30 * time.Second):
+	case <-time.After(embedEtcdTimeout):
 		return nil, etcd, fmt.Errorf("embeddedEtcd server took too long to start")
 	}
 }